@@ -15,6 +15,7 @@ import (
 
 	"api-gateway/internal/config"
 	"api-gateway/internal/handler"
+	"api-gateway/internal/kafka"
 	"api-gateway/internal/middleware"
 	habitspb "api-gateway/proto/habits/v1"
 	userpb "api-gateway/proto/user/v1"
@@ -25,6 +26,9 @@ type App struct {
 	cfg        *config.Config
 	httpServer *http.Server
 	grpcConns  []*grpc.ClientConn
+	jwksCache  *middleware.JWKSCache
+	tokenCache *middleware.TokenCache
+	consumer   *kafka.RevocationConsumer
 }
 
 // New creates a new application
@@ -81,13 +85,27 @@ func (a *App) initGRPCClients() error {
 func (a *App) initHTTPServer() error {
 	userClient := userpb.NewUserServiceClient(a.grpcConns[0])
 	habitsClient := habitspb.NewHabitServiceClient(a.grpcConns[1])
+	analyticsClient := habitspb.NewHabitAnalyticsServiceClient(a.grpcConns[1])
 
-	authMiddleware := middleware.NewAuthMiddleware(userClient)
+	a.jwksCache = middleware.NewJWKSCache(userClient, a.cfg.JWT.JWKSRefreshInterval)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := a.jwksCache.Refresh(ctx); err != nil {
+		log.Printf("Warning: initial JWKS fetch failed, keys will be fetched on first request: %v", err)
+	}
+
+	a.tokenCache = middleware.NewTokenCache(a.cfg.Auth.ValidationCacheTTL)
+	a.consumer = kafka.NewRevocationConsumer(&a.cfg.Kafka, a.tokenCache)
+
+	authMiddleware := middleware.NewAuthMiddleware(userClient, a.jwksCache, a.tokenCache, a.cfg.JWT.Issuer)
 
 	userHandler := handler.NewUserHandler(userClient)
 	habitHandler := handler.NewHabitHandler(habitsClient)
+	analyticsHandler := handler.NewAnalyticsHandler(analyticsClient)
+	jwksHandler := handler.NewJWKSHandler(a.jwksCache)
 
-	router := handler.NewRouter(userHandler, habitHandler, authMiddleware)
+	router := handler.NewRouter(userHandler, habitHandler, analyticsHandler, jwksHandler, authMiddleware)
 	httpHandler := router.Setup()
 
 	a.httpServer = &http.Server{
@@ -106,6 +124,17 @@ func (a *App) Run() error {
 	log.Println("Starting rate limit cleanup routine")
 	middleware.CleanupVisitors()
 
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	a.jwksCache.Start(bgCtx)
+	a.tokenCache.Start(bgCtx)
+
+	go func() {
+		if err := a.consumer.Start(bgCtx); err != nil {
+			log.Printf("Session revocation consumer error: %v", err)
+		}
+	}()
+
 	go func() {
 		log.Printf("Starting HTTP server on %s", a.httpServer.Addr)
 		if err := a.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
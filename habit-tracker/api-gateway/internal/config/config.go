@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"go.uber.org/config"
 )
@@ -12,6 +13,8 @@ type Config struct {
 	HTTP    HTTPConfig    `yaml:"http"`
 	GRPC    GRPCConfig    `yaml:"grpc"`
 	JWT     JWTConfig     `yaml:"jwt"`
+	Auth    AuthConfig    `yaml:"auth"`
+	Kafka   KafkaConfig   `yaml:"kafka"`
 	Logging LoggingConfig `yaml:"logging"`
 	Metrics MetricsConfig `yaml:"metrics"`
 }
@@ -36,7 +39,18 @@ type GRPCConfig struct {
 }
 
 type JWTConfig struct {
-	Secret string `yaml:"secret"`
+	Issuer              string        `yaml:"issuer"`
+	JWKSRefreshInterval time.Duration `yaml:"jwks_refresh_interval"`
+}
+
+type AuthConfig struct {
+	ValidationCacheTTL time.Duration `yaml:"validation_cache_ttl"`
+}
+
+type KafkaConfig struct {
+	Brokers []string `yaml:"brokers"`
+	Topic   string   `yaml:"topic"`
+	GroupID string   `yaml:"group_id"`
 }
 
 type LoggingConfig struct {
@@ -85,8 +99,11 @@ func (c *Config) overrideFromEnv() {
 	if val := os.Getenv("BAD_HABITS_SERVICE_ADDR"); val != "" {
 		c.GRPC.BadHabitsServiceAddr = val
 	}
-	if val := os.Getenv("JWT_SECRET"); val != "" {
-		c.JWT.Secret = val
+	if val := os.Getenv("KAFKA_BROKER"); val != "" {
+		c.Kafka.Brokers = []string{val}
+	}
+	if val := os.Getenv("JWT_ISSUER"); val != "" {
+		c.JWT.Issuer = val
 	}
 }
 
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// ListAchievements retrieves the XP, level and achievements of the authenticated user
+// @Summary List achievements
+// @Description Get the user's XP and level with every achievement, unlocked or locked, in catalog order. Each confirmation earns 10 XP and every unlocked achievement its own XP. Level n starts at 100*n*(n-1)/2 XP. Achievements are unlocked by confirmations and streaks, the user gets an email for each one
+// @Tags achievements
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{xp=int,level=int,level_xp=int,next_level_xp=int,achievements=[]object{code=string,name=string,description=string,xp=int,unlocked=bool,unlocked_at=string,habit_id=string}}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/achievements [get]
+func (h *HabitHandler) ListAchievements(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListAchievementsRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.ListAchievements(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// AnalyticsHandler handles user analytics HTTP requests
+type AnalyticsHandler struct {
+	analyticsClient pb.HabitAnalyticsServiceClient
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(analyticsClient pb.HabitAnalyticsServiceClient) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsClient: analyticsClient,
+	}
+}
+
+// GetCompletionTrend retrieves the completion rate of all habits over time
+// @Summary Get completion trend
+// @Description Get the completion rate of all habits of the user per day, week or month, with the overall rate and whether it is improving, declining or stable
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param from query string true "First date (YYYY-MM-DD)"
+// @Param to query string true "Last date, inclusive (YYYY-MM-DD), at most 731 days after from"
+// @Param granularity query string false "Bucket size: day, week (default) or month"
+// @Success 200 {object} object{points=[]object{period_start=string,completed=int,missed=int,completion_rate=number},overall_rate=number,direction=string,slope=number}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/analytics/trend [get]
+func (h *AnalyticsHandler) GetCompletionTrend(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	fromDate := r.URL.Query().Get("from")
+	toDate := r.URL.Query().Get("to")
+	if fromDate == "" || toDate == "" {
+		http.Error(w, "from and to are required", http.StatusBadRequest)
+		return
+	}
+
+	var granularity pb.TrendGranularity
+	switch r.URL.Query().Get("granularity") {
+	case "", "week":
+		granularity = pb.TrendGranularity_TREND_GRANULARITY_WEEK
+	case "day":
+		granularity = pb.TrendGranularity_TREND_GRANULARITY_DAY
+	case "month":
+		granularity = pb.TrendGranularity_TREND_GRANULARITY_MONTH
+	default:
+		http.Error(w, "granularity must be day, week or month", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetCompletionTrendRequest{
+		UserId:      userID,
+		Granularity: granularity,
+		FromDate:    fromDate,
+		ToDate:      toDate,
+	}
+
+	resp, err := h.analyticsClient.GetCompletionTrend(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	points := make([]map[string]interface{}, len(resp.Points))
+	for i, point := range resp.Points {
+		points[i] = map[string]interface{}{
+			"period_start":    point.PeriodStart,
+			"completed":       point.Completed,
+			"missed":          point.Missed,
+			"completion_rate": point.CompletionRate,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"points":       points,
+		"overall_rate": resp.OverallRate,
+		"direction":    strings.ToLower(strings.TrimPrefix(resp.Direction.String(), "TREND_DIRECTION_")),
+		"slope":        resp.Slope,
+	})
+}
+
+// GetWeekdayBreakdown retrieves the completion rate per day of the week
+// @Summary Get weekday breakdown
+// @Description Get the completion rate of all habits of the user per day of the week (0=Sunday) with the best and worst days. Best and worst are omitted until there is any activity
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{weekdays=[]object{weekday=int,completed=int,missed=int,completion_rate=number},best_weekday=int,worst_weekday=int}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/analytics/weekdays [get]
+func (h *AnalyticsHandler) GetWeekdayBreakdown(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetWeekdayBreakdownRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.analyticsClient.GetWeekdayBreakdown(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetHourDistribution retrieves the number of confirmations per hour of the day
+// @Summary Get hour distribution
+// @Description Get the number of confirmations of the user per local hour of the day, 24 entries indexed by hour
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{confirmations=[]int}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/analytics/hours [get]
+func (h *AnalyticsHandler) GetHourDistribution(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetHourDistributionRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.analyticsClient.GetHourDistribution(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetHabitCorrelations retrieves habit pairs often completed on the same day
+// @Summary Get habit correlations
+// @Description Get pairs of active habits most often completed on the same day, scored by the Jaccard index (0-1) of their confirmed days
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Number of pairs (default and max 50)"
+// @Success 200 {object} object{correlations=[]object{habit_a_id=string,habit_a_name=string,habit_b_id=string,habit_b_name=string,days_together=int,score=number}}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/analytics/correlations [get]
+func (h *AnalyticsHandler) GetHabitCorrelations(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcReq := &pb.GetHabitCorrelationsRequest{
+		UserId: userID,
+	}
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit32 := int32(limit)
+		grpcReq.Limit = &limit32
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.analyticsClient.GetHabitCorrelations(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetMoodCorrelations compares the journal mood on days each habit was done and not done
+// @Summary Get mood correlations
+// @Description Get the average journal mood within a date range and, per active habit, the average mood on rated days it was done and not done. Only days since the habit was created count. Habits with the largest difference come first
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param from query string true "First date (YYYY-MM-DD)"
+// @Param to query string true "Last date, inclusive (YYYY-MM-DD), at most 731 days after from"
+// @Success 200 {object} object{rated_days=int,average_mood=number,habits=[]object{habit_id=string,habit_name=string,days_done=int,avg_mood_done=number,days_not_done=int,avg_mood_not_done=number,difference=number}}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/analytics/mood [get]
+func (h *AnalyticsHandler) GetMoodCorrelations(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	fromDate := r.URL.Query().Get("from")
+	toDate := r.URL.Query().Get("to")
+	if fromDate == "" || toDate == "" {
+		http.Error(w, "from and to are required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetMoodCorrelationsRequest{
+		UserId:   userID,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	}
+
+	resp, err := h.analyticsClient.GetMoodCorrelations(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	habits := make([]map[string]interface{}, len(resp.Habits))
+	for i, habit := range resp.Habits {
+		habits[i] = map[string]interface{}{
+			"habit_id":          habit.HabitId,
+			"habit_name":        habit.HabitName,
+			"days_done":         habit.DaysDone,
+			"avg_mood_done":     habit.AvgMoodDone,
+			"days_not_done":     habit.DaysNotDone,
+			"avg_mood_not_done": habit.AvgMoodNotDone,
+			"difference":        habit.Difference,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"rated_days":   resp.RatedDays,
+		"average_mood": resp.AverageMood,
+		"habits":       habits,
+	})
+}
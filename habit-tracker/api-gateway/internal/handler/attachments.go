@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// maxAttachmentUploadSize limits photos, it stays below the gRPC message limit of habits-service
+const maxAttachmentUploadSize = 10 << 20
+
+// UploadAttachment uploads a photo to attach to a confirmation
+// @Summary Upload attachment
+// @Description Upload a JPEG or PNG photo as proof for a confirmation, then pass its ID in attachment_ids when confirming a habit. A thumbnail is generated and both count against the user's storage quota. Uploads not attached within a day are deleted, as are the photos of deleted confirmations
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "JPEG or PNG image, at most 10 MB and 40 megapixels"
+// @Success 201 {object} object{id=string,content_type=string,size_bytes=int,width=int,height=int,confirmation_id=string,created_at=string}
+// @Failure 400 {object} object{error=string} "Invalid image or quota exceeded"
+// @Failure 401 {object} object{error=string}
+// @Failure 413 {object} object{error=string}
+// @Router /api/v1/attachments/upload [post]
+func (h *HabitHandler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Leaves room for the multipart framing around the file
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentUploadSize+1<<20)
+	if err := r.ParseMultipartForm(maxAttachmentUploadSize); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "File too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "file is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxAttachmentUploadSize {
+		http.Error(w, "File too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Failed to read file", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.UploadAttachmentRequest{
+		UserId: userID,
+		Data:   data,
+	}
+
+	resp, err := h.habitClient.UploadAttachment(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(attachmentResponse(resp.Attachment))
+}
+
+// GetAttachment downloads a photo or its thumbnail
+// @Summary Get attachment
+// @Description Download a photo, or its JPEG thumbnail with the longer side at most 320 pixels. Own uploads are always readable, photos attached to a confirmation also by everyone who can read the habit (accepted partners, followers for friends-only and anyone for public habits)
+// @Tags attachments
+// @Produce image/jpeg
+// @Produce image/png
+// @Security BearerAuth
+// @Param id query string true "Attachment ID"
+// @Param thumbnail query bool false "Download the thumbnail instead of the original"
+// @Success 200 {file} binary
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/attachments/get [get]
+func (h *HabitHandler) GetAttachment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	attachmentID := r.URL.Query().Get("id")
+	if attachmentID == "" {
+		http.Error(w, "Attachment ID is required", http.StatusBadRequest)
+		return
+	}
+
+	thumbnail := false
+	if value := r.URL.Query().Get("thumbnail"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			http.Error(w, "thumbnail must be a boolean", http.StatusBadRequest)
+			return
+		}
+		thumbnail = parsed
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), downloadTimeout)
+	defer cancel()
+
+	grpcReq := &pb.DownloadAttachmentRequest{
+		UserId:       userID,
+		AttachmentId: attachmentID,
+		Thumbnail:    thumbnail,
+	}
+
+	stream, err := h.habitClient.DownloadAttachment(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// Errors such as an unknown attachment arrive with the first message
+	chunk, err := stream.Recv()
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// Slow clients may take longer than the server write timeout allows
+	http.NewResponseController(w).SetWriteDeadline(time.Now().Add(downloadTimeout))
+
+	contentType := "application/octet-stream"
+	if chunk.ContentType != nil {
+		contentType = *chunk.ContentType
+	}
+
+	// Attachments never change, only their owner may see them
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "private, max-age=86400, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		if _, err := w.Write(chunk.Data); err != nil {
+			return
+		}
+
+		chunk, err = stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			// Headers are already sent, the client sees a truncated body
+			log.Printf("Attachment download interrupted: %v", err)
+			return
+		}
+	}
+}
+
+// GetAttachmentUsage retrieves the storage used by attachments
+// @Summary Get attachment usage
+// @Description Get the storage used by the photos and thumbnails of the authenticated user and the quota
+// @Tags attachments
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{used_bytes=int,quota_bytes=int}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/attachments/usage [get]
+func (h *HabitHandler) GetAttachmentUsage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetAttachmentUsageRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.GetAttachmentUsage(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"used_bytes":  resp.UsedBytes,
+		"quota_bytes": resp.QuotaBytes,
+	})
+}
+
+// attachmentResponse converts an attachment to JSON response
+func attachmentResponse(attachment *pb.Attachment) map[string]interface{} {
+	response := map[string]interface{}{
+		"id":           attachment.Id,
+		"content_type": attachment.ContentType,
+		"size_bytes":   attachment.SizeBytes,
+		"width":        attachment.Width,
+		"height":       attachment.Height,
+		"created_at":   attachment.CreatedAt.AsTime(),
+	}
+	if attachment.ConfirmationId != nil {
+		response["confirmation_id"] = *attachment.ConfirmationId
+	}
+
+	return response
+}
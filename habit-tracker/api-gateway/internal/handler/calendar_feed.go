@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// calendarFeedPath is the subscription path of ICS feeds, the token is passed as query parameter
+const calendarFeedPath = "/api/v1/calendar/habits.ics"
+
+// RotateCalendarFeedToken creates a new ICS subscription URL
+// @Summary Rotate calendar feed URL
+// @Description Create a secret subscription URL for Google Calendar, Apple Calendar and other iCalendar clients. Rotating invalidates the previous URL. The URL is only shown once, rotate again to get a new one
+// @Tags calendar
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{token=string,url=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/habits/calendar-feed/rotate [post]
+func (h *HabitHandler) RotateCalendarFeedToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.RotateCalendarFeedTokenRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.RotateCalendarFeedToken(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	feedURL := url.URL{
+		Scheme:   requestScheme(r),
+		Host:     r.Host,
+		Path:     calendarFeedPath,
+		RawQuery: url.Values{"token": {resp.Token}}.Encode(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(map[string]string{
+		"token": resp.Token,
+		"url":   feedURL.String(),
+	})
+}
+
+// RevokeCalendarFeedToken disables the ICS subscription URL
+// @Summary Revoke calendar feed URL
+// @Description Disable the calendar subscription URL of the user until a new one is created
+// @Tags calendar
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{message=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/calendar-feed/revoke [delete]
+func (h *HabitHandler) RevokeCalendarFeedToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.RevokeCalendarFeedTokenRequest{
+		UserId: userID,
+	}
+
+	_, err := h.habitClient.RevokeCalendarFeedToken(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Calendar feed revoked successfully",
+	})
+}
+
+// GetCalendarFeed serves the ICS subscription of a user
+// @Summary Get calendar feed
+// @Description iCalendar feed of the active habits of the token's user. Every habit is a recurring event on its due dates in the habit's timezone, completed occurrences of the last 90 days are marked confirmed with a check mark. Authenticated by the secret token instead of a bearer token
+// @Tags calendar
+// @Produce text/calendar
+// @Param token query string true "Calendar feed token"
+// @Success 200 {string} string "iCalendar document"
+// @Failure 400 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/calendar/habits.ics [get]
+func (h *HabitHandler) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "Token is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetCalendarFeedRequest{
+		Token: token,
+	}
+
+	resp, err := h.habitClient.GetCalendarFeed(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="habits.ics"`)
+	// The URL carries the secret, keep responses out of shared caches
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.Write([]byte(resp.Calendar))
+}
+
+// requestScheme returns the scheme the client used, honoring a TLS-terminating proxy
+func requestScheme(r *http.Request) string {
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto == "https" || proto == "http" {
+		return proto
+	}
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
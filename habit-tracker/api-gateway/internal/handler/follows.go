@@ -0,0 +1,397 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// FollowUser sends a follow request
+// @Summary Follow user
+// @Description Send a follow request to another user by username. Once accepted, the follower can read the user's friends-only habits and gets their confirmations and milestones in the activity feed
+// @Tags follows
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{username=string} true "Follow user request"
+// @Success 201 {object} object{follower_id=string,followee_id=string,followee_username=string,status=string,created_at=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 409 {object} object{error=string}
+// @Router /api/v1/follows/create [post]
+func (h *HabitHandler) FollowUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		Username string `json:"username"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Username == "" {
+		http.Error(w, "username is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.FollowUserRequest{
+		UserId:   userID,
+		Username: req.Username,
+	}
+
+	resp, err := h.habitClient.FollowUser(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp.Follow)
+}
+
+// ListFollowers retrieves the followers of the authenticated user
+// @Summary List followers
+// @Description Get the accepted followers of the user, newest first
+// @Tags follows
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{followers=[]object{follower_id=string,follower_username=string,status=string,created_at=string,responded_at=string}}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/follows/followers [get]
+func (h *HabitHandler) ListFollowers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListFollowersRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.ListFollowers(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListFollowing retrieves the users the authenticated user follows
+// @Summary List followed users
+// @Description Get the users the user follows and the follow requests still awaiting an answer, newest first
+// @Tags follows
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{following=[]object{followee_id=string,followee_username=string,status=string,created_at=string,responded_at=string}}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/follows/following [get]
+func (h *HabitHandler) ListFollowing(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListFollowingRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.ListFollowing(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListFollowRequests retrieves the follow requests sent to the authenticated user
+// @Summary List follow requests
+// @Description Get the follow requests awaiting the user's answer, newest first
+// @Tags follows
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{requests=[]object{follower_id=string,follower_username=string,status=string,created_at=string}}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/follows/requests [get]
+func (h *HabitHandler) ListFollowRequests(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListFollowRequestsRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.ListFollowRequests(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// RespondToFollowRequest accepts or declines a follow request
+// @Summary Respond to follow request
+// @Description Accept or decline a pending follow request. A declined request is deleted, the user can send a new one
+// @Tags follows
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{follower_id=string,accept=bool} true "Respond to follow request"
+// @Success 200 {object} object{follow=object{follower_id=string,follower_username=string,status=string,responded_at=string}}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/follows/respond [post]
+func (h *HabitHandler) RespondToFollowRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		FollowerID string `json:"follower_id"`
+		Accept     bool   `json:"accept"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.FollowerID == "" {
+		http.Error(w, "follower_id is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.RespondToFollowRequestRequest{
+		UserId:     userID,
+		FollowerId: req.FollowerID,
+		Accept:     req.Accept,
+	}
+
+	resp, err := h.habitClient.RespondToFollowRequest(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// UnfollowUser stops following a user
+// @Summary Unfollow user
+// @Description Stop following a user or withdraw a follow request. Their activity is removed from the user's feed
+// @Tags follows
+// @Produce json
+// @Security BearerAuth
+// @Param user_id query string true "ID of the followed user"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/follows/unfollow [delete]
+func (h *HabitHandler) UnfollowUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	followeeID := r.URL.Query().Get("user_id")
+	if followeeID == "" {
+		http.Error(w, "User ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.UnfollowUserRequest{
+		UserId:     userID,
+		FolloweeId: followeeID,
+	}
+
+	_, err := h.habitClient.UnfollowUser(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Unfollowed user successfully",
+	})
+}
+
+// RemoveFollower removes a follower
+// @Summary Remove follower
+// @Description Remove one of the user's followers. They no longer see friends-only habits or get the user's activity
+// @Tags follows
+// @Produce json
+// @Security BearerAuth
+// @Param user_id query string true "ID of the follower"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/follows/followers/remove [delete]
+func (h *HabitHandler) RemoveFollower(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	followerID := r.URL.Query().Get("user_id")
+	if followerID == "" {
+		http.Error(w, "User ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.RemoveFollowerRequest{
+		UserId:     userID,
+		FollowerId: followerID,
+	}
+
+	_, err := h.habitClient.RemoveFollower(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Follower removed successfully",
+	})
+}
+
+// GetActivityFeed retrieves the activity of users the authenticated user follows
+// @Summary Get activity feed
+// @Description Get confirmations and milestones of followed users, newest first. Only habits shared with friends or the public appear, items disappear when a habit is made private or the user is unfollowed. next_page_token is omitted on the last page
+// @Tags feed
+// @Produce json
+// @Security BearerAuth
+// @Param page_size query int false "Items per page (default 20, max 100)"
+// @Param page_token query string false "next_page_token of the previous page"
+// @Success 200 {object} object{items=[]object{id=string,kind=int,actor_id=string,actor_username=string,habit_id=string,habit_name=string,confirmed_for_date=string,streak=int,achievement_code=string,achievement_name=string,occurred_at=string},next_page_token=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/feed [get]
+func (h *HabitHandler) GetActivityFeed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcReq := &pb.GetActivityFeedRequest{
+		UserId:    userID,
+		PageToken: r.URL.Query().Get("page_token"),
+	}
+
+	if pageSizeStr := r.URL.Query().Get("page_size"); pageSizeStr != "" {
+		pageSize, err := strconv.ParseInt(pageSizeStr, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid page_size", http.StatusBadRequest)
+			return
+		}
+		pageSize32 := int32(pageSize)
+		grpcReq.PageSize = &pageSize32
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.habitClient.GetActivityFeed(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	resp.Items = visibleFeedItems(resp.Items, userID)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,457 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// CreateChallenge creates a group challenge
+// @Summary Create challenge
+// @Description Create a time-boxed challenge, e.g. "30 days of running". Share its invite code so others can join with one of their habits. The creator joins the same way. The window lasts at most 365 days and must not end in the past
+// @Tags challenges
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{name=string,description=string,start_date=string,end_date=string} true "Create challenge request"
+// @Success 201 {object} object{id=string,name=string,description=string,start_date=string,end_date=string,invite_code=string,participant_count=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/challenges/create [post]
+func (h *HabitHandler) CreateChallenge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		Name        string  `json:"name"`
+		Description *string `json:"description"`
+		StartDate   string  `json:"start_date"`
+		EndDate     string  `json:"end_date"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Name == "" || req.StartDate == "" || req.EndDate == "" {
+		http.Error(w, "name, start_date and end_date are required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.CreateChallengeRequest{
+		UserId:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+	}
+
+	resp, err := h.habitClient.CreateChallenge(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp.Challenge)
+}
+
+// ListChallenges retrieves the challenges of the authenticated user
+// @Summary List challenges
+// @Description Get the challenges the user created or joined, latest start first
+// @Tags challenges
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{challenges=[]object{id=string,name=string,start_date=string,end_date=string,invite_code=string,participant_count=int}}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/challenges/list [get]
+func (h *HabitHandler) ListChallenges(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListChallengesRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.ListChallenges(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetChallenge retrieves a challenge
+// @Summary Get challenge
+// @Description Get a challenge the user created or joined
+// @Tags challenges
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Challenge ID"
+// @Success 200 {object} object{id=string,creator_id=string,name=string,description=string,start_date=string,end_date=string,invite_code=string,participant_count=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/challenges/get [get]
+func (h *HabitHandler) GetChallenge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	challengeID := r.URL.Query().Get("id")
+	if challengeID == "" {
+		http.Error(w, "Challenge ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetChallengeRequest{
+		ChallengeId: challengeID,
+		UserId:      userID,
+	}
+
+	resp, err := h.habitClient.GetChallenge(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp.Challenge)
+}
+
+// UpdateChallenge updates a challenge
+// @Summary Update challenge
+// @Description Update a challenge the user created. Empty description clears it. Changing the dates recalculates the leaderboard, regenerating the invite code makes the old one stop working
+// @Tags challenges
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Challenge ID"
+// @Param request body object{name=string,description=string,start_date=string,end_date=string,regenerate_invite_code=bool} true "Update challenge request"
+// @Success 200 {object} object{id=string,name=string,description=string,start_date=string,end_date=string,invite_code=string,participant_count=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/challenges/update [put]
+func (h *HabitHandler) UpdateChallenge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	challengeID := r.URL.Query().Get("id")
+	if challengeID == "" {
+		http.Error(w, "Challenge ID is required", http.StatusBadRequest)
+		return
+	}
+
+	var req struct {
+		Name                 *string `json:"name"`
+		Description          *string `json:"description"`
+		StartDate            *string `json:"start_date"`
+		EndDate              *string `json:"end_date"`
+		RegenerateInviteCode bool    `json:"regenerate_invite_code"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.UpdateChallengeRequest{
+		ChallengeId:          challengeID,
+		UserId:               userID,
+		Name:                 req.Name,
+		Description:          req.Description,
+		StartDate:            req.StartDate,
+		EndDate:              req.EndDate,
+		RegenerateInviteCode: req.RegenerateInviteCode,
+	}
+
+	resp, err := h.habitClient.UpdateChallenge(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp.Challenge)
+}
+
+// DeleteChallenge deletes a challenge
+// @Summary Delete challenge
+// @Description Delete a challenge the user created together with its participants and leaderboard. Habits of the participants are kept
+// @Tags challenges
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Challenge ID"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/challenges/delete [delete]
+func (h *HabitHandler) DeleteChallenge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	challengeID := r.URL.Query().Get("id")
+	if challengeID == "" {
+		http.Error(w, "Challenge ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.DeleteChallengeRequest{
+		ChallengeId: challengeID,
+		UserId:      userID,
+	}
+
+	_, err := h.habitClient.DeleteChallenge(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Challenge deleted successfully",
+	})
+}
+
+// JoinChallenge joins a challenge by invite code
+// @Summary Join challenge
+// @Description Join a challenge that hasn't ended with one of the user's active habits. Confirmations of the habit for dates inside the challenge window count toward the leaderboard, including ones made before joining
+// @Tags challenges
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{invite_code=string,habit_id=string} true "Join challenge request"
+// @Success 200 {object} object{id=string,name=string,start_date=string,end_date=string,invite_code=string,participant_count=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Failure 409 {object} object{error=string}
+// @Router /api/v1/challenges/join [post]
+func (h *HabitHandler) JoinChallenge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		InviteCode string `json:"invite_code"`
+		HabitID    string `json:"habit_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.InviteCode == "" || req.HabitID == "" {
+		http.Error(w, "invite_code and habit_id are required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.JoinChallengeRequest{
+		UserId:     userID,
+		InviteCode: req.InviteCode,
+		HabitId:    req.HabitID,
+	}
+
+	resp, err := h.habitClient.JoinChallenge(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp.Challenge)
+}
+
+// LeaveChallenge leaves a challenge
+// @Summary Leave challenge
+// @Description Leave a challenge and drop off its leaderboard. The habit and its confirmations are kept
+// @Tags challenges
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Challenge ID"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/challenges/leave [post]
+func (h *HabitHandler) LeaveChallenge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	challengeID := r.URL.Query().Get("id")
+	if challengeID == "" {
+		http.Error(w, "Challenge ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.LeaveChallengeRequest{
+		ChallengeId: challengeID,
+		UserId:      userID,
+	}
+
+	_, err := h.habitClient.LeaveChallenge(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Left challenge successfully",
+	})
+}
+
+// GetChallengeLeaderboard retrieves the leaderboard of a challenge
+// @Summary Get challenge leaderboard
+// @Description Get participants ranked by confirmations inside the challenge window, then by current streak. Equal scores share a rank. "me" is the user's own position, missing when the user only created the challenge
+// @Tags challenges
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Challenge ID"
+// @Param offset query int false "Number of entries to skip"
+// @Param limit query int false "Maximum number of entries (default 50, max 100)"
+// @Success 200 {object} object{entries=[]object{rank=int,user_id=string,username=string,completions=int,current_streak=int},me=object{rank=int,user_id=string,username=string,completions=int,current_streak=int},total_participants=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/challenges/leaderboard [get]
+func (h *HabitHandler) GetChallengeLeaderboard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	challengeID := r.URL.Query().Get("id")
+	if challengeID == "" {
+		http.Error(w, "Challenge ID is required", http.StatusBadRequest)
+		return
+	}
+
+	grpcReq := &pb.GetChallengeLeaderboardRequest{
+		ChallengeId: challengeID,
+		UserId:      userID,
+	}
+
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		offset, err := strconv.ParseInt(offsetStr, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid offset", http.StatusBadRequest)
+			return
+		}
+		grpcReq.Offset = int32(offset)
+	}
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		grpcReq.Limit = int32(limit)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.habitClient.GetChallengeLeaderboard(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,365 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// ReorderHabits sets the manual order of habits
+// @Summary Reorder habits
+// @Description Move the listed habits to the top of the manual order in the given order. Habits not listed keep their relative order after them. The whole reorder is applied atomically
+// @Tags habits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{habit_ids=[]string} true "Reorder habits request"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/reorder [put]
+func (h *HabitHandler) ReorderHabits(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		HabitIDs []string `json:"habit_ids"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ReorderHabitsRequest{
+		UserId:   userID,
+		HabitIds: req.HabitIDs,
+	}
+
+	_, err := h.habitClient.ReorderHabits(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Habits reordered successfully",
+	})
+}
+
+// ListHabitTags retrieves the tags of the authenticated user
+// @Summary List habit tags
+// @Description Get all tags of the user with the number of habits carrying each, ordered by tag
+// @Tags habits
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{tags=[]object{tag=string,habit_count=int}}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/habits/tags [get]
+func (h *HabitHandler) ListHabitTags(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListHabitTagsRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.ListHabitTags(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CreateHabitGroup handles habit group creation
+// @Summary Create a habit group
+// @Description Create a named group of habits, e.g. "Morning routine". Names are unique per user, new groups go last
+// @Tags habit-groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{name=string,color=string,icon=string} true "Create habit group request"
+// @Success 201 {object} object{id=string,name=string,color=string,icon=string,position=int,habit_count=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 409 {object} object{error=string}
+// @Router /api/v1/habits/groups/create [post]
+func (h *HabitHandler) CreateHabitGroup(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		Name  string  `json:"name"`
+		Color *string `json:"color"`
+		Icon  *string `json:"icon"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.CreateHabitGroupRequest{
+		UserId: userID,
+		Name:   req.Name,
+		Color:  req.Color,
+		Icon:   req.Icon,
+	}
+
+	resp, err := h.habitClient.CreateHabitGroup(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp.Group)
+}
+
+// ListHabitGroups retrieves the habit groups of the authenticated user
+// @Summary List habit groups
+// @Description Get all habit groups of the user in manual order with the number of habits in each
+// @Tags habit-groups
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{groups=[]object}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/habits/groups/list [get]
+func (h *HabitHandler) ListHabitGroups(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListHabitGroupsRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.ListHabitGroups(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// UpdateHabitGroup updates a habit group
+// @Summary Update habit group
+// @Description Rename or restyle a habit group. Empty color or icon clears it
+// @Tags habit-groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Group ID"
+// @Param request body object{name=string,color=string,icon=string} true "Update habit group request"
+// @Success 200 {object} object{id=string,name=string,color=string,icon=string,position=int,habit_count=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Failure 409 {object} object{error=string}
+// @Router /api/v1/habits/groups/update [put]
+func (h *HabitHandler) UpdateHabitGroup(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	groupID := r.URL.Query().Get("id")
+	if groupID == "" {
+		http.Error(w, "Group ID is required", http.StatusBadRequest)
+		return
+	}
+
+	var req struct {
+		Name  *string `json:"name"`
+		Color *string `json:"color"`
+		Icon  *string `json:"icon"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.UpdateHabitGroupRequest{
+		GroupId: groupID,
+		UserId:  userID,
+		Name:    req.Name,
+		Color:   req.Color,
+		Icon:    req.Icon,
+	}
+
+	resp, err := h.habitClient.UpdateHabitGroup(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp.Group)
+}
+
+// DeleteHabitGroup deletes a habit group
+// @Summary Delete habit group
+// @Description Delete a habit group. Its habits are kept without a group
+// @Tags habit-groups
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Group ID"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/groups/delete [delete]
+func (h *HabitHandler) DeleteHabitGroup(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	groupID := r.URL.Query().Get("id")
+	if groupID == "" {
+		http.Error(w, "Group ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.DeleteHabitGroupRequest{
+		GroupId: groupID,
+		UserId:  userID,
+	}
+
+	_, err := h.habitClient.DeleteHabitGroup(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Habit group deleted successfully",
+	})
+}
+
+// ReorderHabitGroups sets the manual order of habit groups
+// @Summary Reorder habit groups
+// @Description Move the listed groups to the top in the given order. Groups not listed keep their relative order after them
+// @Tags habit-groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{group_ids=[]string} true "Reorder habit groups request"
+// @Success 200 {object} object{groups=[]object}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/groups/reorder [put]
+func (h *HabitHandler) ReorderHabitGroups(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		GroupIDs []string `json:"group_ids"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ReorderHabitGroupsRequest{
+		UserId:   userID,
+		GroupIds: req.GroupIDs,
+	}
+
+	resp, err := h.habitClient.ReorderHabitGroups(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
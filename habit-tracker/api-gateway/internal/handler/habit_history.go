@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// historyFormats maps the format query parameter to the export format and its content type
+var historyFormats = map[string]struct {
+	format      pb.HistoryExportFormat
+	contentType string
+}{
+	"csv":  {pb.HistoryExportFormat_HISTORY_EXPORT_FORMAT_CSV, "text/csv; charset=utf-8"},
+	"json": {pb.HistoryExportFormat_HISTORY_EXPORT_FORMAT_JSON, "application/json"},
+}
+
+// ExportHabitHistory downloads habits and their confirmation history
+// @Summary Export habit history
+// @Description Download the habits of the user and their confirmations over a date range as a spreadsheet. CSV has one row per confirmation, JSON lists the habits and their confirmations. Unlike the full data export this is generated on the fly
+// @Tags habits
+// @Produce text/csv
+// @Produce json
+// @Security BearerAuth
+// @Param format query string false "csv (default) or json"
+// @Param habit_id query string false "Only export this habit"
+// @Param from query string false "First date (YYYY-MM-DD), open if not set"
+// @Param to query string false "Last date (YYYY-MM-DD), open if not set"
+// @Success 200 {file} binary
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/export [get]
+func (h *HabitHandler) ExportHabitHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+
+	formatName := strings.ToLower(query.Get("format"))
+	if formatName == "" {
+		formatName = "csv"
+	}
+	format, ok := historyFormats[formatName]
+	if !ok {
+		http.Error(w, "format must be csv or json", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), downloadTimeout)
+	defer cancel()
+
+	grpcReq := &pb.ExportHabitHistoryRequest{
+		UserId: userID,
+		Format: format.format,
+	}
+	if habitID := query.Get("habit_id"); habitID != "" {
+		grpcReq.HabitId = &habitID
+	}
+	if from := query.Get("from"); from != "" {
+		grpcReq.FromDate = &from
+	}
+	if to := query.Get("to"); to != "" {
+		grpcReq.ToDate = &to
+	}
+
+	stream, err := h.habitClient.ExportHabitHistory(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// Validation errors such as an unknown habit arrive with the first message
+	chunk, err := stream.Recv()
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// Long histories may take longer than the server write timeout allows
+	http.NewResponseController(w).SetWriteDeadline(time.Now().Add(downloadTimeout))
+
+	fileName := "habit-history." + formatName
+	if chunk.FileName != nil {
+		fileName = *chunk.FileName
+	}
+
+	w.Header().Set("Content-Type", format.contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		if _, err := w.Write(chunk.Data); err != nil {
+			return
+		}
+
+		chunk, err = stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			// Headers are already sent, the client sees a truncated body
+			log.Printf("Habit history export interrupted: %v", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// maxImportUploadSize limits import files, it stays below the gRPC message limit of habits-service
+const maxImportUploadSize = 10 << 20
+
+// importSources maps the source form field to the tracker an import file was exported from
+var importSources = map[string]pb.HabitImportSource{
+	"loop":     pb.HabitImportSource_HABIT_IMPORT_SOURCE_LOOP,
+	"habitica": pb.HabitImportSource_HABIT_IMPORT_SOURCE_HABITICA,
+	"streaks":  pb.HabitImportSource_HABIT_IMPORT_SOURCE_STREAKS,
+}
+
+// ImportHabits imports habits and their history from another tracker
+// @Summary Import habits
+// @Description Upload an export of Loop Habit Tracker (ZIP or Checkmarks.csv), Habitica (user data JSON) or Streaks (CSV). Habits are mapped to schedules and their history is imported with recomputed streaks. Habits named like an existing habit are skipped. With dry_run the mapping and skipped rows are returned without saving anything, otherwise a background job is started, poll its progress with the status endpoint
+// @Tags import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Export file, at most 10 MB"
+// @Param source formData string true "loop, habitica or streaks"
+// @Param timezone formData string true "IANA timezone the history was recorded in, e.g. Europe/Berlin"
+// @Param dry_run formData bool false "Only report the mapping"
+// @Success 200 {object} object{status=string,dry_run=bool,total_habits=int,total_confirmations=int,skipped_rows=int,habits=[]object{name=string,schedule_type=string,interval_days=int,weekly_days=[]int,confirmations=int,first_date=string,last_date=string,note=string},issues=[]object{row=int,habit_name=string,reason=string}} "Dry run"
+// @Success 202 {object} object{id=string,status=string,total_habits=int,total_confirmations=int,skipped_rows=int,habits_imported=int,confirmations_imported=int,issues=[]object{row=int,habit_name=string,reason=string},created_at=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 409 {object} object{error=string} "Another import is in progress"
+// @Failure 413 {object} object{error=string}
+// @Router /api/v1/habits/import [post]
+func (h *HabitHandler) ImportHabits(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Leaves room for the other form fields next to the file
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportUploadSize+1<<20)
+	if err := r.ParseMultipartForm(maxImportUploadSize); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "File too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	source, ok := importSources[strings.ToLower(r.FormValue("source"))]
+	if !ok {
+		http.Error(w, "source must be loop, habitica or streaks", http.StatusBadRequest)
+		return
+	}
+
+	timezone := r.FormValue("timezone")
+	if timezone == "" {
+		http.Error(w, "timezone is required", http.StatusBadRequest)
+		return
+	}
+
+	dryRun := false
+	if value := r.FormValue("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			http.Error(w, "dry_run must be a boolean", http.StatusBadRequest)
+			return
+		}
+		dryRun = parsed
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "file is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxImportUploadSize {
+		http.Error(w, "File too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Failed to read file", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ImportHabitsRequest{
+		UserId:   userID,
+		Source:   source,
+		Data:     data,
+		Timezone: timezone,
+		DryRun:   dryRun,
+	}
+
+	resp, err := h.habitClient.ImportHabits(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	statusCode := http.StatusAccepted
+	if dryRun {
+		statusCode = http.StatusOK
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(habitImportResponse(resp.Import))
+}
+
+// GetHabitImport returns the progress of a habit import
+// @Summary Get habit import status
+// @Description Get the progress of an import job of the authenticated user, with the rows and habits that were skipped
+// @Tags import
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Import ID"
+// @Success 200 {object} object{id=string,source=string,status=string,total_habits=int,total_confirmations=int,skipped_rows=int,habits_imported=int,confirmations_imported=int,issues=[]object{row=int,habit_name=string,reason=string},error=string,created_at=string,finished_at=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/import/status [get]
+func (h *HabitHandler) GetHabitImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	importID := r.URL.Query().Get("id")
+	if importID == "" {
+		http.Error(w, "Import ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetHabitImportRequest{
+		UserId:   userID,
+		ImportId: importID,
+	}
+
+	resp, err := h.habitClient.GetHabitImport(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(habitImportResponse(resp.Import))
+}
+
+// habitImportResponse converts a habit import to JSON response
+func habitImportResponse(habitImport *pb.HabitImport) map[string]interface{} {
+	resp := map[string]interface{}{
+		"source":                 strings.ToLower(strings.TrimPrefix(habitImport.Source.String(), "HABIT_IMPORT_SOURCE_")),
+		"status":                 strings.ToLower(strings.TrimPrefix(habitImport.Status.String(), "HABIT_IMPORT_STATUS_")),
+		"dry_run":                habitImport.DryRun,
+		"total_habits":           habitImport.TotalHabits,
+		"total_confirmations":    habitImport.TotalConfirmations,
+		"skipped_rows":           habitImport.SkippedRows,
+		"habits_imported":        habitImport.HabitsImported,
+		"confirmations_imported": habitImport.ConfirmationsImported,
+		"created_at":             habitImport.CreatedAt.AsTime(),
+	}
+
+	if habitImport.Id != "" {
+		resp["id"] = habitImport.Id
+	}
+	if habitImport.Error != nil {
+		resp["error"] = *habitImport.Error
+	}
+	if habitImport.FinishedAt != nil {
+		resp["finished_at"] = habitImport.FinishedAt.AsTime()
+	}
+
+	if len(habitImport.Habits) > 0 {
+		habits := make([]map[string]interface{}, len(habitImport.Habits))
+		for i, habit := range habitImport.Habits {
+			habits[i] = map[string]interface{}{
+				"name":          habit.Name,
+				"description":   habit.Description,
+				"color":         habit.Color,
+				"schedule_type": strings.ToLower(strings.TrimPrefix(habit.ScheduleType.String(), "SCHEDULE_TYPE_")),
+				"interval_days": habit.IntervalDays,
+				"weekly_days":   habit.WeeklyDays,
+				"confirmations": habit.Confirmations,
+				"first_date":    habit.FirstDate,
+				"last_date":     habit.LastDate,
+				"note":          habit.Note,
+			}
+		}
+		resp["habits"] = habits
+	}
+
+	issues := make([]map[string]interface{}, len(habitImport.Issues))
+	for i, issue := range habitImport.Issues {
+		issues[i] = map[string]interface{}{
+			"row":        issue.Row,
+			"habit_name": issue.HabitName,
+			"reason":     issue.Reason,
+		}
+	}
+	resp["issues"] = issues
+
+	return resp
+}
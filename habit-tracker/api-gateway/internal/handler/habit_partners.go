@@ -0,0 +1,365 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// InviteHabitPartner invites an accountability partner on a habit
+// @Summary Invite habit partner
+// @Description Invite another user by username as an accountability partner on an active habit. Accepted partners can read the habit, its stats and calendar, nudge the owner and get an email when the streak breaks. A user who declined can be invited again after 30 days
+// @Tags habit-partners
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Habit ID"
+// @Param request body object{username=string} true "Invite habit partner request"
+// @Success 201 {object} object{id=string,habit_id=string,habit_name=string,owner_username=string,partner_username=string,status=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Failure 409 {object} object{error=string}
+// @Failure 429 {object} object{error=string}
+// @Router /api/v1/habits/partners/invite [post]
+func (h *HabitHandler) InviteHabitPartner(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	habitID := r.URL.Query().Get("id")
+	if habitID == "" {
+		http.Error(w, "Habit ID is required", http.StatusBadRequest)
+		return
+	}
+
+	var req struct {
+		Username string `json:"username"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Username == "" {
+		http.Error(w, "username is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.InviteHabitPartnerRequest{
+		HabitId:  habitID,
+		UserId:   userID,
+		Username: req.Username,
+	}
+
+	resp, err := h.habitClient.InviteHabitPartner(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp.Partner)
+}
+
+// ListHabitPartners retrieves the partners of a habit
+// @Summary List habit partners
+// @Description Get the pending and accepted partners of one of the user's habits, oldest first
+// @Tags habit-partners
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Habit ID"
+// @Success 200 {object} object{partners=[]object{id=string,partner_username=string,status=string,last_nudged_at=string,created_at=string,responded_at=string}}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/partners/list [get]
+func (h *HabitHandler) ListHabitPartners(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	habitID := r.URL.Query().Get("id")
+	if habitID == "" {
+		http.Error(w, "Habit ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListHabitPartnersRequest{
+		HabitId: habitID,
+		UserId:  userID,
+	}
+
+	resp, err := h.habitClient.ListHabitPartners(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ListPartnerInvitations retrieves invitations sent to the authenticated user
+// @Summary List partner invitations
+// @Description Get the pending invitations to become an accountability partner, newest first
+// @Tags habit-partners
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{invitations=[]object{id=string,habit_id=string,habit_name=string,owner_username=string,created_at=string}}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/habits/partners/invitations [get]
+func (h *HabitHandler) ListPartnerInvitations(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListPartnerInvitationsRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.ListPartnerInvitations(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// RespondToPartnerInvitation accepts or declines a partner invitation
+// @Summary Respond to partner invitation
+// @Description Accept or decline a pending invitation to become an accountability partner
+// @Tags habit-partners
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{invitation_id=string,accept=bool} true "Respond to partner invitation request"
+// @Success 200 {object} object{id=string,habit_id=string,habit_name=string,owner_username=string,status=string,responded_at=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/partners/respond [post]
+func (h *HabitHandler) RespondToPartnerInvitation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		InvitationID string `json:"invitation_id"`
+		Accept       bool   `json:"accept"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.InvitationID == "" {
+		http.Error(w, "invitation_id is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.RespondToPartnerInvitationRequest{
+		InvitationId: req.InvitationID,
+		UserId:       userID,
+		Accept:       req.Accept,
+	}
+
+	resp, err := h.habitClient.RespondToPartnerInvitation(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp.Partner)
+}
+
+// RemoveHabitPartner ends a partnership
+// @Summary Remove habit partner
+// @Description End a partnership or withdraw an invitation. The owner of the habit removes a partner, a partner leaves
+// @Tags habit-partners
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Partnership ID"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/partners/remove [delete]
+func (h *HabitHandler) RemoveHabitPartner(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	partnershipID := r.URL.Query().Get("id")
+	if partnershipID == "" {
+		http.Error(w, "Partnership ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.RemoveHabitPartnerRequest{
+		PartnershipId: partnershipID,
+		UserId:        userID,
+	}
+
+	_, err := h.habitClient.RemoveHabitPartner(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Habit partner removed successfully",
+	})
+}
+
+// ListSharedHabits retrieves habits shared with the authenticated user
+// @Summary List shared habits
+// @Description Get the active habits of other users the user is an accepted partner on, ordered by owner. Group, position and tags of the habits are left out
+// @Tags habit-partners
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{habits=[]object{partnership_id=string,owner_username=string,habit=object{id=string,name=string,streak=int,confirmed_for_current_period=bool,is_paused=bool}}}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/habits/shared [get]
+func (h *HabitHandler) ListSharedHabits(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListSharedHabitsRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.habitClient.ListSharedHabits(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	for _, shared := range resp.Habits {
+		redactForeignHabit(shared.Habit, userID)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// NudgeHabit nudges the owner of a shared habit
+// @Summary Nudge habit owner
+// @Description Send the owner of a shared habit an email reminder. Only for accepted partners, at most once every 12 hours per partner, and not while the habit is done for the current period or paused
+// @Tags habit-partners
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Habit ID"
+// @Success 200 {object} object{nudged_at=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Failure 429 {object} object{error=string}
+// @Router /api/v1/habits/nudge [post]
+func (h *HabitHandler) NudgeHabit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	habitID := r.URL.Query().Get("id")
+	if habitID == "" {
+		http.Error(w, "Habit ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.NudgeHabitRequest{
+		HabitId: habitID,
+		UserId:  userID,
+	}
+
+	resp, err := h.habitClient.NudgeHabit(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
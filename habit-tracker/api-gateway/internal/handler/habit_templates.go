@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// ListHabitTemplates retrieves habit templates available to the authenticated user
+// @Summary List habit templates
+// @Description Get the template catalog ordered by category and name, followed by the user's private templates
+// @Tags habit-templates
+// @Produce json
+// @Security BearerAuth
+// @Param category query string false "Only catalog templates of this category, e.g. health, fitness, mindfulness, learning, productivity"
+// @Success 200 {object} object{templates=[]object{id=string,is_private=bool,name=string,description=string,color=string,icon=string,category=string,tags=[]string,schedule_type=string,interval_days=int,weekly_days=[]int,suggested_target=string}}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/habits/templates [get]
+func (h *HabitHandler) ListHabitTemplates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcReq := &pb.ListHabitTemplatesRequest{
+		UserId: userID,
+	}
+
+	if category := r.URL.Query().Get("category"); category != "" {
+		grpcReq.Category = &category
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.habitClient.ListHabitTemplates(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// CreateHabitFromTemplate creates a habit from a template
+// @Summary Create habit from template
+// @Description Create a habit with the name, description, color, icon, tags and schedule of a catalog or private template
+// @Tags habit-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{template_id=string,timezone=string,name=string,group_id=string} true "Create habit from template request, name overrides the template name"
+// @Success 201 {object} object{id=string,name=string,schedule_type=string,streak=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/templates/use [post]
+func (h *HabitHandler) CreateHabitFromTemplate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		TemplateID string  `json:"template_id"`
+		Timezone   string  `json:"timezone"` // IANA timezone string
+		Name       *string `json:"name"`
+		GroupID    *string `json:"group_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.TemplateID == "" {
+		http.Error(w, "template_id is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.CreateHabitFromTemplateRequest{
+		TemplateId: req.TemplateID,
+		UserId:     userID,
+		Timezone:   req.Timezone,
+		Name:       req.Name,
+		GroupId:    req.GroupID,
+	}
+
+	resp, err := h.habitClient.CreateHabitFromTemplate(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp.Habit)
+}
+
+// SaveHabitAsTemplate saves a habit as a private template
+// @Summary Save habit as template
+// @Description Save the name, description, color, icon, tags and schedule of one of the user's habits as a private template
+// @Tags habit-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Habit ID"
+// @Param request body object{name=string,suggested_target=string} false "Optional template name (defaults to the habit name) and suggested target"
+// @Success 201 {object} object{id=string,is_private=bool,name=string,schedule_type=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/templates/save [post]
+func (h *HabitHandler) SaveHabitAsTemplate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	habitID := r.URL.Query().Get("id")
+	if habitID == "" {
+		http.Error(w, "Habit ID is required", http.StatusBadRequest)
+		return
+	}
+
+	var req struct {
+		Name            *string `json:"name"`
+		SuggestedTarget *string `json:"suggested_target"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		// The body is optional, so ignore decode errors
+		req.Name = nil
+		req.SuggestedTarget = nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.SaveHabitAsTemplateRequest{
+		HabitId:         habitID,
+		UserId:          userID,
+		Name:            req.Name,
+		SuggestedTarget: req.SuggestedTarget,
+	}
+
+	resp, err := h.habitClient.SaveHabitAsTemplate(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp.Template)
+}
+
+// DeleteHabitTemplate deletes a private template
+// @Summary Delete habit template
+// @Description Delete a private template of the user. Catalog templates can't be deleted
+// @Tags habit-templates
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Template ID"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/templates/delete [delete]
+func (h *HabitHandler) DeleteHabitTemplate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	templateID := r.URL.Query().Get("id")
+	if templateID == "" {
+		http.Error(w, "Template ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.DeleteHabitTemplateRequest{
+		TemplateId: templateID,
+		UserId:     userID,
+	}
+
+	_, err := h.habitClient.DeleteHabitTemplate(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Habit template deleted successfully",
+	})
+}
@@ -31,7 +31,7 @@ func NewHabitHandler(habitClient pb.HabitServiceClient) *HabitHandler {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param request body object{name=string,description=string,color=string,schedule_type=string,interval_days=int,weekly_days=[]int,timezone=string} true "Create habit request"
+// @Param request body object{name=string,description=string,color=string,schedule_type=string,interval_days=int,weekly_days=[]int,timezone=string,group_id=string,icon=string,tags=[]string,visibility=string} true "Create habit request. visibility is private (default), friends or public"
 // @Success 201 {object} object{message=string,habit=object}
 // @Failure 400 {object} object{error=string}
 // @Failure 401 {object} object{error=string}
@@ -50,13 +50,17 @@ func (h *HabitHandler) CreateHabit(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		Name         string  `json:"name"`
-		Description  *string `json:"description"`
-		Color        *string `json:"color"`
-		ScheduleType string  `json:"schedule_type"` // "interval" or "weekly"
-		IntervalDays *int32  `json:"interval_days"`
-		WeeklyDays   []int32 `json:"weekly_days"`
-		Timezone     string  `json:"timezone"` // IANA timezone string
+		Name         string   `json:"name"`
+		Description  *string  `json:"description"`
+		Color        *string  `json:"color"`
+		ScheduleType string   `json:"schedule_type"` // "interval" or "weekly"
+		IntervalDays *int32   `json:"interval_days"`
+		WeeklyDays   []int32  `json:"weekly_days"`
+		Timezone     string   `json:"timezone"` // IANA timezone string
+		GroupID      *string  `json:"group_id"`
+		Icon         *string  `json:"icon"`
+		Tags         []string `json:"tags"`
+		Visibility   *string  `json:"visibility"` // "private" (default), "friends" or "public"
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -100,6 +104,18 @@ func (h *HabitHandler) CreateHabit(w http.ResponseWriter, r *http.Request) {
 		IntervalDays: intervalDays,
 		WeeklyDays:   weeklyDays,
 		Timezone:     req.Timezone,
+		GroupId:      req.GroupID,
+		Icon:         req.Icon,
+		Tags:         req.Tags,
+	}
+
+	if req.Visibility != nil {
+		visibility, ok := parseVisibility(*req.Visibility)
+		if !ok {
+			http.Error(w, "Invalid visibility", http.StatusBadRequest)
+			return
+		}
+		grpcReq.Visibility = &visibility
 	}
 
 	resp, err := h.habitClient.CreateHabit(ctx, grpcReq)
@@ -115,7 +131,7 @@ func (h *HabitHandler) CreateHabit(w http.ResponseWriter, r *http.Request) {
 
 // GetHabit retrieves a single habit by ID
 // @Summary Get habit by ID
-// @Description Retrieve a single habit by its ID
+// @Description Retrieve a single habit by its ID. Other users can read active public habits, friends-only habits of users they follow and habits they are accepted partners on. Group, position and tags are only returned to the owner
 // @Tags habits
 // @Produce json
 // @Security BearerAuth
@@ -157,18 +173,27 @@ func (h *HabitHandler) GetHabit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	redactForeignHabit(resp.Habit, userID)
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp.Habit)
 }
 
-// ListHabits retrieves all habits for the authenticated user
-// @Summary List all habits
-// @Description Get all habits for the authenticated user
+// ListHabits retrieves habits for the authenticated user
+// @Summary List habits
+// @Description Get habits for the authenticated user filtered by status, group and tag in manual order, new habits first. next_page_token is omitted on the last page
 // @Tags habits
 // @Produce json
 // @Security BearerAuth
-// @Param active_only query boolean false "Filter only active habits"
-// @Success 200 {object} object{habits=[]object,total_count=int}
+// @Param status query string false "Filter by status: active, archived or all"
+// @Param active_only query boolean false "Deprecated, use status=active"
+// @Param group_id query string false "Group ID, or none for habits without a group"
+// @Param tag query string false "Tag"
+// @Param page_size query int false "Habits per page (default 50, max 200)"
+// @Param page_token query string false "next_page_token of the previous page"
+// @Param include_total query boolean false "Include total_count"
+// @Success 200 {object} object{habits=[]object,total_count=int,next_page_token=string}
+// @Failure 400 {object} object{error=string}
 // @Failure 401 {object} object{error=string}
 // @Failure 500 {object} object{error=string}
 // @Router /api/v1/habits/list [get]
@@ -186,13 +211,49 @@ func (h *HabitHandler) ListHabits(w http.ResponseWriter, r *http.Request) {
 
 	activeOnly := r.URL.Query().Get("active_only") == "true"
 
+	var statusFilter pb.HabitStatusFilter
+	switch strings.ToLower(r.URL.Query().Get("status")) {
+	case "":
+		statusFilter = pb.HabitStatusFilter_HABIT_STATUS_FILTER_UNSPECIFIED
+	case "active":
+		statusFilter = pb.HabitStatusFilter_HABIT_STATUS_FILTER_ACTIVE
+	case "archived":
+		statusFilter = pb.HabitStatusFilter_HABIT_STATUS_FILTER_ARCHIVED
+	case "all":
+		statusFilter = pb.HabitStatusFilter_HABIT_STATUS_FILTER_ALL
+	default:
+		http.Error(w, "Invalid status", http.StatusBadRequest)
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	activeOnlyPtr := &activeOnly
 	grpcReq := &pb.ListHabitsRequest{
-		UserId:     userID,
-		ActiveOnly: activeOnlyPtr,
+		UserId:            userID,
+		ActiveOnly:        activeOnlyPtr,
+		Status:            statusFilter,
+		PageToken:         r.URL.Query().Get("page_token"),
+		IncludeTotalCount: r.URL.Query().Get("include_total") == "true",
+	}
+
+	if groupID := r.URL.Query().Get("group_id"); groupID != "" {
+		grpcReq.GroupId = &groupID
+	}
+
+	if tag := r.URL.Query().Get("tag"); tag != "" {
+		grpcReq.Tag = &tag
+	}
+
+	if pageSizeStr := r.URL.Query().Get("page_size"); pageSizeStr != "" {
+		pageSize, err := strconv.ParseInt(pageSizeStr, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid page_size", http.StatusBadRequest)
+			return
+		}
+		pageSize32 := int32(pageSize)
+		grpcReq.PageSize = &pageSize32
 	}
 
 	resp, err := h.habitClient.ListHabits(ctx, grpcReq)
@@ -205,6 +266,56 @@ func (h *HabitHandler) ListHabits(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+// GetTodayAgenda retrieves the dashboard of habits for today
+// @Summary Get today's agenda
+// @Description Get active habits grouped into due, at_risk, done and upcoming for the local date of each habit, ordered by deadline
+// @Tags habits
+// @Produce json
+// @Security BearerAuth
+// @Param at_risk_hours query int false "Habits due within this many hours are at risk (default 3, max 24)"
+// @Success 200 {object} object{due=[]object,at_risk=[]object,done=[]object,upcoming=[]object}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/habits/today [get]
+func (h *HabitHandler) GetTodayAgenda(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	grpcReq := &pb.GetTodayAgendaRequest{
+		UserId: userID,
+	}
+
+	if atRiskStr := r.URL.Query().Get("at_risk_hours"); atRiskStr != "" {
+		atRiskHours, err := strconv.ParseInt(atRiskStr, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid at_risk_hours", http.StatusBadRequest)
+			return
+		}
+		hours := int32(atRiskHours)
+		grpcReq.AtRiskHours = &hours
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.habitClient.GetTodayAgenda(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 // UpdateHabit updates an existing habit
 // @Summary Update habit
 // @Description Update an existing habit's properties
@@ -213,7 +324,7 @@ func (h *HabitHandler) ListHabits(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Security BearerAuth
 // @Param id query string true "Habit ID"
-// @Param request body object{name=string,description=string,color=string,schedule_type=string,interval_days=int,weekly_days=[]int,timezone=string} true "Update habit request"
+// @Param request body object{name=string,description=string,color=string,schedule_type=string,interval_days=int,weekly_days=[]int,timezone=string,group_id=string,icon=string,tags=[]string,visibility=string} true "Update habit request. Empty group_id ungroups the habit, empty icon clears it, tags replace all tags when present. visibility is private, friends or public"
 // @Success 200 {object} object{message=string,habit=object}
 // @Failure 400 {object} object{error=string}
 // @Failure 401 {object} object{error=string}
@@ -238,13 +349,17 @@ func (h *HabitHandler) UpdateHabit(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		Name         *string `json:"name"`
-		Description  *string `json:"description"`
-		Color        *string `json:"color"`
-		ScheduleType *string `json:"schedule_type"`
-		IntervalDays *int32  `json:"interval_days"`
-		WeeklyDays   []int32 `json:"weekly_days"`
-		Timezone     *string `json:"timezone"`
+		Name         *string   `json:"name"`
+		Description  *string   `json:"description"`
+		Color        *string   `json:"color"`
+		ScheduleType *string   `json:"schedule_type"`
+		IntervalDays *int32    `json:"interval_days"`
+		WeeklyDays   []int32   `json:"weekly_days"`
+		Timezone     *string   `json:"timezone"`
+		GroupID      *string   `json:"group_id"`
+		Icon         *string   `json:"icon"`
+		Tags         *[]string `json:"tags"`
+		Visibility   *string   `json:"visibility"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -262,6 +377,22 @@ func (h *HabitHandler) UpdateHabit(w http.ResponseWriter, r *http.Request) {
 		Description: req.Description,
 		Color:       req.Color,
 		Timezone:    req.Timezone,
+		GroupId:     req.GroupID,
+		Icon:        req.Icon,
+	}
+
+	if req.Tags != nil {
+		grpcReq.Tags = *req.Tags
+		grpcReq.UpdateTags = true
+	}
+
+	if req.Visibility != nil {
+		visibility, ok := parseVisibility(*req.Visibility)
+		if !ok {
+			http.Error(w, "Invalid visibility", http.StatusBadRequest)
+			return
+		}
+		grpcReq.Visibility = &visibility
 	}
 
 	if req.ScheduleType != nil {
@@ -301,9 +432,9 @@ func (h *HabitHandler) UpdateHabit(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp.Habit)
 }
 
-// DeleteHabit soft deletes a habit
+// DeleteHabit archives a habit
 // @Summary Delete habit
-// @Description Soft delete a habit (mark as inactive)
+// @Description Archive a habit. Kept for backward compatibility, use /api/v1/habits/archive
 // @Tags habits
 // @Produce json
 // @Security BearerAuth
@@ -351,15 +482,320 @@ func (h *HabitHandler) DeleteHabit(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// ArchiveHabit archives a habit
+// @Summary Archive habit
+// @Description Archive a habit. History and streak are kept and deadlines are paused
+// @Tags habits
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Habit ID"
+// @Success 200 {object} object
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/archive [post]
+func (h *HabitHandler) ArchiveHabit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	habitID := r.URL.Query().Get("id")
+	if habitID == "" {
+		http.Error(w, "Habit ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ArchiveHabitRequest{
+		HabitId: habitID,
+		UserId:  userID,
+	}
+
+	resp, err := h.habitClient.ArchiveHabit(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp.Habit)
+}
+
+// UnarchiveHabit restores an archived habit
+// @Summary Unarchive habit
+// @Description Restore an archived habit with its streak and a fresh deadline
+// @Tags habits
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Habit ID"
+// @Success 200 {object} object
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/unarchive [post]
+func (h *HabitHandler) UnarchiveHabit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	habitID := r.URL.Query().Get("id")
+	if habitID == "" {
+		http.Error(w, "Habit ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.UnarchiveHabitRequest{
+		HabitId: habitID,
+		UserId:  userID,
+	}
+
+	resp, err := h.habitClient.UnarchiveHabit(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp.Habit)
+}
+
+// PurgeHabit permanently deletes an archived habit
+// @Summary Purge habit
+// @Description Permanently delete an archived habit and all its confirmations
+// @Tags habits
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Habit ID"
+// @Success 200 {object} object{message=string,deleted_confirmations=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/purge [delete]
+func (h *HabitHandler) PurgeHabit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	habitID := r.URL.Query().Get("id")
+	if habitID == "" {
+		http.Error(w, "Habit ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.PurgeHabitRequest{
+		HabitId: habitID,
+		UserId:  userID,
+	}
+
+	resp, err := h.habitClient.PurgeHabit(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":               "Habit purged successfully",
+		"deleted_confirmations": resp.DeletedConfirmations,
+	})
+}
+
+// PauseHabits starts a vacation for one habit or all habits of the authenticated user
+// @Summary Pause habits
+// @Description Freeze deadlines and keep streaks between start_date and end_date (inclusive, YYYY-MM-DD in habit's timezone). Pauses all active habits when id is omitted
+// @Tags habits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id query string false "Habit ID"
+// @Param request body object{start_date=string,end_date=string,reason=string} true "Pause request"
+// @Success 201 {object} object{pauses=[]object}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/pause [post]
+func (h *HabitHandler) PauseHabits(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		StartDate string  `json:"start_date"`
+		EndDate   string  `json:"end_date"`
+		Reason    *string `json:"reason"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.StartDate == "" || req.EndDate == "" {
+		http.Error(w, "start_date and end_date are required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.PauseHabitsRequest{
+		UserId:    userID,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Reason:    req.Reason,
+	}
+
+	if habitID := r.URL.Query().Get("id"); habitID != "" {
+		grpcReq.HabitId = &habitID
+	}
+
+	resp, err := h.habitClient.PauseHabits(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// ResumeHabits ends ongoing pauses and cancels upcoming ones
+// @Summary Resume habits
+// @Description End ongoing pauses today and cancel upcoming ones. Deadlines restart from now with streaks kept. Resumes all habits when id is omitted
+// @Tags habits
+// @Produce json
+// @Security BearerAuth
+// @Param id query string false "Habit ID"
+// @Success 200 {object} object{resumed_count=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/resume [post]
+func (h *HabitHandler) ResumeHabits(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ResumeHabitsRequest{
+		UserId: userID,
+	}
+
+	if habitID := r.URL.Query().Get("id"); habitID != "" {
+		grpcReq.HabitId = &habitID
+	}
+
+	resp, err := h.habitClient.ResumeHabits(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"resumed_count": resp.ResumedCount,
+	})
+}
+
+// ListHabitPauses retrieves ongoing and upcoming pauses of the authenticated user
+// @Summary List habit pauses
+// @Description Get ongoing and upcoming pauses, optionally for a single habit
+// @Tags habits
+// @Produce json
+// @Security BearerAuth
+// @Param id query string false "Habit ID"
+// @Success 200 {object} object{pauses=[]object}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/habits/pauses [get]
+func (h *HabitHandler) ListHabitPauses(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListHabitPausesRequest{
+		UserId: userID,
+	}
+
+	if habitID := r.URL.Query().Get("id"); habitID != "" {
+		grpcReq.HabitId = &habitID
+	}
+
+	resp, err := h.habitClient.ListHabitPauses(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 // ConfirmHabit confirms habit completion for the current period
 // @Summary Confirm habit completion
-// @Description Mark habit as completed for the current period and increment streak
+// @Description Mark habit as completed for the current period and increment streak. Up to 4 photos uploaded with the attachments endpoint can be attached as proof
 // @Tags habits
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id query string true "Habit ID"
-// @Param request body object{notes=string} false "Optional notes"
+// @Param request body object{notes=string,attachment_ids=[]string} false "Optional notes and attachment IDs"
 // @Success 200 {object} object{message=string,habit=object,confirmation=object}
 // @Failure 400 {object} object{error=string}
 // @Failure 401 {object} object{error=string}
@@ -384,21 +820,24 @@ func (h *HabitHandler) ConfirmHabit(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		Notes *string `json:"notes"`
+		Notes         *string  `json:"notes"`
+		AttachmentIDs []string `json:"attachment_ids"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		// Notes are optional, so ignore decode errors
+		// Notes and attachments are optional, so ignore decode errors
 		req.Notes = nil
+		req.AttachmentIDs = nil
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	grpcReq := &pb.ConfirmHabitRequest{
-		HabitId: habitID,
-		UserId:  userID,
-		Notes:   req.Notes,
+		HabitId:       habitID,
+		UserId:        userID,
+		Notes:         req.Notes,
+		AttachmentIds: req.AttachmentIDs,
 	}
 
 	resp, err := h.habitClient.ConfirmHabit(ctx, grpcReq)
@@ -413,14 +852,15 @@ func (h *HabitHandler) ConfirmHabit(w http.ResponseWriter, r *http.Request) {
 
 // GetHabitHistory retrieves confirmation history for a habit
 // @Summary Get habit confirmation history
-// @Description Retrieve the history of confirmations for a habit with pagination
+// @Description Retrieve the history of confirmations for a habit, newest first. Pauses are included on the first page only, next_page_token is omitted on the last page
 // @Tags habits
 // @Produce json
 // @Security BearerAuth
 // @Param id query string true "Habit ID"
-// @Param limit query int false "Limit (default 30)"
-// @Param offset query int false "Offset (default 0)"
-// @Success 200 {object} object{confirmations=[]object,total_count=int}
+// @Param limit query int false "Confirmations per page (default 30, max 100)"
+// @Param page_token query string false "next_page_token of the previous page"
+// @Param include_total query boolean false "Include total_count"
+// @Success 200 {object} object{confirmations=[]object,total_count=int,pauses=[]object,next_page_token=string}
 // @Failure 400 {object} object{error=string}
 // @Failure 401 {object} object{error=string}
 // @Failure 404 {object} object{error=string}
@@ -444,28 +884,23 @@ func (h *HabitHandler) GetHabitHistory(w http.ResponseWriter, r *http.Request) {
 	}
 
 	limitStr := r.URL.Query().Get("limit")
-	offsetStr := r.URL.Query().Get("offset")
 
-	var limit, offset int32 = 30, 0
+	var limit int32 = 30
 	if limitStr != "" {
 		if l, err := strconv.ParseInt(limitStr, 10, 32); err == nil {
 			limit = int32(l)
 		}
 	}
-	if offsetStr != "" {
-		if o, err := strconv.ParseInt(offsetStr, 10, 32); err == nil {
-			offset = int32(o)
-		}
-	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	grpcReq := &pb.GetHabitHistoryRequest{
-		HabitId: habitID,
-		UserId:  userID,
-		Limit:   &limit,
-		Offset:  &offset,
+		HabitId:           habitID,
+		UserId:            userID,
+		Limit:             &limit,
+		PageToken:         r.URL.Query().Get("page_token"),
+		IncludeTotalCount: r.URL.Query().Get("include_total") == "true",
 	}
 
 	resp, err := h.habitClient.GetHabitHistory(ctx, grpcReq)
@@ -478,14 +913,90 @@ func (h *HabitHandler) GetHabitHistory(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+// GetHabitCalendar retrieves the day-by-day state of a habit for a calendar view
+// @Summary Get habit calendar
+// @Description Get the state of every date in a range (in habit's timezone): done, missed, pending, not_due, frozen or future. States come from the same schedule logic as the stats. Readable by accepted partners, followers and anyone for public habits. Confirmation notes are only returned to the owner, IDs of attached photos to everyone who can read the habit
+// @Tags habits
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Habit ID"
+// @Param from query string true "First date (YYYY-MM-DD)"
+// @Param to query string true "Last date, inclusive (YYYY-MM-DD), at most 366 days after from"
+// @Success 200 {object} object{days=[]object{date=string,state=string,due=bool,notes=string,attachment_ids=[]string}}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/habits/calendar [get]
+func (h *HabitHandler) GetHabitCalendar(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	habitID := r.URL.Query().Get("id")
+	if habitID == "" {
+		http.Error(w, "Habit ID is required", http.StatusBadRequest)
+		return
+	}
+
+	fromDate := r.URL.Query().Get("from")
+	toDate := r.URL.Query().Get("to")
+	if fromDate == "" || toDate == "" {
+		http.Error(w, "from and to are required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetHabitCalendarRequest{
+		HabitId:  habitID,
+		UserId:   userID,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	}
+
+	resp, err := h.habitClient.GetHabitCalendar(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	days := make([]map[string]interface{}, len(resp.Days))
+	for i, day := range resp.Days {
+		days[i] = map[string]interface{}{
+			"date":  day.Date,
+			"state": strings.ToLower(strings.TrimPrefix(day.State.String(), "CALENDAR_DAY_STATE_")),
+			"due":   day.Due,
+		}
+		if day.Notes != nil {
+			days[i]["notes"] = *day.Notes
+		}
+		if len(day.AttachmentIds) > 0 {
+			days[i]["attachment_ids"] = day.AttachmentIds
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"days": days,
+	})
+}
+
 // GetHabitStats retrieves statistics for a habit
 // @Summary Get habit statistics
-// @Description Get statistics including current streak, longest streak, total confirmations, and completion rate
+// @Description Get statistics computed against the periods expected by the habit's schedule history: streaks, completion rate (paused periods excluded) and per-week and per-month rates. Readable by accepted partners
 // @Tags habits
 // @Produce json
 // @Security BearerAuth
 // @Param id query string true "Habit ID"
-// @Success 200 {object} object{current_streak=int,longest_streak=int,total_confirmations=int,completion_rate=number,first_confirmation=string,last_confirmation=string}
+// @Success 200 {object} object{current_streak=int,longest_streak=int,total_confirmations=int,completion_rate=number,first_confirmation=string,last_confirmation=string,paused_days=int,expected_periods=int,completed_periods=int,weekly_rates=[]object{period_start=string,expected=int,completed=int,completion_rate=number},monthly_rates=[]object{period_start=string,expected=int,completed=int,completion_rate=number}}
 // @Failure 400 {object} object{error=string}
 // @Failure 401 {object} object{error=string}
 // @Failure 404 {object} object{error=string}
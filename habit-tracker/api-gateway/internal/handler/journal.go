@@ -0,0 +1,334 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/internal/middleware"
+	pb "api-gateway/proto/habits/v1"
+)
+
+// SaveJournalEntry creates or replaces the journal entry of a day
+// @Summary Save journal entry
+// @Description Create or replace the journal entry of a day with mood and energy ratings (1-5), tags and free text. The entry can be linked to confirmations of the same day. Fields left out are cleared
+// @Tags journal
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{date=string,mood=int,energy=int,content=string,tags=[]string,confirmation_ids=[]string} true "Journal entry, date as YYYY-MM-DD in the user's timezone, content at most 10000 characters"
+// @Success 200 {object} object{id=string,date=string,mood=int,energy=int,content=string,tags=[]string,confirmation_ids=[]string,created_at=string,updated_at=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/journal/save [put]
+func (h *HabitHandler) SaveJournalEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		Date            string   `json:"date"`
+		Mood            *int32   `json:"mood"`
+		Energy          *int32   `json:"energy"`
+		Content         *string  `json:"content"`
+		Tags            []string `json:"tags"`
+		ConfirmationIDs []string `json:"confirmation_ids"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Date == "" {
+		http.Error(w, "date is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.SaveJournalEntryRequest{
+		UserId:          userID,
+		Date:            req.Date,
+		Mood:            req.Mood,
+		Energy:          req.Energy,
+		Content:         req.Content,
+		Tags:            req.Tags,
+		ConfirmationIds: req.ConfirmationIDs,
+	}
+
+	resp, err := h.habitClient.SaveJournalEntry(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(journalEntryResponse(resp.Entry))
+}
+
+// GetJournalEntry retrieves the journal entry of a day
+// @Summary Get journal entry
+// @Description Get the journal entry of the authenticated user for a day
+// @Tags journal
+// @Produce json
+// @Security BearerAuth
+// @Param date query string true "Date (YYYY-MM-DD)"
+// @Success 200 {object} object{id=string,date=string,mood=int,energy=int,content=string,tags=[]string,confirmation_ids=[]string,created_at=string,updated_at=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/journal/get [get]
+func (h *HabitHandler) GetJournalEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	date := r.URL.Query().Get("date")
+	if date == "" {
+		http.Error(w, "date is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetJournalEntryRequest{
+		UserId: userID,
+		Date:   date,
+	}
+
+	resp, err := h.habitClient.GetJournalEntry(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(journalEntryResponse(resp.Entry))
+}
+
+// ListJournalEntries retrieves the journal entries within a date range
+// @Summary List journal entries
+// @Description Get the journal entries of the authenticated user within a date range, oldest first
+// @Tags journal
+// @Produce json
+// @Security BearerAuth
+// @Param from query string true "First date (YYYY-MM-DD)"
+// @Param to query string true "Last date, inclusive (YYYY-MM-DD), at most 366 days after from"
+// @Param tag query string false "Only entries with this tag"
+// @Success 200 {object} object{entries=[]object{id=string,date=string,mood=int,energy=int,content=string,tags=[]string,confirmation_ids=[]string,created_at=string,updated_at=string}}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/journal/list [get]
+func (h *HabitHandler) ListJournalEntries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	fromDate := r.URL.Query().Get("from")
+	toDate := r.URL.Query().Get("to")
+	if fromDate == "" || toDate == "" {
+		http.Error(w, "from and to are required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ListJournalEntriesRequest{
+		UserId:   userID,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	}
+	if tag := r.URL.Query().Get("tag"); tag != "" {
+		grpcReq.Tag = &tag
+	}
+
+	resp, err := h.habitClient.ListJournalEntries(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	entries := make([]map[string]interface{}, len(resp.Entries))
+	for i, entry := range resp.Entries {
+		entries[i] = journalEntryResponse(entry)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"entries": entries,
+	})
+}
+
+// DeleteJournalEntry deletes the journal entry of a day
+// @Summary Delete journal entry
+// @Description Delete the journal entry of the authenticated user for a day. Linked confirmations are kept
+// @Tags journal
+// @Produce json
+// @Security BearerAuth
+// @Param date query string true "Date (YYYY-MM-DD)"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/journal/delete [delete]
+func (h *HabitHandler) DeleteJournalEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	date := r.URL.Query().Get("date")
+	if date == "" {
+		http.Error(w, "date is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.DeleteJournalEntryRequest{
+		UserId: userID,
+		Date:   date,
+	}
+
+	_, err := h.habitClient.DeleteJournalEntry(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Journal entry deleted successfully",
+	})
+}
+
+// SearchNotes searches journal entries and confirmation notes
+// @Summary Search notes
+// @Description Full-text search over the journal entries and confirmation notes of the authenticated user, best matches first. Words are not stemmed, so the search works for any language. Matches in the snippet are wrapped in **
+// @Tags journal
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Search query: words, \"quoted phrases\", OR and -excluded words, at most 200 characters"
+// @Param limit query int false "Number of results (default 20, max 100)"
+// @Success 200 {object} object{results=[]object{kind=string,id=string,date=string,habit_id=string,snippet=string,rank=number}}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Router /api/v1/journal/search [get]
+func (h *HabitHandler) SearchNotes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		http.Error(w, "q is required", http.StatusBadRequest)
+		return
+	}
+
+	grpcReq := &pb.SearchNotesRequest{
+		UserId: userID,
+		Query:  query,
+	}
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit32 := int32(limit)
+		grpcReq.Limit = &limit32
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.habitClient.SearchNotes(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	results := make([]map[string]interface{}, len(resp.Results))
+	for i, result := range resp.Results {
+		results[i] = map[string]interface{}{
+			"kind":     strings.ToLower(strings.TrimPrefix(result.Kind.String(), "NOTE_KIND_")),
+			"id":       result.Id,
+			"date":     result.Date,
+			"habit_id": result.HabitId,
+			"snippet":  result.Snippet,
+			"rank":     result.Rank,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"results": results,
+	})
+}
+
+// journalEntryResponse converts a journal entry to JSON response
+func journalEntryResponse(entry *pb.JournalEntry) map[string]interface{} {
+	tags := entry.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	confirmationIDs := entry.ConfirmationIds
+	if confirmationIDs == nil {
+		confirmationIDs = []string{}
+	}
+
+	return map[string]interface{}{
+		"id":               entry.Id,
+		"date":             entry.Date,
+		"mood":             entry.Mood,
+		"energy":           entry.Energy,
+		"content":          entry.Content,
+		"tags":             tags,
+		"confirmation_ids": confirmationIDs,
+		"created_at":       entry.CreatedAt.AsTime(),
+		"updated_at":       entry.UpdatedAt.AsTime(),
+	}
+}
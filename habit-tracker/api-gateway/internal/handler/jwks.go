@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"api-gateway/internal/middleware"
+)
+
+// jsonWebKey represents a public key in JSON Web Key format
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// JWKSHandler serves public keys used to verify access tokens
+type JWKSHandler struct {
+	jwksCache *middleware.JWKSCache
+}
+
+// NewJWKSHandler creates a new JWKS handler
+func NewJWKSHandler(jwksCache *middleware.JWKSCache) *JWKSHandler {
+	return &JWKSHandler{
+		jwksCache: jwksCache,
+	}
+}
+
+// GetJWKS returns JSON Web Key Set
+// @Summary Get JSON Web Key Set
+// @Description Get public keys used to verify access tokens
+// @Tags auth
+// @Produce json
+// @Success 200 {object} object{keys=[]object{kid=string,kty=string,alg=string,use=string,crv=string,x=string,n=string,e=string}}
+// @Failure 503 {object} object{error=string}
+// @Router /.well-known/jwks.json [get]
+func (h *JWKSHandler) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	jwks, err := h.jwksCache.JWKS(ctx)
+	if err != nil {
+		http.Error(w, "Key set unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	keys := make([]jsonWebKey, 0, len(jwks))
+	for _, key := range jwks {
+		keys = append(keys, jsonWebKey{
+			Kid: key.Kid,
+			Kty: key.Kty,
+			Alg: key.Alg,
+			Use: key.Use,
+			Crv: key.GetCrv(),
+			X:   key.GetX(),
+			N:   key.GetN(),
+			E:   key.GetE(),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"keys": keys,
+	})
+}
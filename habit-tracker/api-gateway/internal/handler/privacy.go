@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"strings"
+
+	pb "api-gateway/proto/habits/v1"
+)
+
+// parseVisibility maps the visibility of a request body to its proto value
+func parseVisibility(value string) (pb.HabitVisibility, bool) {
+	switch strings.ToLower(value) {
+	case "private":
+		return pb.HabitVisibility_HABIT_VISIBILITY_PRIVATE, true
+	case "friends":
+		return pb.HabitVisibility_HABIT_VISIBILITY_FRIENDS, true
+	case "public":
+		return pb.HabitVisibility_HABIT_VISIBILITY_PUBLIC, true
+	default:
+		return pb.HabitVisibility_HABIT_VISIBILITY_UNSPECIFIED, false
+	}
+}
+
+// redactForeignHabit removes how the owner organizes a habit before it is shown to another user.
+// habits-service decides who can read a habit, the gateway decides what they see of it
+func redactForeignHabit(habit *pb.Habit, userID string) {
+	if habit == nil || habit.UserId == userID {
+		return
+	}
+
+	habit.GroupId = nil
+	habit.Position = 0
+	habit.Tags = nil
+}
+
+// visibleFeedItems drops feed items that must never reach another user, in case habits-service
+// returns activity of a private habit or of the user themselves
+func visibleFeedItems(items []*pb.FeedItem, userID string) []*pb.FeedItem {
+	visible := make([]*pb.FeedItem, 0, len(items))
+	for _, item := range items {
+		if item.ActorId == userID {
+			continue
+		}
+
+		switch item.HabitVisibility {
+		case pb.HabitVisibility_HABIT_VISIBILITY_FRIENDS, pb.HabitVisibility_HABIT_VISIBILITY_PUBLIC:
+			visible = append(visible, item)
+		}
+	}
+	return visible
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	pb "api-gateway/proto/habits/v1"
+)
+
+// publicCacheControl lets browsers and shared caches keep public pages for a few minutes,
+// so a changed opt-in or visibility may take that long to show
+const publicCacheControl = "public, max-age=300"
+
+// GetPublicProfile returns the public profile of a user
+// @Summary Get public profile
+// @Description Get the profile of a user who opted in to a public profile, with their active public habits in manual order. No auth required. Users without a public profile are not found. Responses may be cached for 5 minutes
+// @Tags public
+// @Produce json
+// @Param username query string true "Username"
+// @Success 200 {object} object{username=string,first_name=string,member_since=string,habits=[]object{id=string,name=string,color=string,icon=string,schedule_type=int,interval_days=int,weekly_days=[]int,streak=int,confirmed_for_current_period=bool,created_at=string}}
+// @Success 304 "Not modified"
+// @Failure 400 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/public/profile [get]
+func (h *HabitHandler) GetPublicProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	username := r.URL.Query().Get("username")
+	if username == "" {
+		http.Error(w, "Username is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetPublicProfileRequest{
+		Username: username,
+	}
+
+	resp, err := h.habitClient.GetPublicProfile(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	body, err := json.Marshal(resp.Profile)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writePublic(w, r, "application/json", body)
+}
+
+// GetStreakCard renders a shareable streak card of a public habit
+// @Summary Get streak card
+// @Description Render a 1200x630 card with the current streak of an active public habit for sharing on social media. PNG by default so it can be used as og:image, SVG for embedding on web pages. No auth required. Responses may be cached for 5 minutes
+// @Tags public
+// @Produce image/png
+// @Produce image/svg+xml
+// @Param username query string true "Username"
+// @Param habit_id query string true "Habit ID"
+// @Param format query string false "Image format: png (default) or svg"
+// @Success 200 {file} binary "PNG or SVG image"
+// @Success 304 "Not modified"
+// @Failure 400 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/public/streak-card [get]
+func (h *HabitHandler) GetStreakCard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	username := r.URL.Query().Get("username")
+	if username == "" {
+		http.Error(w, "Username is required", http.StatusBadRequest)
+		return
+	}
+
+	habitID := r.URL.Query().Get("habit_id")
+	if habitID == "" {
+		http.Error(w, "Habit ID is required", http.StatusBadRequest)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "png" && format != "svg" {
+		http.Error(w, "format must be png or svg", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetPublicHabitRequest{
+		Username: username,
+		HabitId:  habitID,
+	}
+
+	resp, err := h.habitClient.GetPublicHabit(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	if format == "svg" {
+		// The SVG carries only inline styles, keep it from loading anything when opened directly
+		w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'")
+		writePublic(w, r, "image/svg+xml", renderStreakCardSVG(resp.Username, resp.Habit))
+		return
+	}
+
+	card, err := renderStreakCard(resp.Username, resp.Habit)
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writePublic(w, r, "image/png", card)
+}
+
+// writePublic writes a cacheable public response with an ETag of its body,
+// answering 304 when the client already has it
+func writePublic(w http.ResponseWriter, r *http.Request, contentType string, body []byte) {
+	sum := sha256.Sum256(body)
+	etag := fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:16]))
+
+	w.Header().Set("Cache-Control", publicCacheControl)
+	w.Header().Set("ETag", etag)
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
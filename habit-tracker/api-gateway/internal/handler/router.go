@@ -10,25 +10,31 @@ import (
 
 // Router sets up HTTP routes
 type Router struct {
-	userHandler    *UserHandler
-	habitHandler   *HabitHandler
-	authMiddleware *middleware.AuthMiddleware
-	mux            *http.ServeMux
+	userHandler      *UserHandler
+	habitHandler     *HabitHandler
+	analyticsHandler *AnalyticsHandler
+	jwksHandler      *JWKSHandler
+	authMiddleware   *middleware.AuthMiddleware
+	mux              *http.ServeMux
 }
 
 // NewRouter creates a new router
-func NewRouter(userHandler *UserHandler, habitHandler *HabitHandler, authMiddleware *middleware.AuthMiddleware) *Router {
+func NewRouter(userHandler *UserHandler, habitHandler *HabitHandler, analyticsHandler *AnalyticsHandler, jwksHandler *JWKSHandler, authMiddleware *middleware.AuthMiddleware) *Router {
 	return &Router{
-		userHandler:    userHandler,
-		habitHandler:   habitHandler,
-		authMiddleware: authMiddleware,
-		mux:            http.NewServeMux(),
+		userHandler:      userHandler,
+		habitHandler:     habitHandler,
+		analyticsHandler: analyticsHandler,
+		jwksHandler:      jwksHandler,
+		authMiddleware:   authMiddleware,
+		mux:              http.NewServeMux(),
 	}
 }
 
 // Setup configures all routes
 func (r *Router) Setup() http.Handler {
 
+	r.mux.HandleFunc("/.well-known/jwks.json", r.jwksHandler.GetJWKS)
+
 	r.mux.HandleFunc("/api/v1/auth/register", r.userHandler.Register)
 	r.mux.HandleFunc("/api/v1/auth/login", r.userHandler.Login)
 	r.mux.HandleFunc("/api/v1/auth/refresh", r.userHandler.RefreshToken)
@@ -36,20 +42,98 @@ func (r *Router) Setup() http.Handler {
 	r.mux.HandleFunc("/api/v1/auth/resend-verification", r.userHandler.ResendVerificationEmail)
 	r.mux.HandleFunc("/api/v1/auth/forgot-password", r.userHandler.ForgotPassword)
 	r.mux.HandleFunc("/api/v1/auth/reset-password", r.userHandler.ResetPassword)
+	r.mux.HandleFunc("/api/v1/auth/magic-link/request", r.userHandler.RequestMagicLink)
+	r.mux.HandleFunc("/api/v1/auth/magic-link/consume", r.userHandler.ConsumeMagicLink)
+	r.mux.HandleFunc("/api/v1/auth/email-change/confirm", r.userHandler.ConfirmEmailChange)
+	r.mux.HandleFunc("/api/v1/auth/email-change/undo", r.userHandler.UndoEmailChange)
+	r.mux.HandleFunc("/api/v1/users/export/download", r.userHandler.DownloadDataExport)
+	r.mux.HandleFunc("/api/v1/public/profile", r.habitHandler.GetPublicProfile)
+	r.mux.HandleFunc("/api/v1/public/streak-card", r.habitHandler.GetStreakCard)
+	r.mux.HandleFunc("/api/v1/calendar/habits.ics", r.habitHandler.GetCalendarFeed)
 
 	r.mux.HandleFunc("/api/v1/auth/logout", r.authMiddleware.Auth(r.userHandler.Logout))
 	r.mux.HandleFunc("/api/v1/users/profile", r.authMiddleware.Auth(r.userHandler.GetProfile))
+	r.mux.HandleFunc("/api/v1/users/profile/update", r.authMiddleware.Auth(r.userHandler.UpdateProfile))
 	r.mux.HandleFunc("/api/v1/users/change-password", r.authMiddleware.Auth(r.userHandler.ChangePassword))
+	r.mux.HandleFunc("/api/v1/users/change-email", r.authMiddleware.Auth(r.userHandler.RequestEmailChange))
 	r.mux.HandleFunc("/api/v1/users/deactivate", r.authMiddleware.Auth(r.userHandler.DeactivateAccount))
+	r.mux.HandleFunc("/api/v1/users/export", r.authMiddleware.Auth(r.userHandler.ExportData))
+	r.mux.HandleFunc("/api/v1/users/export/status", r.authMiddleware.Auth(r.userHandler.GetDataExport))
+	r.mux.HandleFunc("/api/v1/users/account", r.authMiddleware.Auth(r.userHandler.DeleteAccount))
+	r.mux.HandleFunc("/api/v1/users/account/cancel-deletion", r.authMiddleware.Auth(r.userHandler.CancelAccountDeletion))
 
 	r.mux.HandleFunc("/api/v1/habits/create", r.authMiddleware.Auth(r.habitHandler.CreateHabit))
 	r.mux.HandleFunc("/api/v1/habits/list", r.authMiddleware.Auth(r.habitHandler.ListHabits))
 	r.mux.HandleFunc("/api/v1/habits/get", r.authMiddleware.Auth(r.habitHandler.GetHabit))
+	r.mux.HandleFunc("/api/v1/habits/today", r.authMiddleware.Auth(r.habitHandler.GetTodayAgenda))
 	r.mux.HandleFunc("/api/v1/habits/update", r.authMiddleware.Auth(r.habitHandler.UpdateHabit))
 	r.mux.HandleFunc("/api/v1/habits/delete", r.authMiddleware.Auth(r.habitHandler.DeleteHabit))
+	r.mux.HandleFunc("/api/v1/habits/archive", r.authMiddleware.Auth(r.habitHandler.ArchiveHabit))
+	r.mux.HandleFunc("/api/v1/habits/unarchive", r.authMiddleware.Auth(r.habitHandler.UnarchiveHabit))
+	r.mux.HandleFunc("/api/v1/habits/purge", r.authMiddleware.Auth(r.habitHandler.PurgeHabit))
+	r.mux.HandleFunc("/api/v1/habits/pause", r.authMiddleware.Auth(r.habitHandler.PauseHabits))
+	r.mux.HandleFunc("/api/v1/habits/resume", r.authMiddleware.Auth(r.habitHandler.ResumeHabits))
+	r.mux.HandleFunc("/api/v1/habits/pauses", r.authMiddleware.Auth(r.habitHandler.ListHabitPauses))
 	r.mux.HandleFunc("/api/v1/habits/confirm", r.authMiddleware.Auth(r.habitHandler.ConfirmHabit))
 	r.mux.HandleFunc("/api/v1/habits/history", r.authMiddleware.Auth(r.habitHandler.GetHabitHistory))
+	r.mux.HandleFunc("/api/v1/habits/calendar", r.authMiddleware.Auth(r.habitHandler.GetHabitCalendar))
 	r.mux.HandleFunc("/api/v1/habits/stats", r.authMiddleware.Auth(r.habitHandler.GetHabitStats))
+	r.mux.HandleFunc("/api/v1/habits/reorder", r.authMiddleware.Auth(r.habitHandler.ReorderHabits))
+	r.mux.HandleFunc("/api/v1/habits/tags", r.authMiddleware.Auth(r.habitHandler.ListHabitTags))
+	r.mux.HandleFunc("/api/v1/habits/groups/create", r.authMiddleware.Auth(r.habitHandler.CreateHabitGroup))
+	r.mux.HandleFunc("/api/v1/habits/groups/list", r.authMiddleware.Auth(r.habitHandler.ListHabitGroups))
+	r.mux.HandleFunc("/api/v1/habits/groups/update", r.authMiddleware.Auth(r.habitHandler.UpdateHabitGroup))
+	r.mux.HandleFunc("/api/v1/habits/groups/delete", r.authMiddleware.Auth(r.habitHandler.DeleteHabitGroup))
+	r.mux.HandleFunc("/api/v1/habits/groups/reorder", r.authMiddleware.Auth(r.habitHandler.ReorderHabitGroups))
+	r.mux.HandleFunc("/api/v1/habits/templates", r.authMiddleware.Auth(r.habitHandler.ListHabitTemplates))
+	r.mux.HandleFunc("/api/v1/habits/templates/use", r.authMiddleware.Auth(r.habitHandler.CreateHabitFromTemplate))
+	r.mux.HandleFunc("/api/v1/habits/templates/save", r.authMiddleware.Auth(r.habitHandler.SaveHabitAsTemplate))
+	r.mux.HandleFunc("/api/v1/habits/templates/delete", r.authMiddleware.Auth(r.habitHandler.DeleteHabitTemplate))
+	r.mux.HandleFunc("/api/v1/habits/partners/invite", r.authMiddleware.Auth(r.habitHandler.InviteHabitPartner))
+	r.mux.HandleFunc("/api/v1/habits/partners/list", r.authMiddleware.Auth(r.habitHandler.ListHabitPartners))
+	r.mux.HandleFunc("/api/v1/habits/partners/invitations", r.authMiddleware.Auth(r.habitHandler.ListPartnerInvitations))
+	r.mux.HandleFunc("/api/v1/habits/partners/respond", r.authMiddleware.Auth(r.habitHandler.RespondToPartnerInvitation))
+	r.mux.HandleFunc("/api/v1/habits/partners/remove", r.authMiddleware.Auth(r.habitHandler.RemoveHabitPartner))
+	r.mux.HandleFunc("/api/v1/habits/shared", r.authMiddleware.Auth(r.habitHandler.ListSharedHabits))
+	r.mux.HandleFunc("/api/v1/habits/nudge", r.authMiddleware.Auth(r.habitHandler.NudgeHabit))
+	r.mux.HandleFunc("/api/v1/challenges/create", r.authMiddleware.Auth(r.habitHandler.CreateChallenge))
+	r.mux.HandleFunc("/api/v1/challenges/list", r.authMiddleware.Auth(r.habitHandler.ListChallenges))
+	r.mux.HandleFunc("/api/v1/challenges/get", r.authMiddleware.Auth(r.habitHandler.GetChallenge))
+	r.mux.HandleFunc("/api/v1/challenges/update", r.authMiddleware.Auth(r.habitHandler.UpdateChallenge))
+	r.mux.HandleFunc("/api/v1/challenges/delete", r.authMiddleware.Auth(r.habitHandler.DeleteChallenge))
+	r.mux.HandleFunc("/api/v1/challenges/join", r.authMiddleware.Auth(r.habitHandler.JoinChallenge))
+	r.mux.HandleFunc("/api/v1/challenges/leave", r.authMiddleware.Auth(r.habitHandler.LeaveChallenge))
+	r.mux.HandleFunc("/api/v1/challenges/leaderboard", r.authMiddleware.Auth(r.habitHandler.GetChallengeLeaderboard))
+	r.mux.HandleFunc("/api/v1/achievements", r.authMiddleware.Auth(r.habitHandler.ListAchievements))
+	r.mux.HandleFunc("/api/v1/follows/create", r.authMiddleware.Auth(r.habitHandler.FollowUser))
+	r.mux.HandleFunc("/api/v1/follows/followers", r.authMiddleware.Auth(r.habitHandler.ListFollowers))
+	r.mux.HandleFunc("/api/v1/follows/following", r.authMiddleware.Auth(r.habitHandler.ListFollowing))
+	r.mux.HandleFunc("/api/v1/follows/requests", r.authMiddleware.Auth(r.habitHandler.ListFollowRequests))
+	r.mux.HandleFunc("/api/v1/follows/respond", r.authMiddleware.Auth(r.habitHandler.RespondToFollowRequest))
+	r.mux.HandleFunc("/api/v1/follows/unfollow", r.authMiddleware.Auth(r.habitHandler.UnfollowUser))
+	r.mux.HandleFunc("/api/v1/follows/followers/remove", r.authMiddleware.Auth(r.habitHandler.RemoveFollower))
+	r.mux.HandleFunc("/api/v1/feed", r.authMiddleware.Auth(r.habitHandler.GetActivityFeed))
+	r.mux.HandleFunc("/api/v1/habits/calendar-feed/rotate", r.authMiddleware.Auth(r.habitHandler.RotateCalendarFeedToken))
+	r.mux.HandleFunc("/api/v1/habits/calendar-feed/revoke", r.authMiddleware.Auth(r.habitHandler.RevokeCalendarFeedToken))
+	r.mux.HandleFunc("/api/v1/habits/import", r.authMiddleware.Auth(r.habitHandler.ImportHabits))
+	r.mux.HandleFunc("/api/v1/habits/import/status", r.authMiddleware.Auth(r.habitHandler.GetHabitImport))
+	r.mux.HandleFunc("/api/v1/habits/export", r.authMiddleware.Auth(r.habitHandler.ExportHabitHistory))
+
+	r.mux.HandleFunc("/api/v1/journal/save", r.authMiddleware.Auth(r.habitHandler.SaveJournalEntry))
+	r.mux.HandleFunc("/api/v1/journal/get", r.authMiddleware.Auth(r.habitHandler.GetJournalEntry))
+	r.mux.HandleFunc("/api/v1/journal/list", r.authMiddleware.Auth(r.habitHandler.ListJournalEntries))
+	r.mux.HandleFunc("/api/v1/journal/delete", r.authMiddleware.Auth(r.habitHandler.DeleteJournalEntry))
+	r.mux.HandleFunc("/api/v1/journal/search", r.authMiddleware.Auth(r.habitHandler.SearchNotes))
+
+	r.mux.HandleFunc("/api/v1/attachments/upload", r.authMiddleware.Auth(r.habitHandler.UploadAttachment))
+	r.mux.HandleFunc("/api/v1/attachments/get", r.authMiddleware.Auth(r.habitHandler.GetAttachment))
+	r.mux.HandleFunc("/api/v1/attachments/usage", r.authMiddleware.Auth(r.habitHandler.GetAttachmentUsage))
+
+	r.mux.HandleFunc("/api/v1/analytics/trend", r.authMiddleware.Auth(r.analyticsHandler.GetCompletionTrend))
+	r.mux.HandleFunc("/api/v1/analytics/weekdays", r.authMiddleware.Auth(r.analyticsHandler.GetWeekdayBreakdown))
+	r.mux.HandleFunc("/api/v1/analytics/hours", r.authMiddleware.Auth(r.analyticsHandler.GetHourDistribution))
+	r.mux.HandleFunc("/api/v1/analytics/correlations", r.authMiddleware.Auth(r.analyticsHandler.GetHabitCorrelations))
+	r.mux.HandleFunc("/api/v1/analytics/mood", r.authMiddleware.Auth(r.analyticsHandler.GetMoodCorrelations))
 
 	r.mux.HandleFunc("/swagger/", httpSwagger.WrapHandler)
 
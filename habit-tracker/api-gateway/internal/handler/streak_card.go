@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	pb "api-gateway/proto/habits/v1"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	streakCardWidth  = 1200
+	streakCardHeight = 630
+	streakCardMargin = 80
+
+	streakCardColor   = "#FF5722"
+	streakCardNameMax = 32
+
+	// Usernames longer than this would run into the footer of the card
+	streakCardUsernameMax = 24
+)
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// streakCardHexColor returns the habit color, or the default for missing and malformed colors
+func streakCardHexColor(habit *pb.PublicHabit) string {
+	if habit.Color != nil && hexColorPattern.MatchString(*habit.Color) {
+		return *habit.Color
+	}
+	return streakCardColor
+}
+
+// renderStreakCard renders the streak card of a public habit as PNG, which social networks accept
+// as Open Graph image unlike SVG. The size matches the Open Graph image size so the card can be
+// linked directly. Text is drawn with the embedded DejaVu Sans, which covers Latin, Cyrillic and
+// Greek scripts and common symbols. Characters the font lacks, like most emoji, are left out
+func renderStreakCard(username string, habit *pb.PublicHabit) ([]byte, error) {
+	fonts, err := loadStreakCardFonts()
+	if err != nil {
+		return nil, err
+	}
+
+	hex := streakCardHexColor(habit)
+	r, _ := strconv.ParseUint(hex[1:3], 16, 8)
+	g, _ := strconv.ParseUint(hex[3:5], 16, 8)
+	b, _ := strconv.ParseUint(hex[5:7], 16, 8)
+
+	// Same darkening as the black overlay with 15% opacity of the SVG card
+	background := color.RGBA{R: uint8(r * 85 / 100), G: uint8(g * 85 / 100), B: uint8(b * 85 / 100), A: 0xff}
+
+	img := image.NewRGBA(image.Rect(0, 0, streakCardWidth, streakCardHeight))
+	draw.Draw(img, img.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+
+	white := color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	textWidth := streakCardWidth - 2*streakCardMargin
+	footer := blend(white, background, 80)
+
+	// Positions and sizes follow the SVG card, long text is scaled down to fit the card
+	lines := []struct {
+		text     string
+		font     *opentype.Font
+		size     float64
+		x, y     int
+		endAlign bool
+		color    color.RGBA
+	}{
+		{text: truncateRunes(habit.Name, streakCardNameMax), font: fonts.bold, size: 56, x: streakCardMargin, y: 150, color: white},
+		{text: strconv.Itoa(int(habit.Streak)), font: fonts.bold, size: 200, x: streakCardMargin, y: 390, color: white},
+		{text: streakCaption(habit), font: fonts.regular, size: 44, x: streakCardMargin, y: 460, color: blend(white, background, 90)},
+		{text: "@" + truncateRunes(username, streakCardUsernameMax), font: fonts.regular, size: 36, x: streakCardMargin, y: 560, color: footer},
+		{text: "Habit Tracker", font: fonts.regular, size: 36, x: streakCardWidth - streakCardMargin, y: 560, endAlign: true, color: footer},
+	}
+
+	for _, line := range lines {
+		text := drawableText(line.font, line.text)
+		face, width, err := fitText(line.font, text, line.size, textWidth)
+		if err != nil {
+			return nil, err
+		}
+
+		x := line.x
+		if line.endAlign {
+			x -= width
+		}
+
+		d := font.Drawer{
+			Dst:  img,
+			Src:  image.NewUniform(line.color),
+			Face: face,
+			Dot:  fixed.P(x, line.y),
+		}
+		d.DrawString(text)
+		face.Close()
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode streak card: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// renderStreakCardSVG renders the streak card of a public habit as SVG for embedding on web pages
+func renderStreakCardSVG(username string, habit *pb.PublicHabit) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		streakCardWidth, streakCardHeight, streakCardWidth, streakCardHeight)
+	b.WriteString(`<style>text{font-family:-apple-system,'Segoe UI',Roboto,Helvetica,Arial,sans-serif;fill:#FFFFFF}</style>`)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" rx="32" fill="%s"/>`, streakCardHexColor(habit))
+	b.WriteString(`<rect width="100%" height="100%" rx="32" fill="#000000" fill-opacity="0.15"/>`)
+	fmt.Fprintf(&b, `<text x="80" y="150" font-size="56" font-weight="600">%s</text>`, html.EscapeString(truncateRunes(habit.Name, streakCardNameMax)))
+	fmt.Fprintf(&b, `<text x="80" y="390" font-size="200" font-weight="700">%d</text>`, habit.Streak)
+	fmt.Fprintf(&b, `<text x="80" y="460" font-size="44" fill-opacity="0.9">%s</text>`, streakCaption(habit))
+	fmt.Fprintf(&b, `<text x="80" y="560" font-size="36" fill-opacity="0.8">@%s</text>`, html.EscapeString(truncateRunes(username, streakCardUsernameMax)))
+	fmt.Fprintf(&b, `<text x="%d" y="560" font-size="36" fill-opacity="0.8" text-anchor="end">Habit Tracker</text>`, streakCardWidth-80)
+	b.WriteString(`</svg>`)
+
+	return b.Bytes()
+}
+
+// streakCaption describes the streak unit of the habit's schedule
+func streakCaption(habit *pb.PublicHabit) string {
+	unit := "period"
+	switch {
+	case habit.ScheduleType == pb.ScheduleType_SCHEDULE_TYPE_WEEKLY:
+		unit = "scheduled day"
+	case habit.IntervalDays != nil && *habit.IntervalDays == 1:
+		unit = "day"
+	}
+
+	if habit.Streak == 1 {
+		return unit + " streak"
+	}
+	return unit + "s in a row"
+}
+
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max-1]) + "…"
+}
+
+// blend mixes fg over bg with the given opacity in percent
+func blend(fg, bg color.RGBA, opacity uint32) color.RGBA {
+	mix := func(f, b uint8) uint8 {
+		return uint8((uint32(f)*opacity + uint32(b)*(100-opacity)) / 100)
+	}
+	return color.RGBA{R: mix(fg.R, bg.R), G: mix(fg.G, bg.G), B: mix(fg.B, bg.B), A: 0xff}
+}
+
+//go:embed fonts/DejaVuSans.ttf
+var dejaVuSans []byte
+
+//go:embed fonts/DejaVuSans-Bold.ttf
+var dejaVuSansBold []byte
+
+type streakCardFonts struct {
+	regular *opentype.Font
+	bold    *opentype.Font
+}
+
+// loadStreakCardFonts parses the embedded fonts once
+var loadStreakCardFonts = sync.OnceValues(func() (*streakCardFonts, error) {
+	regular, err := opentype.Parse(dejaVuSans)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse regular font: %w", err)
+	}
+
+	bold, err := opentype.Parse(dejaVuSansBold)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bold font: %w", err)
+	}
+
+	return &streakCardFonts{regular: regular, bold: bold}, nil
+})
+
+// drawableText removes the characters f has no glyph for, which would be drawn as empty boxes
+func drawableText(f *opentype.Font, text string) string {
+	var buf sfnt.Buffer
+	drawable := strings.Map(func(r rune) rune {
+		if index, err := f.GlyphIndex(&buf, r); err != nil || index == 0 {
+			return -1
+		}
+		return r
+	}, text)
+
+	// Removed emoji leave doubled spaces behind
+	return strings.Join(strings.Fields(drawable), " ")
+}
+
+// fitText returns a face of the given size, or a smaller one when text would be wider than
+// maxWidth, together with the width of text drawn with it
+func fitText(f *opentype.Font, text string, size float64, maxWidth int) (font.Face, int, error) {
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create font face: %w", err)
+	}
+
+	width := font.MeasureString(face, text).Ceil()
+	if width <= maxWidth {
+		return face, width, nil
+	}
+	face.Close()
+
+	size = size * float64(maxWidth) / float64(width)
+	face, err = opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create font face: %w", err)
+	}
+
+	return face, font.MeasureString(face, text).Ceil(), nil
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	pb "api-gateway/proto/habits/v1"
+
+	"golang.org/x/image/font/sfnt"
+)
+
+func TestStreakCardFontsCoverUserText(t *testing.T) {
+	fonts, err := loadStreakCardFonts()
+	if err != nil {
+		t.Fatalf("failed to load fonts: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		text string
+	}{
+		{name: "latin", text: "Read 20 pages"},
+		{name: "cyrillic", text: "Зарядка по утрам"},
+		{name: "accented", text: "Café, crème brûlée, Żółć"},
+		{name: "greek", text: "Τρέξιμο"},
+		{name: "symbols", text: "★ ✓ ☀ …"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf sfnt.Buffer
+			for _, r := range strings.ReplaceAll(tt.text, " ", "") {
+				for name, f := range map[string]*sfnt.Font{"regular": fonts.regular, "bold": fonts.bold} {
+					index, err := f.GlyphIndex(&buf, r)
+					if err != nil {
+						t.Fatalf("failed to look up %q: %v", r, err)
+					}
+					if index == 0 {
+						t.Errorf("%s font has no glyph for %q", name, r)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDrawableTextDropsMissingGlyphs(t *testing.T) {
+	fonts, err := loadStreakCardFonts()
+	if err != nil {
+		t.Fatalf("failed to load fonts: %v", err)
+	}
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "Бег 🏃 по утрам", want: "Бег по утрам"},
+		{text: "🔥🔥🔥", want: ""},
+		{text: "Read ★ daily", want: "Read ★ daily"},
+	}
+
+	for _, tt := range tests {
+		if got := drawableText(fonts.regular, tt.text); got != tt.want {
+			t.Errorf("drawableText(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestRenderStreakCard(t *testing.T) {
+	intervalDays := int32(1)
+	habit := &pb.PublicHabit{
+		Name:         "Зарядка по утрам и вечерам без пропусков",
+		Streak:       1234567,
+		ScheduleType: pb.ScheduleType_SCHEDULE_TYPE_INTERVAL,
+		IntervalDays: &intervalDays,
+	}
+
+	card, err := renderStreakCard("пользователь_с_очень_длинным_именем", habit)
+	if err != nil {
+		t.Fatalf("failed to render streak card: %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(card))
+	if err != nil {
+		t.Fatalf("streak card is not a PNG: %v", err)
+	}
+	if size := img.Bounds().Size(); size.X != streakCardWidth || size.Y != streakCardHeight {
+		t.Errorf("streak card is %dx%d, want %dx%d", size.X, size.Y, streakCardWidth, streakCardHeight)
+	}
+}
+
+func TestStreakCardFormatsTruncateUsernameAlike(t *testing.T) {
+	username := strings.Repeat("ю", streakCardUsernameMax+10)
+	want := "@" + truncateRunes(username, streakCardUsernameMax)
+
+	svg := string(renderStreakCardSVG(username, &pb.PublicHabit{Name: "Run"}))
+	if !strings.Contains(svg, ">"+want+"<") {
+		t.Errorf("SVG card does not show the username as %q", want)
+	}
+	if strings.Contains(svg, username) {
+		t.Error("SVG card shows the full username")
+	}
+}
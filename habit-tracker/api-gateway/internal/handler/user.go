@@ -3,7 +3,11 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -198,7 +202,7 @@ func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} object{id=string,email=string,username=string,first_name=string,timezone=string,is_active=bool,created_at=string}
+// @Success 200 {object} object{id=string,email=string,username=string,first_name=string,timezone=string,is_active=bool,public_profile=bool,created_at=string,deletion_scheduled_at=string}
 // @Failure 401 {object} object{error=string}
 // @Failure 404 {object} object{error=string}
 // @Failure 500 {object} object{error=string}
@@ -228,15 +232,82 @@ func (h *UserHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	profile := map[string]interface{}{
+		"id":             resp.User.Id,
+		"email":          resp.User.Email,
+		"username":       resp.User.Username,
+		"first_name":     resp.User.FirstName,
+		"timezone":       resp.User.Timezone,
+		"is_active":      resp.User.IsActive,
+		"public_profile": resp.User.PublicProfile,
+		"created_at":     resp.User.CreatedAt,
+	}
+	if resp.User.DeletionScheduledAt != nil {
+		profile["deletion_scheduled_at"] = resp.User.DeletionScheduledAt.AsTime()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(profile)
+}
+
+// UpdateProfile updates user profile
+// @Summary Update user profile
+// @Description Update the first name and public profile opt-in of the authenticated user. With a public profile, the username, first name and public habits are readable without auth. Omitted fields are left unchanged
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{first_name=string,public_profile=bool} true "Profile update request"
+// @Success 200 {object} object{id=string,email=string,username=string,first_name=string,timezone=string,public_profile=bool}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 500 {object} object{error=string}
+// @Router /api/v1/users/profile/update [put]
+func (h *UserHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		FirstName     *string `json:"first_name"`
+		PublicProfile *bool   `json:"public_profile"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.UpdateUserRequest{
+		UserId:        userID,
+		FirstName:     req.FirstName,
+		PublicProfile: req.PublicProfile,
+	}
+
+	resp, err := h.userClient.UpdateUser(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"id":         resp.User.Id,
-		"email":      resp.User.Email,
-		"username":   resp.User.Username,
-		"first_name": resp.User.FirstName,
-		"timezone":   resp.User.Timezone,
-		"is_active":  resp.User.IsActive,
-		"created_at": resp.User.CreatedAt,
+		"id":             resp.User.Id,
+		"email":          resp.User.Email,
+		"username":       resp.User.Username,
+		"first_name":     resp.User.FirstName,
+		"timezone":       resp.User.Timezone,
+		"public_profile": resp.User.PublicProfile,
 	})
 }
 
@@ -300,7 +371,7 @@ func handleGRPCError(w http.ResponseWriter, err error) {
 	switch st.Code() {
 	case codes.NotFound:
 		httpStatus = http.StatusNotFound
-	case codes.InvalidArgument:
+	case codes.InvalidArgument, codes.FailedPrecondition:
 		httpStatus = http.StatusBadRequest
 	case codes.Unauthenticated:
 		httpStatus = http.StatusUnauthorized
@@ -308,6 +379,8 @@ func handleGRPCError(w http.ResponseWriter, err error) {
 		httpStatus = http.StatusForbidden
 	case codes.AlreadyExists:
 		httpStatus = http.StatusConflict
+	case codes.ResourceExhausted:
+		httpStatus = http.StatusTooManyRequests
 	default:
 		httpStatus = http.StatusInternalServerError
 	}
@@ -709,3 +782,577 @@ func (h *UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
 		"message": "Password reset successfully. Please login with your new password",
 	})
 }
+
+// RequestMagicLink handles passwordless sign-in link request
+// @Summary Request magic sign-in link
+// @Description Sends a single-use sign-in link to the email address
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body object{email=string} true "Email address"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 500 {object} object{error=string}
+// @Router /api/v1/auth/magic-link/request [post]
+func (h *UserHandler) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		Email string `json:"email"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Email == "" {
+		http.Error(w, "Email is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.RequestMagicLinkRequest{
+		Email: req.Email,
+	}
+
+	resp, err := h.userClient.RequestMagicLink(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	message := "If the email exists, a sign-in link has been sent"
+	if resp.Message != nil {
+		message = *resp.Message
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": message,
+	})
+}
+
+// ConsumeMagicLink handles sign-in with magic link token
+// @Summary Sign in with magic link
+// @Description Exchange single-use magic link token for access and refresh tokens
+// @Tags auth
+// @Produce json
+// @Param token query string true "Magic link token"
+// @Success 200 {object} object{message=string,user_id=string,email=string,username=string,access_token=string,refresh_token=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 500 {object} object{error=string}
+// @Router /api/v1/auth/magic-link/consume [get]
+func (h *UserHandler) ConsumeMagicLink(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "Token is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ipAddr := r.RemoteAddr
+	userAgent := r.UserAgent()
+
+	grpcReq := &pb.ConsumeMagicLinkRequest{
+		Token:     token,
+		IpAddress: &ipAddr,
+		UserAgent: &userAgent,
+	}
+
+	resp, err := h.userClient.ConsumeMagicLink(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":       "Login successful",
+		"user_id":       resp.User.Id,
+		"email":         resp.User.Email,
+		"username":      resp.User.Username,
+		"access_token":  resp.AccessToken,
+		"refresh_token": resp.RefreshToken,
+	})
+}
+
+// RequestEmailChange handles email change request
+// @Summary Request email change
+// @Description Sends confirmation link to the new address and notice with undo link to the current one (requires password)
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{new_email=string,password=string} true "Email change request"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 403 {object} object{error=string}
+// @Failure 409 {object} object{error=string}
+// @Failure 500 {object} object{error=string}
+// @Router /api/v1/users/change-email [post]
+func (h *UserHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	sessionID := middleware.GetSessionID(r)
+	if userID == "" || sessionID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		NewEmail string `json:"new_email"`
+		Password string `json:"password"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.NewEmail == "" {
+		http.Error(w, "New email is required", http.StatusBadRequest)
+		return
+	}
+
+	if req.Password == "" {
+		http.Error(w, "Password is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.RequestEmailChangeRequest{
+		UserId:    userID,
+		SessionId: sessionID,
+		Password:  req.Password,
+		NewEmail:  req.NewEmail,
+	}
+
+	resp, err := h.userClient.RequestEmailChange(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	message := "Confirmation link has been sent to the new email address"
+	if resp.Message != nil {
+		message = *resp.Message
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": message,
+	})
+}
+
+// ConfirmEmailChange handles email change confirmation
+// @Summary Confirm email change
+// @Description Apply email change using token sent to the new address. Other sessions are revoked
+// @Tags auth
+// @Produce json
+// @Param token query string true "Email change token"
+// @Success 200 {object} object{message=string,user_id=string,email=string,revoked_sessions=int}
+// @Failure 400 {object} object{error=string}
+// @Failure 409 {object} object{error=string}
+// @Failure 500 {object} object{error=string}
+// @Router /api/v1/auth/email-change/confirm [get]
+func (h *UserHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "Token is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ConfirmEmailChangeRequest{
+		Token: token,
+	}
+
+	resp, err := h.userClient.ConfirmEmailChange(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":          "Email changed successfully",
+		"user_id":          resp.User.Id,
+		"email":            resp.User.Email,
+		"revoked_sessions": resp.RevokedSessions,
+	})
+}
+
+// UndoEmailChange handles email change undo from the old address
+// @Summary Undo email change
+// @Description Cancel pending email change or revert applied one using token sent to the old address
+// @Tags auth
+// @Produce json
+// @Param token query string true "Undo token"
+// @Success 200 {object} object{message=string,reverted=bool}
+// @Failure 400 {object} object{error=string}
+// @Failure 500 {object} object{error=string}
+// @Router /api/v1/auth/email-change/undo [get]
+func (h *UserHandler) UndoEmailChange(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "Token is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.UndoEmailChangeRequest{
+		Token: token,
+	}
+
+	resp, err := h.userClient.UndoEmailChange(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	message := "Email change cancelled"
+	if resp.Reverted {
+		message = "Email change reverted. All sessions have been signed out, please reset your password"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":  message,
+		"reverted": resp.Reverted,
+	})
+}
+
+// downloadTimeout limits how long a data export download may take
+const downloadTimeout = 10 * time.Minute
+
+// dataExportResponse converts data export to JSON response
+func dataExportResponse(export *pb.DataExport) map[string]interface{} {
+	resp := map[string]interface{}{
+		"id":         export.Id,
+		"status":     strings.ToLower(strings.TrimPrefix(export.Status.String(), "DATA_EXPORT_STATUS_")),
+		"created_at": export.CreatedAt.AsTime(),
+	}
+
+	if export.Error != nil {
+		resp["error"] = *export.Error
+	}
+	if export.FileSize != nil {
+		resp["file_size"] = *export.FileSize
+	}
+	if export.CompletedAt != nil {
+		resp["completed_at"] = export.CompletedAt.AsTime()
+	}
+	if export.ExpiresAt != nil {
+		resp["expires_at"] = export.ExpiresAt.AsTime()
+	}
+
+	return resp
+}
+
+// ExportData starts export of all user data
+// @Summary Export user data
+// @Description Start asynchronous export of all user data. A download link is emailed when the archive is ready. Returns the export already in progress if there is one
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 202 {object} object{id=string,status=string,created_at=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 500 {object} object{error=string}
+// @Router /api/v1/users/export [post]
+func (h *UserHandler) ExportData(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.ExportUserDataRequest{
+		UserId: userID,
+	}
+
+	resp, err := h.userClient.ExportUserData(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(dataExportResponse(resp.Export))
+}
+
+// GetDataExport returns status of a data export
+// @Summary Get data export status
+// @Description Get status of a data export job of the authenticated user
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "Export ID"
+// @Success 200 {object} object{id=string,status=string,error=string,file_size=int,created_at=string,completed_at=string,expires_at=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/users/export/status [get]
+func (h *UserHandler) GetDataExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	exportID := r.URL.Query().Get("id")
+	if exportID == "" {
+		http.Error(w, "Export ID is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetDataExportRequest{
+		UserId:   userID,
+		ExportId: exportID,
+	}
+
+	resp, err := h.userClient.GetDataExport(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(dataExportResponse(resp.Export))
+}
+
+// DownloadDataExport streams data export archive
+// @Summary Download data export
+// @Description Download data export ZIP archive using time-limited token from email
+// @Tags users
+// @Produce application/zip
+// @Param token query string true "Download token"
+// @Success 200 {file} binary
+// @Failure 400 {object} object{error=string}
+// @Failure 404 {object} object{error=string}
+// @Router /api/v1/users/export/download [get]
+func (h *UserHandler) DownloadDataExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "Token is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), downloadTimeout)
+	defer cancel()
+
+	grpcReq := &pb.DownloadDataExportRequest{
+		Token: token,
+	}
+
+	stream, err := h.userClient.DownloadDataExport(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// Errors such as an invalid token arrive with the first message
+	chunk, err := stream.Recv()
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	// Archive may be larger than the server write timeout allows
+	http.NewResponseController(w).SetWriteDeadline(time.Now().Add(downloadTimeout))
+
+	fileName := "habit-tracker-export.zip"
+	if chunk.FileName != nil {
+		fileName = *chunk.FileName
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	w.Header().Set("Cache-Control", "no-store")
+	if chunk.FileSize != nil {
+		w.Header().Set("Content-Length", fmt.Sprintf("%d", *chunk.FileSize))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		if _, err := w.Write(chunk.Data); err != nil {
+			return
+		}
+
+		chunk, err = stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			// Headers are already sent, the client sees a truncated body
+			log.Printf("Data export download interrupted: %v", err)
+			return
+		}
+	}
+}
+
+// DeleteAccount handles account deletion request
+// @Summary Delete account
+// @Description Schedule permanent deletion of the account and all its data. Deletion happens after a grace period and can be cancelled until then
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{password=string} true "Current password"
+// @Success 202 {object} object{message=string,scheduled_for=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 403 {object} object{error=string}
+// @Failure 500 {object} object{error=string}
+// @Router /api/v1/users/account [delete]
+func (h *UserHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req struct {
+		Password string `json:"password"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.Password == "" {
+		http.Error(w, "Password is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.DeleteAccountRequest{
+		UserId:   userID,
+		Password: req.Password,
+	}
+
+	resp, err := h.userClient.DeleteAccount(ctx, grpcReq)
+	if err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":       "Account is scheduled for deletion",
+		"scheduled_for": resp.ScheduledFor.AsTime(),
+	})
+}
+
+// CancelAccountDeletion handles cancellation of scheduled account deletion
+// @Summary Cancel account deletion
+// @Description Cancel scheduled account deletion during grace period
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object{error=string}
+// @Failure 401 {object} object{error=string}
+// @Failure 500 {object} object{error=string}
+// @Router /api/v1/users/account/cancel-deletion [post]
+func (h *UserHandler) CancelAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := middleware.GetUserID(r)
+	if userID == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.CancelAccountDeletionRequest{
+		UserId: userID,
+	}
+
+	if _, err := h.userClient.CancelAccountDeletion(ctx, grpcReq); err != nil {
+		handleGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Account deletion cancelled",
+	})
+}
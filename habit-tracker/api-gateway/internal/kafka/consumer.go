@@ -0,0 +1,98 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+
+	"api-gateway/internal/config"
+	"api-gateway/internal/middleware"
+	eventspb "api-gateway/proto/events/v1"
+)
+
+// RevocationConsumer evicts cached tokens when user-service revokes sessions
+type RevocationConsumer struct {
+	reader     *kafka.Reader
+	tokenCache *middleware.TokenCache
+}
+
+// NewRevocationConsumer creates a new revocation consumer.
+// Every gateway instance must see all revocation events, so consumer group is suffixed with hostname.
+func NewRevocationConsumer(cfg *config.KafkaConfig, tokenCache *middleware.TokenCache) *RevocationConsumer {
+	groupID := cfg.GroupID
+	if hostname, err := os.Hostname(); err == nil {
+		groupID = fmt.Sprintf("%s-%s", cfg.GroupID, hostname)
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:        cfg.Brokers,
+		GroupID:        groupID,
+		Topic:          cfg.Topic,
+		MinBytes:       1,
+		MaxBytes:       10e6, // 10MB
+		MaxWait:        500 * time.Millisecond,
+		CommitInterval: time.Second,
+		StartOffset:    kafka.LastOffset,
+	})
+
+	return &RevocationConsumer{
+		reader:     reader,
+		tokenCache: tokenCache,
+	}
+}
+
+// Start starts consuming messages from Kafka
+func (c *RevocationConsumer) Start(ctx context.Context) error {
+	log.Println("Starting session revocation consumer...")
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping session revocation consumer...")
+			return c.reader.Close()
+		default:
+			message, err := c.reader.ReadMessage(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					continue
+				}
+				log.Printf("Error reading message: %v", err)
+				continue
+			}
+
+			if err := c.processMessage(message); err != nil {
+				log.Printf("Error processing message: %v", err)
+			}
+		}
+	}
+}
+
+// processMessage processes a Kafka message
+func (c *RevocationConsumer) processMessage(message kafka.Message) error {
+	var event eventspb.Event
+	if err := proto.Unmarshal(message.Value, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+
+	if event.EventType != eventspb.EventType_EVENT_TYPE_SESSIONS_REVOKED {
+		return nil
+	}
+
+	revoked := event.GetSessionsRevoked()
+	if revoked == nil {
+		return fmt.Errorf("sessions revoked event is nil")
+	}
+
+	if len(revoked.SessionIds) == 0 {
+		c.tokenCache.EvictUser(revoked.UserId)
+	} else {
+		c.tokenCache.EvictSessions(revoked.SessionIds...)
+	}
+
+	return nil
+}
@@ -2,10 +2,16 @@ package middleware
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	pb "api-gateway/proto/user/v1"
 )
 
@@ -16,18 +22,76 @@ const (
 	SessionIDKey contextKey = "sessionID"
 )
 
-// AuthMiddleware wraps gRPC client for token validation
+// errSessionCheckUnavailable is returned when user-service can't tell whether session was revoked
+var errSessionCheckUnavailable = errors.New("Session check unavailable")
+
+// accessClaims represents claims of access token issued by user-service
+type accessClaims struct {
+	UserID    string `json:"user_id"`
+	TokenType string `json:"token_type"`
+	SessionID string `json:"session_id"`
+	jwt.RegisteredClaims
+}
+
+// AuthMiddleware verifies tokens locally and checks session revocation via gRPC
 type AuthMiddleware struct {
 	userClient pb.UserServiceClient
+	jwksCache  *JWKSCache
+	tokenCache *TokenCache
+	issuer     string
 }
 
 // NewAuthMiddleware creates a new auth middleware
-func NewAuthMiddleware(userClient pb.UserServiceClient) *AuthMiddleware {
+func NewAuthMiddleware(userClient pb.UserServiceClient, jwksCache *JWKSCache, tokenCache *TokenCache, issuer string) *AuthMiddleware {
 	return &AuthMiddleware{
 		userClient: userClient,
+		jwksCache:  jwksCache,
+		tokenCache: tokenCache,
+		issuer:     issuer,
 	}
 }
 
+// verifyToken verifies token signature with cached JWKS and returns its claims
+func (m *AuthMiddleware) verifyToken(ctx context.Context, tokenString string) (*accessClaims, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+	}
+	if m.issuer != "" {
+		options = append(options, jwt.WithIssuer(m.issuer))
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &accessClaims{}, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return nil, fmt.Errorf("missing kid header")
+		}
+
+		alg, key, err := m.jwksCache.Key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+
+		if token.Method.Alg() != alg {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key, nil
+	}, options...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	claims, ok := token.Claims.(*accessClaims)
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	if claims.TokenType != "access" {
+		return nil, fmt.Errorf("not an access token")
+	}
+
+	return claims, nil
+}
+
 // Auth validates JWT token from Authorization header
 func (m *AuthMiddleware) Auth(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -50,43 +114,70 @@ func (m *AuthMiddleware) Auth(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
+		tokenHash := HashToken(token)
 
-		validateReq := &pb.ValidateTokenRequest{
-			AccessToken: token,
+		userID, sessionID, ok := m.tokenCache.Get(tokenHash)
+		if !ok {
+			var err error
+			userID, sessionID, err = m.validateToken(r.Context(), token, tokenHash)
+			if errors.Is(err, errSessionCheckUnavailable) {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
 		}
 
-		resp, err := m.userClient.ValidateToken(ctx, validateReq)
-		if err != nil {
-			http.Error(w, "Failed to validate token", http.StatusUnauthorized)
-			return
-		}
+		ctx := context.WithValue(r.Context(), UserIDKey, userID)
+		ctx = context.WithValue(ctx, SessionIDKey, sessionID)
 
-		if !resp.Valid {
-			errorMsg := "Invalid token"
-			if resp.Error != nil {
-				errorMsg = *resp.Error
-			}
-			http.Error(w, errorMsg, http.StatusUnauthorized)
-			return
-		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	}
+}
 
-		if resp.UserId == nil {
-			http.Error(w, "Missing user ID in token", http.StatusUnauthorized)
-			return
-		}
+// validateToken verifies token, checks its session and caches the result
+func (m *AuthMiddleware) validateToken(ctx context.Context, token, tokenHash string) (string, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
 
-		if resp.SessionId == nil {
-			http.Error(w, "Missing session ID in token", http.StatusUnauthorized)
-			return
+	claims, err := m.verifyToken(ctx, token)
+	if err != nil {
+		return "", "", fmt.Errorf("Invalid token")
+	}
+
+	if claims.UserID == "" {
+		return "", "", fmt.Errorf("Missing user ID in token")
+	}
+
+	if claims.SessionID == "" {
+		return "", "", fmt.Errorf("Missing session ID in token")
+	}
+
+	resp, err := m.userClient.CheckSession(ctx, &pb.CheckSessionRequest{
+		UserId:    claims.UserID,
+		SessionId: claims.SessionID,
+	})
+	if err != nil {
+		// The token may well be valid, so the client should retry rather than sign in again
+		if code := status.Code(err); code == codes.Unavailable || code == codes.DeadlineExceeded {
+			return "", "", errSessionCheckUnavailable
 		}
+		return "", "", fmt.Errorf("Failed to validate token")
+	}
 
-		ctx = context.WithValue(r.Context(), UserIDKey, *resp.UserId)
-		ctx = context.WithValue(ctx, SessionIDKey, *resp.SessionId)
+	if !resp.Active {
+		return "", "", fmt.Errorf("session not found or expired")
+	}
 
-		next.ServeHTTP(w, r.WithContext(ctx))
+	var expiresAt time.Time
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
 	}
+	m.tokenCache.Set(tokenHash, claims.UserID, claims.SessionID, expiresAt)
+
+	return claims.UserID, claims.SessionID, nil
 }
 
 // GetUserID extracts user ID from request context
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "api-gateway/proto/user/v1"
+)
+
+func TestVerifyToken(t *testing.T) {
+	key, jwk := newEd25519JWK(t, "current")
+	foreignKey, _ := newEd25519JWK(t, "current")
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+
+	inAnHour := time.Now().Add(time.Hour)
+	withClaims := func(modify func(*accessClaims)) *accessClaims {
+		claims := newAccessClaims("user-1", "session-1", inAnHour)
+		modify(claims)
+		return claims
+	}
+	sign := func(method jwt.SigningMethod, signingKey interface{}, kid string, claims *accessClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(signingKey)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return signed
+	}
+
+	tests := []struct {
+		name    string
+		issuer  string
+		token   string
+		wantErr bool
+	}{
+		{name: "valid", issuer: "user-service", token: signToken(t, key, "current", newAccessClaims("user-1", "session-1", inAnHour))},
+		{name: "issuer not enforced", token: signToken(t, key, "current", withClaims(func(c *accessClaims) { c.Issuer = "elsewhere" }))},
+		{name: "wrong issuer", issuer: "user-service", token: signToken(t, key, "current", withClaims(func(c *accessClaims) { c.Issuer = "elsewhere" })), wantErr: true},
+		{name: "missing issuer", issuer: "user-service", token: signToken(t, key, "current", withClaims(func(c *accessClaims) { c.Issuer = "" })), wantErr: true},
+		{name: "refresh token", token: signToken(t, key, "current", withClaims(func(c *accessClaims) { c.TokenType = "refresh" })), wantErr: true},
+		{name: "expired", token: signToken(t, key, "current", newAccessClaims("user-1", "session-1", time.Now().Add(-time.Minute))), wantErr: true},
+		{name: "alg none", token: sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "current", newAccessClaims("user-1", "session-1", inAnHour)), wantErr: true},
+		{name: "hmac keyed with the public key", token: sign(jwt.SigningMethodHS256, []byte(key.Public().(ed25519.PublicKey)), "current", newAccessClaims("user-1", "session-1", inAnHour)), wantErr: true},
+		{name: "alg differs from the jwk", token: sign(jwt.SigningMethodRS256, rsaKey, "current", newAccessClaims("user-1", "session-1", inAnHour)), wantErr: true},
+		{name: "unknown kid", token: signToken(t, key, "unknown", newAccessClaims("user-1", "session-1", inAnHour)), wantErr: true},
+		{name: "missing kid", token: sign(jwt.SigningMethodEdDSA, key, "", newAccessClaims("user-1", "session-1", inAnHour)), wantErr: true},
+		{name: "foreign key with a known kid", token: signToken(t, foreignKey, "current", newAccessClaims("user-1", "session-1", inAnHour)), wantErr: true},
+		{name: "malformed", token: "not.a.token", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeUserClient{keys: []*pb.JSONWebKey{jwk}}
+			auth := NewAuthMiddleware(client, NewJWKSCache(client, time.Hour), NewTokenCache(time.Minute), tt.issuer)
+
+			claims, err := auth.verifyToken(context.Background(), tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("verifyToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (claims.UserID != "user-1" || claims.SessionID != "session-1") {
+				t.Errorf("verifyToken() claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestAuthSessionCheckUnavailable(t *testing.T) {
+	key, jwk := newEd25519JWK(t, "current")
+	client := &fakeUserClient{keys: []*pb.JSONWebKey{jwk}, sessionErr: status.Error(codes.Unavailable, "failed to check session")}
+	auth := NewAuthMiddleware(client, NewJWKSCache(client, time.Hour), NewTokenCache(time.Minute), "user-service")
+
+	token := signToken(t, key, "current", newAccessClaims("user-1", "session-1", time.Now().Add(15*time.Minute)))
+	handler := auth.Auth(func(w http.ResponseWriter, r *http.Request) {})
+
+	serve := func() int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec.Code
+	}
+
+	if code := serve(); code != http.StatusServiceUnavailable {
+		t.Fatalf("during outage: status = %d, want %d", code, http.StatusServiceUnavailable)
+	}
+
+	// The failed check is not cached, the next request asks user-service again
+	client.mu.Lock()
+	client.sessionErr = nil
+	client.mu.Unlock()
+
+	if code := serve(); code != http.StatusOK {
+		t.Errorf("after outage: status = %d, want %d", code, http.StatusOK)
+	}
+	if calls := client.sessionCalls.Load(); calls != 2 {
+		t.Errorf("CheckSession called %d times, want 2", calls)
+	}
+}
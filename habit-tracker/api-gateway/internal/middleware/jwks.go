@@ -0,0 +1,230 @@
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	pb "api-gateway/proto/user/v1"
+)
+
+const (
+	// minJWKSRefetchInterval limits refetches triggered by tokens with unknown kid
+	minJWKSRefetchInterval = 30 * time.Second
+	// defaultJWKSRefreshInterval is used when no refresh interval is configured
+	defaultJWKSRefreshInterval = 5 * time.Minute
+)
+
+// verificationKey is a parsed public key from JWKS
+type verificationKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+// JWKSCache keeps public keys of user-service so tokens can be verified locally
+type JWKSCache struct {
+	userClient      pb.UserServiceClient
+	refreshInterval time.Duration
+
+	// refreshMu lets one fetch run at a time, requests waiting for it reuse its result
+	refreshMu sync.Mutex
+	// refetchedAt is when a token with unknown kid last triggered a fetch, guarded by refreshMu
+	refetchedAt time.Time
+
+	mu        sync.RWMutex
+	jwks      []*pb.JSONWebKey
+	keys      map[string]verificationKey
+	fetchedAt time.Time
+}
+
+// NewJWKSCache creates a new JWKS cache, refreshed every defaultJWKSRefreshInterval when refreshInterval isn't positive
+func NewJWKSCache(userClient pb.UserServiceClient, refreshInterval time.Duration) *JWKSCache {
+	if refreshInterval <= 0 {
+		refreshInterval = defaultJWKSRefreshInterval
+	}
+
+	return &JWKSCache{
+		userClient:      userClient,
+		refreshInterval: refreshInterval,
+		keys:            make(map[string]verificationKey),
+	}
+}
+
+// Refresh fetches current key set from user-service
+func (c *JWKSCache) Refresh(ctx context.Context) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	return c.fetch(ctx)
+}
+
+// fetch replaces the key set with the one of user-service, the caller holds refreshMu
+func (c *JWKSCache) fetch(ctx context.Context) error {
+	resp, err := c.userClient.GetJWKS(ctx, &pb.GetJWKSRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	keys := make(map[string]verificationKey, len(resp.Keys))
+	for _, jwk := range resp.Keys {
+		key, err := parseJWK(jwk)
+		if err != nil {
+			log.Printf("Skipping JWK %s: %v", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	c.mu.Lock()
+	c.jwks = resp.Keys
+	c.keys = keys
+	c.fetchedAt = time.Now()
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Start refreshes keys periodically until ctx is cancelled
+func (c *JWKSCache) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(c.refreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				refreshCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+				if err := c.Refresh(refreshCtx); err != nil {
+					log.Printf("JWKS refresh error: %v", err)
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
+// Key returns verification key by kid, refetching key set when kid is unknown. Concurrent requests
+// with unknown kids share one fetch, and a fetch runs at most once per minJWKSRefetchInterval so that
+// forged kids can't flood user-service
+func (c *JWKSCache) Key(ctx context.Context, kid string) (string, crypto.PublicKey, error) {
+	if key, ok := c.cachedKey(kid); ok {
+		return key.alg, key.key, nil
+	}
+
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	// The key may have arrived with the fetch this request waited for
+	if key, ok := c.cachedKey(kid); ok {
+		return key.alg, key.key, nil
+	}
+
+	c.mu.RLock()
+	fetchedAt := c.fetchedAt
+	c.mu.RUnlock()
+
+	if time.Since(fetchedAt) < minJWKSRefetchInterval || time.Since(c.refetchedAt) < minJWKSRefetchInterval {
+		return "", nil, fmt.Errorf("unknown signing key: %s", kid)
+	}
+
+	// Failed fetches count too, an unreachable user-service must not be retried by every request
+	c.refetchedAt = time.Now()
+	if err := c.fetch(ctx); err != nil {
+		return "", nil, err
+	}
+
+	key, ok := c.cachedKey(kid)
+	if !ok {
+		return "", nil, fmt.Errorf("unknown signing key: %s", kid)
+	}
+
+	return key.alg, key.key, nil
+}
+
+func (c *JWKSCache) cachedKey(kid string) (verificationKey, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	key, ok := c.keys[kid]
+	return key, ok
+}
+
+// JWKS returns cached key set, fetching it if cache is empty
+func (c *JWKSCache) JWKS(ctx context.Context) ([]*pb.JSONWebKey, error) {
+	c.mu.RLock()
+	jwks := c.jwks
+	c.mu.RUnlock()
+
+	if jwks != nil {
+		return jwks, nil
+	}
+
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	c.mu.RLock()
+	jwks = c.jwks
+	c.mu.RUnlock()
+
+	if jwks != nil {
+		return jwks, nil
+	}
+
+	if err := c.fetch(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.jwks, nil
+}
+
+// parseJWK converts proto JWK to a public key
+func parseJWK(jwk *pb.JSONWebKey) (verificationKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.GetN())
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.GetE())
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("invalid exponent: %w", err)
+		}
+
+		return verificationKey{
+			alg: jwk.Alg,
+			key: &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			},
+		}, nil
+	case "OKP":
+		if jwk.GetCrv() != "Ed25519" {
+			return verificationKey{}, fmt.Errorf("unsupported curve: %s", jwk.GetCrv())
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.GetX())
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("invalid public key: %w", err)
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return verificationKey{}, fmt.Errorf("invalid ed25519 key size")
+		}
+
+		return verificationKey{
+			alg: jwk.Alg,
+			key: ed25519.PublicKey(x),
+		}, nil
+	default:
+		return verificationKey{}, fmt.Errorf("unsupported key type: %s", jwk.Kty)
+	}
+}
@@ -0,0 +1,251 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "api-gateway/proto/user/v1"
+)
+
+// fakeUserClient serves a fixed key set and session state in place of user-service
+type fakeUserClient struct {
+	pb.UserServiceClient
+
+	mu       sync.Mutex
+	keys     []*pb.JSONWebKey
+	jwksErr  error
+	inactive map[string]bool
+	// sessionErr, when set, is returned by CheckSession
+	sessionErr error
+
+	// gate, when set, holds GetJWKS calls until it is closed
+	gate chan struct{}
+
+	jwksCalls    atomic.Int32
+	sessionCalls atomic.Int32
+}
+
+func (c *fakeUserClient) GetJWKS(ctx context.Context, _ *pb.GetJWKSRequest, _ ...grpc.CallOption) (*pb.GetJWKSResponse, error) {
+	c.jwksCalls.Add(1)
+
+	if c.gate != nil {
+		select {
+		case <-c.gate:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.jwksErr != nil {
+		return nil, c.jwksErr
+	}
+	return &pb.GetJWKSResponse{Keys: c.keys}, nil
+}
+
+func (c *fakeUserClient) CheckSession(_ context.Context, req *pb.CheckSessionRequest, _ ...grpc.CallOption) (*pb.CheckSessionResponse, error) {
+	c.sessionCalls.Add(1)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sessionErr != nil {
+		return nil, c.sessionErr
+	}
+	return &pb.CheckSessionResponse{Active: !c.inactive[req.SessionId]}, nil
+}
+
+func (c *fakeUserClient) setKeys(keys ...*pb.JSONWebKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys = keys
+}
+
+// newEd25519JWK generates a signing key and its public JWK
+func newEd25519JWK(t *testing.T, kid string) (ed25519.PrivateKey, *pb.JSONWebKey) {
+	t.Helper()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	crv := "Ed25519"
+	x := base64.RawURLEncoding.EncodeToString(public)
+	return private, &pb.JSONWebKey{Kid: kid, Kty: "OKP", Alg: "EdDSA", Use: "sig", Crv: &crv, X: &x}
+}
+
+func TestJWKSCacheCollapsesConcurrentRefetches(t *testing.T) {
+	_, jwk := newEd25519JWK(t, "current")
+	client := &fakeUserClient{keys: []*pb.JSONWebKey{jwk}, gate: make(chan struct{})}
+	cache := NewJWKSCache(client, time.Hour)
+
+	const requests = 50
+	errs := make(chan error, requests)
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := cache.Key(context.Background(), "forged")
+			errs <- err
+		}()
+	}
+
+	// Let the requests pile up behind the first fetch before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(client.gate)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err == nil {
+			t.Fatal("Key() returned a key for a forged kid")
+		}
+	}
+	if calls := client.jwksCalls.Load(); calls != 1 {
+		t.Errorf("GetJWKS called %d times, want 1", calls)
+	}
+}
+
+func TestJWKSCacheRateLimitsUnknownKids(t *testing.T) {
+	_, jwk := newEd25519JWK(t, "current")
+	client := &fakeUserClient{keys: []*pb.JSONWebKey{jwk}}
+	cache := NewJWKSCache(client, time.Hour)
+
+	for _, kid := range []string{"forged-1", "forged-2", "forged-3"} {
+		if _, _, err := cache.Key(context.Background(), kid); err == nil {
+			t.Fatalf("Key(%q) returned a key for a forged kid", kid)
+		}
+	}
+
+	if calls := client.jwksCalls.Load(); calls != 1 {
+		t.Errorf("GetJWKS called %d times, want 1", calls)
+	}
+
+	// Known keys keep working without fetches
+	if _, _, err := cache.Key(context.Background(), "current"); err != nil {
+		t.Errorf("Key(current) error = %v", err)
+	}
+	if calls := client.jwksCalls.Load(); calls != 1 {
+		t.Errorf("GetJWKS called %d times after a known kid, want 1", calls)
+	}
+}
+
+func TestJWKSCacheRateLimitsFailedFetches(t *testing.T) {
+	client := &fakeUserClient{jwksErr: errors.New("unavailable")}
+	cache := NewJWKSCache(client, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := cache.Key(context.Background(), "current"); err == nil {
+			t.Fatal("Key() succeeded without a key set")
+		}
+	}
+
+	if calls := client.jwksCalls.Load(); calls != 1 {
+		t.Errorf("GetJWKS called %d times, want 1", calls)
+	}
+}
+
+func TestJWKSCacheFetchesRotatedKey(t *testing.T) {
+	_, oldJWK := newEd25519JWK(t, "old")
+	client := &fakeUserClient{keys: []*pb.JSONWebKey{oldJWK}}
+	cache := NewJWKSCache(client, time.Hour)
+
+	if err := cache.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	_, newJWK := newEd25519JWK(t, "new")
+	client.setKeys(oldJWK, newJWK)
+
+	// Pretend the last fetch is old enough for a refetch
+	cache.mu.Lock()
+	cache.fetchedAt = time.Now().Add(-2 * minJWKSRefetchInterval)
+	cache.mu.Unlock()
+
+	alg, key, err := cache.Key(context.Background(), "new")
+	if err != nil {
+		t.Fatalf("Key(new) error = %v", err)
+	}
+	if alg != "EdDSA" || key == nil {
+		t.Errorf("Key(new) = %q, %v", alg, key)
+	}
+}
+
+func TestParseJWK(t *testing.T) {
+	_, valid := newEd25519JWK(t, "valid")
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		jwk     *pb.JSONWebKey
+		wantErr bool
+	}{
+		{name: "ed25519", jwk: valid},
+		{name: "rsa", jwk: &pb.JSONWebKey{Kid: "rsa", Kty: "RSA", Alg: "RS256", N: str("sXchDaQebHnPiGvyDOAT4saGEUetSyo9MKLOoWFsueri23bOdgWp4Dy1WlUzewbgBHod5pcM9H95GQRV3JDXboIRROSBigeC5yjU1hGzHHyXss8UDprecbAYxknTcQkhslANGRUZmdTOQ5qTRsLAt6BTYuyvVRdhS8exSZEy_c4gs_7svlJJQ4H9_NxsiIoLwAEk7-Q3UXERGYw_75IDrGA84-lA_-Ct4eTlXHBIY2EaV7t7LjJaynVJCpkv4LKjTTAumiGUIuQhrNhZLuF_RJLqHpM2kgWFLU7-VTdL1VbC2tejvcI2BlMkEpk1BzBZI0KQB0GaDWFLN-aEAw3vRw"), E: str("AQAB")}},
+		{name: "unsupported key type", jwk: &pb.JSONWebKey{Kid: "ec", Kty: "EC", Alg: "ES256"}, wantErr: true},
+		{name: "unsupported curve", jwk: &pb.JSONWebKey{Kid: "x448", Kty: "OKP", Alg: "EdDSA", Crv: str("Ed448"), X: valid.X}, wantErr: true},
+		{name: "short ed25519 key", jwk: &pb.JSONWebKey{Kid: "short", Kty: "OKP", Alg: "EdDSA", Crv: str("Ed25519"), X: str("AAAA")}, wantErr: true},
+		{name: "ed25519 key not base64url", jwk: &pb.JSONWebKey{Kid: "bad", Kty: "OKP", Alg: "EdDSA", Crv: str("Ed25519"), X: str("not base64!")}, wantErr: true},
+		{name: "rsa modulus not base64url", jwk: &pb.JSONWebKey{Kid: "bad", Kty: "RSA", Alg: "RS256", N: str("***"), E: str("AQAB")}, wantErr: true},
+		{name: "rsa exponent not base64url", jwk: &pb.JSONWebKey{Kid: "bad", Kty: "RSA", Alg: "RS256", N: str("AQAB"), E: str("***")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseJWK(tt.jwk)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseJWK() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJWKSCacheSkipsMalformedKeys(t *testing.T) {
+	_, valid := newEd25519JWK(t, "valid")
+	short := "AAAA"
+	client := &fakeUserClient{keys: []*pb.JSONWebKey{
+		{Kid: "malformed", Kty: "OKP", Alg: "EdDSA", Crv: valid.Crv, X: &short},
+		valid,
+	}}
+	cache := NewJWKSCache(client, time.Hour)
+
+	if err := cache.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	if _, _, err := cache.Key(context.Background(), "valid"); err != nil {
+		t.Errorf("Key(valid) error = %v", err)
+	}
+	if _, _, err := cache.Key(context.Background(), "malformed"); err == nil {
+		t.Error("Key(malformed) returned a key")
+	}
+}
+
+func TestJWKSCacheDefaultsRefreshInterval(t *testing.T) {
+	client := &fakeUserClient{}
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		cache := NewJWKSCache(client, interval)
+		if cache.refreshInterval != defaultJWKSRefreshInterval {
+			t.Errorf("NewJWKSCache(%v) refresh interval = %v, want %v", interval, cache.refreshInterval, defaultJWKSRefreshInterval)
+		}
+	}
+
+	// Start must not panic on a missing interval
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	NewJWKSCache(client, 0).Start(ctx)
+}
@@ -6,6 +6,9 @@ import (
 	"time"
 )
 
+// redactedQueryParams are query parameters carrying secrets, e.g. calendar feed tokens
+var redactedQueryParams = []string{"token"}
+
 type responseWriter struct {
 	http.ResponseWriter
 	statusCode int
@@ -39,10 +42,29 @@ func Logging(next http.Handler) http.Handler {
 		log.Printf(
 			"%s %s %d %s %d bytes",
 			r.Method,
-			r.RequestURI,
+			loggedURI(r),
 			rw.statusCode,
 			duration,
 			rw.bytes,
 		)
 	})
 }
+
+// loggedURI returns the request URI with secret query parameters redacted
+func loggedURI(r *http.Request) string {
+	query := r.URL.Query()
+
+	redacted := false
+	for _, param := range redactedQueryParams {
+		if query.Has(param) {
+			query.Set(param, "REDACTED")
+			redacted = true
+		}
+	}
+
+	if !redacted {
+		return r.RequestURI
+	}
+
+	return r.URL.Path + "?" + query.Encode()
+}
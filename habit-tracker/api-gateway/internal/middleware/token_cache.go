@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// tokenCacheEntry represents a validated access token
+type tokenCacheEntry struct {
+	userID    string
+	sessionID string
+	expiresAt time.Time
+}
+
+// TokenCache caches successful token validations keyed by token hash.
+// Entries live for a short TTL and are evicted early when user-service reports session revocation.
+type TokenCache struct {
+	ttl time.Duration
+
+	mu       sync.RWMutex
+	entries  map[string]*tokenCacheEntry
+	sessions map[string]map[string]struct{}
+}
+
+// NewTokenCache creates a new token validation cache
+func NewTokenCache(ttl time.Duration) *TokenCache {
+	return &TokenCache{
+		ttl:      ttl,
+		entries:  make(map[string]*tokenCacheEntry),
+		sessions: make(map[string]map[string]struct{}),
+	}
+}
+
+// HashToken creates a hash of the token used as cache key
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// Get returns cached user ID and session ID for token hash
+func (c *TokenCache) Get(tokenHash string) (string, string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[tokenHash]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return "", "", false
+	}
+
+	return entry.userID, entry.sessionID, true
+}
+
+// Set caches validation result until TTL or token expiration, whichever comes first
+func (c *TokenCache) Set(tokenHash, userID, sessionID string, tokenExpiresAt time.Time) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	expiresAt := time.Now().Add(c.ttl)
+	if !tokenExpiresAt.IsZero() && tokenExpiresAt.Before(expiresAt) {
+		expiresAt = tokenExpiresAt
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[tokenHash] = &tokenCacheEntry{
+		userID:    userID,
+		sessionID: sessionID,
+		expiresAt: expiresAt,
+	}
+
+	hashes, ok := c.sessions[sessionID]
+	if !ok {
+		hashes = make(map[string]struct{})
+		c.sessions[sessionID] = hashes
+	}
+	hashes[tokenHash] = struct{}{}
+}
+
+// EvictSessions removes cached tokens of the given sessions
+func (c *TokenCache) EvictSessions(sessionIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, sessionID := range sessionIDs {
+		for tokenHash := range c.sessions[sessionID] {
+			delete(c.entries, tokenHash)
+		}
+		delete(c.sessions, sessionID)
+	}
+}
+
+// EvictUser removes all cached tokens of the user
+func (c *TokenCache) EvictUser(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for tokenHash, entry := range c.entries {
+		if entry.userID != userID {
+			continue
+		}
+		delete(c.entries, tokenHash)
+		delete(c.sessions, entry.sessionID)
+	}
+}
+
+// Start removes expired entries periodically until ctx is cancelled
+func (c *TokenCache) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.cleanup()
+			}
+		}
+	}()
+}
+
+// cleanup removes expired entries
+func (c *TokenCache) cleanup() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for tokenHash, entry := range c.entries {
+		if now.Before(entry.expiresAt) {
+			continue
+		}
+		delete(c.entries, tokenHash)
+
+		if hashes, ok := c.sessions[entry.sessionID]; ok {
+			delete(hashes, tokenHash)
+			if len(hashes) == 0 {
+				delete(c.sessions, entry.sessionID)
+			}
+		}
+	}
+}
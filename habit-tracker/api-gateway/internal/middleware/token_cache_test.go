@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"crypto/ed25519"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	pb "api-gateway/proto/user/v1"
+)
+
+// signToken signs claims with the Ed25519 key published under kid
+func signToken(t *testing.T, key ed25519.PrivateKey, kid string, claims jwt.Claims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = kid
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func newAccessClaims(userID, sessionID string, expiresAt time.Time) *accessClaims {
+	return &accessClaims{
+		UserID:    userID,
+		TokenType: "access",
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "user-service",
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+}
+
+func TestTokenCacheHit(t *testing.T) {
+	cache := NewTokenCache(time.Minute)
+	cache.Set("hash", "user-1", "session-1", time.Now().Add(time.Hour))
+
+	userID, sessionID, ok := cache.Get("hash")
+	if !ok || userID != "user-1" || sessionID != "session-1" {
+		t.Errorf("Get() = %q, %q, %v, want user-1, session-1, true", userID, sessionID, ok)
+	}
+
+	if _, _, ok := cache.Get("other"); ok {
+		t.Error("Get() hit for a token that was never cached")
+	}
+}
+
+func TestTokenCacheExpiry(t *testing.T) {
+	tests := []struct {
+		name           string
+		ttl            time.Duration
+		tokenExpiresAt time.Time
+		wantHit        bool
+	}{
+		{name: "within ttl", ttl: time.Minute, tokenExpiresAt: time.Now().Add(time.Hour), wantHit: true},
+		{name: "no token expiry", ttl: time.Minute, wantHit: true},
+		{name: "ttl elapsed", ttl: time.Nanosecond, tokenExpiresAt: time.Now().Add(time.Hour)},
+		{name: "token expired before ttl", ttl: time.Minute, tokenExpiresAt: time.Now().Add(-time.Second)},
+		{name: "cache disabled", ttl: 0, tokenExpiresAt: time.Now().Add(time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewTokenCache(tt.ttl)
+			cache.Set("hash", "user-1", "session-1", tt.tokenExpiresAt)
+			time.Sleep(time.Millisecond)
+
+			if _, _, ok := cache.Get("hash"); ok != tt.wantHit {
+				t.Errorf("Get() hit = %v, want %v", ok, tt.wantHit)
+			}
+		})
+	}
+}
+
+func TestTokenCacheEvictSessions(t *testing.T) {
+	cache := NewTokenCache(time.Minute)
+	expiresAt := time.Now().Add(time.Hour)
+	cache.Set("hash-1", "user-1", "session-1", expiresAt)
+	cache.Set("hash-2", "user-1", "session-1", expiresAt)
+	cache.Set("hash-3", "user-1", "session-2", expiresAt)
+
+	cache.EvictSessions("session-1")
+
+	for _, hash := range []string{"hash-1", "hash-2"} {
+		if _, _, ok := cache.Get(hash); ok {
+			t.Errorf("Get(%q) hit after its session was revoked", hash)
+		}
+	}
+	if _, _, ok := cache.Get("hash-3"); !ok {
+		t.Error("Get(hash-3) missed, its session was not revoked")
+	}
+}
+
+func TestTokenCacheEvictUser(t *testing.T) {
+	cache := NewTokenCache(time.Minute)
+	expiresAt := time.Now().Add(time.Hour)
+	cache.Set("hash-1", "user-1", "session-1", expiresAt)
+	cache.Set("hash-2", "user-1", "session-2", expiresAt)
+	cache.Set("hash-3", "user-2", "session-3", expiresAt)
+
+	cache.EvictUser("user-1")
+
+	for _, hash := range []string{"hash-1", "hash-2"} {
+		if _, _, ok := cache.Get(hash); ok {
+			t.Errorf("Get(%q) hit after its user was evicted", hash)
+		}
+	}
+	if _, _, ok := cache.Get("hash-3"); !ok {
+		t.Error("Get(hash-3) missed, its user was not evicted")
+	}
+	if _, ok := cache.sessions["session-1"]; ok {
+		t.Error("session index still holds an evicted session")
+	}
+}
+
+func TestTokenCacheCleanup(t *testing.T) {
+	cache := NewTokenCache(time.Minute)
+	cache.Set("expired", "user-1", "session-1", time.Now().Add(-time.Second))
+	cache.Set("valid", "user-1", "session-2", time.Now().Add(time.Hour))
+
+	cache.cleanup()
+
+	if _, ok := cache.entries["expired"]; ok {
+		t.Error("cleanup() kept an expired entry")
+	}
+	if _, ok := cache.sessions["session-1"]; ok {
+		t.Error("cleanup() kept the session index of an expired entry")
+	}
+	if _, ok := cache.entries["valid"]; !ok {
+		t.Error("cleanup() removed a valid entry")
+	}
+}
+
+func TestAuthUsesTokenCache(t *testing.T) {
+	key, jwk := newEd25519JWK(t, "current")
+	client := &fakeUserClient{keys: []*pb.JSONWebKey{jwk}, inactive: make(map[string]bool)}
+	tokenCache := NewTokenCache(time.Minute)
+	auth := NewAuthMiddleware(client, NewJWKSCache(client, time.Hour), tokenCache, "user-service")
+
+	token := signToken(t, key, "current", newAccessClaims("user-1", "session-1", time.Now().Add(15*time.Minute)))
+
+	var gotUserID, gotSessionID string
+	handler := auth.Auth(func(w http.ResponseWriter, r *http.Request) {
+		gotUserID, gotSessionID = GetUserID(r), GetSessionID(r)
+	})
+
+	serve := func() int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec.Code
+	}
+
+	// The first request checks the session, the second one is served from cache
+	for i := 0; i < 2; i++ {
+		if code := serve(); code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want %d", i+1, code, http.StatusOK)
+		}
+	}
+	if gotUserID != "user-1" || gotSessionID != "session-1" {
+		t.Errorf("context = %q, %q, want user-1, session-1", gotUserID, gotSessionID)
+	}
+	if calls := client.sessionCalls.Load(); calls != 1 {
+		t.Errorf("CheckSession called %d times, want 1", calls)
+	}
+
+	// A revoked session is checked again and rejected
+	client.mu.Lock()
+	client.inactive["session-1"] = true
+	client.mu.Unlock()
+	tokenCache.EvictSessions("session-1")
+
+	if code := serve(); code != http.StatusUnauthorized {
+		t.Errorf("after revoke: status = %d, want %d", code, http.StatusUnauthorized)
+	}
+	if calls := client.sessionCalls.Load(); calls != 2 {
+		t.Errorf("CheckSession called %d times, want 2", calls)
+	}
+}
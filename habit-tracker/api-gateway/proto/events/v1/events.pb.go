@@ -0,0 +1,2113 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: events/v1/events.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventType defines the type of event
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED                  EventType = 0
+	EventType_EVENT_TYPE_USER_REGISTERED              EventType = 1
+	EventType_EVENT_TYPE_EMAIL_VERIFICATION_REQUESTED EventType = 2
+	EventType_EVENT_TYPE_PASSWORD_RESET_REQUESTED     EventType = 3
+	EventType_EVENT_TYPE_PASSWORD_CHANGED             EventType = 4
+	EventType_EVENT_TYPE_SESSIONS_REVOKED             EventType = 5
+	EventType_EVENT_TYPE_MAGIC_LINK_REQUESTED         EventType = 6
+	EventType_EVENT_TYPE_EMAIL_CHANGE_REQUESTED       EventType = 7
+	EventType_EVENT_TYPE_DATA_EXPORT_READY            EventType = 8
+	EventType_EVENT_TYPE_ACCOUNT_DELETION_SCHEDULED   EventType = 9
+	EventType_EVENT_TYPE_USER_DELETED                 EventType = 10
+	EventType_EVENT_TYPE_HABIT_PARTNER_INVITED        EventType = 11
+	EventType_EVENT_TYPE_HABIT_NUDGED                 EventType = 12
+	EventType_EVENT_TYPE_HABIT_STREAK_BROKEN          EventType = 13
+	EventType_EVENT_TYPE_ACHIEVEMENT_UNLOCKED         EventType = 14
+	EventType_EVENT_TYPE_HABIT_CONFIRMED              EventType = 15
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_USER_REGISTERED",
+		2:  "EVENT_TYPE_EMAIL_VERIFICATION_REQUESTED",
+		3:  "EVENT_TYPE_PASSWORD_RESET_REQUESTED",
+		4:  "EVENT_TYPE_PASSWORD_CHANGED",
+		5:  "EVENT_TYPE_SESSIONS_REVOKED",
+		6:  "EVENT_TYPE_MAGIC_LINK_REQUESTED",
+		7:  "EVENT_TYPE_EMAIL_CHANGE_REQUESTED",
+		8:  "EVENT_TYPE_DATA_EXPORT_READY",
+		9:  "EVENT_TYPE_ACCOUNT_DELETION_SCHEDULED",
+		10: "EVENT_TYPE_USER_DELETED",
+		11: "EVENT_TYPE_HABIT_PARTNER_INVITED",
+		12: "EVENT_TYPE_HABIT_NUDGED",
+		13: "EVENT_TYPE_HABIT_STREAK_BROKEN",
+		14: "EVENT_TYPE_ACHIEVEMENT_UNLOCKED",
+		15: "EVENT_TYPE_HABIT_CONFIRMED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":                  0,
+		"EVENT_TYPE_USER_REGISTERED":              1,
+		"EVENT_TYPE_EMAIL_VERIFICATION_REQUESTED": 2,
+		"EVENT_TYPE_PASSWORD_RESET_REQUESTED":     3,
+		"EVENT_TYPE_PASSWORD_CHANGED":             4,
+		"EVENT_TYPE_SESSIONS_REVOKED":             5,
+		"EVENT_TYPE_MAGIC_LINK_REQUESTED":         6,
+		"EVENT_TYPE_EMAIL_CHANGE_REQUESTED":       7,
+		"EVENT_TYPE_DATA_EXPORT_READY":            8,
+		"EVENT_TYPE_ACCOUNT_DELETION_SCHEDULED":   9,
+		"EVENT_TYPE_USER_DELETED":                 10,
+		"EVENT_TYPE_HABIT_PARTNER_INVITED":        11,
+		"EVENT_TYPE_HABIT_NUDGED":                 12,
+		"EVENT_TYPE_HABIT_STREAK_BROKEN":          13,
+		"EVENT_TYPE_ACHIEVEMENT_UNLOCKED":         14,
+		"EVENT_TYPE_HABIT_CONFIRMED":              15,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_v1_events_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_events_v1_events_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+// NotificationType defines the type of notification to send
+type NotificationType int32
+
+const (
+	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED NotificationType = 0
+	NotificationType_NOTIFICATION_TYPE_EMAIL       NotificationType = 1
+	NotificationType_NOTIFICATION_TYPE_SMS         NotificationType = 2
+	NotificationType_NOTIFICATION_TYPE_PUSH        NotificationType = 3
+)
+
+// Enum value maps for NotificationType.
+var (
+	NotificationType_name = map[int32]string{
+		0: "NOTIFICATION_TYPE_UNSPECIFIED",
+		1: "NOTIFICATION_TYPE_EMAIL",
+		2: "NOTIFICATION_TYPE_SMS",
+		3: "NOTIFICATION_TYPE_PUSH",
+	}
+	NotificationType_value = map[string]int32{
+		"NOTIFICATION_TYPE_UNSPECIFIED": 0,
+		"NOTIFICATION_TYPE_EMAIL":       1,
+		"NOTIFICATION_TYPE_SMS":         2,
+		"NOTIFICATION_TYPE_PUSH":        3,
+	}
+)
+
+func (x NotificationType) Enum() *NotificationType {
+	p := new(NotificationType)
+	*p = x
+	return p
+}
+
+func (x NotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_events_v1_events_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationType) Type() protoreflect.EnumType {
+	return &file_events_v1_events_proto_enumTypes[1]
+}
+
+func (x NotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationType.Descriptor instead.
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+// UserRegisteredEvent is published when a new user registers
+type UserRegisteredEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email             string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username          string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName         string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	VerificationToken string                 `protobuf:"bytes,5,opt,name=verification_token,json=verificationToken,proto3" json:"verification_token,omitempty"`
+	Timezone          string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserRegisteredEvent) Reset() {
+	*x = UserRegisteredEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRegisteredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRegisteredEvent) ProtoMessage() {}
+
+func (x *UserRegisteredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRegisteredEvent.ProtoReflect.Descriptor instead.
+func (*UserRegisteredEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserRegisteredEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserRegisteredEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserRegisteredEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserRegisteredEvent) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserRegisteredEvent) GetVerificationToken() string {
+	if x != nil {
+		return x.VerificationToken
+	}
+	return ""
+}
+
+func (x *UserRegisteredEvent) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UserRegisteredEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// EmailVerificationRequestedEvent is published when email verification is requested
+type EmailVerificationRequestedEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email             string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	VerificationToken string                 `protobuf:"bytes,3,opt,name=verification_token,json=verificationToken,proto3" json:"verification_token,omitempty"`
+	RequestedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EmailVerificationRequestedEvent) Reset() {
+	*x = EmailVerificationRequestedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailVerificationRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailVerificationRequestedEvent) ProtoMessage() {}
+
+func (x *EmailVerificationRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailVerificationRequestedEvent.ProtoReflect.Descriptor instead.
+func (*EmailVerificationRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EmailVerificationRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmailVerificationRequestedEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *EmailVerificationRequestedEvent) GetVerificationToken() string {
+	if x != nil {
+		return x.VerificationToken
+	}
+	return ""
+}
+
+func (x *EmailVerificationRequestedEvent) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+// PasswordResetRequestedEvent is published when password reset is requested
+type PasswordResetRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	ResetToken    string                 `protobuf:"bytes,3,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordResetRequestedEvent) Reset() {
+	*x = PasswordResetRequestedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordResetRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordResetRequestedEvent) ProtoMessage() {}
+
+func (x *PasswordResetRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordResetRequestedEvent.ProtoReflect.Descriptor instead.
+func (*PasswordResetRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PasswordResetRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PasswordResetRequestedEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PasswordResetRequestedEvent) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *PasswordResetRequestedEvent) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+// PasswordChangedEvent is published when password is changed or reset
+type PasswordChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	WasReset      bool                   `protobuf:"varint,4,opt,name=was_reset,json=wasReset,proto3" json:"was_reset,omitempty"` // true if changed via reset, false if changed via change password
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordChangedEvent) Reset() {
+	*x = PasswordChangedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordChangedEvent) ProtoMessage() {}
+
+func (x *PasswordChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordChangedEvent.ProtoReflect.Descriptor instead.
+func (*PasswordChangedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PasswordChangedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PasswordChangedEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PasswordChangedEvent) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *PasswordChangedEvent) GetWasReset() bool {
+	if x != nil {
+		return x.WasReset
+	}
+	return false
+}
+
+// SessionsRevokedEvent is published when user sessions are revoked (logout, revoke, password reset)
+type SessionsRevokedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionIds    []string               `protobuf:"bytes,2,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionsRevokedEvent) Reset() {
+	*x = SessionsRevokedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionsRevokedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsRevokedEvent) ProtoMessage() {}
+
+func (x *SessionsRevokedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsRevokedEvent.ProtoReflect.Descriptor instead.
+func (*SessionsRevokedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SessionsRevokedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionsRevokedEvent) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+func (x *SessionsRevokedEvent) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// MagicLinkRequestedEvent is published when user requests a sign-in link by email
+type MagicLinkRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName     string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MagicLinkRequestedEvent) Reset() {
+	*x = MagicLinkRequestedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MagicLinkRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MagicLinkRequestedEvent) ProtoMessage() {}
+
+func (x *MagicLinkRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MagicLinkRequestedEvent.ProtoReflect.Descriptor instead.
+func (*MagicLinkRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MagicLinkRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MagicLinkRequestedEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MagicLinkRequestedEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MagicLinkRequestedEvent) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *MagicLinkRequestedEvent) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MagicLinkRequestedEvent) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *MagicLinkRequestedEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// EmailChangeRequestedEvent is published when user requests to change email address
+type EmailChangeRequestedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	OldEmail      string                 `protobuf:"bytes,4,opt,name=old_email,json=oldEmail,proto3" json:"old_email,omitempty"`
+	NewEmail      string                 `protobuf:"bytes,5,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	ConfirmToken  string                 `protobuf:"bytes,6,opt,name=confirm_token,json=confirmToken,proto3" json:"confirm_token,omitempty"`
+	UndoToken     string                 `protobuf:"bytes,7,opt,name=undo_token,json=undoToken,proto3" json:"undo_token,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailChangeRequestedEvent) Reset() {
+	*x = EmailChangeRequestedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailChangeRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailChangeRequestedEvent) ProtoMessage() {}
+
+func (x *EmailChangeRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailChangeRequestedEvent.ProtoReflect.Descriptor instead.
+func (*EmailChangeRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EmailChangeRequestedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetOldEmail() string {
+	if x != nil {
+		return x.OldEmail
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetConfirmToken() string {
+	if x != nil {
+		return x.ConfirmToken
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetUndoToken() string {
+	if x != nil {
+		return x.UndoToken
+	}
+	return ""
+}
+
+func (x *EmailChangeRequestedEvent) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+// DataExportReadyEvent is published when user data export archive is ready for download
+type DataExportReadyEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName     string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	ExportId      string                 `protobuf:"bytes,5,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	DownloadToken string                 `protobuf:"bytes,6,opt,name=download_token,json=downloadToken,proto3" json:"download_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExportReadyEvent) Reset() {
+	*x = DataExportReadyEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExportReadyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExportReadyEvent) ProtoMessage() {}
+
+func (x *DataExportReadyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExportReadyEvent.ProtoReflect.Descriptor instead.
+func (*DataExportReadyEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DataExportReadyEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DataExportReadyEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DataExportReadyEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DataExportReadyEvent) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *DataExportReadyEvent) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *DataExportReadyEvent) GetDownloadToken() string {
+	if x != nil {
+		return x.DownloadToken
+	}
+	return ""
+}
+
+func (x *DataExportReadyEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// AccountDeletionScheduledEvent is published when user requests account deletion
+type AccountDeletionScheduledEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName     string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ScheduledFor  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountDeletionScheduledEvent) Reset() {
+	*x = AccountDeletionScheduledEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDeletionScheduledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDeletionScheduledEvent) ProtoMessage() {}
+
+func (x *AccountDeletionScheduledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDeletionScheduledEvent.ProtoReflect.Descriptor instead.
+func (*AccountDeletionScheduledEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AccountDeletionScheduledEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccountDeletionScheduledEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AccountDeletionScheduledEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AccountDeletionScheduledEvent) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *AccountDeletionScheduledEvent) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *AccountDeletionScheduledEvent) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return nil
+}
+
+// UserDeletedEvent is published when account deletion grace period has passed.
+// Every service owning user data must purge it, handling of the event must be idempotent.
+type UserDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeletedEvent) Reset() {
+	*x = UserDeletedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeletedEvent) ProtoMessage() {}
+
+func (x *UserDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeletedEvent.ProtoReflect.Descriptor instead.
+func (*UserDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserDeletedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDeletedEvent) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// HabitPartnerInvitedEvent is published by habits-service when a user is invited
+// as an accountability partner on a habit
+type HabitPartnerInvitedEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId     string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	HabitId          string                 `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	HabitName        string                 `protobuf:"bytes,3,opt,name=habit_name,json=habitName,proto3" json:"habit_name,omitempty"`
+	OwnerUsername    string                 `protobuf:"bytes,4,opt,name=owner_username,json=ownerUsername,proto3" json:"owner_username,omitempty"`
+	PartnerUserId    string                 `protobuf:"bytes,5,opt,name=partner_user_id,json=partnerUserId,proto3" json:"partner_user_id,omitempty"`
+	PartnerEmail     string                 `protobuf:"bytes,6,opt,name=partner_email,json=partnerEmail,proto3" json:"partner_email,omitempty"`
+	PartnerUsername  string                 `protobuf:"bytes,7,opt,name=partner_username,json=partnerUsername,proto3" json:"partner_username,omitempty"`
+	PartnerFirstName string                 `protobuf:"bytes,8,opt,name=partner_first_name,json=partnerFirstName,proto3" json:"partner_first_name,omitempty"`
+	InvitedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HabitPartnerInvitedEvent) Reset() {
+	*x = HabitPartnerInvitedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitPartnerInvitedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitPartnerInvitedEvent) ProtoMessage() {}
+
+func (x *HabitPartnerInvitedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitPartnerInvitedEvent.ProtoReflect.Descriptor instead.
+func (*HabitPartnerInvitedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HabitPartnerInvitedEvent) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *HabitPartnerInvitedEvent) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitPartnerInvitedEvent) GetHabitName() string {
+	if x != nil {
+		return x.HabitName
+	}
+	return ""
+}
+
+func (x *HabitPartnerInvitedEvent) GetOwnerUsername() string {
+	if x != nil {
+		return x.OwnerUsername
+	}
+	return ""
+}
+
+func (x *HabitPartnerInvitedEvent) GetPartnerUserId() string {
+	if x != nil {
+		return x.PartnerUserId
+	}
+	return ""
+}
+
+func (x *HabitPartnerInvitedEvent) GetPartnerEmail() string {
+	if x != nil {
+		return x.PartnerEmail
+	}
+	return ""
+}
+
+func (x *HabitPartnerInvitedEvent) GetPartnerUsername() string {
+	if x != nil {
+		return x.PartnerUsername
+	}
+	return ""
+}
+
+func (x *HabitPartnerInvitedEvent) GetPartnerFirstName() string {
+	if x != nil {
+		return x.PartnerFirstName
+	}
+	return ""
+}
+
+func (x *HabitPartnerInvitedEvent) GetInvitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InvitedAt
+	}
+	return nil
+}
+
+// HabitNudgedEvent is published by habits-service when a partner nudges the owner of a habit
+type HabitNudgedEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HabitId         string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	HabitName       string                 `protobuf:"bytes,2,opt,name=habit_name,json=habitName,proto3" json:"habit_name,omitempty"`
+	PartnerUsername string                 `protobuf:"bytes,3,opt,name=partner_username,json=partnerUsername,proto3" json:"partner_username,omitempty"`
+	OwnerUserId     string                 `protobuf:"bytes,4,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	OwnerEmail      string                 `protobuf:"bytes,5,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	OwnerUsername   string                 `protobuf:"bytes,6,opt,name=owner_username,json=ownerUsername,proto3" json:"owner_username,omitempty"`
+	OwnerFirstName  string                 `protobuf:"bytes,7,opt,name=owner_first_name,json=ownerFirstName,proto3" json:"owner_first_name,omitempty"`
+	NudgedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=nudged_at,json=nudgedAt,proto3" json:"nudged_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HabitNudgedEvent) Reset() {
+	*x = HabitNudgedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitNudgedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitNudgedEvent) ProtoMessage() {}
+
+func (x *HabitNudgedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitNudgedEvent.ProtoReflect.Descriptor instead.
+func (*HabitNudgedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HabitNudgedEvent) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitNudgedEvent) GetHabitName() string {
+	if x != nil {
+		return x.HabitName
+	}
+	return ""
+}
+
+func (x *HabitNudgedEvent) GetPartnerUsername() string {
+	if x != nil {
+		return x.PartnerUsername
+	}
+	return ""
+}
+
+func (x *HabitNudgedEvent) GetOwnerUserId() string {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return ""
+}
+
+func (x *HabitNudgedEvent) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *HabitNudgedEvent) GetOwnerUsername() string {
+	if x != nil {
+		return x.OwnerUsername
+	}
+	return ""
+}
+
+func (x *HabitNudgedEvent) GetOwnerFirstName() string {
+	if x != nil {
+		return x.OwnerFirstName
+	}
+	return ""
+}
+
+func (x *HabitNudgedEvent) GetNudgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NudgedAt
+	}
+	return nil
+}
+
+// HabitStreakBrokenEvent is published by habits-service for every accepted partner
+// when the owner of a habit misses a deadline with a running streak
+type HabitStreakBrokenEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	HabitId          string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	HabitName        string                 `protobuf:"bytes,2,opt,name=habit_name,json=habitName,proto3" json:"habit_name,omitempty"`
+	LostStreak       int32                  `protobuf:"varint,3,opt,name=lost_streak,json=lostStreak,proto3" json:"lost_streak,omitempty"`
+	MissedDate       string                 `protobuf:"bytes,4,opt,name=missed_date,json=missedDate,proto3" json:"missed_date,omitempty"` // Date in format "YYYY-MM-DD" (in habit's timezone)
+	OwnerUsername    string                 `protobuf:"bytes,5,opt,name=owner_username,json=ownerUsername,proto3" json:"owner_username,omitempty"`
+	PartnerUserId    string                 `protobuf:"bytes,6,opt,name=partner_user_id,json=partnerUserId,proto3" json:"partner_user_id,omitempty"`
+	PartnerEmail     string                 `protobuf:"bytes,7,opt,name=partner_email,json=partnerEmail,proto3" json:"partner_email,omitempty"`
+	PartnerUsername  string                 `protobuf:"bytes,8,opt,name=partner_username,json=partnerUsername,proto3" json:"partner_username,omitempty"`
+	PartnerFirstName string                 `protobuf:"bytes,9,opt,name=partner_first_name,json=partnerFirstName,proto3" json:"partner_first_name,omitempty"`
+	BrokenAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=broken_at,json=brokenAt,proto3" json:"broken_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HabitStreakBrokenEvent) Reset() {
+	*x = HabitStreakBrokenEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitStreakBrokenEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitStreakBrokenEvent) ProtoMessage() {}
+
+func (x *HabitStreakBrokenEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitStreakBrokenEvent.ProtoReflect.Descriptor instead.
+func (*HabitStreakBrokenEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HabitStreakBrokenEvent) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitStreakBrokenEvent) GetHabitName() string {
+	if x != nil {
+		return x.HabitName
+	}
+	return ""
+}
+
+func (x *HabitStreakBrokenEvent) GetLostStreak() int32 {
+	if x != nil {
+		return x.LostStreak
+	}
+	return 0
+}
+
+func (x *HabitStreakBrokenEvent) GetMissedDate() string {
+	if x != nil {
+		return x.MissedDate
+	}
+	return ""
+}
+
+func (x *HabitStreakBrokenEvent) GetOwnerUsername() string {
+	if x != nil {
+		return x.OwnerUsername
+	}
+	return ""
+}
+
+func (x *HabitStreakBrokenEvent) GetPartnerUserId() string {
+	if x != nil {
+		return x.PartnerUserId
+	}
+	return ""
+}
+
+func (x *HabitStreakBrokenEvent) GetPartnerEmail() string {
+	if x != nil {
+		return x.PartnerEmail
+	}
+	return ""
+}
+
+func (x *HabitStreakBrokenEvent) GetPartnerUsername() string {
+	if x != nil {
+		return x.PartnerUsername
+	}
+	return ""
+}
+
+func (x *HabitStreakBrokenEvent) GetPartnerFirstName() string {
+	if x != nil {
+		return x.PartnerFirstName
+	}
+	return ""
+}
+
+func (x *HabitStreakBrokenEvent) GetBrokenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BrokenAt
+	}
+	return nil
+}
+
+// AchievementUnlockedEvent is published by habits-service when a user unlocks an achievement
+type AchievementUnlockedEvent struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserId                 string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email                  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username               string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName              string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	AchievementCode        string                 `protobuf:"bytes,5,opt,name=achievement_code,json=achievementCode,proto3" json:"achievement_code,omitempty"`
+	AchievementName        string                 `protobuf:"bytes,6,opt,name=achievement_name,json=achievementName,proto3" json:"achievement_name,omitempty"`
+	AchievementDescription string                 `protobuf:"bytes,7,opt,name=achievement_description,json=achievementDescription,proto3" json:"achievement_description,omitempty"`
+	Xp                     int32                  `protobuf:"varint,8,opt,name=xp,proto3" json:"xp,omitempty"`       // XP granted by the achievement
+	Level                  int32                  `protobuf:"varint,9,opt,name=level,proto3" json:"level,omitempty"` // Level of the user after the unlock
+	UnlockedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	HabitId                string                 `protobuf:"bytes,11,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"` // Habit whose confirmation unlocked the achievement
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AchievementUnlockedEvent) Reset() {
+	*x = AchievementUnlockedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementUnlockedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementUnlockedEvent) ProtoMessage() {}
+
+func (x *AchievementUnlockedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementUnlockedEvent.ProtoReflect.Descriptor instead.
+func (*AchievementUnlockedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AchievementUnlockedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedEvent) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedEvent) GetAchievementCode() string {
+	if x != nil {
+		return x.AchievementCode
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedEvent) GetAchievementName() string {
+	if x != nil {
+		return x.AchievementName
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedEvent) GetAchievementDescription() string {
+	if x != nil {
+		return x.AchievementDescription
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedEvent) GetXp() int32 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *AchievementUnlockedEvent) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AchievementUnlockedEvent) GetUnlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return nil
+}
+
+func (x *AchievementUnlockedEvent) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+// HabitConfirmedEvent is published by habits-service when a habit visible to followers is confirmed.
+// habits-service consumes it to fan out activity feeds
+type HabitConfirmedEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitId          string                 `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	ConfirmationId   string                 `protobuf:"bytes,3,opt,name=confirmation_id,json=confirmationId,proto3" json:"confirmation_id,omitempty"`
+	ConfirmedForDate string                 `protobuf:"bytes,4,opt,name=confirmed_for_date,json=confirmedForDate,proto3" json:"confirmed_for_date,omitempty"` // Date in format "YYYY-MM-DD" (in habit's timezone)
+	Streak           int32                  `protobuf:"varint,5,opt,name=streak,proto3" json:"streak,omitempty"`                                              // Streak after the confirmation
+	ConfirmedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HabitConfirmedEvent) Reset() {
+	*x = HabitConfirmedEvent{}
+	mi := &file_events_v1_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitConfirmedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitConfirmedEvent) ProtoMessage() {}
+
+func (x *HabitConfirmedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitConfirmedEvent.ProtoReflect.Descriptor instead.
+func (*HabitConfirmedEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HabitConfirmedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HabitConfirmedEvent) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitConfirmedEvent) GetConfirmationId() string {
+	if x != nil {
+		return x.ConfirmationId
+	}
+	return ""
+}
+
+func (x *HabitConfirmedEvent) GetConfirmedForDate() string {
+	if x != nil {
+		return x.ConfirmedForDate
+	}
+	return ""
+}
+
+func (x *HabitConfirmedEvent) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *HabitConfirmedEvent) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+// Event wrapper that contains all event types
+type Event struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EventId   string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType EventType              `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=events.v1.EventType" json:"event_type,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Event_UserRegistered
+	//	*Event_EmailVerificationRequested
+	//	*Event_PasswordResetRequested
+	//	*Event_PasswordChanged
+	//	*Event_SessionsRevoked
+	//	*Event_MagicLinkRequested
+	//	*Event_EmailChangeRequested
+	//	*Event_DataExportReady
+	//	*Event_AccountDeletionScheduled
+	//	*Event_UserDeleted
+	//	*Event_HabitPartnerInvited
+	//	*Event_HabitNudged
+	//	*Event_HabitStreakBroken
+	//	*Event_AchievementUnlocked
+	//	*Event_HabitConfirmed
+	Payload       isEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_events_v1_events_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_events_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_events_v1_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Event) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Event) GetEventType() EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *Event) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Event) GetPayload() isEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetUserRegistered() *UserRegisteredEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_UserRegistered); ok {
+			return x.UserRegistered
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetEmailVerificationRequested() *EmailVerificationRequestedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_EmailVerificationRequested); ok {
+			return x.EmailVerificationRequested
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetPasswordResetRequested() *PasswordResetRequestedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_PasswordResetRequested); ok {
+			return x.PasswordResetRequested
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetPasswordChanged() *PasswordChangedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_PasswordChanged); ok {
+			return x.PasswordChanged
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetSessionsRevoked() *SessionsRevokedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_SessionsRevoked); ok {
+			return x.SessionsRevoked
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetMagicLinkRequested() *MagicLinkRequestedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_MagicLinkRequested); ok {
+			return x.MagicLinkRequested
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetEmailChangeRequested() *EmailChangeRequestedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_EmailChangeRequested); ok {
+			return x.EmailChangeRequested
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetDataExportReady() *DataExportReadyEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_DataExportReady); ok {
+			return x.DataExportReady
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetAccountDeletionScheduled() *AccountDeletionScheduledEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_AccountDeletionScheduled); ok {
+			return x.AccountDeletionScheduled
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetUserDeleted() *UserDeletedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_UserDeleted); ok {
+			return x.UserDeleted
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetHabitPartnerInvited() *HabitPartnerInvitedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_HabitPartnerInvited); ok {
+			return x.HabitPartnerInvited
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetHabitNudged() *HabitNudgedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_HabitNudged); ok {
+			return x.HabitNudged
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetHabitStreakBroken() *HabitStreakBrokenEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_HabitStreakBroken); ok {
+			return x.HabitStreakBroken
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetAchievementUnlocked() *AchievementUnlockedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_AchievementUnlocked); ok {
+			return x.AchievementUnlocked
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetHabitConfirmed() *HabitConfirmedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_HabitConfirmed); ok {
+			return x.HabitConfirmed
+		}
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_UserRegistered struct {
+	UserRegistered *UserRegisteredEvent `protobuf:"bytes,10,opt,name=user_registered,json=userRegistered,proto3,oneof"`
+}
+
+type Event_EmailVerificationRequested struct {
+	EmailVerificationRequested *EmailVerificationRequestedEvent `protobuf:"bytes,11,opt,name=email_verification_requested,json=emailVerificationRequested,proto3,oneof"`
+}
+
+type Event_PasswordResetRequested struct {
+	PasswordResetRequested *PasswordResetRequestedEvent `protobuf:"bytes,12,opt,name=password_reset_requested,json=passwordResetRequested,proto3,oneof"`
+}
+
+type Event_PasswordChanged struct {
+	PasswordChanged *PasswordChangedEvent `protobuf:"bytes,13,opt,name=password_changed,json=passwordChanged,proto3,oneof"`
+}
+
+type Event_SessionsRevoked struct {
+	SessionsRevoked *SessionsRevokedEvent `protobuf:"bytes,14,opt,name=sessions_revoked,json=sessionsRevoked,proto3,oneof"`
+}
+
+type Event_MagicLinkRequested struct {
+	MagicLinkRequested *MagicLinkRequestedEvent `protobuf:"bytes,15,opt,name=magic_link_requested,json=magicLinkRequested,proto3,oneof"`
+}
+
+type Event_EmailChangeRequested struct {
+	EmailChangeRequested *EmailChangeRequestedEvent `protobuf:"bytes,16,opt,name=email_change_requested,json=emailChangeRequested,proto3,oneof"`
+}
+
+type Event_DataExportReady struct {
+	DataExportReady *DataExportReadyEvent `protobuf:"bytes,17,opt,name=data_export_ready,json=dataExportReady,proto3,oneof"`
+}
+
+type Event_AccountDeletionScheduled struct {
+	AccountDeletionScheduled *AccountDeletionScheduledEvent `protobuf:"bytes,18,opt,name=account_deletion_scheduled,json=accountDeletionScheduled,proto3,oneof"`
+}
+
+type Event_UserDeleted struct {
+	UserDeleted *UserDeletedEvent `protobuf:"bytes,19,opt,name=user_deleted,json=userDeleted,proto3,oneof"`
+}
+
+type Event_HabitPartnerInvited struct {
+	HabitPartnerInvited *HabitPartnerInvitedEvent `protobuf:"bytes,20,opt,name=habit_partner_invited,json=habitPartnerInvited,proto3,oneof"`
+}
+
+type Event_HabitNudged struct {
+	HabitNudged *HabitNudgedEvent `protobuf:"bytes,21,opt,name=habit_nudged,json=habitNudged,proto3,oneof"`
+}
+
+type Event_HabitStreakBroken struct {
+	HabitStreakBroken *HabitStreakBrokenEvent `protobuf:"bytes,22,opt,name=habit_streak_broken,json=habitStreakBroken,proto3,oneof"`
+}
+
+type Event_AchievementUnlocked struct {
+	AchievementUnlocked *AchievementUnlockedEvent `protobuf:"bytes,23,opt,name=achievement_unlocked,json=achievementUnlocked,proto3,oneof"`
+}
+
+type Event_HabitConfirmed struct {
+	HabitConfirmed *HabitConfirmedEvent `protobuf:"bytes,24,opt,name=habit_confirmed,json=habitConfirmed,proto3,oneof"`
+}
+
+func (*Event_UserRegistered) isEvent_Payload() {}
+
+func (*Event_EmailVerificationRequested) isEvent_Payload() {}
+
+func (*Event_PasswordResetRequested) isEvent_Payload() {}
+
+func (*Event_PasswordChanged) isEvent_Payload() {}
+
+func (*Event_SessionsRevoked) isEvent_Payload() {}
+
+func (*Event_MagicLinkRequested) isEvent_Payload() {}
+
+func (*Event_EmailChangeRequested) isEvent_Payload() {}
+
+func (*Event_DataExportReady) isEvent_Payload() {}
+
+func (*Event_AccountDeletionScheduled) isEvent_Payload() {}
+
+func (*Event_UserDeleted) isEvent_Payload() {}
+
+func (*Event_HabitPartnerInvited) isEvent_Payload() {}
+
+func (*Event_HabitNudged) isEvent_Payload() {}
+
+func (*Event_HabitStreakBroken) isEvent_Payload() {}
+
+func (*Event_AchievementUnlocked) isEvent_Payload() {}
+
+func (*Event_HabitConfirmed) isEvent_Payload() {}
+
+var File_events_v1_events_proto protoreflect.FileDescriptor
+
+const file_events_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x16events/v1/events.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x85\x02\n" +
+	"\x13UserRegisteredEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12-\n" +
+	"\x12verification_token\x18\x05 \x01(\tR\x11verificationToken\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbe\x01\n" +
+	"\x1fEmailVerificationRequestedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12-\n" +
+	"\x12verification_token\x18\x03 \x01(\tR\x11verificationToken\x12=\n" +
+	"\frequested_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"\xac\x01\n" +
+	"\x1bPasswordResetRequestedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1f\n" +
+	"\vreset_token\x18\x03 \x01(\tR\n" +
+	"resetToken\x12=\n" +
+	"\frequested_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"\x9d\x01\n" +
+	"\x14PasswordChangedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"changed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x1b\n" +
+	"\twas_reset\x18\x04 \x01(\bR\bwasReset\"\x8b\x01\n" +
+	"\x14SessionsRevokedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vsession_ids\x18\x02 \x03(\tR\n" +
+	"sessionIds\x129\n" +
+	"\n" +
+	"revoked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"\x93\x02\n" +
+	"\x17MagicLinkRequestedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12=\n" +
+	"\frequested_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xac\x02\n" +
+	"\x19EmailChangeRequestedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\told_email\x18\x04 \x01(\tR\boldEmail\x12\x1b\n" +
+	"\tnew_email\x18\x05 \x01(\tR\bnewEmail\x12#\n" +
+	"\rconfirm_token\x18\x06 \x01(\tR\fconfirmToken\x12\x1d\n" +
+	"\n" +
+	"undo_token\x18\a \x01(\tR\tundoToken\x12=\n" +
+	"\frequested_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"\xff\x01\n" +
+	"\x14DataExportReadyEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\texport_id\x18\x05 \x01(\tR\bexportId\x12%\n" +
+	"\x0edownload_token\x18\x06 \x01(\tR\rdownloadToken\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x89\x02\n" +
+	"\x1dAccountDeletionScheduledEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12=\n" +
+	"\frequested_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12?\n" +
+	"\rscheduled_for\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\"f\n" +
+	"\x10UserDeletedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\x81\x03\n" +
+	"\x18HabitPartnerInvitedEvent\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"habit_name\x18\x03 \x01(\tR\thabitName\x12%\n" +
+	"\x0eowner_username\x18\x04 \x01(\tR\rownerUsername\x12&\n" +
+	"\x0fpartner_user_id\x18\x05 \x01(\tR\rpartnerUserId\x12#\n" +
+	"\rpartner_email\x18\x06 \x01(\tR\fpartnerEmail\x12)\n" +
+	"\x10partner_username\x18\a \x01(\tR\x0fpartnerUsername\x12,\n" +
+	"\x12partner_first_name\x18\b \x01(\tR\x10partnerFirstName\x129\n" +
+	"\n" +
+	"invited_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tinvitedAt\"\xc6\x02\n" +
+	"\x10HabitNudgedEvent\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"habit_name\x18\x02 \x01(\tR\thabitName\x12)\n" +
+	"\x10partner_username\x18\x03 \x01(\tR\x0fpartnerUsername\x12\"\n" +
+	"\rowner_user_id\x18\x04 \x01(\tR\vownerUserId\x12\x1f\n" +
+	"\vowner_email\x18\x05 \x01(\tR\n" +
+	"ownerEmail\x12%\n" +
+	"\x0eowner_username\x18\x06 \x01(\tR\rownerUsername\x12(\n" +
+	"\x10owner_first_name\x18\a \x01(\tR\x0eownerFirstName\x127\n" +
+	"\tnudged_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bnudgedAt\"\x9a\x03\n" +
+	"\x16HabitStreakBrokenEvent\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"habit_name\x18\x02 \x01(\tR\thabitName\x12\x1f\n" +
+	"\vlost_streak\x18\x03 \x01(\x05R\n" +
+	"lostStreak\x12\x1f\n" +
+	"\vmissed_date\x18\x04 \x01(\tR\n" +
+	"missedDate\x12%\n" +
+	"\x0eowner_username\x18\x05 \x01(\tR\rownerUsername\x12&\n" +
+	"\x0fpartner_user_id\x18\x06 \x01(\tR\rpartnerUserId\x12#\n" +
+	"\rpartner_email\x18\a \x01(\tR\fpartnerEmail\x12)\n" +
+	"\x10partner_username\x18\b \x01(\tR\x0fpartnerUsername\x12,\n" +
+	"\x12partner_first_name\x18\t \x01(\tR\x10partnerFirstName\x127\n" +
+	"\tbroken_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bbrokenAt\"\x91\x03\n" +
+	"\x18AchievementUnlockedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12)\n" +
+	"\x10achievement_code\x18\x05 \x01(\tR\x0fachievementCode\x12)\n" +
+	"\x10achievement_name\x18\x06 \x01(\tR\x0fachievementName\x127\n" +
+	"\x17achievement_description\x18\a \x01(\tR\x16achievementDescription\x12\x0e\n" +
+	"\x02xp\x18\b \x01(\x05R\x02xp\x12\x14\n" +
+	"\x05level\x18\t \x01(\x05R\x05level\x12;\n" +
+	"\vunlocked_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"unlockedAt\x12\x19\n" +
+	"\bhabit_id\x18\v \x01(\tR\ahabitId\"\xf7\x01\n" +
+	"\x13HabitConfirmedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\tR\ahabitId\x12'\n" +
+	"\x0fconfirmation_id\x18\x03 \x01(\tR\x0econfirmationId\x12,\n" +
+	"\x12confirmed_for_date\x18\x04 \x01(\tR\x10confirmedForDate\x12\x16\n" +
+	"\x06streak\x18\x05 \x01(\x05R\x06streak\x12=\n" +
+	"\fconfirmed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmedAt\"\x9f\v\n" +
+	"\x05Event\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x123\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\x0e2\x14.events.v1.EventTypeR\teventType\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12I\n" +
+	"\x0fuser_registered\x18\n" +
+	" \x01(\v2\x1e.events.v1.UserRegisteredEventH\x00R\x0euserRegistered\x12n\n" +
+	"\x1cemail_verification_requested\x18\v \x01(\v2*.events.v1.EmailVerificationRequestedEventH\x00R\x1aemailVerificationRequested\x12b\n" +
+	"\x18password_reset_requested\x18\f \x01(\v2&.events.v1.PasswordResetRequestedEventH\x00R\x16passwordResetRequested\x12L\n" +
+	"\x10password_changed\x18\r \x01(\v2\x1f.events.v1.PasswordChangedEventH\x00R\x0fpasswordChanged\x12L\n" +
+	"\x10sessions_revoked\x18\x0e \x01(\v2\x1f.events.v1.SessionsRevokedEventH\x00R\x0fsessionsRevoked\x12V\n" +
+	"\x14magic_link_requested\x18\x0f \x01(\v2\".events.v1.MagicLinkRequestedEventH\x00R\x12magicLinkRequested\x12\\\n" +
+	"\x16email_change_requested\x18\x10 \x01(\v2$.events.v1.EmailChangeRequestedEventH\x00R\x14emailChangeRequested\x12M\n" +
+	"\x11data_export_ready\x18\x11 \x01(\v2\x1f.events.v1.DataExportReadyEventH\x00R\x0fdataExportReady\x12h\n" +
+	"\x1aaccount_deletion_scheduled\x18\x12 \x01(\v2(.events.v1.AccountDeletionScheduledEventH\x00R\x18accountDeletionScheduled\x12@\n" +
+	"\fuser_deleted\x18\x13 \x01(\v2\x1b.events.v1.UserDeletedEventH\x00R\vuserDeleted\x12Y\n" +
+	"\x15habit_partner_invited\x18\x14 \x01(\v2#.events.v1.HabitPartnerInvitedEventH\x00R\x13habitPartnerInvited\x12@\n" +
+	"\fhabit_nudged\x18\x15 \x01(\v2\x1b.events.v1.HabitNudgedEventH\x00R\vhabitNudged\x12S\n" +
+	"\x13habit_streak_broken\x18\x16 \x01(\v2!.events.v1.HabitStreakBrokenEventH\x00R\x11habitStreakBroken\x12X\n" +
+	"\x14achievement_unlocked\x18\x17 \x01(\v2#.events.v1.AchievementUnlockedEventH\x00R\x13achievementUnlocked\x12I\n" +
+	"\x0fhabit_confirmed\x18\x18 \x01(\v2\x1e.events.v1.HabitConfirmedEventH\x00R\x0ehabitConfirmedB\t\n" +
+	"\apayload*\xc1\x04\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aEVENT_TYPE_USER_REGISTERED\x10\x01\x12+\n" +
+	"'EVENT_TYPE_EMAIL_VERIFICATION_REQUESTED\x10\x02\x12'\n" +
+	"#EVENT_TYPE_PASSWORD_RESET_REQUESTED\x10\x03\x12\x1f\n" +
+	"\x1bEVENT_TYPE_PASSWORD_CHANGED\x10\x04\x12\x1f\n" +
+	"\x1bEVENT_TYPE_SESSIONS_REVOKED\x10\x05\x12#\n" +
+	"\x1fEVENT_TYPE_MAGIC_LINK_REQUESTED\x10\x06\x12%\n" +
+	"!EVENT_TYPE_EMAIL_CHANGE_REQUESTED\x10\a\x12 \n" +
+	"\x1cEVENT_TYPE_DATA_EXPORT_READY\x10\b\x12)\n" +
+	"%EVENT_TYPE_ACCOUNT_DELETION_SCHEDULED\x10\t\x12\x1b\n" +
+	"\x17EVENT_TYPE_USER_DELETED\x10\n" +
+	"\x12$\n" +
+	" EVENT_TYPE_HABIT_PARTNER_INVITED\x10\v\x12\x1b\n" +
+	"\x17EVENT_TYPE_HABIT_NUDGED\x10\f\x12\"\n" +
+	"\x1eEVENT_TYPE_HABIT_STREAK_BROKEN\x10\r\x12#\n" +
+	"\x1fEVENT_TYPE_ACHIEVEMENT_UNLOCKED\x10\x0e\x12\x1e\n" +
+	"\x1aEVENT_TYPE_HABIT_CONFIRMED\x10\x0f*\x89\x01\n" +
+	"\x10NotificationType\x12!\n" +
+	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17NOTIFICATION_TYPE_EMAIL\x10\x01\x12\x19\n" +
+	"\x15NOTIFICATION_TYPE_SMS\x10\x02\x12\x1a\n" +
+	"\x16NOTIFICATION_TYPE_PUSH\x10\x03B/Z-notification-service/proto/events/v1;eventspbb\x06proto3"
+
+var (
+	file_events_v1_events_proto_rawDescOnce sync.Once
+	file_events_v1_events_proto_rawDescData []byte
+)
+
+func file_events_v1_events_proto_rawDescGZIP() []byte {
+	file_events_v1_events_proto_rawDescOnce.Do(func() {
+		file_events_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_events_proto_rawDesc), len(file_events_v1_events_proto_rawDesc)))
+	})
+	return file_events_v1_events_proto_rawDescData
+}
+
+var file_events_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_events_v1_events_proto_goTypes = []any{
+	(EventType)(0),                          // 0: events.v1.EventType
+	(NotificationType)(0),                   // 1: events.v1.NotificationType
+	(*UserRegisteredEvent)(nil),             // 2: events.v1.UserRegisteredEvent
+	(*EmailVerificationRequestedEvent)(nil), // 3: events.v1.EmailVerificationRequestedEvent
+	(*PasswordResetRequestedEvent)(nil),     // 4: events.v1.PasswordResetRequestedEvent
+	(*PasswordChangedEvent)(nil),            // 5: events.v1.PasswordChangedEvent
+	(*SessionsRevokedEvent)(nil),            // 6: events.v1.SessionsRevokedEvent
+	(*MagicLinkRequestedEvent)(nil),         // 7: events.v1.MagicLinkRequestedEvent
+	(*EmailChangeRequestedEvent)(nil),       // 8: events.v1.EmailChangeRequestedEvent
+	(*DataExportReadyEvent)(nil),            // 9: events.v1.DataExportReadyEvent
+	(*AccountDeletionScheduledEvent)(nil),   // 10: events.v1.AccountDeletionScheduledEvent
+	(*UserDeletedEvent)(nil),                // 11: events.v1.UserDeletedEvent
+	(*HabitPartnerInvitedEvent)(nil),        // 12: events.v1.HabitPartnerInvitedEvent
+	(*HabitNudgedEvent)(nil),                // 13: events.v1.HabitNudgedEvent
+	(*HabitStreakBrokenEvent)(nil),          // 14: events.v1.HabitStreakBrokenEvent
+	(*AchievementUnlockedEvent)(nil),        // 15: events.v1.AchievementUnlockedEvent
+	(*HabitConfirmedEvent)(nil),             // 16: events.v1.HabitConfirmedEvent
+	(*Event)(nil),                           // 17: events.v1.Event
+	(*timestamppb.Timestamp)(nil),           // 18: google.protobuf.Timestamp
+}
+var file_events_v1_events_proto_depIdxs = []int32{
+	18, // 0: events.v1.UserRegisteredEvent.created_at:type_name -> google.protobuf.Timestamp
+	18, // 1: events.v1.EmailVerificationRequestedEvent.requested_at:type_name -> google.protobuf.Timestamp
+	18, // 2: events.v1.PasswordResetRequestedEvent.requested_at:type_name -> google.protobuf.Timestamp
+	18, // 3: events.v1.PasswordChangedEvent.changed_at:type_name -> google.protobuf.Timestamp
+	18, // 4: events.v1.SessionsRevokedEvent.revoked_at:type_name -> google.protobuf.Timestamp
+	18, // 5: events.v1.MagicLinkRequestedEvent.requested_at:type_name -> google.protobuf.Timestamp
+	18, // 6: events.v1.MagicLinkRequestedEvent.expires_at:type_name -> google.protobuf.Timestamp
+	18, // 7: events.v1.EmailChangeRequestedEvent.requested_at:type_name -> google.protobuf.Timestamp
+	18, // 8: events.v1.DataExportReadyEvent.expires_at:type_name -> google.protobuf.Timestamp
+	18, // 9: events.v1.AccountDeletionScheduledEvent.requested_at:type_name -> google.protobuf.Timestamp
+	18, // 10: events.v1.AccountDeletionScheduledEvent.scheduled_for:type_name -> google.protobuf.Timestamp
+	18, // 11: events.v1.UserDeletedEvent.deleted_at:type_name -> google.protobuf.Timestamp
+	18, // 12: events.v1.HabitPartnerInvitedEvent.invited_at:type_name -> google.protobuf.Timestamp
+	18, // 13: events.v1.HabitNudgedEvent.nudged_at:type_name -> google.protobuf.Timestamp
+	18, // 14: events.v1.HabitStreakBrokenEvent.broken_at:type_name -> google.protobuf.Timestamp
+	18, // 15: events.v1.AchievementUnlockedEvent.unlocked_at:type_name -> google.protobuf.Timestamp
+	18, // 16: events.v1.HabitConfirmedEvent.confirmed_at:type_name -> google.protobuf.Timestamp
+	0,  // 17: events.v1.Event.event_type:type_name -> events.v1.EventType
+	18, // 18: events.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 19: events.v1.Event.user_registered:type_name -> events.v1.UserRegisteredEvent
+	3,  // 20: events.v1.Event.email_verification_requested:type_name -> events.v1.EmailVerificationRequestedEvent
+	4,  // 21: events.v1.Event.password_reset_requested:type_name -> events.v1.PasswordResetRequestedEvent
+	5,  // 22: events.v1.Event.password_changed:type_name -> events.v1.PasswordChangedEvent
+	6,  // 23: events.v1.Event.sessions_revoked:type_name -> events.v1.SessionsRevokedEvent
+	7,  // 24: events.v1.Event.magic_link_requested:type_name -> events.v1.MagicLinkRequestedEvent
+	8,  // 25: events.v1.Event.email_change_requested:type_name -> events.v1.EmailChangeRequestedEvent
+	9,  // 26: events.v1.Event.data_export_ready:type_name -> events.v1.DataExportReadyEvent
+	10, // 27: events.v1.Event.account_deletion_scheduled:type_name -> events.v1.AccountDeletionScheduledEvent
+	11, // 28: events.v1.Event.user_deleted:type_name -> events.v1.UserDeletedEvent
+	12, // 29: events.v1.Event.habit_partner_invited:type_name -> events.v1.HabitPartnerInvitedEvent
+	13, // 30: events.v1.Event.habit_nudged:type_name -> events.v1.HabitNudgedEvent
+	14, // 31: events.v1.Event.habit_streak_broken:type_name -> events.v1.HabitStreakBrokenEvent
+	15, // 32: events.v1.Event.achievement_unlocked:type_name -> events.v1.AchievementUnlockedEvent
+	16, // 33: events.v1.Event.habit_confirmed:type_name -> events.v1.HabitConfirmedEvent
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_events_proto_init() }
+func file_events_v1_events_proto_init() {
+	if File_events_v1_events_proto != nil {
+		return
+	}
+	file_events_v1_events_proto_msgTypes[15].OneofWrappers = []any{
+		(*Event_UserRegistered)(nil),
+		(*Event_EmailVerificationRequested)(nil),
+		(*Event_PasswordResetRequested)(nil),
+		(*Event_PasswordChanged)(nil),
+		(*Event_SessionsRevoked)(nil),
+		(*Event_MagicLinkRequested)(nil),
+		(*Event_EmailChangeRequested)(nil),
+		(*Event_DataExportReady)(nil),
+		(*Event_AccountDeletionScheduled)(nil),
+		(*Event_UserDeleted)(nil),
+		(*Event_HabitPartnerInvited)(nil),
+		(*Event_HabitNudged)(nil),
+		(*Event_HabitStreakBroken)(nil),
+		(*Event_AchievementUnlocked)(nil),
+		(*Event_HabitConfirmed)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_events_proto_rawDesc), len(file_events_v1_events_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_events_proto_goTypes,
+		DependencyIndexes: file_events_v1_events_proto_depIdxs,
+		EnumInfos:         file_events_v1_events_proto_enumTypes,
+		MessageInfos:      file_events_v1_events_proto_msgTypes,
+	}.Build()
+	File_events_v1_events_proto = out.File
+	file_events_v1_events_proto_goTypes = nil
+	file_events_v1_events_proto_depIdxs = nil
+}
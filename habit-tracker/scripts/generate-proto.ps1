@@ -170,6 +170,49 @@ if (Test-Path "proto\events\v1\events.proto") {
     }
 }
 
+# Generate Notification Service protos
+if (Test-Path "proto\notification\v1\notification.proto") {
+    Print-Info "Generating notification-service protos..."
+    Push-Location "services\notification-service"
+    try {
+        & protoc `
+            --go_out=. `
+            --go_opt=paths=source_relative `
+            --go-grpc_out=. `
+            --go-grpc_opt=paths=source_relative `
+            -I ..\..\proto `
+            ..\..\proto\notification\v1\notification.proto
+
+        if ($LASTEXITCODE -eq 0) {
+            New-Item -ItemType Directory -Force -Path "proto\notification\v1" | Out-Null
+            if (Test-Path "notification\v1\notification.pb.go") {
+                Move-Item -Force "notification\v1\*.pb.go" "proto\notification\v1\"
+                Remove-Item -Recurse -Force "notification"
+            }
+            Pop-Location
+            Print-Success "Generated notification-service protos"
+        } else {
+            Pop-Location
+            Print-Error "Failed to generate notification-service protos"
+        }
+    } catch {
+        Pop-Location
+        Print-Error "Failed to generate notification-service protos: $_"
+    }
+}
+
+# Copy client protos used by user-service data export
+Print-Info "Copying habits and notification protos to user-service..."
+try {
+    New-Item -ItemType Directory -Force -Path "services\user-service\proto\habits\v1" | Out-Null
+    New-Item -ItemType Directory -Force -Path "services\user-service\proto\notification\v1" | Out-Null
+    Copy-Item -Force "services\habits-service\proto\habits\v1\*.pb.go" "services\user-service\proto\habits\v1\"
+    Copy-Item -Force "services\notification-service\proto\notification\v1\*.pb.go" "services\user-service\proto\notification\v1\"
+    Print-Success "Copied protos to user-service"
+} catch {
+    Print-Error "Failed to copy protos to user-service: $_"
+}
+
 # Copy user service protos to api-gateway
 Print-Info "Copying user service protos to api-gateway..."
 try {
@@ -190,4 +233,14 @@ try {
     Print-Error "Failed to copy habits protos to api-gateway: $_"
 }
 
+# Copy events protos consumed by habits-service
+Print-Info "Copying events protos to habits-service..."
+try {
+    New-Item -ItemType Directory -Force -Path "services\habits-service\proto\events\v1" | Out-Null
+    Copy-Item -Force "services\notification-service\proto\events\v1\*.pb.go" "services\habits-service\proto\events\v1\"
+    Print-Success "Copied events protos to habits-service"
+} catch {
+    Print-Error "Failed to copy events protos to habits-service: $_"
+}
+
 Print-Success "All proto files generated successfully!"
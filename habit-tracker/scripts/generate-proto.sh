@@ -141,4 +141,39 @@ if [ -f "proto/events/v1/events.proto" ]; then
     fi
 fi
 
+# Generate Notification Service protos
+if [ -f "proto/notification/v1/notification.proto" ]; then
+    print_info "Generating notification-service protos..."
+    cd services/notification-service
+    protoc \
+        --go_out=. \
+        --go_opt=paths=source_relative \
+        --go-grpc_out=. \
+        --go-grpc_opt=paths=source_relative \
+        -I ../../proto \
+        ../../proto/notification/v1/notification.proto
+
+    if [ $? -eq 0 ]; then
+        mkdir -p proto/notification/v1
+        if [ -f "notification/v1/notification.pb.go" ]; then
+            mv notification/v1/*.pb.go proto/notification/v1/
+            rm -rf notification
+        fi
+        cd ../..
+        print_success "Generated notification-service protos"
+    else
+        cd ../..
+        print_error "Failed to generate notification-service protos"
+    fi
+fi
+
+# Copy client protos used by user-service data export
+mkdir -p services/user-service/proto/habits/v1 services/user-service/proto/notification/v1
+cp services/habits-service/proto/habits/v1/*.pb.go services/user-service/proto/habits/v1/
+cp services/notification-service/proto/notification/v1/*.pb.go services/user-service/proto/notification/v1/
+
+# Copy events protos consumed by habits-service
+mkdir -p services/habits-service/proto/events/v1
+cp services/notification-service/proto/events/v1/*.pb.go services/habits-service/proto/events/v1/
+
 print_success "All proto files generated successfully!"
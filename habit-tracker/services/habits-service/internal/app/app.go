@@ -9,21 +9,36 @@ import (
 	"time"
 
 	"habits-service/internal/config"
+	domainservice "habits-service/internal/domain/service"
 	cronpkg "habits-service/internal/infrastructure/cron"
 	infradb "habits-service/internal/infrastructure/db"
+	"habits-service/internal/infrastructure/kafka"
 	"habits-service/internal/infrastructure/postgres"
+	infraredis "habits-service/internal/infrastructure/redis"
+	"habits-service/internal/infrastructure/storage"
 	"habits-service/internal/service"
 	"habits-service/internal/transport/grpc"
+	userpb "habits-service/proto/user/v1"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 // App represents the application
 type App struct {
-	config          *config.Config
-	grpcServer      *grpc.Server
-	deadlineChecker *cronpkg.DeadlineChecker
-	dbPool          *pgxpool.Pool
+	config            *config.Config
+	grpcServer        *grpc.Server
+	deadlineChecker   *cronpkg.DeadlineChecker
+	userEvents        *kafka.UserEventsConsumer
+	feedEvents        *kafka.FeedConsumer
+	attachmentService domainservice.AttachmentService
+	analyticsService  domainservice.AnalyticsService
+	kafkaProducer     *kafka.Producer
+	userConn          *grpclib.ClientConn
+	redisClient       *redis.Client
+	dbPool            *pgxpool.Pool
 }
 
 // New creates a new application
@@ -42,12 +57,70 @@ func New() (*App, error) {
 	}
 	fmt.Println("Connected to PostgreSQL")
 
+	redisClient, err := infraredis.NewRedisClient(&cfg.Redis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+	fmt.Println("Connected to Redis")
+
+	attachmentStorage, err := storage.New(ctx, &cfg.Attachments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize attachment storage: %w", err)
+	}
+	fmt.Printf("Attachment storage initialized (%s)\n", cfg.Attachments.Backend)
+
 	habitRepo := postgres.NewHabitRepository(dbPool)
 	confirmationRepo := postgres.NewHabitConfirmationRepository(dbPool)
+	pauseRepo := postgres.NewHabitPauseRepository(dbPool)
+	analyticsRepo := postgres.NewAnalyticsRepository(dbPool)
+	groupRepo := postgres.NewHabitGroupRepository(dbPool)
+	templateRepo := postgres.NewHabitTemplateRepository(dbPool)
+	partnerRepo := postgres.NewHabitPartnerRepository(dbPool)
+	challengeRepo := postgres.NewChallengeRepository(dbPool)
+	leaderboards := infraredis.NewLeaderboardStorage(redisClient)
+	achievementRepo := postgres.NewAchievementRepository(dbPool)
+	followRepo := postgres.NewFollowRepository(dbPool)
+	feedRepo := postgres.NewFeedRepository(dbPool)
+	calendarFeedRepo := postgres.NewCalendarFeedRepository(dbPool)
+	importRepo := postgres.NewHabitImportRepository(dbPool)
+	journalRepo := postgres.NewJournalRepository(dbPool)
+	attachmentRepo := postgres.NewAttachmentRepository(dbPool)
+
+	userConn, err := grpclib.NewClient(
+		cfg.Services.UserServiceAddr,
+		grpclib.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to user-service: %w", err)
+	}
+
+	kafkaProducer := kafka.NewProducer(&cfg.Kafka)
+	fmt.Println("Kafka producer initialized")
+
+	userClient := userpb.NewUserServiceClient(userConn)
 
-	habitService := service.NewHabitService(habitRepo, confirmationRepo)
+	partnerService := service.NewHabitPartnerService(partnerRepo, habitRepo, pauseRepo, userClient, kafkaProducer)
+	challengeService := service.NewChallengeService(challengeRepo, habitRepo, confirmationRepo, leaderboards, userClient)
+	achievementService := service.NewAchievementService(achievementRepo, confirmationRepo, analyticsRepo, userClient, kafkaProducer)
+	followService := service.NewFollowService(followRepo, feedRepo, userClient)
+	feedService := service.NewFeedService(feedRepo, kafkaProducer)
+	calendarService := service.NewCalendarFeedService(calendarFeedRepo, habitRepo, confirmationRepo)
+	journalService := service.NewJournalService(journalRepo)
+	attachmentService := service.NewAttachmentService(attachmentRepo, attachmentStorage,
+		cfg.Attachments.QuotaBytes, cfg.Attachments.UnattachedTTL)
+	habitService := service.NewHabitService(habitRepo, confirmationRepo, pauseRepo, analyticsRepo, groupRepo, templateRepo, importRepo,
+		partnerService, challengeService, achievementService, followService, feedService, calendarService, journalService, attachmentService)
+	analyticsService := service.NewAnalyticsService(analyticsRepo)
+	groupService := service.NewHabitGroupService(groupRepo)
+	templateService := service.NewHabitTemplateService(templateRepo, habitService)
+	profileService := service.NewProfileService(habitRepo, userClient)
+	importService := service.NewHabitImportService(importRepo, habitRepo, habitService)
 	fmt.Println("Services initialized")
 
+	if err := importService.FailInterrupted(ctx); err != nil {
+		fmt.Printf("Warning: failed to fail interrupted habit imports: %v\n", err)
+	}
+
 	var deadlineChecker *cronpkg.DeadlineChecker
 	if cfg.Scheduler.Enabled {
 		deadlineChecker = cronpkg.NewDeadlineChecker(habitService,
@@ -58,15 +131,28 @@ func New() (*App, error) {
 		fmt.Println("Deadline checker is disabled in configuration")
 	}
 
-	grpcHandler := grpc.NewHabitServiceHandler(habitService)
+	userEvents := kafka.NewUserEventsConsumer(&cfg.Kafka, habitService)
+	feedEvents := kafka.NewFeedConsumer(&cfg.Kafka, feedService)
+	fmt.Println("Kafka consumers initialized")
+
+	grpcHandler := grpc.NewHabitServiceHandler(habitService, groupService, templateService, partnerService, challengeService,
+		achievementService, followService, feedService, profileService, calendarService, importService, journalService, attachmentService)
+	analyticsHandler := grpc.NewHabitAnalyticsHandler(analyticsService)
 
-	grpcServer := grpc.NewServer(grpcHandler, cfg.GRPC.Port)
+	grpcServer := grpc.NewServer(grpcHandler, analyticsHandler, cfg.GRPC.Port)
 
 	return &App{
-		config:          cfg,
-		grpcServer:      grpcServer,
-		deadlineChecker: deadlineChecker,
-		dbPool:          dbPool,
+		config:            cfg,
+		grpcServer:        grpcServer,
+		deadlineChecker:   deadlineChecker,
+		userEvents:        userEvents,
+		feedEvents:        feedEvents,
+		attachmentService: attachmentService,
+		analyticsService:  analyticsService,
+		kafkaProducer:     kafkaProducer,
+		userConn:          userConn,
+		redisClient:       redisClient,
+		dbPool:            dbPool,
 	}, nil
 }
 
@@ -81,6 +167,28 @@ func (a *App) Run() error {
 		}
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	consumerDone := make(chan struct{})
+	go func() {
+		defer close(consumerDone)
+		if err := a.userEvents.Start(ctx); err != nil {
+			fmt.Printf("Kafka consumer error: %v\n", err)
+		}
+	}()
+
+	feedDone := make(chan struct{})
+	go func() {
+		defer close(feedDone)
+		if err := a.feedEvents.Start(ctx); err != nil {
+			fmt.Printf("Kafka feed consumer error: %v\n", err)
+		}
+	}()
+
+	go a.runAttachmentCleanup(ctx)
+	go a.runRollupRebuild(ctx)
+
 	go func() {
 		if err := a.grpcServer.Start(); err != nil {
 			fmt.Printf("gRPC server error: %v\n", err)
@@ -96,12 +204,69 @@ func (a *App) Run() error {
 
 	a.grpcServer.Stop()
 
+	cancel()
+	<-consumerDone
+	<-feedDone
+
 	if a.deadlineChecker != nil {
 		a.deadlineChecker.Stop()
 	}
 
+	if err := a.kafkaProducer.Close(); err != nil {
+		fmt.Printf("Error closing Kafka producer: %v\n", err)
+	}
+
+	a.userConn.Close()
+
+	if err := infraredis.Close(a.redisClient); err != nil {
+		fmt.Printf("Error closing Redis client: %v\n", err)
+	}
+
 	a.dbPool.Close()
 
 	fmt.Println("Server shutdown complete")
 	return nil
 }
+
+// runAttachmentCleanup periodically deletes attachments of deleted confirmations and uploads that were never attached
+func (a *App) runAttachmentCleanup(ctx context.Context) {
+	ticker := time.NewTicker(a.config.Attachments.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.attachmentService.CleanupOrphaned(ctx); err != nil {
+				fmt.Printf("Warning: failed to clean up orphaned attachments: %v\n", err)
+			}
+		}
+	}
+}
+
+// runRollupRebuild periodically recomputes the analytics rollups of users who confirmed habits since
+// the last successful run, repairing counts that drifted from the confirmations
+func (a *App) runRollupRebuild(ctx context.Context) {
+	interval := a.config.Scheduler.RollupRebuildInterval
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// The first run also covers confirmations made shortly before a restart
+	since := time.Now().Add(-interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			startedAt := time.Now()
+			if err := a.analyticsService.RebuildRollups(ctx, since); err != nil {
+				// Users that failed are rebuilt with the next run
+				fmt.Printf("Warning: failed to rebuild analytics rollups: %v\n", err)
+				continue
+			}
+			since = startedAt
+		}
+	}
+}
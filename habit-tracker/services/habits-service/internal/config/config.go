@@ -9,13 +9,15 @@ import (
 )
 
 type Config struct {
-	Service   ServiceConfig   `yaml:"service"`
-	GRPC      GRPCConfig      `yaml:"grpc"`
-	Database  DatabaseConfig  `yaml:"database"`
-	Redis     RedisConfig     `yaml:"redis"`
-	Kafka     KafkaConfig     `yaml:"kafka"`
-	Scheduler SchedulerConfig `yaml:"scheduler"`
-	Logging   LoggingConfig   `yaml:"logging"`
+	Service     ServiceConfig     `yaml:"service"`
+	GRPC        GRPCConfig        `yaml:"grpc"`
+	Database    DatabaseConfig    `yaml:"database"`
+	Redis       RedisConfig       `yaml:"redis"`
+	Kafka       KafkaConfig       `yaml:"kafka"`
+	Scheduler   SchedulerConfig   `yaml:"scheduler"`
+	Attachments AttachmentsConfig `yaml:"attachments"`
+	Services    ServicesConfig    `yaml:"services"`
+	Logging     LoggingConfig     `yaml:"logging"`
 }
 
 type ServiceConfig struct {
@@ -56,13 +58,42 @@ type RedisConfig struct {
 }
 
 type KafkaConfig struct {
-	Brokers []string `yaml:"brokers"`
-	Topic   string   `yaml:"topic"`
+	Brokers         []string `yaml:"brokers"`
+	Topic           string   `yaml:"topic"`
+	UserEventsTopic string   `yaml:"user_events_topic"`
+	GroupID         string   `yaml:"group_id"`
+	FeedGroupID     string   `yaml:"feed_group_id"` // Consumes the service's own habit events to fan out feeds
+
+	RetryBackoff    time.Duration `yaml:"retry_backoff"`     // Delay before a failed message is processed again
+	MaxRetryBackoff time.Duration `yaml:"max_retry_backoff"` // The delay doubles with every failure up to this
 }
 
 type SchedulerConfig struct {
-	CheckInterval time.Duration `yaml:"check_interval"` // How often to check for missed deadlines
-	Enabled       bool          `yaml:"enabled"`
+	CheckInterval         time.Duration `yaml:"check_interval"`          // How often to check for missed deadlines
+	RollupRebuildInterval time.Duration `yaml:"rollup_rebuild_interval"` // How often rollups of users who confirmed since the last run are recomputed
+	Enabled               bool          `yaml:"enabled"`
+}
+
+type AttachmentsConfig struct {
+	Backend         string        `yaml:"backend"` // local or s3
+	Dir             string        `yaml:"dir"`     // Root directory of the local backend
+	S3              S3Config      `yaml:"s3"`
+	QuotaBytes      int64         `yaml:"quota_bytes"`      // Storage per user, thumbnails included
+	UnattachedTTL   time.Duration `yaml:"unattached_ttl"`   // Uploads not used by a confirmation within this time are removed
+	CleanupInterval time.Duration `yaml:"cleanup_interval"` // How often removed and unused attachments are deleted from storage
+}
+
+type S3Config struct {
+	Endpoint  string `yaml:"endpoint"` // e.g. https://s3.eu-central-1.amazonaws.com or http://minio:9000
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	PathStyle bool   `yaml:"path_style"` // Address the bucket in the path instead of the host name, needed by most S3-compatible servers
+}
+
+type ServicesConfig struct {
+	UserServiceAddr string `yaml:"user_service_addr"`
 }
 
 type LoggingConfig struct {
@@ -89,10 +120,25 @@ func Load() (*Config, error) {
 	}
 
 	cfg.overrideFromEnv()
+	cfg.applyDefaults()
 
 	return &cfg, nil
 }
 
+// applyDefaults fills in values that must not stay zero when they are missing from the config file
+func (c *Config) applyDefaults() {
+	// A zero backoff would retry a failing message in a tight loop
+	if c.Kafka.RetryBackoff <= 0 {
+		c.Kafka.RetryBackoff = time.Second
+	}
+	if c.Kafka.MaxRetryBackoff < c.Kafka.RetryBackoff {
+		c.Kafka.MaxRetryBackoff = max(time.Minute, c.Kafka.RetryBackoff)
+	}
+	if c.Scheduler.RollupRebuildInterval <= 0 {
+		c.Scheduler.RollupRebuildInterval = 24 * time.Hour
+	}
+}
+
 // overrideFromEnv overrides config values with environment variables if present
 func (c *Config) overrideFromEnv() {
 	if val := os.Getenv("SERVICE_NAME"); val != "" {
@@ -131,6 +177,27 @@ func (c *Config) overrideFromEnv() {
 	if val := os.Getenv("REDIS_DB"); val != "" {
 		fmt.Sscanf(val, "%d", &c.Redis.DB)
 	}
+	if val := os.Getenv("ATTACHMENT_BACKEND"); val != "" {
+		c.Attachments.Backend = val
+	}
+	if val := os.Getenv("ATTACHMENT_DIR"); val != "" {
+		c.Attachments.Dir = val
+	}
+	if val := os.Getenv("ATTACHMENT_S3_ENDPOINT"); val != "" {
+		c.Attachments.S3.Endpoint = val
+	}
+	if val := os.Getenv("ATTACHMENT_S3_BUCKET"); val != "" {
+		c.Attachments.S3.Bucket = val
+	}
+	if val := os.Getenv("ATTACHMENT_S3_ACCESS_KEY"); val != "" {
+		c.Attachments.S3.AccessKey = val
+	}
+	if val := os.Getenv("ATTACHMENT_S3_SECRET_KEY"); val != "" {
+		c.Attachments.S3.SecretKey = val
+	}
+	if val := os.Getenv("USER_SERVICE_ADDR"); val != "" {
+		c.Services.UserServiceAddr = val
+	}
 	if val := os.Getenv("LOG_LEVEL"); val != "" {
 		c.Logging.Level = val
 	}
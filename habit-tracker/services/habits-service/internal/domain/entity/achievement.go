@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AchievementRuleKind is the fact an achievement rule checks
+type AchievementRuleKind string
+
+const (
+	// AchievementRuleConfirmations checks the total number of confirmations of the user
+	AchievementRuleConfirmations AchievementRuleKind = "confirmations"
+
+	// AchievementRuleStreak checks the streak of the habit that triggered the evaluation
+	AchievementRuleStreak AchievementRuleKind = "streak"
+
+	// AchievementRulePerfectDays checks consecutive local days, up to today, on which the user
+	// confirmed at least one habit and missed none
+	AchievementRulePerfectDays AchievementRuleKind = "perfect_days"
+
+	// AchievementRuleComeback checks the streak of a habit rebuilt after it lost one
+	AchievementRuleComeback AchievementRuleKind = "comeback"
+)
+
+// AchievementRule unlocks an achievement once the checked fact reaches the threshold
+type AchievementRule struct {
+	Kind      AchievementRuleKind
+	Threshold int32
+}
+
+// Achievement is a badge of the catalog
+type Achievement struct {
+	Code        string
+	Name        string
+	Description string
+	XP          int32
+	Rule        AchievementRule
+}
+
+// UnlockedAchievement is an achievement a user unlocked
+type UnlockedAchievement struct {
+	UserID     uuid.UUID
+	Code       string
+	HabitID    *uuid.UUID // Habit whose event unlocked it, nil once the habit is purged
+	UnlockedAt time.Time
+}
+
+// AchievementStatus is an achievement of the catalog as seen by a user
+type AchievementStatus struct {
+	Achievement *Achievement
+	Unlocked    *UnlockedAchievement // Nil while locked
+}
+
+// AchievementProgress is the XP, level and badges of a user
+type AchievementProgress struct {
+	XP           int32
+	Level        int32
+	LevelXP      int32 // XP at which the current level started
+	NextLevelXP  int32 // XP at which the next level starts
+	Achievements []*AchievementStatus
+}
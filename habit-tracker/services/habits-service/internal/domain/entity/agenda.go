@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// Agenda groups a user's active habits by what is expected of them today.
+// Each habit is evaluated in its own timezone
+type Agenda struct {
+	Due      []*AgendaItem // Deadline is today and not confirmed yet
+	AtRisk   []*AgendaItem // Due and the deadline is close
+	Done     []*AgendaItem // Confirmed today
+	Upcoming []*AgendaItem // Next deadline is after today, or the habit is paused
+}
+
+// AgendaItem is a habit on the agenda
+type AgendaItem struct {
+	Habit    *Habit
+	DueDate  string        // Local date of the next deadline (YYYY-MM-DD)
+	TimeLeft time.Duration // Until the next deadline, negative if it already passed
+}
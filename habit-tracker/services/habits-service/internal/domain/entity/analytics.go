@@ -0,0 +1,81 @@
+package entity
+
+import "github.com/google/uuid"
+
+// TrendGranularity is the size of the buckets of a completion trend
+type TrendGranularity string
+
+const (
+	TrendGranularityDay   TrendGranularity = "day"
+	TrendGranularityWeek  TrendGranularity = "week"
+	TrendGranularityMonth TrendGranularity = "month"
+)
+
+// TrendDirection summarizes how the completion rate develops over time
+type TrendDirection string
+
+const (
+	TrendImproving        TrendDirection = "improving"
+	TrendDeclining        TrendDirection = "declining"
+	TrendStable           TrendDirection = "stable"
+	TrendInsufficientData TrendDirection = "insufficient_data"
+)
+
+// CompletionPoint is the completion of all habits of a user within a bucket
+type CompletionPoint struct {
+	PeriodStart string // Date in format "YYYY-MM-DD"
+	Completed   int32
+	Missed      int32
+	Rate        float64 // Percentage (0-100) of completed out of completed and missed
+}
+
+// CompletionTrend is the completion rate of a user over time
+type CompletionTrend struct {
+	Points      []*CompletionPoint
+	OverallRate float64
+	Direction   TrendDirection
+	Slope       float64 // Change of the rate in percentage points per bucket
+}
+
+// WeekdayRate is the completion rate of a user on a day of the week
+type WeekdayRate struct {
+	Weekday   int32 // 0=Sunday, 1=Monday, ..., 6=Saturday
+	Completed int32
+	Missed    int32
+	Rate      float64
+}
+
+// WeekdayBreakdown is the completion rate of a user on each day of the week
+type WeekdayBreakdown struct {
+	Days         []*WeekdayRate // Indexed by weekday
+	BestWeekday  *int32         // Nil until any day has activity
+	WorstWeekday *int32
+}
+
+// HabitCorrelation tells how often two habits are completed on the same day
+type HabitCorrelation struct {
+	HabitAID     uuid.UUID
+	HabitAName   string
+	HabitBID     uuid.UUID
+	HabitBName   string
+	DaysTogether int32
+	Score        float64 // Jaccard index of the confirmed days (0-1)
+}
+
+// MoodCorrelation compares the mood of a user on days a habit was done and days it was not
+type MoodCorrelation struct {
+	HabitID        uuid.UUID
+	HabitName      string
+	DaysDone       int32
+	AvgMoodDone    *float64 // Nil without rated days
+	DaysNotDone    int32
+	AvgMoodNotDone *float64
+	Difference     *float64 // AvgMoodDone - AvgMoodNotDone, nil unless both are known
+}
+
+// MoodSummary is the mood of a user within a date range and how it relates to their habits
+type MoodSummary struct {
+	RatedDays   int32
+	AverageMood *float64
+	Habits      []*MoodCorrelation
+}
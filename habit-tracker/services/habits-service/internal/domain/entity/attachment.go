@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attachment is a photo uploaded as proof for a confirmation
+type Attachment struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	ConfirmationID *uuid.UUID // Nil until used by a confirmation
+	HabitID        *uuid.UUID // Habit of the confirmation, only loaded by GetByID
+
+	ContentType        string
+	SizeBytes          int64
+	ThumbnailSizeBytes int64
+	Width              int32
+	Height             int32
+
+	CreatedAt  time.Time
+	AttachedAt *time.Time
+}
+
+// AttachmentUsage is the attachment storage used by a user
+type AttachmentUsage struct {
+	UsedBytes  int64 // Originals and thumbnails
+	QuotaBytes int64
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "github.com/google/uuid"
+
+// CalendarDayState represents the state of a habit on a local date
+type CalendarDayState string
+
+const (
+	CalendarDayDone    CalendarDayState = "done"    // Confirmed on this date
+	CalendarDayMissed  CalendarDayState = "missed"  // Due on this date and the period was not completed
+	CalendarDayPending CalendarDayState = "pending" // Due today and not confirmed yet
+	CalendarDayNotDue  CalendarDayState = "not_due" // Nothing was expected on this date
+	CalendarDayFrozen  CalendarDayState = "frozen"  // Paused, or after the habit was archived
+	CalendarDayFuture  CalendarDayState = "future"  // After today
+)
+
+// CalendarDay is a single day of a habit calendar
+type CalendarDay struct {
+	Date  string // Date in format "YYYY-MM-DD" (in habit's timezone)
+	State CalendarDayState
+	Due   bool    // True when a period of the schedule ends on this date
+	Notes *string // Notes of the confirmation made on this date
+
+	AttachmentIDs []uuid.UUID // Photos attached to the confirmation as proof
+}
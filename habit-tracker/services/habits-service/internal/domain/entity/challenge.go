@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Challenge is a time-boxed challenge users join with one of their habits.
+// Confirmations of the habit for dates inside the window count toward the leaderboard
+type Challenge struct {
+	ID        uuid.UUID
+	CreatorID uuid.UUID
+
+	Name        string
+	Description *string
+
+	StartDate string // Inclusive, format "2006-01-02"
+	EndDate   string // Inclusive, format "2006-01-02"
+
+	InviteCode string
+
+	// ParticipantCount is not persisted, it is counted from the participants
+	ParticipantCount int32
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CoversDate reports whether the date is inside the challenge window
+func (c *Challenge) CoversDate(date string) bool {
+	return date >= c.StartDate && date <= c.EndDate
+}
+
+// HasEnded reports whether the window is over as of the date
+func (c *Challenge) HasEnded(date string) bool {
+	return date > c.EndDate
+}
+
+// ChallengeParticipant is a user taking part in a challenge with one of their habits
+type ChallengeParticipant struct {
+	ChallengeID uuid.UUID
+	UserID      uuid.UUID
+	Username    string
+	HabitID     uuid.UUID
+	JoinedAt    time.Time
+}
+
+// LeaderboardEntry is the position of a participant on a challenge leaderboard.
+// Participants are ranked by completions in the window, then by current streak
+type LeaderboardEntry struct {
+	Rank          int32 // 1-based
+	UserID        uuid.UUID
+	Username      string
+	Completions   int32
+	CurrentStreak int32
+}
+
+// Leaderboard is a page of a challenge leaderboard with the position of the requesting user
+type Leaderboard struct {
+	Entries []*LeaderboardEntry
+
+	// Me is nil when the requesting user is not a participant
+	Me *LeaderboardEntry
+
+	TotalParticipants int32
+}
@@ -17,4 +17,7 @@ type HabitConfirmation struct {
 
 	Notes     *string
 	CreatedAt time.Time
+
+	// AttachmentIDs are the photos attached as proof, only loaded where needed
+	AttachmentIDs []uuid.UUID
 }
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FeedItemKind is the kind of activity a feed item reports
+type FeedItemKind string
+
+const (
+	FeedItemConfirmation FeedItemKind = "confirmation"
+	FeedItemMilestone    FeedItemKind = "milestone"
+)
+
+// FeedItem is an activity of a followed user in the feed of a follower
+type FeedItem struct {
+	ID      uuid.UUID
+	UserID  uuid.UUID // Follower the item is shown to
+	ActorID uuid.UUID
+	HabitID uuid.UUID
+	EventID uuid.UUID // Habit event the item was fanned out from
+
+	Kind FeedItemKind
+
+	// Set for confirmations
+	ConfirmedForDate *string
+	Streak           *int32
+
+	// Set for milestones
+	AchievementCode *string
+
+	OccurredAt time.Time
+
+	// Not persisted, joined from the follow and the habit
+	ActorUsername   string
+	HabitName       string
+	HabitVisibility HabitVisibility
+
+	// AchievementName is not persisted, it comes from the catalog
+	AchievementName *string
+}
+
+// FeedPage is a page of the activity feed of a user, newest first
+type FeedPage struct {
+	Items []*FeedItem
+	Next  *PageCursor // Nil on the last page
+}
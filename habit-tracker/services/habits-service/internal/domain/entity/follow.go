@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FollowStatus represents the state of a follow request
+type FollowStatus string
+
+const (
+	FollowStatusPending  FollowStatus = "pending"
+	FollowStatusAccepted FollowStatus = "accepted"
+)
+
+// Follow is a follow relationship between two users, or a request to establish one.
+// Accepted followers see the followee's friends-only habits and get them in their activity feed
+type Follow struct {
+	FollowerID       uuid.UUID
+	FollowerUsername string
+	FolloweeID       uuid.UUID
+	FolloweeUsername string
+
+	Status FollowStatus
+
+	CreatedAt   time.Time
+	RespondedAt *time.Time
+}
+
+// IsAccepted reports whether the followee accepted the request
+func (f *Follow) IsAccepted() bool {
+	return f.Status == FollowStatusAccepted
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HabitGroup is a user-defined section of habits, e.g., "Morning routine"
+type HabitGroup struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+
+	Name  string
+	Color *string // HEX color, e.g., "#FF5722"
+	Icon  *string
+
+	Position int32 // Manual sort order, ascending
+
+	// HabitCount is not persisted, it counts active and archived habits in the group
+	HabitCount int32
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TagUsage is a tag of a user with the number of habits carrying it
+type TagUsage struct {
+	Tag        string
+	HabitCount int32
+}
+
+// HabitFilter selects habits of a user
+type HabitFilter struct {
+	Status  HabitStatus
+	GroupID *uuid.UUID // uuid.Nil selects habits without a group
+	Tag     *string
+}
@@ -14,6 +14,24 @@ const (
 	ScheduleTypeWeekly   ScheduleType = "weekly"
 )
 
+// HabitStatus filters habits by their archive state
+type HabitStatus string
+
+const (
+	HabitStatusActive   HabitStatus = "active"
+	HabitStatusArchived HabitStatus = "archived"
+	HabitStatusAll      HabitStatus = "all"
+)
+
+// HabitVisibility controls who besides the owner and accepted partners can see a habit
+type HabitVisibility string
+
+const (
+	HabitVisibilityPrivate HabitVisibility = "private"
+	HabitVisibilityFriends HabitVisibility = "friends" // Accepted followers
+	HabitVisibilityPublic  HabitVisibility = "public"  // Any user
+)
+
 // Habit represents a user's habit
 type Habit struct {
 	ID     uuid.UUID
@@ -22,6 +40,13 @@ type Habit struct {
 	Name        string
 	Description *string
 	Color       *string // HEX color, e.g., "#FF5722"
+	Icon        *string // Icon name chosen by the client, e.g., "dumbbell"
+
+	GroupID  *uuid.UUID // Nil when the habit is not in a group
+	Position int32      // Manual sort order, ascending
+	Tags     []string   // Lowercase, sorted
+
+	Visibility HabitVisibility
 
 	ScheduleType ScheduleType
 	IntervalDays *int32  // Required for interval type (1=daily, 2=every other day, etc.)
@@ -35,9 +60,23 @@ type Habit struct {
 	ConfirmedForCurrentPeriod bool
 	LastConfirmedAt           *time.Time
 
-	IsActive  bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	IsActive   bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ArchivedAt *time.Time // Set when the habit is archived
+
+	// IsPaused is not persisted, it is set when a pending pause covers the current local date
+	IsPaused bool
+}
+
+// IsArchived returns true if the habit has been archived
+func (h *Habit) IsArchived() bool {
+	return !h.IsActive
+}
+
+// IsVisibleToFollowers returns true if accepted followers can see the habit
+func (h *Habit) IsVisibleToFollowers() bool {
+	return h.Visibility == HabitVisibilityFriends || h.Visibility == HabitVisibilityPublic
 }
 
 // IsInterval returns true if the habit uses interval scheduling
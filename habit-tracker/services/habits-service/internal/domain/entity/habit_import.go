@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HabitImportSource is the tracker an import file was exported from
+type HabitImportSource string
+
+const (
+	HabitImportSourceLoop     HabitImportSource = "loop"     // Loop Habit Tracker CSV or ZIP export
+	HabitImportSourceHabitica HabitImportSource = "habitica" // Habitica user data JSON
+	HabitImportSourceStreaks  HabitImportSource = "streaks"  // Streaks CSV export
+)
+
+// HabitImportStatus represents state of a habit import job
+type HabitImportStatus string
+
+const (
+	HabitImportStatusPending    HabitImportStatus = "pending"
+	HabitImportStatusProcessing HabitImportStatus = "processing"
+	HabitImportStatusCompleted  HabitImportStatus = "completed"
+	HabitImportStatusFailed     HabitImportStatus = "failed"
+)
+
+// HabitImport is a job importing habits and their history from another tracker.
+// Dry runs only report the mapping and are never persisted
+type HabitImport struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+
+	Source HabitImportSource
+	Status HabitImportStatus
+	DryRun bool
+
+	TotalHabits        int32
+	TotalConfirmations int32
+	SkippedRows        int32
+
+	HabitsImported        int32
+	ConfirmationsImported int32
+
+	// Habits is only set on the response to the upload, jobs don't persist the mapping
+	Habits []*ImportedHabit
+	Issues []*ImportIssue
+
+	Error *string
+
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// IsInProgress checks if the import is still running
+func (i *HabitImport) IsInProgress() bool {
+	return i.Status == HabitImportStatusPending || i.Status == HabitImportStatusProcessing
+}
+
+// ImportedHabit is a habit mapped from another tracker together with the days it was done
+type ImportedHabit struct {
+	Name        string
+	Description *string
+	Color       *string
+
+	ScheduleType ScheduleType
+	IntervalDays *int32
+	WeeklyDays   []int32
+
+	Dates []string // Local dates in format "YYYY-MM-DD", ascending and unique
+
+	// Note explains how the schedule was approximated, nil when it maps exactly
+	Note *string
+}
+
+// ImportIssue is a row or habit of an import file that was skipped or could not be mapped
+type ImportIssue struct {
+	Row       *int32 // Line of the file, nil when the issue concerns a whole habit
+	HabitName *string
+	Reason    string
+}
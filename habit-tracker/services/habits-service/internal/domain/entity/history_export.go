@@ -0,0 +1,25 @@
+package entity
+
+// HistoryExportFormat is the file format of a habit history export
+type HistoryExportFormat string
+
+const (
+	HistoryExportFormatCSV  HistoryExportFormat = "csv"  // One row per confirmation
+	HistoryExportFormatJSON HistoryExportFormat = "json" // Habits and their confirmations
+)
+
+// UserDataExport is all data stored about a user, gathered for a data export
+type UserDataExport struct {
+	Habits         []*Habit             // Including archived habits
+	Confirmations  []*HabitConfirmation // With the IDs of their attachments
+	Pauses         []*HabitPause
+	Groups         []*HabitGroup
+	Templates      []*HabitTemplate // Private templates only
+	Partners       []*HabitPartner  // As owner or partner, including declined invitations
+	Challenges     []*Challenge     // Created or joined
+	Achievements   *AchievementProgress
+	Follows        []*Follow   // Followers, follow requests and followed users
+	FeedItems      []*FeedItem // Activity of followed users in the user's feed
+	JournalEntries []*JournalEntry
+	Attachments    []*Attachment
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JournalEntry is the journal of a user for one day
+type JournalEntry struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Date   string // Date in format "YYYY-MM-DD" (in user's timezone)
+
+	Mood    *int32 // Rating 1-5
+	Energy  *int32 // Rating 1-5
+	Content *string
+	Tags    []string
+
+	ConfirmationIDs []uuid.UUID // Confirmations of the same day the entry is linked to
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NoteKind tells where a note search result was found
+type NoteKind string
+
+const (
+	NoteKindJournal      NoteKind = "journal"
+	NoteKindConfirmation NoteKind = "confirmation"
+)
+
+// NoteSearchResult is a journal entry or confirmation note matching a search query
+type NoteSearchResult struct {
+	Kind    NoteKind
+	ID      uuid.UUID  // Journal entry or confirmation ID
+	Date    string     // Date in format "YYYY-MM-DD"
+	HabitID *uuid.UUID // Set for confirmation notes
+	Snippet string     // Excerpt with matches wrapped in "**"
+	Rank    float64
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "github.com/google/uuid"
+
+// PageCursor is the sort key of the last item of a page, the next page starts after it
+type PageCursor struct {
+	Key string    // Primary sort value of the listing, e.g. confirmed_for_date
+	ID  uuid.UUID // Tie breaker for items with equal keys
+}
+
+// PageRequest selects a page of a keyset paginated listing
+type PageRequest struct {
+	Size         int32
+	After        *PageCursor // Nil for the first page
+	IncludeTotal bool        // Total counts need a separate COUNT(*) and are skipped unless asked for
+}
+
+// HabitPage is a page of habits of a user
+type HabitPage struct {
+	Habits     []*Habit
+	Next       *PageCursor // Nil on the last page
+	TotalCount *int32
+}
+
+// HistoryPage is a page of the confirmation history of a habit
+type HistoryPage struct {
+	Confirmations []*HabitConfirmation
+	Pauses        []*HabitPause // Only on the first page
+	Next          *PageCursor
+	TotalCount    *int32
+}
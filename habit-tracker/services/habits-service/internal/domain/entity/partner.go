@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PartnerStatus represents the state of a partner invitation
+type PartnerStatus string
+
+const (
+	PartnerStatusPending  PartnerStatus = "pending"
+	PartnerStatusAccepted PartnerStatus = "accepted"
+	PartnerStatusDeclined PartnerStatus = "declined"
+)
+
+// HabitPartner is an accountability partner on a habit, or an invitation to become one.
+// Accepted partners can read the habit, its stats and calendar, and nudge the owner
+type HabitPartner struct {
+	ID      uuid.UUID
+	HabitID uuid.UUID
+
+	// HabitName is not persisted, it is joined from the habit
+	HabitName string
+
+	OwnerID         uuid.UUID
+	OwnerUsername   string
+	PartnerID       uuid.UUID
+	PartnerUsername string
+
+	Status       PartnerStatus
+	LastNudgedAt *time.Time
+
+	CreatedAt   time.Time
+	RespondedAt *time.Time
+}
+
+// IsAccepted reports whether the partner accepted the invitation
+func (p *HabitPartner) IsAccepted() bool {
+	return p.Status == PartnerStatusAccepted
+}
+
+// SharedHabit is a habit of another user seen by an accepted partner
+type SharedHabit struct {
+	PartnershipID uuid.UUID
+	OwnerUsername string
+	Habit         *Habit
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HabitPause represents a vacation period during which habit deadlines are frozen
+type HabitPause struct {
+	ID      uuid.UUID
+	HabitID uuid.UUID
+	UserID  uuid.UUID
+
+	StartDate string // Date in format "YYYY-MM-DD" (in habit's timezone)
+	EndDate   string // Inclusive, date in format "YYYY-MM-DD" (in habit's timezone)
+	Reason    *string
+
+	ResumedAt *time.Time // Set once the deadline was recalculated after the pause
+	CreatedAt time.Time
+}
+
+// CoversDate returns true if the pause is still pending and includes the given local date
+func (p *HabitPause) CoversDate(date string) bool {
+	return p.ResumedAt == nil && p.StartDate <= date && date <= p.EndDate
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// PublicProfile is a user who opted in to a profile readable without auth,
+// with their active public habits
+type PublicProfile struct {
+	Username    string
+	FirstName   string
+	MemberSince time.Time
+
+	Habits []*Habit // Ordered by the user's manual order
+}
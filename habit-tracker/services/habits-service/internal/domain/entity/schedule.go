@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+)
+
+// ScheduleVersion is a habit schedule in effect from a local date until the next version starts
+type ScheduleVersion struct {
+	HabitID uuid.UUID
+
+	ScheduleType ScheduleType
+	IntervalDays *int32
+	WeeklyDays   []int32
+
+	EffectiveFrom string // Date in format "YYYY-MM-DD" (in habit's timezone)
+}
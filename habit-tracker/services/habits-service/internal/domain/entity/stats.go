@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// HabitStats represents habit statistics computed against the habit's schedule.
+// A period is the span of days in which one confirmation is expected
+type HabitStats struct {
+	CurrentStreak      int32
+	LongestStreak      int32
+	TotalConfirmations int32
+	CompletionRate     float64
+	FirstConfirmation  *time.Time
+	LastConfirmation   *time.Time
+	PausedDays         int32
+
+	ExpectedPeriods  int32
+	CompletedPeriods int32
+
+	WeeklyRates  []*PeriodRate
+	MonthlyRates []*PeriodRate
+}
+
+// PeriodRate is the completion rate of the periods due within a calendar week or month
+type PeriodRate struct {
+	PeriodStart string // Date in format "YYYY-MM-DD", Monday for weeks and the 1st for months
+	Expected    int32
+	Completed   int32
+	Rate        float64
+}
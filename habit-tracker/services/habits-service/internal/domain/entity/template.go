@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HabitTemplate is a predefined habit a user can create a habit from.
+// Catalog templates are shared by all users, private templates are saved from a user's own habits
+type HabitTemplate struct {
+	ID     uuid.UUID
+	UserID *uuid.UUID // Nil for catalog templates
+
+	Name        string
+	Description *string
+	Color       *string // HEX color, e.g., "#FF5722"
+	Icon        *string
+	Category    *string // Catalog section, e.g., "health", nil for private templates
+	Tags        []string
+
+	ScheduleType ScheduleType
+	IntervalDays *int32
+	WeeklyDays   []int32
+
+	// SuggestedTarget is a free text hint, e.g., "8 glasses" or "20 minutes"
+	SuggestedTarget *string
+
+	CreatedAt time.Time
+}
+
+// IsPrivate reports whether the template belongs to a user
+func (t *HabitTemplate) IsPrivate() bool {
+	return t.UserID != nil
+}
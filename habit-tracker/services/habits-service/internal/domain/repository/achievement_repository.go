@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AchievementRepository defines the interface for unlocked achievements persistence
+type AchievementRepository interface {
+	// Unlock stores an unlocked achievement unless the user already has it.
+	// Returns false when it was unlocked before
+	Unlock(ctx context.Context, achievement *entity.UnlockedAchievement) (bool, error)
+
+	// GetByUserID retrieves the achievements a user unlocked, oldest first
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UnlockedAchievement, error)
+
+	// RecordStreakBreak stores the latest streak a habit lost
+	RecordStreakBreak(ctx context.Context, habitID, userID uuid.UUID, lostStreak int32, brokenAt time.Time) error
+
+	// HasStreakBreak reports whether a habit ever lost a streak
+	HasStreakBreak(ctx context.Context, habitID uuid.UUID) (bool, error)
+
+	// DeleteByUserID deletes the achievements and streak breaks of a user
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
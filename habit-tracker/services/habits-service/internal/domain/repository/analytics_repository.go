@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnalyticsRepository defines the interface for user analytics rollups
+type AnalyticsRepository interface {
+	// RecordMissed adds a missed deadline to the daily rollup of the given local date
+	RecordMissed(ctx context.Context, userID uuid.UUID, localDate string) error
+
+	// GetCompletionPoints aggregates daily rollups within [fromDate, toDate] into buckets
+	GetCompletionPoints(ctx context.Context, userID uuid.UUID, granularity entity.TrendGranularity, fromDate, toDate string) ([]*entity.CompletionPoint, error)
+
+	// GetWeekdayRates aggregates daily rollups by day of the week
+	GetWeekdayRates(ctx context.Context, userID uuid.UUID) ([]*entity.WeekdayRate, error)
+
+	// GetHourDistribution returns confirmations per local hour, indexed 0-23
+	GetHourDistribution(ctx context.Context, userID uuid.UUID) ([]int32, error)
+
+	// GetCorrelations returns habit pairs most often completed on the same day
+	GetCorrelations(ctx context.Context, userID uuid.UUID, limit int32) ([]*entity.HabitCorrelation, error)
+
+	// GetMoodCorrelations returns the journal mood within [fromDate, toDate] and per active habit the mood
+	// on days it was done and not done, counting only days since the habit was created
+	GetMoodCorrelations(ctx context.Context, userID uuid.UUID, fromDate, toDate string) (*entity.MoodSummary, error)
+
+	// GetConfirmingUserIDs returns the users who created confirmations at or after since
+	GetConfirmingUserIDs(ctx context.Context, since time.Time) ([]uuid.UUID, error)
+
+	// RebuildUserRollups recomputes the confirmation counts of a user's rollups from the confirmations,
+	// repairing rollups that drifted. Missed deadlines are kept
+	RebuildUserRollups(ctx context.Context, userID uuid.UUID) error
+
+	// DeleteByUserID removes all rollups of a user
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentRepository defines the interface for attachment metadata persistence
+type AttachmentRepository interface {
+	// CreateWithinQuota saves an attachment unless the user's attachments would exceed quotaBytes,
+	// returns whether it was saved
+	CreateWithinQuota(ctx context.Context, attachment *entity.Attachment, quotaBytes int64) (bool, error)
+
+	// GetByID retrieves an attachment by ID together with the habit of its confirmation
+	GetByID(ctx context.Context, attachmentID uuid.UUID) (*entity.Attachment, error)
+
+	// CountAttachable counts the given attachments of a user that were never used by a confirmation
+	CountAttachable(ctx context.Context, userID uuid.UUID, attachmentIDs []uuid.UUID) (int, error)
+
+	// GetIDsByConfirmationIDs retrieves the attachment IDs of confirmations, oldest upload first
+	GetIDsByConfirmationIDs(ctx context.Context, confirmationIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+
+	// GetUsedBytes returns the storage used by the attachments of a user
+	GetUsedBytes(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	// GetOrphaned retrieves attachments whose confirmation was deleted and unused uploads created before unusedBefore
+	GetOrphaned(ctx context.Context, unusedBefore time.Time, limit int32) ([]*entity.Attachment, error)
+
+	// GetByUserID retrieves all attachments of a user
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Attachment, error)
+
+	// Delete deletes attachments by ID
+	Delete(ctx context.Context, attachmentIDs []uuid.UUID) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CalendarFeedRepository defines the interface for ICS subscription token persistence
+type CalendarFeedRepository interface {
+	// Save stores the token hash of a user, replacing the previous one
+	Save(ctx context.Context, userID uuid.UUID, tokenHash []byte, createdAt time.Time) error
+
+	// GetUserID retrieves the user of a token hash
+	GetUserID(ctx context.Context, tokenHash []byte) (uuid.UUID, error)
+
+	// DeleteByUserID deletes the token of a user, returns whether there was one
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// ChallengeRepository defines the interface for challenge persistence
+type ChallengeRepository interface {
+	// Create creates a new challenge. A taken invite code makes it fail with an "already exists" error
+	Create(ctx context.Context, challenge *entity.Challenge) error
+
+	// GetByID retrieves a challenge with its participant count
+	GetByID(ctx context.Context, challengeID uuid.UUID) (*entity.Challenge, error)
+
+	// GetByInviteCode retrieves a challenge with its participant count by invite code
+	GetByInviteCode(ctx context.Context, inviteCode string) (*entity.Challenge, error)
+
+	// GetByUserID retrieves challenges the user created or joined, latest start first
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Challenge, error)
+
+	// GetByHabitID retrieves challenges a habit is taking part in
+	GetByHabitID(ctx context.Context, habitID uuid.UUID) ([]*entity.Challenge, error)
+
+	// Update updates a challenge of its creator. A taken invite code makes it fail with an "already exists" error
+	Update(ctx context.Context, challenge *entity.Challenge) error
+
+	// Delete deletes a challenge of its creator with its participants
+	Delete(ctx context.Context, challengeID, creatorID uuid.UUID) error
+
+	// AddParticipant adds a participant, a user can join a challenge once
+	AddParticipant(ctx context.Context, participant *entity.ChallengeParticipant) error
+
+	// RemoveParticipant removes a participant from a challenge
+	RemoveParticipant(ctx context.Context, challengeID, userID uuid.UUID) error
+
+	// GetParticipant retrieves a participant of a challenge
+	GetParticipant(ctx context.Context, challengeID, userID uuid.UUID) (*entity.ChallengeParticipant, error)
+
+	// GetParticipants retrieves all participants of a challenge, earliest joined first
+	GetParticipants(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeParticipant, error)
+
+	// DeleteByUserID deletes the challenges a user created and removes the user from the others
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
@@ -3,38 +3,42 @@ package repository
 import (
 	"context"
 	"habits-service/internal/domain/entity"
-	"time"
 
 	"github.com/google/uuid"
 )
 
 // HabitConfirmationRepository defines the interface for habit confirmation persistence
 type HabitConfirmationRepository interface {
-	// Create creates a new habit confirmation
+	// Create creates a new habit confirmation, links its attachments and adds it to the analytics rollups
+	// in the same transaction. Fails when an attachment isn't an unused upload of the user
 	Create(ctx context.Context, confirmation *entity.HabitConfirmation) error
 
-	// GetByHabitID retrieves confirmations for a habit with pagination
-	GetByHabitID(ctx context.Context, habitID uuid.UUID, limit, offset int32) ([]*entity.HabitConfirmation, error)
+	// GetByHabitID retrieves up to limit confirmations of a habit before the cursor, newest first
+	GetByHabitID(ctx context.Context, habitID uuid.UUID, limit int32, after *entity.PageCursor) ([]*entity.HabitConfirmation, error)
+
+	// GetByUserID retrieves all confirmations of a user across all habits
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitConfirmation, error)
+
+	// StreamByUserID calls fn for each confirmation of a user with a date in [fromDate, toDate], oldest first.
+	// A nil habitID selects all habits and nil dates leave the range open. Rows are read as fn consumes them
+	StreamByUserID(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID, fromDate, toDate *string,
+		fn func(*entity.HabitConfirmation) error) error
 
 	// CountByHabitID returns the total count of confirmations for a habit
 	CountByHabitID(ctx context.Context, habitID uuid.UUID) (int32, error)
 
+	// CountByUserID returns the total count of confirmations of a user across all habits
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int32, error)
+
+	// CountByHabitIDBetween returns the count of confirmations for a habit with dates in [fromDate, toDate]
+	CountByHabitIDBetween(ctx context.Context, habitID uuid.UUID, fromDate, toDate string) (int32, error)
+
 	// GetLatestByHabitID retrieves the most recent confirmation for a habit
 	GetLatestByHabitID(ctx context.Context, habitID uuid.UUID) (*entity.HabitConfirmation, error)
 
 	// ExistsForDate checks if a confirmation exists for a habit on a specific date
 	ExistsForDate(ctx context.Context, habitID uuid.UUID, date string) (bool, error)
 
-	// GetStats retrieves statistics for a habit
-	GetStats(ctx context.Context, habitID uuid.UUID) (*HabitStats, error)
-}
-
-// HabitStats represents habit statistics
-type HabitStats struct {
-	CurrentStreak        int32
-	LongestStreak        int32
-	TotalConfirmations   int32
-	CompletionRate       float64
-	FirstConfirmation    *time.Time
-	LastConfirmation     *time.Time
+	// GetAllByHabitID retrieves all confirmations of a habit ordered by date
+	GetAllByHabitID(ctx context.Context, habitID uuid.UUID) ([]*entity.HabitConfirmation, error)
 }
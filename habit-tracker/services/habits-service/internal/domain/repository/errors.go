@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is returned when a record doesn't exist or belongs to another user
+	ErrNotFound = errors.New("not found or unauthorized")
+
+	// ErrHabitNotFound is returned when a habit doesn't exist or belongs to another user
+	ErrHabitNotFound = fmt.Errorf("habit %w", ErrNotFound)
+
+	// ErrAlreadyExists is returned when a record conflicts with an existing one
+	ErrAlreadyExists = errors.New("already exists")
+
+	// ErrInvalidArgument is returned when input is rejected, messages wrapping it start with "invalid"
+	ErrInvalidArgument = errors.New("invalid")
+
+	// ErrTooManyRequests is returned when an action is repeated sooner than allowed
+	ErrTooManyRequests = errors.New("too many requests")
+)
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// FeedRepository defines the interface for activity feed persistence
+type FeedRepository interface {
+	// FanOut copies an item of the actor into the feed of every accepted follower.
+	// Items of an event already fanned out are skipped. Returns the number of items written
+	FanOut(ctx context.Context, item *entity.FeedItem) (int64, error)
+
+	// GetPage retrieves up to limit items of a user's feed after the cursor, newest first.
+	// Items of private or archived habits and of users no longer followed are left out
+	GetPage(ctx context.Context, userID uuid.UUID, limit int32, after *entity.PageCursor) ([]*entity.FeedItem, error)
+
+	// DeleteByActor deletes the items of an actor from a user's feed
+	DeleteByActor(ctx context.Context, userID, actorID uuid.UUID) error
+
+	// DeleteByUserID deletes the feed of a user and the items the user appears in as the actor
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FollowRepository defines the interface for follow persistence
+type FollowRepository interface {
+	// Create creates a pending follow request. An existing request or follow of the same pair
+	// makes it fail with an "already exists" error
+	Create(ctx context.Context, follow *entity.Follow) error
+
+	// Get retrieves the follow or follow request of a pair
+	Get(ctx context.Context, followerID, followeeID uuid.UUID) (*entity.Follow, error)
+
+	// Accept accepts a pending follow request sent to the followee
+	Accept(ctx context.Context, followerID, followeeID uuid.UUID, respondedAt time.Time) error
+
+	// IsFollowing reports whether the follower has an accepted follow of the followee
+	IsFollowing(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error)
+
+	// GetFollowers retrieves the follows of a user with the given status, newest first
+	GetFollowers(ctx context.Context, followeeID uuid.UUID, status entity.FollowStatus) ([]*entity.Follow, error)
+
+	// GetFollowing retrieves the pending and accepted follows of a user, newest first
+	GetFollowing(ctx context.Context, followerID uuid.UUID) ([]*entity.Follow, error)
+
+	// Delete deletes the follow or follow request of a pair
+	Delete(ctx context.Context, followerID, followeeID uuid.UUID) error
+
+	// DeletePending deletes a follow request that wasn't accepted yet
+	DeletePending(ctx context.Context, followerID, followeeID uuid.UUID) error
+
+	// DeleteByUserID deletes all follows where the user is the follower or the followee
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// HabitGroupRepository defines the interface for habit group persistence
+type HabitGroupRepository interface {
+	// Create creates a new group at the end of the user's manual order
+	Create(ctx context.Context, group *entity.HabitGroup) error
+
+	// GetByIDAndUserID retrieves a group of a user with its habit count
+	GetByIDAndUserID(ctx context.Context, groupID, userID uuid.UUID) (*entity.HabitGroup, error)
+
+	// GetByUserID retrieves all groups of a user in manual order with their habit counts
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitGroup, error)
+
+	// Update updates name, color and icon of a group
+	Update(ctx context.Context, group *entity.HabitGroup) error
+
+	// Delete deletes a group, its habits are kept without a group
+	Delete(ctx context.Context, groupID, userID uuid.UUID) error
+
+	// Reorder moves the listed groups to the top of the manual order atomically
+	Reorder(ctx context.Context, userID uuid.UUID, groupIDs []uuid.UUID) error
+
+	// DeleteByUserID deletes all groups of a user
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// HabitImportRepository defines the interface for habit import job persistence
+type HabitImportRepository interface {
+	// Create creates a pending import job together with the issues found while parsing
+	Create(ctx context.Context, habitImport *entity.HabitImport) error
+
+	// GetByID retrieves an import job with its issues
+	GetByID(ctx context.Context, importID uuid.UUID) (*entity.HabitImport, error)
+
+	// GetInProgressByUserID retrieves the pending or processing import of a user, nil if none
+	GetInProgressByUserID(ctx context.Context, userID uuid.UUID) (*entity.HabitImport, error)
+
+	// MarkProcessing marks an import job as running
+	MarkProcessing(ctx context.Context, importID uuid.UUID) error
+
+	// AddProgress counts a habit and its confirmations as imported
+	AddProgress(ctx context.Context, importID uuid.UUID, confirmations int32) error
+
+	// AddIssues appends issues found while importing
+	AddIssues(ctx context.Context, importID uuid.UUID, issues []*entity.ImportIssue) error
+
+	// MarkCompleted marks an import job as finished
+	MarkCompleted(ctx context.Context, importID uuid.UUID) error
+
+	// MarkFailed stores the failure reason
+	MarkFailed(ctx context.Context, importID uuid.UUID, errMsg string) error
+
+	// FailInProgress fails all pending and processing jobs, used on startup after unclean shutdown
+	FailInProgress(ctx context.Context, errMsg string) (int64, error)
+
+	// DeleteByUserID deletes all import jobs of a user
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
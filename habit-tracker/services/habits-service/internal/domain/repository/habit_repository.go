@@ -10,25 +10,58 @@ import (
 
 // HabitRepository defines the interface for habit persistence
 type HabitRepository interface {
-	// Create creates a new habit
+	// Create creates a new habit together with its first schedule version
 	Create(ctx context.Context, habit *entity.Habit) error
 
+	// Import creates a habit like Create and bulk inserts its confirmation history,
+	// adding it to the analytics rollups in the same transaction
+	Import(ctx context.Context, habit *entity.Habit, confirmations []*entity.HabitConfirmation) error
+
 	// GetByID retrieves a habit by ID
 	GetByID(ctx context.Context, habitID uuid.UUID) (*entity.Habit, error)
 
-	// GetByUserID retrieves all habits for a user
-	GetByUserID(ctx context.Context, userID uuid.UUID, activeOnly bool) ([]*entity.Habit, error)
+	// GetByUserID retrieves habits for a user filtered by status
+	GetByUserID(ctx context.Context, userID uuid.UUID, status entity.HabitStatus) ([]*entity.Habit, error)
+
+	// GetPageByUserID retrieves up to limit filtered habits of a user after the cursor, in manual order
+	GetPageByUserID(ctx context.Context, userID uuid.UUID, filter entity.HabitFilter, limit int32, after *entity.PageCursor) ([]*entity.Habit, error)
+
+	// CountByUserID returns the number of habits of a user matching the filter
+	CountByUserID(ctx context.Context, userID uuid.UUID, filter entity.HabitFilter) (int32, error)
+
+	// GetSharedWithUser retrieves active habits of other users the user is an accepted partner on,
+	// ordered by owner and the owner's manual order
+	GetSharedWithUser(ctx context.Context, partnerID uuid.UUID) ([]*entity.SharedHabit, error)
+
+	// Reorder moves the listed habits to the top of the manual order atomically
+	Reorder(ctx context.Context, userID uuid.UUID, habitIDs []uuid.UUID) error
+
+	// GetTagsByUserID retrieves the tags of a user with the number of habits carrying each
+	GetTagsByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.TagUsage, error)
 
-	// Update updates a habit
+	// Update updates a habit and records a new schedule version if the schedule changed
 	Update(ctx context.Context, habit *entity.Habit) error
 
-	// Delete soft deletes a habit (sets is_active = false)
-	Delete(ctx context.Context, habitID uuid.UUID) error
+	// GetScheduleVersions retrieves the schedule history of a habit, oldest first
+	GetScheduleVersions(ctx context.Context, habitID uuid.UUID) ([]*entity.ScheduleVersion, error)
+
+	// Archive hides a habit from active lists and deadline checks, keeping its history
+	Archive(ctx context.Context, habitID uuid.UUID) error
+
+	// Unarchive restores an archived habit with a fresh deadline
+	Unarchive(ctx context.Context, habitID uuid.UUID, nextDeadline time.Time) error
+
+	// Purge permanently deletes an archived habit and its confirmations and removes them from the
+	// analytics rollups. Returns the number of deleted confirmations
+	Purge(ctx context.Context, habitID uuid.UUID) (int64, error)
 
 	// UpdateStreakAndDeadline updates the streak and next deadline for a habit
 	UpdateStreakAndDeadline(ctx context.Context, habitID uuid.UUID, streak int32, nextDeadline time.Time, confirmed bool) error
 
-	// GetHabitsWithMissedDeadlines retrieves habits that have passed their deadline and haven't been confirmed
+	// Habits with a pending pause covering the current local date are skipped by the deadline queries below
+
+	// GetHabitsWithMissedDeadlines retrieves habits that have passed their deadline and haven't been confirmed.
+	// Unlike the other deadline queries it skips habits paused on the local date of the deadline
 	GetHabitsWithMissedDeadlines(ctx context.Context) ([]*entity.Habit, error)
 
 	// GetHabitsToResetConfirmation retrieves confirmed habits where deadline is within time window
@@ -43,4 +76,7 @@ type HabitRepository interface {
 
 	// GetByIDAndUserID retrieves a habit by ID and user ID (for authorization)
 	GetByIDAndUserID(ctx context.Context, habitID, userID uuid.UUID) (*entity.Habit, error)
+
+	// DeleteByUserID permanently deletes all habits of a user together with their confirmations
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 }
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// JournalRepository defines the interface for journal entry persistence
+type JournalRepository interface {
+	// Upsert creates or replaces the entry of the user for its date together with its linked confirmations.
+	// Confirmations that are not the user's or not of the entry's date are rejected
+	Upsert(ctx context.Context, entry *entity.JournalEntry) error
+
+	// GetByDate retrieves the entry of a user for a date
+	GetByDate(ctx context.Context, userID uuid.UUID, date string) (*entity.JournalEntry, error)
+
+	// GetRange retrieves the entries of a user within [fromDate, toDate], optionally only those with a tag
+	GetRange(ctx context.Context, userID uuid.UUID, fromDate, toDate string, tag *string) ([]*entity.JournalEntry, error)
+
+	// GetByUserID retrieves all entries of a user, oldest first
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.JournalEntry, error)
+
+	// Delete deletes the entry of a user for a date
+	Delete(ctx context.Context, userID uuid.UUID, date string) error
+
+	// Search finds journal entries and confirmation notes of a user matching a web search style query, best matches first
+	Search(ctx context.Context, userID uuid.UUID, query string, limit int32) ([]*entity.NoteSearchResult, error)
+
+	// DeleteByUserID deletes all entries of a user
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
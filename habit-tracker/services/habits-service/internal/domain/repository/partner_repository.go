@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HabitPartnerRepository defines the interface for habit partner persistence
+type HabitPartnerRepository interface {
+	// Create creates a pending invitation. An invitation of the same partner declined at or before
+	// declinedBefore is reopened, a more recently declined one makes it fail with a "too many invitations"
+	// error and a pending or accepted one with an "already exists" error
+	Create(ctx context.Context, partner *entity.HabitPartner, declinedBefore time.Time) error
+
+	// GetByID retrieves a partnership with the name of its habit
+	GetByID(ctx context.Context, partnershipID uuid.UUID) (*entity.HabitPartner, error)
+
+	// GetAccepted retrieves the accepted partnership of a user on a habit
+	GetAccepted(ctx context.Context, habitID, partnerID uuid.UUID) (*entity.HabitPartner, error)
+
+	// GetByHabitID retrieves pending and accepted partners of a habit, oldest first
+	GetByHabitID(ctx context.Context, habitID uuid.UUID) ([]*entity.HabitPartner, error)
+
+	// GetPendingByPartnerID retrieves invitations to active habits awaiting the user's answer, newest first
+	GetPendingByPartnerID(ctx context.Context, partnerID uuid.UUID) ([]*entity.HabitPartner, error)
+
+	// GetByUserID retrieves all partnerships and invitations where the user is the owner or the partner,
+	// including declined ones
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPartner, error)
+
+	// CountByHabitID returns the number of pending and accepted partners of a habit
+	CountByHabitID(ctx context.Context, habitID uuid.UUID) (int32, error)
+
+	// Respond sets the status of a pending invitation sent to the partner
+	Respond(ctx context.Context, partnershipID, partnerID uuid.UUID, status entity.PartnerStatus, respondedAt time.Time) error
+
+	// RecordNudge stores a nudge unless the previous one was sent after notBefore.
+	// Returns false when the nudge was rejected
+	RecordNudge(ctx context.Context, partnershipID uuid.UUID, nudgedAt, notBefore time.Time) (bool, error)
+
+	// Delete deletes a partnership on behalf of the owner of the habit or the partner
+	Delete(ctx context.Context, partnershipID, userID uuid.UUID) error
+
+	// DeleteByUserID deletes all partnerships where the user is the owner or the partner
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
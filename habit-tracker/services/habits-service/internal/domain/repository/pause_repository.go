@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HabitPauseRepository defines the interface for habit pause persistence
+type HabitPauseRepository interface {
+	// CreateBatch creates pauses for several habits in a single transaction
+	CreateBatch(ctx context.Context, pauses []*entity.HabitPause) error
+
+	// GetByHabitID retrieves all pauses of a habit, newest first
+	GetByHabitID(ctx context.Context, habitID uuid.UUID) ([]*entity.HabitPause, error)
+
+	// GetPendingByUserID retrieves ongoing and upcoming pauses of a user
+	GetPendingByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPause, error)
+
+	// GetByUserID retrieves all pauses of a user, oldest first
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPause, error)
+
+	// ExistsOverlapping checks if a pending pause of the habit overlaps the given date range
+	ExistsOverlapping(ctx context.Context, habitID uuid.UUID, startDate, endDate string) (bool, error)
+
+	// GetEnded retrieves pending pauses whose end date has passed in the habit's timezone
+	GetEnded(ctx context.Context, now time.Time) ([]*entity.HabitPause, error)
+
+	// Resume closes a pause with the given end date and moves the habit to a fresh deadline
+	Resume(ctx context.Context, pause *entity.HabitPause, endDate string, nextDeadline time.Time) error
+
+	// Delete removes a pause that has not started yet
+	Delete(ctx context.Context, pauseID uuid.UUID) error
+}
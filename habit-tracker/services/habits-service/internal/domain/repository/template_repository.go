@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// HabitTemplateRepository defines the interface for habit template persistence
+type HabitTemplateRepository interface {
+	// Create creates a new private template
+	Create(ctx context.Context, template *entity.HabitTemplate) error
+
+	// GetVisible retrieves a catalog template or a private template of the user
+	GetVisible(ctx context.Context, templateID, userID uuid.UUID) (*entity.HabitTemplate, error)
+
+	// GetVisibleByUserID retrieves catalog templates followed by private templates of the user,
+	// optionally limited to a catalog category
+	GetVisibleByUserID(ctx context.Context, userID uuid.UUID, category *string) ([]*entity.HabitTemplate, error)
+
+	// CountByUserID returns the number of private templates of a user
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int32, error)
+
+	// Delete deletes a private template of a user, catalog templates can't be deleted
+	Delete(ctx context.Context, templateID, userID uuid.UUID) error
+
+	// DeleteByUserID deletes all private templates of a user
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// AchievementService defines the interface for XP, levels and achievements
+type AchievementService interface {
+	// HandleConfirmation evaluates the locked achievements of the owner of a confirmed habit
+	// and notifies about the ones unlocked. Evaluating the same confirmation again unlocks nothing new.
+	// Best effort, failures are logged
+	HandleConfirmation(ctx context.Context, habit *entity.Habit, confirmation *entity.HabitConfirmation)
+
+	// HandleStreakBroken remembers that a habit lost a streak, for the comeback achievement.
+	// Best effort, failures are logged
+	HandleStreakBroken(ctx context.Context, habit *entity.Habit, lostStreak int32)
+
+	// GetProgress retrieves the XP and level of a user with every achievement of the catalog,
+	// unlocked or not
+	GetProgress(ctx context.Context, userID uuid.UUID) (*entity.AchievementProgress, error)
+
+	// PurgeUserData deletes the achievements of a user
+	PurgeUserData(ctx context.Context, userID uuid.UUID) error
+}
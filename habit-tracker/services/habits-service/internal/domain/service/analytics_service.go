@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnalyticsService defines the interface for user-level analytics across habits
+type AnalyticsService interface {
+	// GetCompletionTrend retrieves the completion rate of all habits of a user within [fromDate, toDate]
+	GetCompletionTrend(ctx context.Context, userID uuid.UUID, granularity entity.TrendGranularity, fromDate, toDate string) (*entity.CompletionTrend, error)
+
+	// GetWeekdayBreakdown retrieves the completion rate per day of the week with the best and worst days
+	GetWeekdayBreakdown(ctx context.Context, userID uuid.UUID) (*entity.WeekdayBreakdown, error)
+
+	// GetHourDistribution retrieves the number of confirmations per local hour of the day
+	GetHourDistribution(ctx context.Context, userID uuid.UUID) ([]int32, error)
+
+	// GetHabitCorrelations retrieves habit pairs most often completed on the same day
+	GetHabitCorrelations(ctx context.Context, userID uuid.UUID, limit int32) ([]*entity.HabitCorrelation, error)
+
+	// GetMoodCorrelations compares the journal mood within [fromDate, toDate] on days each habit was done and not done
+	GetMoodCorrelations(ctx context.Context, userID uuid.UUID, fromDate, toDate string) (*entity.MoodSummary, error)
+
+	// RebuildRollups recomputes from the confirmations the rollups of users who confirmed habits at or
+	// after since, the users whose rollups may have drifted. Users whose rebuild fails are skipped and
+	// an error reports them once all other users are rebuilt
+	RebuildRollups(ctx context.Context, since time.Time) error
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentService defines the interface for photos attached to confirmations as proof
+type AttachmentService interface {
+	// Upload stores a JPEG or PNG image with a thumbnail. The attachment can then be passed to ConfirmHabit,
+	// uploads not used within a day are removed
+	Upload(ctx context.Context, userID uuid.UUID, data []byte) (*entity.Attachment, error)
+
+	// Get retrieves an attachment by ID, access is checked by the caller
+	Get(ctx context.Context, attachmentID uuid.UUID) (*entity.Attachment, error)
+
+	// Open opens the file of an attachment, or its JPEG thumbnail. The caller closes the reader
+	Open(ctx context.Context, attachment *entity.Attachment, thumbnail bool) (io.ReadCloser, error)
+
+	// GetUsage retrieves the storage used by the user and their quota
+	GetUsage(ctx context.Context, userID uuid.UUID) (*entity.AttachmentUsage, error)
+
+	// ValidateAttachable checks that the attachments belong to the user and were never used by a confirmation
+	ValidateAttachable(ctx context.Context, userID uuid.UUID, attachmentIDs []uuid.UUID) error
+
+	// GetAttachmentIDs retrieves the attachment IDs of confirmations
+	GetAttachmentIDs(ctx context.Context, confirmationIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+
+	// CleanupOrphaned deletes attachments of deleted confirmations and unused uploads from storage
+	CleanupOrphaned(ctx context.Context) error
+
+	// ExportUserData retrieves the metadata of all attachments of a user for a data export
+	ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.Attachment, error)
+
+	// PurgeUserData deletes all attachments of a deleted user
+	PurgeUserData(ctx context.Context, userID uuid.UUID) error
+}
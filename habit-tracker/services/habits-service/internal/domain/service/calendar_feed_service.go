@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CalendarFeedService defines the interface for ICS subscriptions of habit schedules
+type CalendarFeedService interface {
+	// RotateToken creates a new secret feed token for a user. The previous token stops working.
+	// The token is only returned here, it is stored hashed
+	RotateToken(ctx context.Context, userID uuid.UUID) (string, error)
+
+	// RevokeToken disables the feed of a user until a new token is created
+	RevokeToken(ctx context.Context, userID uuid.UUID) error
+
+	// RenderFeed renders the active habits of the token's user as an iCalendar document
+	RenderFeed(ctx context.Context, token string) ([]byte, error)
+
+	// PurgeUserData deletes the feed token of a deleted user
+	PurgeUserData(ctx context.Context, userID uuid.UUID) error
+}
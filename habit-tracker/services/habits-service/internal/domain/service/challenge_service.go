@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// ChallengeService defines the interface for group challenge business logic
+type ChallengeService interface {
+	// CreateChallenge creates a challenge with a fresh invite code. The creator joins separately with a habit
+	CreateChallenge(ctx context.Context, creatorID uuid.UUID, name string, description *string, startDate, endDate string) (*entity.Challenge, error)
+
+	// GetChallenge retrieves a challenge for its creator or a participant
+	GetChallenge(ctx context.Context, challengeID, userID uuid.UUID) (*entity.Challenge, error)
+
+	// ListChallenges retrieves the challenges a user created or joined
+	ListChallenges(ctx context.Context, userID uuid.UUID) ([]*entity.Challenge, error)
+
+	// UpdateChallenge updates a challenge of its creator, nil fields are left unchanged.
+	// Changing the window recalculates the leaderboard
+	UpdateChallenge(ctx context.Context, challengeID, creatorID uuid.UUID, name, description, startDate, endDate *string, regenerateInviteCode bool) (*entity.Challenge, error)
+
+	// DeleteChallenge deletes a challenge of its creator with its leaderboard
+	DeleteChallenge(ctx context.Context, challengeID, creatorID uuid.UUID) error
+
+	// JoinChallenge joins the challenge with the invite code, counting confirmations of one of the user's active habits
+	JoinChallenge(ctx context.Context, userID uuid.UUID, inviteCode string, habitID uuid.UUID) (*entity.Challenge, error)
+
+	// LeaveChallenge removes the user from a challenge and its leaderboard
+	LeaveChallenge(ctx context.Context, challengeID, userID uuid.UUID) error
+
+	// GetLeaderboard retrieves a page of the leaderboard with the position of the user,
+	// for the creator or a participant
+	GetLeaderboard(ctx context.Context, challengeID, userID uuid.UUID, offset, limit int32) (*entity.Leaderboard, error)
+
+	// RecordProgress updates the leaderboards of the running challenges a habit takes part in
+	// after it was confirmed or missed on date. Best effort, failures are logged
+	RecordProgress(ctx context.Context, habit *entity.Habit, date string)
+
+	// RemoveHabit removes the participants using a habit from leaderboards before it is purged.
+	// Best effort, failures are logged
+	RemoveHabit(ctx context.Context, habitID uuid.UUID)
+
+	// PurgeUserData deletes the challenges a user created and removes the user from the others
+	PurgeUserData(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"habits-service/internal/domain/repository"
+)
+
+// Errors the services wrap with %w, the transport maps them to status codes with errors.Is.
+// The errors of the repositories are passed on as they are
+var (
+	ErrNotFound        = repository.ErrNotFound
+	ErrHabitNotFound   = repository.ErrHabitNotFound
+	ErrAlreadyExists   = repository.ErrAlreadyExists
+	ErrInvalidArgument = repository.ErrInvalidArgument
+	ErrTooManyRequests = repository.ErrTooManyRequests
+
+	// ErrInvalidPageToken is returned for a page token that wasn't issued by the service
+	ErrInvalidPageToken = fmt.Errorf("%w page_token", ErrInvalidArgument)
+
+	// ErrHabitArchived is returned when an archived habit is confirmed, paused or archived again
+	ErrHabitArchived = errors.New("habit is archived")
+
+	// ErrHabitNotArchived is returned when a habit that isn't archived is restored or purged
+	ErrHabitNotArchived = errors.New("habit is not archived")
+
+	// ErrAlreadyPaused is returned when a pause overlaps an existing one
+	ErrAlreadyPaused = errors.New("habit is already paused for these dates")
+
+	// ErrNothingToPause is returned when a user without active habits pauses all of them
+	ErrNothingToPause = errors.New("no active habits to pause")
+
+	// ErrQuotaExceeded is returned when an upload doesn't fit in the user's storage quota
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
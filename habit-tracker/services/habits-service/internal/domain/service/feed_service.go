@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// FeedService defines the interface for activity feed business logic.
+// Confirmations are published as habit events and fanned out when the events are consumed
+type FeedService interface {
+	// PublishConfirmation publishes a confirmation of a habit visible to followers.
+	// Best effort, failures are logged
+	PublishConfirmation(ctx context.Context, habit *entity.Habit, confirmation *entity.HabitConfirmation)
+
+	// FanOut writes an item into the feeds of the accepted followers of its actor,
+	// unless the habit is private. Fanning out the same event again writes nothing
+	FanOut(ctx context.Context, item *entity.FeedItem) error
+
+	// GetFeed retrieves a page of the activity of users the user follows, newest first
+	GetFeed(ctx context.Context, userID uuid.UUID, page entity.PageRequest) (*entity.FeedPage, error)
+
+	// PurgeUserData deletes the feed of the user and the user's activity in other feeds
+	PurgeUserData(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// FollowService defines the interface for the follow graph business logic
+type FollowService interface {
+	// Follow sends a follow request to a user by username
+	Follow(ctx context.Context, followerID uuid.UUID, username string) (*entity.Follow, error)
+
+	// RespondToRequest accepts or declines a follow request sent to the followee.
+	// Declined requests are deleted and nil is returned
+	RespondToRequest(ctx context.Context, followeeID, followerID uuid.UUID, accept bool) (*entity.Follow, error)
+
+	// Unfollow stops following a user or withdraws a follow request
+	Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error
+
+	// RemoveFollower removes a follower or declines a follow request of the user
+	RemoveFollower(ctx context.Context, followeeID, followerID uuid.UUID) error
+
+	// ListFollowers retrieves the accepted followers of a user
+	ListFollowers(ctx context.Context, userID uuid.UUID) ([]*entity.Follow, error)
+
+	// ListFollowing retrieves the pending and accepted follows of a user
+	ListFollowing(ctx context.Context, userID uuid.UUID) ([]*entity.Follow, error)
+
+	// ListRequests retrieves the follow requests awaiting the user's answer
+	ListRequests(ctx context.Context, userID uuid.UUID) ([]*entity.Follow, error)
+
+	// IsFollowing reports whether the follower has an accepted follow of the followee
+	IsFollowing(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error)
+
+	// PurgeUserData deletes all follows where the user is the follower or the followee
+	PurgeUserData(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// HabitGroupService defines the interface for habit group business logic
+type HabitGroupService interface {
+	// CreateGroup creates a new group at the end of the user's groups
+	CreateGroup(ctx context.Context, userID uuid.UUID, name string, color, icon *string) (*entity.HabitGroup, error)
+
+	// ListGroups retrieves all groups of a user in manual order
+	ListGroups(ctx context.Context, userID uuid.UUID) ([]*entity.HabitGroup, error)
+
+	// UpdateGroup updates a group, empty color or icon clears it
+	UpdateGroup(ctx context.Context, groupID, userID uuid.UUID, name, color, icon *string) (*entity.HabitGroup, error)
+
+	// DeleteGroup deletes a group, its habits are kept without a group
+	DeleteGroup(ctx context.Context, groupID, userID uuid.UUID) error
+
+	// ReorderGroups moves the listed groups to the top in the given order, returns all groups in the new order
+	ReorderGroups(ctx context.Context, userID uuid.UUID, groupIDs []uuid.UUID) ([]*entity.HabitGroup, error)
+}
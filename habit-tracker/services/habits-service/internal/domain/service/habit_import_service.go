@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// HabitImportService defines the interface for importing habits and their history from other trackers
+type HabitImportService interface {
+	// ImportHabits maps an export of another tracker to habits. A dry run only reports the mapping
+	// and the skipped rows, otherwise the habits are imported by a background job
+	ImportHabits(ctx context.Context, userID uuid.UUID, source entity.HabitImportSource, data []byte,
+		timezone string, dryRun bool) (*entity.HabitImport, error)
+
+	// GetImport retrieves an import job of the user with its progress and issues
+	GetImport(ctx context.Context, userID, importID uuid.UUID) (*entity.HabitImport, error)
+
+	// FailInterrupted fails jobs left in progress by a previous run
+	FailInterrupted(ctx context.Context) error
+}
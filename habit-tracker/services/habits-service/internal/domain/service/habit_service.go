@@ -3,40 +3,104 @@ package service
 import (
 	"context"
 	"habits-service/internal/domain/entity"
-	"habits-service/internal/domain/repository"
+	"io"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 // HabitService defines the interface for habit business logic
 type HabitService interface {
-	// CreateHabit creates a new habit with timezone conversion
+	// CreateHabit creates a new habit with timezone conversion. Habits are private unless visibility is set
 	CreateHabit(ctx context.Context, userID uuid.UUID, name string, description, color *string,
-		scheduleType entity.ScheduleType, intervalDays *int32, weeklyDays []int32, timezone string) (*entity.Habit, error)
+		scheduleType entity.ScheduleType, intervalDays *int32, weeklyDays []int32, timezone string,
+		groupID *uuid.UUID, icon *string, tags []string, visibility *entity.HabitVisibility) (*entity.Habit, error)
 
-	// GetHabit retrieves a habit by ID
+	// ImportHabit creates a private habit with the history imported from another tracker.
+	// The streak and deadline are recomputed from the history
+	ImportHabit(ctx context.Context, userID uuid.UUID, imported *entity.ImportedHabit, timezoneOffset int32) (*entity.Habit, error)
+
+	// GetHabit retrieves a habit by ID. Other users can read active habits they are accepted partners on,
+	// friends-only habits of users they follow and public habits
 	GetHabit(ctx context.Context, habitID, userID uuid.UUID) (*entity.Habit, error)
 
-	// ListHabits retrieves all habits for a user
-	ListHabits(ctx context.Context, userID uuid.UUID, activeOnly bool) ([]*entity.Habit, int32, error)
+	// ListHabits retrieves a page of filtered habits for a user in manual order
+	ListHabits(ctx context.Context, userID uuid.UUID, filter entity.HabitFilter, page entity.PageRequest) (*entity.HabitPage, error)
+
+	// ReorderHabits moves the listed habits to the top of the manual order in the given order,
+	// the other habits keep their relative order after them
+	ReorderHabits(ctx context.Context, userID uuid.UUID, habitIDs []uuid.UUID) error
+
+	// ListTags retrieves the tags of a user with the number of habits carrying each
+	ListTags(ctx context.Context, userID uuid.UUID) ([]*entity.TagUsage, error)
+
+	// ListSharedHabits retrieves the active habits of other users the user is an accepted partner on
+	ListSharedHabits(ctx context.Context, userID uuid.UUID) ([]*entity.SharedHabit, error)
+
+	// GetTodayAgenda groups the user's active habits into due, at risk, done and upcoming for their local date.
+	// Habits are at risk when their deadline is within atRiskWithin
+	GetTodayAgenda(ctx context.Context, userID uuid.UUID, atRiskWithin time.Duration) (*entity.Agenda, error)
 
-	// UpdateHabit updates a habit
+	// UpdateHabit updates a habit. uuid.Nil as groupID removes the habit from its group,
+	// nil tags keep the current tags
 	UpdateHabit(ctx context.Context, habitID, userID uuid.UUID, name *string, description, color *string,
-		scheduleType *entity.ScheduleType, intervalDays *int32, weeklyDays []int32, timezone *string) (*entity.Habit, error)
+		scheduleType *entity.ScheduleType, intervalDays *int32, weeklyDays []int32, timezone *string,
+		groupID *uuid.UUID, icon *string, tags []string, visibility *entity.HabitVisibility) (*entity.Habit, error)
 
-	// DeleteHabit soft deletes a habit
+	// DeleteHabit archives a habit, kept for backward compatibility
 	DeleteHabit(ctx context.Context, habitID, userID uuid.UUID) error
 
-	// ConfirmHabit confirms habit completion for the current period
-	ConfirmHabit(ctx context.Context, habitID, userID uuid.UUID, notes *string) (*entity.Habit, *entity.HabitConfirmation, error)
+	// ArchiveHabit archives a habit, keeping its history and streak and pausing deadlines
+	ArchiveHabit(ctx context.Context, habitID, userID uuid.UUID) (*entity.Habit, error)
+
+	// UnarchiveHabit restores an archived habit with its streak and a fresh deadline
+	UnarchiveHabit(ctx context.Context, habitID, userID uuid.UUID) (*entity.Habit, error)
+
+	// PurgeHabit permanently deletes an archived habit and its confirmations, returns number of deleted confirmations
+	PurgeHabit(ctx context.Context, habitID, userID uuid.UUID) (int64, error)
+
+	// ConfirmHabit confirms habit completion for the current period, attaching previously uploaded photos
+	ConfirmHabit(ctx context.Context, habitID, userID uuid.UUID, notes *string, attachmentIDs []uuid.UUID) (*entity.Habit, *entity.HabitConfirmation, error)
+
+	// PauseHabits freezes deadlines of one habit, or of all active habits when habitID is nil, for the given local dates
+	PauseHabits(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID, startDate, endDate string, reason *string) ([]*entity.HabitPause, error)
 
-	// GetHabitHistory retrieves confirmation history for a habit
-	GetHabitHistory(ctx context.Context, habitID, userID uuid.UUID, limit, offset int32) ([]*entity.HabitConfirmation, int32, error)
+	// ResumeHabits ends ongoing pauses now and cancels upcoming ones, returns number of affected pauses
+	ResumeHabits(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID) (int32, error)
 
-	// GetHabitStats retrieves statistics for a habit
-	GetHabitStats(ctx context.Context, habitID, userID uuid.UUID) (*repository.HabitStats, error)
+	// ListPauses retrieves ongoing and upcoming pauses of a user, optionally for a single habit
+	ListPauses(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID) ([]*entity.HabitPause, error)
 
-	// ProcessMissedDeadlines checks for missed deadlines and resets streaks
+	// GetHabitHistory retrieves a page of the confirmation history of a habit, newest first.
+	// Pauses are returned with the first page only
+	GetHabitHistory(ctx context.Context, habitID, userID uuid.UUID, page entity.PageRequest) (*entity.HistoryPage, error)
+
+	// GetHabitCalendar resolves the state of every local date in [fromDate, toDate] from the schedule history.
+	// Readable by the same users as GetHabit, confirmation notes are only included for the owner
+	GetHabitCalendar(ctx context.Context, habitID, userID uuid.UUID, fromDate, toDate string) ([]*entity.CalendarDay, error)
+
+	// OpenAttachment opens a photo of the user, or a photo attached to a confirmation of a habit the user
+	// can read as with GetHabit. Uploads not used by a confirmation are only readable by their owner
+	OpenAttachment(ctx context.Context, attachmentID, userID uuid.UUID, thumbnail bool) (*entity.Attachment, io.ReadCloser, error)
+
+	// GetHabitStats computes statistics for a habit against its schedule history. Readable by the same users as GetHabit
+	GetHabitStats(ctx context.Context, habitID, userID uuid.UUID) (*entity.HabitStats, error)
+
+	// ExportUserHabits retrieves all data of a user covered by PurgeUserData, except the analytics rollups
+	// derived from the confirmations and the calendar feed token
+	ExportUserHabits(ctx context.Context, userID uuid.UUID) (*entity.UserDataExport, error)
+
+	// ExportHistory writes the habits of a user and their confirmations with dates in [fromDate, toDate]
+	// to w as CSV or JSON. A nil habitID exports all habits, empty dates leave the range open.
+	// Confirmations are streamed from the database, nothing is written before the request is validated
+	ExportHistory(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID, fromDate, toDate string,
+		format entity.HistoryExportFormat, w io.Writer) error
+
+	// PurgeUserData permanently deletes all habits, confirmations, groups, templates, partnerships, follows, feeds
+	// and the calendar feed token of a deleted user, returns number of deleted habits
+	PurgeUserData(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	// ProcessMissedDeadlines checks for missed deadlines, resets streaks and notifies partners of broken streaks
 	ProcessMissedDeadlines(ctx context.Context) error
 
 	// ResetConfirmationFlags resets confirmation flags for habits entering new period
@@ -44,4 +108,7 @@ type HabitService interface {
 
 	// ProcessExpiredConfirmedDeadlines moves confirmed habits with expired deadlines to next period
 	ProcessExpiredConfirmedDeadlines(ctx context.Context) error
+
+	// ProcessEndedPauses recalculates deadlines of habits whose pause has ended
+	ProcessEndedPauses(ctx context.Context) error
 }
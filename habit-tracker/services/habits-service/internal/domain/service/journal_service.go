@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// JournalService defines the interface for the daily journal of a user
+type JournalService interface {
+	// SaveEntry creates or replaces the entry of the user for entry.Date
+	SaveEntry(ctx context.Context, entry *entity.JournalEntry) (*entity.JournalEntry, error)
+
+	// GetEntry retrieves the entry of a user for a date
+	GetEntry(ctx context.Context, userID uuid.UUID, date string) (*entity.JournalEntry, error)
+
+	// ListEntries retrieves the entries of a user within [fromDate, toDate], optionally only those with a tag
+	ListEntries(ctx context.Context, userID uuid.UUID, fromDate, toDate, tag string) ([]*entity.JournalEntry, error)
+
+	// DeleteEntry deletes the entry of a user for a date
+	DeleteEntry(ctx context.Context, userID uuid.UUID, date string) error
+
+	// SearchNotes searches the journal entries and confirmation notes of a user
+	SearchNotes(ctx context.Context, userID uuid.UUID, query string, limit int32) ([]*entity.NoteSearchResult, error)
+
+	// ExportUserData retrieves the whole journal of a user for a data export
+	ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.JournalEntry, error)
+
+	// PurgeUserData deletes the journal of a deleted user
+	PurgeUserData(ctx context.Context, userID uuid.UUID) error
+}
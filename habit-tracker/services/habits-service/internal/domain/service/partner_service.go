@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HabitPartnerService defines the interface for accountability partner business logic
+type HabitPartnerService interface {
+	// InvitePartner invites a user by username as a partner on one of the owner's active habits
+	InvitePartner(ctx context.Context, habitID, ownerID uuid.UUID, username string) (*entity.HabitPartner, error)
+
+	// ListPartners retrieves the pending and accepted partners of a habit for its owner
+	ListPartners(ctx context.Context, habitID, ownerID uuid.UUID) ([]*entity.HabitPartner, error)
+
+	// ListInvitations retrieves the pending invitations sent to a user
+	ListInvitations(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPartner, error)
+
+	// RespondToInvitation accepts or declines a pending invitation sent to the user
+	RespondToInvitation(ctx context.Context, invitationID, userID uuid.UUID, accept bool) (*entity.HabitPartner, error)
+
+	// RemovePartner ends a partnership or withdraws an invitation, on behalf of the owner or the partner
+	RemovePartner(ctx context.Context, partnershipID, userID uuid.UUID) error
+
+	// Nudge sends the owner of a habit a reminder from an accepted partner, returns when it was sent
+	Nudge(ctx context.Context, habitID, partnerID uuid.UUID) (time.Time, error)
+
+	// IsPartner reports whether the user is an accepted partner on the habit
+	IsPartner(ctx context.Context, habitID, userID uuid.UUID) (bool, error)
+
+	// NotifyStreakBroken notifies the accepted partners of a habit that its owner lost a streak.
+	// Best effort, failures are logged
+	NotifyStreakBroken(ctx context.Context, habit *entity.Habit, lostStreak int32, missedDate string)
+
+	// ExportUserData retrieves all partnerships and invitations where the user is the owner or the partner
+	ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPartner, error)
+
+	// PurgeUserData deletes all partnerships where the user is the owner or the partner
+	PurgeUserData(ctx context.Context, userID uuid.UUID) error
+}
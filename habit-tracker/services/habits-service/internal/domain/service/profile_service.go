@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// ProfileService defines the interface for public profiles
+type ProfileService interface {
+	// GetPublicProfile retrieves the public profile of a user by username with their active public habits
+	GetPublicProfile(ctx context.Context, username string) (*entity.PublicProfile, error)
+
+	// GetPublicHabit retrieves the public profile of a user with only the given active public habit
+	GetPublicHabit(ctx context.Context, username string, habitID uuid.UUID) (*entity.PublicProfile, error)
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// HabitTemplateService defines the interface for habit template business logic
+type HabitTemplateService interface {
+	// ListTemplates retrieves the catalog followed by the user's private templates,
+	// a category limits the result to that catalog section
+	ListTemplates(ctx context.Context, userID uuid.UUID, category *string) ([]*entity.HabitTemplate, error)
+
+	// CreateHabitFromTemplate creates a habit with the name, schedule and style of a template.
+	// An optional name overrides the template name
+	CreateHabitFromTemplate(ctx context.Context, templateID, userID uuid.UUID, timezone string,
+		name *string, groupID *uuid.UUID) (*entity.Habit, error)
+
+	// SaveHabitAsTemplate saves one of the user's habits as a private template
+	SaveHabitAsTemplate(ctx context.Context, habitID, userID uuid.UUID, name, suggestedTarget *string) (*entity.HabitTemplate, error)
+
+	// DeleteTemplate deletes a private template, catalog templates can't be deleted
+	DeleteTemplate(ctx context.Context, templateID, userID uuid.UUID) error
+}
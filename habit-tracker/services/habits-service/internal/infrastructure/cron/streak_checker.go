@@ -61,7 +61,12 @@ func (d *DeadlineChecker) checkDeadlines() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer cancel()
 
-	err := d.habitService.ProcessExpiredConfirmedDeadlines(ctx)
+	err := d.habitService.ProcessEndedPauses(ctx)
+	if err != nil {
+		log.Printf("Error processing ended pauses: %v", err)
+	}
+
+	err = d.habitService.ProcessExpiredConfirmedDeadlines(ctx)
 	if err != nil {
 		log.Printf("Error processing expired confirmed deadlines: %v", err)
 	}
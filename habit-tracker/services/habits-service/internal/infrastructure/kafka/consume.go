@@ -0,0 +1,84 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// errMalformedEvent marks events that can never be processed, they are skipped instead of retried
+var errMalformedEvent = errors.New("malformed event")
+
+// messageReader is the part of kafka.Reader used by the consumers
+type messageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// retryBackoff is the delay between attempts to process a message
+type retryBackoff struct {
+	initial time.Duration
+	max     time.Duration
+}
+
+// consume processes messages one at a time and commits each offset only after the message was
+// processed. A failed message is retried with exponential backoff until it succeeds, so that no event
+// of a partition is skipped. Malformed events are logged and committed
+func consume(ctx context.Context, name string, reader messageReader, backoff retryBackoff, process func(context.Context, kafka.Message) error) error {
+	log.Printf("Starting %s...", name)
+
+	for {
+		message, err := reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Printf("Stopping %s...", name)
+				return reader.Close()
+			}
+			log.Printf("Error fetching message: %v", err)
+			sleep(ctx, backoff.initial)
+			continue
+		}
+
+		delay := backoff.initial
+		for {
+			err := process(ctx, message)
+			if err == nil {
+				break
+			}
+			if errors.Is(err, errMalformedEvent) {
+				log.Printf("Skipping message at offset %d: %v", message.Offset, err)
+				break
+			}
+
+			log.Printf("Error processing message at offset %d, retrying in %s: %v", message.Offset, delay, err)
+			if !sleep(ctx, delay) {
+				// The offset stays uncommitted, the message is redelivered after a restart
+				log.Printf("Stopping %s...", name)
+				return reader.Close()
+			}
+			delay = min(delay*2, backoff.max)
+		}
+
+		if err := reader.CommitMessages(ctx, message); err != nil {
+			// An uncommitted message is delivered again, processing is idempotent
+			log.Printf("Error committing message at offset %d: %v", message.Offset, err)
+		}
+	}
+}
+
+// sleep waits for d and reports false when ctx is cancelled first
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,83 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"habits-service/internal/config"
+	"habits-service/internal/domain/service"
+	eventspb "habits-service/proto/events/v1"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// UserEventsConsumer purges habits of users deleted in user-service
+type UserEventsConsumer struct {
+	reader       messageReader
+	backoff      retryBackoff
+	habitService service.HabitService
+}
+
+// NewUserEventsConsumer creates a new user events consumer
+func NewUserEventsConsumer(cfg *config.KafkaConfig, habitService service.HabitService) *UserEventsConsumer {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:        cfg.Brokers,
+		GroupID:        cfg.GroupID,
+		Topic:          cfg.UserEventsTopic,
+		MinBytes:       1,
+		MaxBytes:       10e6, // 10MB
+		MaxWait:        500 * time.Millisecond,
+		CommitInterval: time.Second,
+		StartOffset:    kafka.FirstOffset, // New consumer group must not miss deletions published before it existed
+	})
+
+	return &UserEventsConsumer{
+		reader:       reader,
+		backoff:      retryBackoff{initial: cfg.RetryBackoff, max: cfg.MaxRetryBackoff},
+		habitService: habitService,
+	}
+}
+
+// Start consumes messages from Kafka until ctx is cancelled. Offsets are committed only after
+// a message was processed, failures are retried
+func (c *UserEventsConsumer) Start(ctx context.Context) error {
+	return consume(ctx, "user events consumer", c.reader, c.backoff, c.processMessage)
+}
+
+// processMessage processes a Kafka message
+func (c *UserEventsConsumer) processMessage(ctx context.Context, message kafka.Message) error {
+	var event eventspb.Event
+	if err := proto.Unmarshal(message.Value, &event); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal: %v", errMalformedEvent, err)
+	}
+
+	if event.EventType != eventspb.EventType_EVENT_TYPE_USER_DELETED {
+		return nil
+	}
+
+	return c.handleUserDeleted(ctx, event.GetUserDeleted())
+}
+
+// handleUserDeleted purges habits of a deleted user, redelivered events delete nothing
+func (c *UserEventsConsumer) handleUserDeleted(ctx context.Context, event *eventspb.UserDeletedEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: user deleted event is nil", errMalformedEvent)
+	}
+
+	userID, err := uuid.Parse(event.UserId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid user ID %q", errMalformedEvent, event.UserId)
+	}
+
+	deleted, err := c.habitService.PurgeUserData(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to purge habits of user %s: %w", userID, err)
+	}
+
+	log.Printf("Purged %d habits of deleted user %s", deleted, userID)
+	return nil
+}
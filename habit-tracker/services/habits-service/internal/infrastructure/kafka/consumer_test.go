@@ -0,0 +1,265 @@
+package kafka_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"habits-service/internal/domain/service"
+	"habits-service/internal/infrastructure/kafka"
+	infraredis "habits-service/internal/infrastructure/redis"
+	"habits-service/internal/infrastructure/storage"
+	habitservice "habits-service/internal/service"
+	eventspb "habits-service/proto/events/v1"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	kafkago "github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// memoryBus is an in-memory stand-in for a Kafka topic with a single partition
+type memoryBus struct {
+	mu        sync.Mutex
+	offset    int64
+	messages  chan kafkago.Message
+	committed chan kafkago.Message
+}
+
+func newMemoryBus() *memoryBus {
+	return &memoryBus{
+		messages:  make(chan kafkago.Message, 16),
+		committed: make(chan kafkago.Message, 16),
+	}
+}
+
+func (b *memoryBus) publish(value []byte) {
+	b.mu.Lock()
+	b.offset++
+	message := kafkago.Message{Offset: b.offset, Value: value}
+	b.mu.Unlock()
+
+	b.messages <- message
+}
+
+func (b *memoryBus) publishUserDeleted(t *testing.T, userID uuid.UUID) {
+	t.Helper()
+
+	value, err := proto.Marshal(&eventspb.Event{
+		EventId:   uuid.NewString(),
+		EventType: eventspb.EventType_EVENT_TYPE_USER_DELETED,
+		Payload: &eventspb.Event_UserDeleted{UserDeleted: &eventspb.UserDeletedEvent{
+			UserId:    userID.String(),
+			DeletedAt: timestamppb.Now(),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal event: %v", err)
+	}
+
+	b.publish(value)
+}
+
+func (b *memoryBus) FetchMessage(ctx context.Context) (kafkago.Message, error) {
+	select {
+	case message := <-b.messages:
+		return message, nil
+	case <-ctx.Done():
+		return kafkago.Message{}, ctx.Err()
+	}
+}
+
+func (b *memoryBus) CommitMessages(_ context.Context, messages ...kafkago.Message) error {
+	for _, message := range messages {
+		b.committed <- message
+	}
+	return nil
+}
+
+func (b *memoryBus) Close() error {
+	return nil
+}
+
+// waitCommitted waits until the consumer commits the next message
+func (b *memoryBus) waitCommitted(t *testing.T) kafkago.Message {
+	t.Helper()
+
+	select {
+	case message := <-b.committed:
+		return message
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the message to be committed")
+		return kafkago.Message{}
+	}
+}
+
+// startConsumer runs a user events consumer until the test ends
+func startConsumer(t *testing.T, bus *memoryBus, habitService service.HabitService) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- kafka.NewUserEventsConsumerWithReader(bus, habitService).Start(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("consumer stopped with error: %v", err)
+		}
+	})
+}
+
+// newPurgeHabitService wires the services taking part in the purge to repositories backed by db
+func newPurgeHabitService(db *memoryDB, attachments storage.Storage) service.HabitService {
+	// Leaderboards are cleaned up best effort, an unreachable Redis only logs failures
+	leaderboards := infraredis.NewLeaderboardStorage(redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		DialTimeout: 50 * time.Millisecond,
+		MaxRetries:  -1,
+	}))
+
+	habitRepo := &fakeHabitRepository{db: db}
+	feedRepo := &fakeFeedRepository{db: db}
+
+	return habitservice.NewHabitService(
+		habitRepo,
+		nil,
+		nil,
+		&fakeAnalyticsRepository{db: db},
+		&fakeGroupRepository{db: db},
+		&fakeTemplateRepository{db: db},
+		&fakeImportRepository{db: db},
+		habitservice.NewHabitPartnerService(&fakePartnerRepository{db: db}, habitRepo, nil, nil, nil),
+		habitservice.NewChallengeService(&fakeChallengeRepository{db: db}, habitRepo, nil, leaderboards, nil),
+		habitservice.NewAchievementService(&fakeAchievementRepository{db: db}, nil, nil, nil, nil),
+		habitservice.NewFollowService(&fakeFollowRepository{db: db}, feedRepo, nil),
+		habitservice.NewFeedService(feedRepo, nil),
+		habitservice.NewCalendarFeedService(&fakeCalendarFeedRepository{db: db}, habitRepo, nil),
+		habitservice.NewJournalService(&fakeJournalRepository{db: db}),
+		habitservice.NewAttachmentService(&fakeAttachmentRepository{db: db}, attachments, 1<<20, time.Hour),
+	)
+}
+
+func TestUserDeletedEventPurgesAllUserData(t *testing.T) {
+	db := newMemoryDB(loadSchema(t))
+	deletedUser, keptUser := uuid.New(), uuid.New()
+	db.seed(deletedUser, keptUser)
+
+	dir := t.TempDir()
+	attachments, err := storage.NewLocalStorage(dir)
+	if err != nil {
+		t.Fatalf("failed to create attachment storage: %v", err)
+	}
+	for _, a := range db.find("attachments", func(*row) bool { return true }) {
+		key := fmt.Sprintf("%s/%s", a.values["user_id"], a.values["id"])
+		for _, suffix := range []string{"", "_thumb.jpg"} {
+			if err := attachments.Put(context.Background(), key+suffix, []byte("image"), "image/jpeg"); err != nil {
+				t.Fatalf("failed to store attachment: %v", err)
+			}
+		}
+	}
+
+	before := make(map[string]int)
+	for _, table := range db.schema.tables {
+		before[table] = len(db.find(table, func(r *row) bool { return r.seed == keptUser }))
+		if before[table] == 0 {
+			t.Fatalf("table %s was not seeded", table)
+		}
+	}
+
+	bus := newMemoryBus()
+	startConsumer(t, bus, newPurgeHabitService(db, attachments))
+	bus.publishUserDeleted(t, deletedUser)
+	bus.waitCommitted(t)
+
+	for _, table := range db.schema.tables {
+		if left := db.find(table, func(r *row) bool { return r.seed == deletedUser }); len(left) > 0 {
+			t.Errorf("%s: %d rows of the deleted user left", table, len(left))
+		}
+		if kept := db.find(table, func(r *row) bool { return r.seed == keptUser }); len(kept) != before[table] {
+			t.Errorf("%s: %d of %d rows of the other user left", table, len(kept), before[table])
+		}
+	}
+
+	if files, _ := filepath.Glob(filepath.Join(dir, deletedUser.String(), "*")); len(files) > 0 {
+		t.Errorf("%d attachment files of the deleted user left", len(files))
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, keptUser.String(), "*")); len(files) != 2 {
+		t.Errorf("%d attachment files of the other user left, want 2", len(files))
+	}
+}
+
+func TestUserDeletedEventIsRedeliveredAfterPurgeFailure(t *testing.T) {
+	db := newMemoryDB(loadSchema(t))
+	deletedUser, keptUser := uuid.New(), uuid.New()
+	db.seed(deletedUser, keptUser)
+
+	attachments, err := storage.NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to create attachment storage: %v", err)
+	}
+
+	habitService := &failingHabitService{
+		HabitService: newPurgeHabitService(db, attachments),
+		failures:     2,
+	}
+
+	bus := newMemoryBus()
+	startConsumer(t, bus, habitService)
+	bus.publishUserDeleted(t, deletedUser)
+	bus.waitCommitted(t)
+
+	if calls := habitService.callCount(); calls != 3 {
+		t.Errorf("purge ran %d times before the event was committed, want 3", calls)
+	}
+	if left := db.find("habits", func(r *row) bool { return r.seed == deletedUser }); len(left) > 0 {
+		t.Errorf("%d habits of the deleted user left", len(left))
+	}
+}
+
+func TestMalformedEventIsSkipped(t *testing.T) {
+	habitService := &failingHabitService{}
+
+	bus := newMemoryBus()
+	startConsumer(t, bus, habitService)
+	bus.publish([]byte("not an event"))
+	bus.waitCommitted(t)
+
+	if calls := habitService.callCount(); calls != 0 {
+		t.Errorf("purge ran %d times for a malformed event", calls)
+	}
+}
+
+// failingHabitService fails the first purges before handing them to the wrapped service
+type failingHabitService struct {
+	service.HabitService
+
+	mu       sync.Mutex
+	failures int
+	calls    int
+}
+
+func (s *failingHabitService) PurgeUserData(ctx context.Context, userID uuid.UUID) (int64, error) {
+	s.mu.Lock()
+	s.calls++
+	fail := s.calls <= s.failures
+	s.mu.Unlock()
+
+	if fail {
+		return 0, errors.New("database unavailable")
+	}
+	return s.HabitService.PurgeUserData(ctx, userID)
+}
+
+func (s *failingHabitService) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
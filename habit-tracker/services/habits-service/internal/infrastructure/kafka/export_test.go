@@ -0,0 +1,16 @@
+package kafka
+
+import (
+	"time"
+
+	"habits-service/internal/domain/service"
+)
+
+// NewUserEventsConsumerWithReader creates a user events consumer that reads from reader instead of Kafka
+func NewUserEventsConsumerWithReader(reader messageReader, habitService service.HabitService) *UserEventsConsumer {
+	return &UserEventsConsumer{
+		reader:       reader,
+		backoff:      retryBackoff{initial: time.Millisecond, max: 10 * time.Millisecond},
+		habitService: habitService,
+	}
+}
@@ -0,0 +1,138 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"habits-service/internal/config"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/service"
+	eventspb "habits-service/proto/events/v1"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// FeedConsumer fans out habit events published by this service into activity feeds
+type FeedConsumer struct {
+	reader      messageReader
+	backoff     retryBackoff
+	feedService service.FeedService
+}
+
+// NewFeedConsumer creates a new feed consumer
+func NewFeedConsumer(cfg *config.KafkaConfig, feedService service.FeedService) *FeedConsumer {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:        cfg.Brokers,
+		GroupID:        cfg.FeedGroupID,
+		Topic:          cfg.Topic,
+		MinBytes:       1,
+		MaxBytes:       10e6, // 10MB
+		MaxWait:        500 * time.Millisecond,
+		CommitInterval: time.Second,
+		StartOffset:    kafka.LastOffset, // Feeds start empty, there is no point in replaying old activity
+	})
+
+	return &FeedConsumer{
+		reader:      reader,
+		backoff:     retryBackoff{initial: cfg.RetryBackoff, max: cfg.MaxRetryBackoff},
+		feedService: feedService,
+	}
+}
+
+// Start consumes messages from Kafka until ctx is cancelled. Offsets are committed only after
+// a message was processed, failures are retried
+func (c *FeedConsumer) Start(ctx context.Context) error {
+	return consume(ctx, "feed consumer", c.reader, c.backoff, c.processMessage)
+}
+
+// processMessage processes a Kafka message
+func (c *FeedConsumer) processMessage(ctx context.Context, message kafka.Message) error {
+	var event eventspb.Event
+	if err := proto.Unmarshal(message.Value, &event); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal: %v", errMalformedEvent, err)
+	}
+
+	eventID, err := uuid.Parse(event.EventId)
+	if err != nil {
+		return fmt.Errorf("%w: invalid event ID %q", errMalformedEvent, event.EventId)
+	}
+
+	switch event.EventType {
+	case eventspb.EventType_EVENT_TYPE_HABIT_CONFIRMED:
+		return c.handleHabitConfirmed(ctx, eventID, event.GetHabitConfirmed())
+	case eventspb.EventType_EVENT_TYPE_ACHIEVEMENT_UNLOCKED:
+		return c.handleAchievementUnlocked(ctx, eventID, event.GetAchievementUnlocked())
+	default:
+		return nil
+	}
+}
+
+// handleHabitConfirmed fans out a confirmation
+func (c *FeedConsumer) handleHabitConfirmed(ctx context.Context, eventID uuid.UUID, event *eventspb.HabitConfirmedEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: habit confirmed event is nil", errMalformedEvent)
+	}
+
+	actorID, habitID, err := parseActorAndHabit(event.UserId, event.HabitId)
+	if err != nil {
+		return err
+	}
+
+	confirmedForDate := event.ConfirmedForDate
+	streak := event.Streak
+
+	return c.feedService.FanOut(ctx, &entity.FeedItem{
+		ActorID:          actorID,
+		HabitID:          habitID,
+		EventID:          eventID,
+		Kind:             entity.FeedItemConfirmation,
+		ConfirmedForDate: &confirmedForDate,
+		Streak:           &streak,
+		OccurredAt:       event.ConfirmedAt.AsTime(),
+	})
+}
+
+// handleAchievementUnlocked fans out an achievement as a milestone of the habit that unlocked it
+func (c *FeedConsumer) handleAchievementUnlocked(ctx context.Context, eventID uuid.UUID, event *eventspb.AchievementUnlockedEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: achievement unlocked event is nil", errMalformedEvent)
+	}
+
+	// Events published before feeds existed carry no habit, there is nothing to check visibility against
+	if event.HabitId == "" {
+		return nil
+	}
+
+	actorID, habitID, err := parseActorAndHabit(event.UserId, event.HabitId)
+	if err != nil {
+		return err
+	}
+
+	achievementCode := event.AchievementCode
+
+	return c.feedService.FanOut(ctx, &entity.FeedItem{
+		ActorID:         actorID,
+		HabitID:         habitID,
+		EventID:         eventID,
+		Kind:            entity.FeedItemMilestone,
+		AchievementCode: &achievementCode,
+		OccurredAt:      event.UnlockedAt.AsTime(),
+	})
+}
+
+func parseActorAndHabit(userID, habitID string) (uuid.UUID, uuid.UUID, error) {
+	actorID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("%w: invalid user ID %q", errMalformedEvent, userID)
+	}
+
+	parsedHabitID, err := uuid.Parse(habitID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("%w: invalid habit ID %q", errMalformedEvent, habitID)
+	}
+
+	return actorID, parsedHabitID, nil
+}
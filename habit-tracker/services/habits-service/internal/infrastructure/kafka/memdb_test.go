@@ -0,0 +1,414 @@
+package kafka_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// nonUserColumns are UUID columns without a foreign key that do not identify a user
+var nonUserColumns = map[string]bool{
+	"event_id": true,
+}
+
+var (
+	createTableRe = regexp.MustCompile(`(?is)CREATE TABLE IF NOT EXISTS (\w+) \((.*?)\n\);`)
+	alterColumnRe = regexp.MustCompile(`(?i)ALTER TABLE (\w+) ADD COLUMN (\w+ UUID[^;]*);`)
+	uuidColumnRe  = regexp.MustCompile(`(?i)^\s*(\w+) UUID\b(.*)$`)
+	referencesRe  = regexp.MustCompile(`(?i)REFERENCES (\w+)\(id\) ON DELETE (CASCADE|SET NULL)`)
+)
+
+// foreignKey is a column referencing the id of another table
+type foreignKey struct {
+	table   string
+	column  string
+	parent  string
+	cascade bool // SET NULL otherwise
+}
+
+// schema holds what the purge test needs to know about the tables created by the migrations
+type schema struct {
+	tables      []string            // In creation order
+	userColumns map[string][]string // Columns holding a user ID, per table
+	foreignKeys []foreignKey
+}
+
+// loadSchema reads the UUID columns of all tables from the migrations
+func loadSchema(t *testing.T) *schema {
+	t.Helper()
+
+	files, err := filepath.Glob("../../../migrations/*.up.sql")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to find migrations: %v", err)
+	}
+	sort.Strings(files)
+
+	s := &schema{userColumns: make(map[string][]string)}
+	addColumn := func(table, definition string) {
+		match := uuidColumnRe.FindStringSubmatch(definition)
+		if match == nil {
+			return
+		}
+
+		column, rest := match[1], match[2]
+		if ref := referencesRe.FindStringSubmatch(rest); ref != nil {
+			s.foreignKeys = append(s.foreignKeys, foreignKey{
+				table:   table,
+				column:  column,
+				parent:  ref[1],
+				cascade: strings.EqualFold(ref[2], "CASCADE"),
+			})
+			return
+		}
+
+		if column != "id" && !nonUserColumns[column] {
+			s.userColumns[table] = append(s.userColumns[table], column)
+		}
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+
+		for _, match := range createTableRe.FindAllStringSubmatch(string(data), -1) {
+			s.tables = append(s.tables, match[1])
+			for _, line := range strings.Split(match[2], "\n") {
+				addColumn(match[1], line)
+			}
+		}
+
+		for _, match := range alterColumnRe.FindAllStringSubmatch(string(data), -1) {
+			addColumn(match[1], match[2])
+		}
+	}
+
+	return s
+}
+
+// row maps column names to values, uuid.Nil is NULL. Seed is the user whose deletion must remove the row
+type row struct {
+	values map[string]uuid.UUID
+	seed   uuid.UUID
+}
+
+// memoryDB keeps the UUID columns of every table and applies the ON DELETE rules of the migrations
+type memoryDB struct {
+	mu     sync.Mutex
+	schema *schema
+	rows   map[string][]*row
+}
+
+func newMemoryDB(schema *schema) *memoryDB {
+	return &memoryDB{schema: schema, rows: make(map[string][]*row)}
+}
+
+// seed adds a row of each user to every table, each row references the rows of the same user. Tables with
+// several user columns also get a row per column where only that column is the deleted user, such as a
+// follow between both users. These rows reference the rows of the kept user, so that only the user
+// column can remove them
+func (db *memoryDB) seed(deletedUser, keptUser uuid.UUID) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	deletedRows := db.insertAll(deletedUser)
+	keptRows := db.insertAll(keptUser)
+
+	for _, table := range db.schema.tables {
+		columns := db.schema.userColumns[table]
+		if len(columns) < 2 {
+			continue
+		}
+
+		for _, column := range columns {
+			shared := db.insert(table, keptUser, deletedUser)
+			shared.values[column] = deletedUser
+			db.link(shared, table, keptRows)
+		}
+	}
+
+	for table, r := range deletedRows {
+		db.link(r, table, deletedRows)
+	}
+	for table, r := range keptRows {
+		db.link(r, table, keptRows)
+	}
+}
+
+// insertAll adds a row owned by userID to every table
+func (db *memoryDB) insertAll(userID uuid.UUID) map[string]*row {
+	rows := make(map[string]*row, len(db.schema.tables))
+	for _, table := range db.schema.tables {
+		rows[table] = db.insert(table, userID, userID)
+	}
+	return rows
+}
+
+// link points the foreign keys of a row of table to parents
+func (db *memoryDB) link(r *row, table string, parents map[string]*row) {
+	for _, fk := range db.schema.foreignKeys {
+		if fk.table == table {
+			r.values[fk.column] = parents[fk.parent].values["id"]
+		}
+	}
+}
+
+// insert adds a row with every user column set to userID
+func (db *memoryDB) insert(table string, userID, seed uuid.UUID) *row {
+	r := &row{values: map[string]uuid.UUID{"id": uuid.New()}, seed: seed}
+	for _, column := range db.schema.userColumns[table] {
+		r.values[column] = userID
+	}
+
+	db.rows[table] = append(db.rows[table], r)
+	return r
+}
+
+// deleteByUser runs DELETE FROM table WHERE column1 = userID OR column2 = userID ...
+func (db *memoryDB) deleteByUser(table string, userID uuid.UUID, columns ...string) int64 {
+	return db.delete(table, func(r *row) bool {
+		for _, column := range columns {
+			if r.values[column] == userID {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// delete removes the matching rows of a table and applies ON DELETE rules to the rows referencing them
+func (db *memoryDB) delete(table string, match func(*row) bool) int64 {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.deleteLocked(table, match)
+}
+
+func (db *memoryDB) deleteLocked(table string, match func(*row) bool) int64 {
+	deleted := make(map[uuid.UUID]bool)
+	kept := db.rows[table][:0]
+	for _, r := range db.rows[table] {
+		if match(r) {
+			deleted[r.values["id"]] = true
+		} else {
+			kept = append(kept, r)
+		}
+	}
+	db.rows[table] = kept
+
+	if len(deleted) == 0 {
+		return 0
+	}
+
+	for _, fk := range db.schema.foreignKeys {
+		if fk.parent != table {
+			continue
+		}
+
+		references := func(r *row) bool { return deleted[r.values[fk.column]] }
+		if fk.cascade {
+			db.deleteLocked(fk.table, references)
+			continue
+		}
+		for _, r := range db.rows[fk.table] {
+			if references(r) {
+				r.values[fk.column] = uuid.Nil
+			}
+		}
+	}
+
+	return int64(len(deleted))
+}
+
+// find returns the rows of a table matching a filter
+func (db *memoryDB) find(table string, match func(*row) bool) []*row {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var rows []*row
+	for _, r := range db.rows[table] {
+		if match(r) {
+			rows = append(rows, r)
+		}
+	}
+	return rows
+}
+
+// The fakes below run the same statements as the PostgreSQL repositories. Methods the purge does not
+// call are left to the embedded nil interfaces and panic when used
+
+type fakeHabitRepository struct {
+	repository.HabitRepository
+	db *memoryDB
+}
+
+func (r *fakeHabitRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) (int64, error) {
+	return r.db.deleteByUser("habits", userID, "user_id"), nil
+}
+
+type fakeAnalyticsRepository struct {
+	repository.AnalyticsRepository
+	db *memoryDB
+}
+
+func (r *fakeAnalyticsRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	for _, table := range []string{
+		"analytics_habit_pair_rollups",
+		"analytics_habit_rollups",
+		"analytics_hourly_rollups",
+		"analytics_daily_rollups",
+	} {
+		r.db.deleteByUser(table, userID, "user_id")
+	}
+	return nil
+}
+
+type fakeGroupRepository struct {
+	repository.HabitGroupRepository
+	db *memoryDB
+}
+
+func (r *fakeGroupRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	r.db.deleteByUser("habit_groups", userID, "user_id")
+	return nil
+}
+
+type fakeTemplateRepository struct {
+	repository.HabitTemplateRepository
+	db *memoryDB
+}
+
+func (r *fakeTemplateRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	r.db.deleteByUser("habit_templates", userID, "user_id")
+	return nil
+}
+
+type fakeImportRepository struct {
+	repository.HabitImportRepository
+	db *memoryDB
+}
+
+func (r *fakeImportRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	r.db.deleteByUser("habit_imports", userID, "user_id")
+	return nil
+}
+
+type fakePartnerRepository struct {
+	repository.HabitPartnerRepository
+	db *memoryDB
+}
+
+func (r *fakePartnerRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	r.db.deleteByUser("habit_partners", userID, "owner_id", "partner_id")
+	return nil
+}
+
+type fakeChallengeRepository struct {
+	repository.ChallengeRepository
+	db *memoryDB
+}
+
+func (r *fakeChallengeRepository) GetByUserID(_ context.Context, userID uuid.UUID) ([]*entity.Challenge, error) {
+	joined := make(map[uuid.UUID]bool)
+	for _, participant := range r.db.find("challenge_participants", func(p *row) bool { return p.values["user_id"] == userID }) {
+		joined[participant.values["challenge_id"]] = true
+	}
+
+	var challenges []*entity.Challenge
+	for _, c := range r.db.find("challenges", func(c *row) bool {
+		return c.values["creator_id"] == userID || joined[c.values["id"]]
+	}) {
+		challenges = append(challenges, &entity.Challenge{ID: c.values["id"], CreatorID: c.values["creator_id"]})
+	}
+	return challenges, nil
+}
+
+func (r *fakeChallengeRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	r.db.deleteByUser("challenge_participants", userID, "user_id")
+	r.db.deleteByUser("challenges", userID, "creator_id")
+	return nil
+}
+
+type fakeAchievementRepository struct {
+	repository.AchievementRepository
+	db *memoryDB
+}
+
+func (r *fakeAchievementRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	r.db.deleteByUser("user_achievements", userID, "user_id")
+	r.db.deleteByUser("habit_streak_breaks", userID, "user_id")
+	return nil
+}
+
+type fakeFollowRepository struct {
+	repository.FollowRepository
+	db *memoryDB
+}
+
+func (r *fakeFollowRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	r.db.deleteByUser("user_follows", userID, "follower_id", "followee_id")
+	return nil
+}
+
+type fakeFeedRepository struct {
+	repository.FeedRepository
+	db *memoryDB
+}
+
+func (r *fakeFeedRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	r.db.deleteByUser("feed_items", userID, "user_id", "actor_id")
+	return nil
+}
+
+type fakeCalendarFeedRepository struct {
+	repository.CalendarFeedRepository
+	db *memoryDB
+}
+
+func (r *fakeCalendarFeedRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) (bool, error) {
+	return r.db.deleteByUser("calendar_feeds", userID, "user_id") > 0, nil
+}
+
+type fakeJournalRepository struct {
+	repository.JournalRepository
+	db *memoryDB
+}
+
+func (r *fakeJournalRepository) DeleteByUserID(_ context.Context, userID uuid.UUID) error {
+	r.db.deleteByUser("journal_entries", userID, "user_id")
+	return nil
+}
+
+type fakeAttachmentRepository struct {
+	repository.AttachmentRepository
+	db *memoryDB
+}
+
+func (r *fakeAttachmentRepository) GetByUserID(_ context.Context, userID uuid.UUID) ([]*entity.Attachment, error) {
+	var attachments []*entity.Attachment
+	for _, a := range r.db.find("attachments", func(a *row) bool { return a.values["user_id"] == userID }) {
+		attachments = append(attachments, &entity.Attachment{ID: a.values["id"], UserID: a.values["user_id"]})
+	}
+	return attachments, nil
+}
+
+func (r *fakeAttachmentRepository) Delete(_ context.Context, attachmentIDs []uuid.UUID) error {
+	ids := make(map[uuid.UUID]bool, len(attachmentIDs))
+	for _, id := range attachmentIDs {
+		ids[id] = true
+	}
+
+	r.db.delete("attachments", func(a *row) bool { return ids[a.values["id"]] })
+	return nil
+}
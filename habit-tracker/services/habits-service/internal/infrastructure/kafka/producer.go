@@ -0,0 +1,278 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"habits-service/internal/config"
+	eventspb "habits-service/proto/events/v1"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Producer handles publishing habit events to Kafka
+type Producer struct {
+	writer *kafka.Writer
+}
+
+// NewProducer creates a new Kafka producer
+func NewProducer(cfg *config.KafkaConfig) *Producer {
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        cfg.Topic,
+		Balancer:     &kafka.LeastBytes{},
+		BatchSize:    10,
+		BatchTimeout: 10 * time.Millisecond,
+		Async:        true,
+	}
+
+	return &Producer{
+		writer: writer,
+	}
+}
+
+// PublishHabitPartnerInvitedEvent publishes a partner invitation event
+func (p *Producer) PublishHabitPartnerInvitedEvent(ctx context.Context, event *HabitPartnerInvitedEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_HABIT_PARTNER_INVITED,
+		Timestamp: timestamppb.New(event.InvitedAt),
+		Payload: &eventspb.Event_HabitPartnerInvited{
+			HabitPartnerInvited: &eventspb.HabitPartnerInvitedEvent{
+				InvitationId:     event.InvitationID,
+				HabitId:          event.HabitID,
+				HabitName:        event.HabitName,
+				OwnerUsername:    event.OwnerUsername,
+				PartnerUserId:    event.PartnerUserID,
+				PartnerEmail:     event.PartnerEmail,
+				PartnerUsername:  event.PartnerUsername,
+				PartnerFirstName: event.PartnerFirstName,
+				InvitedAt:        timestamppb.New(event.InvitedAt),
+			},
+		},
+	}
+
+	if err := p.publish(ctx, event.HabitID, protoEvent); err != nil {
+		return fmt.Errorf("failed to publish habit partner invited event: %w", err)
+	}
+
+	log.Printf("Published habit partner invited event for habit_id: %s", event.HabitID)
+	return nil
+}
+
+// PublishHabitNudgedEvent publishes a partner nudge event
+func (p *Producer) PublishHabitNudgedEvent(ctx context.Context, event *HabitNudgedEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_HABIT_NUDGED,
+		Timestamp: timestamppb.New(event.NudgedAt),
+		Payload: &eventspb.Event_HabitNudged{
+			HabitNudged: &eventspb.HabitNudgedEvent{
+				HabitId:         event.HabitID,
+				HabitName:       event.HabitName,
+				PartnerUsername: event.PartnerUsername,
+				OwnerUserId:     event.OwnerUserID,
+				OwnerEmail:      event.OwnerEmail,
+				OwnerUsername:   event.OwnerUsername,
+				OwnerFirstName:  event.OwnerFirstName,
+				NudgedAt:        timestamppb.New(event.NudgedAt),
+			},
+		},
+	}
+
+	if err := p.publish(ctx, event.HabitID, protoEvent); err != nil {
+		return fmt.Errorf("failed to publish habit nudged event: %w", err)
+	}
+
+	log.Printf("Published habit nudged event for habit_id: %s", event.HabitID)
+	return nil
+}
+
+// PublishHabitStreakBrokenEvent publishes a streak broken event for one partner
+func (p *Producer) PublishHabitStreakBrokenEvent(ctx context.Context, event *HabitStreakBrokenEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_HABIT_STREAK_BROKEN,
+		Timestamp: timestamppb.New(event.BrokenAt),
+		Payload: &eventspb.Event_HabitStreakBroken{
+			HabitStreakBroken: &eventspb.HabitStreakBrokenEvent{
+				HabitId:          event.HabitID,
+				HabitName:        event.HabitName,
+				LostStreak:       event.LostStreak,
+				MissedDate:       event.MissedDate,
+				OwnerUsername:    event.OwnerUsername,
+				PartnerUserId:    event.PartnerUserID,
+				PartnerEmail:     event.PartnerEmail,
+				PartnerUsername:  event.PartnerUsername,
+				PartnerFirstName: event.PartnerFirstName,
+				BrokenAt:         timestamppb.New(event.BrokenAt),
+			},
+		},
+	}
+
+	if err := p.publish(ctx, event.HabitID, protoEvent); err != nil {
+		return fmt.Errorf("failed to publish habit streak broken event: %w", err)
+	}
+
+	log.Printf("Published habit streak broken event for habit_id: %s", event.HabitID)
+	return nil
+}
+
+// PublishAchievementUnlockedEvent publishes an achievement unlocked event
+func (p *Producer) PublishAchievementUnlockedEvent(ctx context.Context, event *AchievementUnlockedEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_ACHIEVEMENT_UNLOCKED,
+		Timestamp: timestamppb.New(event.UnlockedAt),
+		Payload: &eventspb.Event_AchievementUnlocked{
+			AchievementUnlocked: &eventspb.AchievementUnlockedEvent{
+				UserId:                 event.UserID,
+				Email:                  event.Email,
+				Username:               event.Username,
+				FirstName:              event.FirstName,
+				AchievementCode:        event.AchievementCode,
+				AchievementName:        event.AchievementName,
+				AchievementDescription: event.AchievementDescription,
+				Xp:                     event.XP,
+				Level:                  event.Level,
+				UnlockedAt:             timestamppb.New(event.UnlockedAt),
+				HabitId:                event.HabitID,
+			},
+		},
+	}
+
+	if err := p.publish(ctx, event.UserID, protoEvent); err != nil {
+		return fmt.Errorf("failed to publish achievement unlocked event: %w", err)
+	}
+
+	log.Printf("Published achievement unlocked event for user_id: %s", event.UserID)
+	return nil
+}
+
+// PublishHabitConfirmedEvent publishes a habit confirmed event
+func (p *Producer) PublishHabitConfirmedEvent(ctx context.Context, event *HabitConfirmedEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_HABIT_CONFIRMED,
+		Timestamp: timestamppb.New(event.ConfirmedAt),
+		Payload: &eventspb.Event_HabitConfirmed{
+			HabitConfirmed: &eventspb.HabitConfirmedEvent{
+				UserId:           event.UserID,
+				HabitId:          event.HabitID,
+				ConfirmationId:   event.ConfirmationID,
+				ConfirmedForDate: event.ConfirmedForDate,
+				Streak:           event.Streak,
+				ConfirmedAt:      timestamppb.New(event.ConfirmedAt),
+			},
+		},
+	}
+
+	if err := p.publish(ctx, event.HabitID, protoEvent); err != nil {
+		return fmt.Errorf("failed to publish habit confirmed event: %w", err)
+	}
+
+	log.Printf("Published habit confirmed event for habit_id: %s", event.HabitID)
+	return nil
+}
+
+// publish marshals an event and writes it keyed by habit or user, so events of one stay ordered
+func (p *Producer) publish(ctx context.Context, key string, event *eventspb.Event) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(key),
+		Value: data,
+		Time:  time.Now(),
+	}
+
+	return p.writer.WriteMessages(ctx, message)
+}
+
+// Close closes the Kafka producer
+func (p *Producer) Close() error {
+	if p.writer != nil {
+		return p.writer.Close()
+	}
+	return nil
+}
+
+// HabitPartnerInvitedEvent represents a partner invitation event
+type HabitPartnerInvitedEvent struct {
+	EventID          string
+	InvitationID     string
+	HabitID          string
+	HabitName        string
+	OwnerUsername    string
+	PartnerUserID    string
+	PartnerEmail     string
+	PartnerUsername  string
+	PartnerFirstName string
+	InvitedAt        time.Time
+}
+
+// HabitNudgedEvent represents a partner nudge event
+type HabitNudgedEvent struct {
+	EventID         string
+	HabitID         string
+	HabitName       string
+	PartnerUsername string
+	OwnerUserID     string
+	OwnerEmail      string
+	OwnerUsername   string
+	OwnerFirstName  string
+	NudgedAt        time.Time
+}
+
+// HabitStreakBrokenEvent represents a streak broken event addressed to one partner
+type HabitStreakBrokenEvent struct {
+	EventID          string
+	HabitID          string
+	HabitName        string
+	LostStreak       int32
+	MissedDate       string
+	OwnerUsername    string
+	PartnerUserID    string
+	PartnerEmail     string
+	PartnerUsername  string
+	PartnerFirstName string
+	BrokenAt         time.Time
+}
+
+// AchievementUnlockedEvent represents an achievement unlocked event
+type AchievementUnlockedEvent struct {
+	EventID                string
+	UserID                 string
+	Email                  string
+	Username               string
+	FirstName              string
+	AchievementCode        string
+	AchievementName        string
+	AchievementDescription string
+	XP                     int32
+	Level                  int32
+	UnlockedAt             time.Time
+	HabitID                string
+}
+
+// HabitConfirmedEvent represents a habit confirmed event
+type HabitConfirmedEvent struct {
+	EventID          string
+	UserID           string
+	HabitID          string
+	ConfirmationID   string
+	ConfirmedForDate string
+	Streak           int32
+	ConfirmedAt      time.Time
+}
+
+func NewEventID() string {
+	return uuid.New().String()
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type achievementRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAchievementRepository creates a new PostgreSQL achievement repository
+func NewAchievementRepository(pool *pgxpool.Pool) repository.AchievementRepository {
+	return &achievementRepository{pool: pool}
+}
+
+func (r *achievementRepository) Unlock(ctx context.Context, achievement *entity.UnlockedAchievement) (bool, error) {
+	query := `
+		INSERT INTO user_achievements (user_id, code, habit_id, unlocked_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, code) DO NOTHING
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		achievement.UserID,
+		achievement.Code,
+		achievement.HabitID,
+		achievement.UnlockedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to unlock achievement: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (r *achievementRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UnlockedAchievement, error) {
+	query := `
+		SELECT user_id, code, habit_id, unlocked_at
+		FROM user_achievements
+		WHERE user_id = $1
+		ORDER BY unlocked_at, code
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievements: %w", err)
+	}
+	defer rows.Close()
+
+	var achievements []*entity.UnlockedAchievement
+	for rows.Next() {
+		achievement := &entity.UnlockedAchievement{}
+		err := rows.Scan(&achievement.UserID, &achievement.Code, &achievement.HabitID, &achievement.UnlockedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan achievement: %w", err)
+		}
+		achievements = append(achievements, achievement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate achievements: %w", err)
+	}
+
+	return achievements, nil
+}
+
+func (r *achievementRepository) RecordStreakBreak(ctx context.Context, habitID, userID uuid.UUID, lostStreak int32, brokenAt time.Time) error {
+	query := `
+		INSERT INTO habit_streak_breaks (habit_id, user_id, lost_streak, broken_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (habit_id) DO UPDATE SET
+			lost_streak = EXCLUDED.lost_streak,
+			broken_at = EXCLUDED.broken_at
+	`
+
+	_, err := r.pool.Exec(ctx, query, habitID, userID, lostStreak, brokenAt)
+	if err != nil {
+		return fmt.Errorf("failed to record streak break: %w", err)
+	}
+
+	return nil
+}
+
+func (r *achievementRepository) HasStreakBreak(ctx context.Context, habitID uuid.UUID) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM habit_streak_breaks WHERE habit_id = $1)",
+		habitID,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check streak break: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (r *achievementRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "DELETE FROM user_achievements WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete achievements: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM habit_streak_breaks WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete streak breaks: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,505 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// recordConfirmationRollups adds a confirmation to the daily, hourly, habit and habit pair rollups
+// within the transaction that creates it, so that the rollups never miss a confirmation
+func recordConfirmationRollups(ctx context.Context, tx pgx.Tx, confirmation *entity.HabitConfirmation) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO analytics_daily_rollups (user_id, local_date, completed, updated_at)
+		VALUES ($1, $2, 1, NOW())
+		ON CONFLICT (user_id, local_date) DO UPDATE SET
+			completed = analytics_daily_rollups.completed + 1,
+			updated_at = NOW()
+	`, confirmation.UserID, confirmation.ConfirmedForDate)
+	if err != nil {
+		return fmt.Errorf("failed to update daily rollup: %w", err)
+	}
+
+	// The hour is taken in the habit's timezone
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_hourly_rollups (user_id, local_hour, confirmations)
+		SELECT $1, EXTRACT(HOUR FROM $2::TIMESTAMP + make_interval(hours => h.timezone_offset_hours))::SMALLINT, 1
+		FROM habits h
+		WHERE h.id = $3
+		ON CONFLICT (user_id, local_hour) DO UPDATE SET
+			confirmations = analytics_hourly_rollups.confirmations + 1
+	`, confirmation.UserID, confirmation.ConfirmedAt, confirmation.HabitID)
+	if err != nil {
+		return fmt.Errorf("failed to update hourly rollup: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_habit_rollups (habit_id, user_id, confirmed_days)
+		VALUES ($1, $2, 1)
+		ON CONFLICT (habit_id) DO UPDATE SET
+			confirmed_days = analytics_habit_rollups.confirmed_days + 1
+	`, confirmation.HabitID, confirmation.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to update habit rollup: %w", err)
+	}
+
+	// Pair the habit with every other habit confirmed on the same day
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_habit_pair_rollups (habit_a_id, habit_b_id, user_id, days_together)
+		SELECT LEAST($1::UUID, habit_id), GREATEST($1::UUID, habit_id), $2, 1
+		FROM habit_confirmations
+		WHERE user_id = $2
+		  AND confirmed_for_date = $3
+		  AND habit_id <> $1
+		ON CONFLICT (habit_a_id, habit_b_id) DO UPDATE SET
+			days_together = analytics_habit_pair_rollups.days_together + 1
+	`, confirmation.HabitID, confirmation.UserID, confirmation.ConfirmedForDate)
+	if err != nil {
+		return fmt.Errorf("failed to update habit pair rollups: %w", err)
+	}
+
+	return nil
+}
+
+// removeHabitRollups subtracts the confirmations of a habit from the daily and hourly rollups before
+// the habit is purged. Habit and habit pair rollups are deleted with the habit
+func removeHabitRollups(ctx context.Context, tx pgx.Tx, habitID uuid.UUID) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE analytics_daily_rollups d SET
+			completed = GREATEST(d.completed - c.count, 0),
+			updated_at = NOW()
+		FROM (
+			SELECT user_id, confirmed_for_date, COUNT(*) AS count
+			FROM habit_confirmations
+			WHERE habit_id = $1
+			GROUP BY user_id, confirmed_for_date
+		) c
+		WHERE d.user_id = c.user_id AND d.local_date = c.confirmed_for_date
+	`, habitID)
+	if err != nil {
+		return fmt.Errorf("failed to update daily rollups: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM analytics_daily_rollups
+		WHERE user_id = (SELECT user_id FROM habits WHERE id = $1)
+		  AND completed = 0
+		  AND missed = 0
+	`, habitID)
+	if err != nil {
+		return fmt.Errorf("failed to delete empty daily rollups: %w", err)
+	}
+
+	// Imported history was never added to the hourly rollups
+	_, err = tx.Exec(ctx, `
+		UPDATE analytics_hourly_rollups r SET
+			confirmations = GREATEST(r.confirmations - c.count, 0)
+		FROM (
+			SELECT c.user_id, EXTRACT(HOUR FROM c.confirmed_at + make_interval(hours => h.timezone_offset_hours))::SMALLINT AS local_hour, COUNT(*) AS count
+			FROM habit_confirmations c
+			JOIN habits h ON h.id = c.habit_id
+			WHERE c.habit_id = $1
+			  AND c.created_at - c.confirmed_at < INTERVAL '1 hour'
+			GROUP BY 1, 2
+		) c
+		WHERE r.user_id = c.user_id AND r.local_hour = c.local_hour
+	`, habitID)
+	if err != nil {
+		return fmt.Errorf("failed to update hourly rollups: %w", err)
+	}
+
+	return nil
+}
+
+type analyticsRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAnalyticsRepository creates a new PostgreSQL analytics rollup repository
+func NewAnalyticsRepository(pool *pgxpool.Pool) repository.AnalyticsRepository {
+	return &analyticsRepository{pool: pool}
+}
+
+func (r *analyticsRepository) RecordMissed(ctx context.Context, userID uuid.UUID, localDate string) error {
+	query := `
+		INSERT INTO analytics_daily_rollups (user_id, local_date, missed, updated_at)
+		VALUES ($1, $2, 1, NOW())
+		ON CONFLICT (user_id, local_date) DO UPDATE SET
+			missed = analytics_daily_rollups.missed + 1,
+			updated_at = NOW()
+	`
+
+	_, err := r.pool.Exec(ctx, query, userID, localDate)
+	if err != nil {
+		return fmt.Errorf("failed to update daily rollup: %w", err)
+	}
+
+	return nil
+}
+
+func (r *analyticsRepository) GetCompletionPoints(ctx context.Context, userID uuid.UUID, granularity entity.TrendGranularity, fromDate, toDate string) ([]*entity.CompletionPoint, error) {
+	// Granularity is one of the entity constants, date_trunc weeks start on Monday
+	query := `
+		SELECT
+			date_trunc($2, local_date)::DATE::TEXT AS period_start,
+			SUM(completed)::INTEGER,
+			SUM(missed)::INTEGER
+		FROM analytics_daily_rollups
+		WHERE user_id = $1
+		  AND local_date >= $3
+		  AND local_date <= $4
+		GROUP BY 1
+		ORDER BY 1
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, string(granularity), fromDate, toDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get completion points: %w", err)
+	}
+	defer rows.Close()
+
+	var points []*entity.CompletionPoint
+	for rows.Next() {
+		point := &entity.CompletionPoint{}
+		if err := rows.Scan(&point.PeriodStart, &point.Completed, &point.Missed); err != nil {
+			return nil, fmt.Errorf("failed to scan completion point: %w", err)
+		}
+		points = append(points, point)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate completion points: %w", err)
+	}
+
+	return points, nil
+}
+
+func (r *analyticsRepository) GetWeekdayRates(ctx context.Context, userID uuid.UUID) ([]*entity.WeekdayRate, error) {
+	query := `
+		SELECT
+			EXTRACT(DOW FROM local_date)::INTEGER AS weekday,
+			SUM(completed)::INTEGER,
+			SUM(missed)::INTEGER
+		FROM analytics_daily_rollups
+		WHERE user_id = $1
+		GROUP BY 1
+		ORDER BY 1
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get weekday rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []*entity.WeekdayRate
+	for rows.Next() {
+		rate := &entity.WeekdayRate{}
+		if err := rows.Scan(&rate.Weekday, &rate.Completed, &rate.Missed); err != nil {
+			return nil, fmt.Errorf("failed to scan weekday rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate weekday rates: %w", err)
+	}
+
+	return rates, nil
+}
+
+func (r *analyticsRepository) GetHourDistribution(ctx context.Context, userID uuid.UUID) ([]int32, error) {
+	query := `
+		SELECT local_hour, confirmations
+		FROM analytics_hourly_rollups
+		WHERE user_id = $1
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hour distribution: %w", err)
+	}
+	defer rows.Close()
+
+	hours := make([]int32, 24)
+	for rows.Next() {
+		var hour int16
+		var confirmations int32
+		if err := rows.Scan(&hour, &confirmations); err != nil {
+			return nil, fmt.Errorf("failed to scan hour distribution: %w", err)
+		}
+		if hour >= 0 && hour < 24 {
+			hours[hour] = confirmations
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate hour distribution: %w", err)
+	}
+
+	return hours, nil
+}
+
+func (r *analyticsRepository) GetCorrelations(ctx context.Context, userID uuid.UUID, limit int32) ([]*entity.HabitCorrelation, error) {
+	query := `
+		SELECT
+			p.habit_a_id, ha.name,
+			p.habit_b_id, hb.name,
+			p.days_together,
+			p.days_together::FLOAT8 / NULLIF(ra.confirmed_days + rb.confirmed_days - p.days_together, 0) AS score
+		FROM analytics_habit_pair_rollups p
+		JOIN analytics_habit_rollups ra ON ra.habit_id = p.habit_a_id
+		JOIN analytics_habit_rollups rb ON rb.habit_id = p.habit_b_id
+		JOIN habits ha ON ha.id = p.habit_a_id
+		JOIN habits hb ON hb.id = p.habit_b_id
+		WHERE p.user_id = $1
+		  AND ha.is_active = TRUE
+		  AND hb.is_active = TRUE
+		ORDER BY score DESC NULLS LAST, p.days_together DESC
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habit correlations: %w", err)
+	}
+	defer rows.Close()
+
+	var correlations []*entity.HabitCorrelation
+	for rows.Next() {
+		correlation := &entity.HabitCorrelation{}
+		var score *float64
+		err := rows.Scan(
+			&correlation.HabitAID, &correlation.HabitAName,
+			&correlation.HabitBID, &correlation.HabitBName,
+			&correlation.DaysTogether,
+			&score,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit correlation: %w", err)
+		}
+		if score != nil {
+			correlation.Score = *score
+		}
+		correlations = append(correlations, correlation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate habit correlations: %w", err)
+	}
+
+	return correlations, nil
+}
+
+func (r *analyticsRepository) GetMoodCorrelations(ctx context.Context, userID uuid.UUID, fromDate, toDate string) (*entity.MoodSummary, error) {
+	summary := &entity.MoodSummary{}
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*), AVG(mood)::FLOAT8
+		FROM journal_entries
+		WHERE user_id = $1 AND mood IS NOT NULL AND entry_date BETWEEN $2 AND $3
+	`, userID, fromDate, toDate).Scan(&summary.RatedDays, &summary.AverageMood)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mood average: %w", err)
+	}
+
+	if summary.RatedDays == 0 {
+		return summary, nil
+	}
+
+	// Rated days before a habit existed tell nothing about it and are left out
+	query := `
+		WITH moods AS (
+			SELECT entry_date, mood
+			FROM journal_entries
+			WHERE user_id = $1 AND mood IS NOT NULL AND entry_date BETWEEN $2 AND $3
+		)
+		SELECT
+			h.id, h.name,
+			COUNT(*) FILTER (WHERE c.id IS NOT NULL),
+			(AVG(m.mood) FILTER (WHERE c.id IS NOT NULL))::FLOAT8,
+			COUNT(*) FILTER (WHERE c.id IS NULL),
+			(AVG(m.mood) FILTER (WHERE c.id IS NULL))::FLOAT8
+		FROM habits h
+		JOIN moods m ON m.entry_date >= (h.created_at + make_interval(hours => h.timezone_offset_hours))::DATE
+		LEFT JOIN habit_confirmations c ON c.habit_id = h.id AND c.confirmed_for_date = m.entry_date
+		WHERE h.user_id = $1 AND h.is_active = TRUE
+		GROUP BY h.id, h.name
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, fromDate, toDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mood correlations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		correlation := &entity.MoodCorrelation{}
+		err := rows.Scan(
+			&correlation.HabitID, &correlation.HabitName,
+			&correlation.DaysDone, &correlation.AvgMoodDone,
+			&correlation.DaysNotDone, &correlation.AvgMoodNotDone,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan mood correlation: %w", err)
+		}
+		summary.Habits = append(summary.Habits, correlation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate mood correlations: %w", err)
+	}
+
+	return summary, nil
+}
+
+func (r *analyticsRepository) GetConfirmingUserIDs(ctx context.Context, since time.Time) ([]uuid.UUID, error) {
+	query := `SELECT DISTINCT user_id FROM habit_confirmations WHERE created_at >= $1`
+
+	rows, err := r.pool.Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rollup users: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan rollup user: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rollup users: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+func (r *analyticsRepository) RebuildUserRollups(ctx context.Context, userID uuid.UUID) error {
+	// A confirmation committed after the snapshot makes the rebuild fail on the rows it touched
+	// instead of being dropped, the next rebuild picks the user up again
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Missed deadlines are recorded nowhere else and are kept as they are
+	_, err = tx.Exec(ctx, `
+		UPDATE analytics_daily_rollups SET completed = 0, updated_at = NOW()
+		WHERE user_id = $1 AND completed <> 0
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to reset daily rollups: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_daily_rollups (user_id, local_date, completed, updated_at)
+		SELECT user_id, confirmed_for_date, COUNT(*), NOW()
+		FROM habit_confirmations
+		WHERE user_id = $1
+		GROUP BY user_id, confirmed_for_date
+		ON CONFLICT (user_id, local_date) DO UPDATE SET
+			completed = EXCLUDED.completed,
+			updated_at = NOW()
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to rebuild daily rollups: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM analytics_daily_rollups WHERE user_id = $1 AND completed = 0 AND missed = 0`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete empty daily rollups: %w", err)
+	}
+
+	for _, table := range []string{
+		"analytics_habit_pair_rollups",
+		"analytics_habit_rollups",
+		"analytics_hourly_rollups",
+	} {
+		if _, err := tx.Exec(ctx, "DELETE FROM "+table+" WHERE user_id = $1", userID); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", table, err)
+		}
+	}
+
+	// Imported history is created long after the day it confirms and carries no time of day,
+	// it is left out of the hourly rollups as when it was imported
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_hourly_rollups (user_id, local_hour, confirmations)
+		SELECT c.user_id, EXTRACT(HOUR FROM c.confirmed_at + make_interval(hours => h.timezone_offset_hours))::SMALLINT, COUNT(*)
+		FROM habit_confirmations c
+		JOIN habits h ON h.id = c.habit_id
+		WHERE c.user_id = $1
+		  AND c.created_at - c.confirmed_at < INTERVAL '1 hour'
+		GROUP BY 1, 2
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to rebuild hourly rollups: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_habit_rollups (habit_id, user_id, confirmed_days)
+		SELECT habit_id, user_id, COUNT(*)
+		FROM habit_confirmations
+		WHERE user_id = $1
+		GROUP BY habit_id, user_id
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to rebuild habit rollups: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_habit_pair_rollups (habit_a_id, habit_b_id, user_id, days_together)
+		SELECT a.habit_id, b.habit_id, a.user_id, COUNT(*)
+		FROM habit_confirmations a
+		JOIN habit_confirmations b
+		  ON b.user_id = a.user_id
+		 AND b.confirmed_for_date = a.confirmed_for_date
+		 AND b.habit_id > a.habit_id
+		WHERE a.user_id = $1
+		GROUP BY a.habit_id, b.habit_id, a.user_id
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to rebuild habit pair rollups: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *analyticsRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, table := range []string{
+		"analytics_habit_pair_rollups",
+		"analytics_habit_rollups",
+		"analytics_hourly_rollups",
+		"analytics_daily_rollups",
+	} {
+		if _, err := tx.Exec(ctx, "DELETE FROM "+table+" WHERE user_id = $1", userID); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", table, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type attachmentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAttachmentRepository creates a new PostgreSQL attachment repository
+func NewAttachmentRepository(pool *pgxpool.Pool) repository.AttachmentRepository {
+	return &attachmentRepository{pool: pool}
+}
+
+const attachmentColumns = `
+	id, user_id, confirmation_id, content_type,
+	size_bytes, thumbnail_size_bytes, width, height,
+	created_at, attached_at
+`
+
+func scanAttachment(row pgx.Row) (*entity.Attachment, error) {
+	attachment := &entity.Attachment{}
+	err := row.Scan(
+		&attachment.ID, &attachment.UserID, &attachment.ConfirmationID, &attachment.ContentType,
+		&attachment.SizeBytes, &attachment.ThumbnailSizeBytes, &attachment.Width, &attachment.Height,
+		&attachment.CreatedAt, &attachment.AttachedAt,
+	)
+	return attachment, err
+}
+
+func (r *attachmentRepository) queryAttachments(ctx context.Context, query string, args ...any) ([]*entity.Attachment, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*entity.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+func (r *attachmentRepository) CreateWithinQuota(ctx context.Context, attachment *entity.Attachment, quotaBytes int64) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Serializes uploads of the same user so that parallel uploads cannot exceed the quota together
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, attachment.UserID.String()); err != nil {
+		return false, fmt.Errorf("failed to lock attachment quota: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO attachments (
+			id, user_id, content_type, size_bytes, thumbnail_size_bytes, width, height, created_at
+		)
+		SELECT $1::UUID, $2::UUID, $3::TEXT, $4::BIGINT, $5::BIGINT, $6::INTEGER, $7::INTEGER, $8::TIMESTAMP
+		WHERE (
+			SELECT COALESCE(SUM(size_bytes + thumbnail_size_bytes), 0) FROM attachments WHERE user_id = $2
+		) + $4 + $5 <= $9
+	`,
+		attachment.ID, attachment.UserID, attachment.ContentType, attachment.SizeBytes, attachment.ThumbnailSizeBytes,
+		attachment.Width, attachment.Height, attachment.CreatedAt, quotaBytes,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+func (r *attachmentRepository) GetByID(ctx context.Context, attachmentID uuid.UUID) (*entity.Attachment, error) {
+	attachment := &entity.Attachment{}
+	err := r.pool.QueryRow(ctx, `
+		SELECT
+			a.id, a.user_id, a.confirmation_id, a.content_type,
+			a.size_bytes, a.thumbnail_size_bytes, a.width, a.height,
+			a.created_at, a.attached_at, c.habit_id
+		FROM attachments a
+		LEFT JOIN habit_confirmations c ON c.id = a.confirmation_id
+		WHERE a.id = $1
+	`, attachmentID).Scan(
+		&attachment.ID, &attachment.UserID, &attachment.ConfirmationID, &attachment.ContentType,
+		&attachment.SizeBytes, &attachment.ThumbnailSizeBytes, &attachment.Width, &attachment.Height,
+		&attachment.CreatedAt, &attachment.AttachedAt, &attachment.HabitID,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("attachment %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	return attachment, nil
+}
+
+func (r *attachmentRepository) CountAttachable(ctx context.Context, userID uuid.UUID, attachmentIDs []uuid.UUID) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM attachments
+		WHERE id = ANY($1) AND user_id = $2 AND attached_at IS NULL
+	`, attachmentIDs, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check attachments: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *attachmentRepository) GetIDsByConfirmationIDs(ctx context.Context, confirmationIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	ids := make(map[uuid.UUID][]uuid.UUID)
+	if len(confirmationIDs) == 0 {
+		return ids, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT confirmation_id, id
+		FROM attachments
+		WHERE confirmation_id = ANY($1)
+		ORDER BY created_at, id
+	`, confirmationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get confirmation attachments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var confirmationID, attachmentID uuid.UUID
+		if err := rows.Scan(&confirmationID, &attachmentID); err != nil {
+			return nil, fmt.Errorf("failed to scan confirmation attachment: %w", err)
+		}
+		ids[confirmationID] = append(ids[confirmationID], attachmentID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating confirmation attachments: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *attachmentRepository) GetUsedBytes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var used int64
+	err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE(SUM(size_bytes + thumbnail_size_bytes), 0)::BIGINT FROM attachments WHERE user_id = $1
+	`, userID).Scan(&used)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get attachment usage: %w", err)
+	}
+
+	return used, nil
+}
+
+func (r *attachmentRepository) GetOrphaned(ctx context.Context, unusedBefore time.Time, limit int32) ([]*entity.Attachment, error) {
+	query := `
+		SELECT ` + attachmentColumns + `
+		FROM attachments
+		WHERE confirmation_id IS NULL
+		  AND (attached_at IS NOT NULL OR created_at < $1)
+		ORDER BY created_at
+		LIMIT $2
+	`
+
+	return r.queryAttachments(ctx, query, unusedBefore, limit)
+}
+
+func (r *attachmentRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM attachments WHERE user_id = $1 ORDER BY created_at`
+
+	return r.queryAttachments(ctx, query, userID)
+}
+
+func (r *attachmentRepository) Delete(ctx context.Context, attachmentIDs []uuid.UUID) error {
+	if _, err := r.pool.Exec(ctx, `DELETE FROM attachments WHERE id = ANY($1)`, attachmentIDs); err != nil {
+		return fmt.Errorf("failed to delete attachments: %w", err)
+	}
+
+	return nil
+}
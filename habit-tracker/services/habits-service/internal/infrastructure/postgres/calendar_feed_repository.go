@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type calendarFeedRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCalendarFeedRepository creates a new PostgreSQL calendar feed repository
+func NewCalendarFeedRepository(pool *pgxpool.Pool) repository.CalendarFeedRepository {
+	return &calendarFeedRepository{pool: pool}
+}
+
+func (r *calendarFeedRepository) Save(ctx context.Context, userID uuid.UUID, tokenHash []byte, createdAt time.Time) error {
+	query := `
+		INSERT INTO calendar_feeds (user_id, token_hash, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = EXCLUDED.created_at
+	`
+
+	if _, err := r.pool.Exec(ctx, query, userID, tokenHash, createdAt); err != nil {
+		return fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *calendarFeedRepository) GetUserID(ctx context.Context, tokenHash []byte) (uuid.UUID, error) {
+	var userID uuid.UUID
+	err := r.pool.QueryRow(ctx, `SELECT user_id FROM calendar_feeds WHERE token_hash = $1`, tokenHash).Scan(&userID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return uuid.Nil, fmt.Errorf("calendar feed %w", repository.ErrNotFound)
+		}
+		return uuid.Nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+
+	return userID, nil
+}
+
+func (r *calendarFeedRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) (bool, error) {
+	result, err := r.pool.Exec(ctx, `DELETE FROM calendar_feeds WHERE user_id = $1`, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
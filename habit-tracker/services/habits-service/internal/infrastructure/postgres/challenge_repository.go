@@ -0,0 +1,314 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const challengeColumns = `
+	c.id, c.creator_id, c.name, c.description, c.start_date::TEXT, c.end_date::TEXT, c.invite_code,
+	(SELECT COUNT(*) FROM challenge_participants cp WHERE cp.challenge_id = c.id),
+	c.created_at, c.updated_at
+`
+
+type challengeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewChallengeRepository creates a new PostgreSQL challenge repository
+func NewChallengeRepository(pool *pgxpool.Pool) repository.ChallengeRepository {
+	return &challengeRepository{pool: pool}
+}
+
+func (r *challengeRepository) Create(ctx context.Context, challenge *entity.Challenge) error {
+	query := `
+		INSERT INTO challenges (
+			id, creator_id, name, description, start_date, end_date, invite_code, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5::DATE, $6::DATE, $7, $8, $9)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		challenge.ID,
+		challenge.CreatorID,
+		challenge.Name,
+		challenge.Description,
+		challenge.StartDate,
+		challenge.EndDate,
+		challenge.InviteCode,
+		challenge.CreatedAt,
+		challenge.UpdatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("challenge invite code %w", repository.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to create challenge: %w", err)
+	}
+
+	return nil
+}
+
+func (r *challengeRepository) GetByID(ctx context.Context, challengeID uuid.UUID) (*entity.Challenge, error) {
+	query := `SELECT ` + challengeColumns + ` FROM challenges c WHERE c.id = $1`
+
+	challenge, err := scanChallenge(r.pool.QueryRow(ctx, query, challengeID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("challenge %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+func (r *challengeRepository) GetByInviteCode(ctx context.Context, inviteCode string) (*entity.Challenge, error) {
+	query := `SELECT ` + challengeColumns + ` FROM challenges c WHERE c.invite_code = $1`
+
+	challenge, err := scanChallenge(r.pool.QueryRow(ctx, query, inviteCode))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("challenge %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+func (r *challengeRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Challenge, error) {
+	query := `SELECT ` + challengeColumns + `
+		FROM challenges c
+		WHERE c.creator_id = $1
+			OR EXISTS (SELECT 1 FROM challenge_participants p WHERE p.challenge_id = c.id AND p.user_id = $1)
+		ORDER BY c.start_date DESC, c.id DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenges: %w", err)
+	}
+
+	return scanChallenges(rows)
+}
+
+func (r *challengeRepository) GetByHabitID(ctx context.Context, habitID uuid.UUID) ([]*entity.Challenge, error) {
+	query := `SELECT ` + challengeColumns + `
+		FROM challenges c
+		JOIN challenge_participants p ON p.challenge_id = c.id
+		WHERE p.habit_id = $1
+		ORDER BY c.start_date, c.id
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenges: %w", err)
+	}
+
+	return scanChallenges(rows)
+}
+
+func (r *challengeRepository) Update(ctx context.Context, challenge *entity.Challenge) error {
+	query := `
+		UPDATE challenges SET
+			name = $1,
+			description = $2,
+			start_date = $3::DATE,
+			end_date = $4::DATE,
+			invite_code = $5,
+			updated_at = $6
+		WHERE id = $7 AND creator_id = $8
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		challenge.Name,
+		challenge.Description,
+		challenge.StartDate,
+		challenge.EndDate,
+		challenge.InviteCode,
+		challenge.UpdatedAt,
+		challenge.ID,
+		challenge.CreatorID,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("challenge invite code %w", repository.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to update challenge: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("challenge %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *challengeRepository) Delete(ctx context.Context, challengeID, creatorID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM challenges WHERE id = $1 AND creator_id = $2", challengeID, creatorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete challenge: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("challenge %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *challengeRepository) AddParticipant(ctx context.Context, participant *entity.ChallengeParticipant) error {
+	query := `
+		INSERT INTO challenge_participants (challenge_id, user_id, username, habit_id, joined_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		participant.ChallengeID,
+		participant.UserID,
+		participant.Username,
+		participant.HabitID,
+		participant.JoinedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("challenge participant %w", repository.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to add challenge participant: %w", err)
+	}
+
+	return nil
+}
+
+func (r *challengeRepository) RemoveParticipant(ctx context.Context, challengeID, userID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx,
+		"DELETE FROM challenge_participants WHERE challenge_id = $1 AND user_id = $2",
+		challengeID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove challenge participant: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("challenge participant %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *challengeRepository) GetParticipant(ctx context.Context, challengeID, userID uuid.UUID) (*entity.ChallengeParticipant, error) {
+	query := `
+		SELECT challenge_id, user_id, username, habit_id, joined_at
+		FROM challenge_participants
+		WHERE challenge_id = $1 AND user_id = $2
+	`
+
+	participant := &entity.ChallengeParticipant{}
+	err := r.pool.QueryRow(ctx, query, challengeID, userID).Scan(
+		&participant.ChallengeID, &participant.UserID, &participant.Username,
+		&participant.HabitID, &participant.JoinedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("challenge participant %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get challenge participant: %w", err)
+	}
+
+	return participant, nil
+}
+
+func (r *challengeRepository) GetParticipants(ctx context.Context, challengeID uuid.UUID) ([]*entity.ChallengeParticipant, error) {
+	query := `
+		SELECT challenge_id, user_id, username, habit_id, joined_at
+		FROM challenge_participants
+		WHERE challenge_id = $1
+		ORDER BY joined_at, user_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge participants: %w", err)
+	}
+	defer rows.Close()
+
+	var participants []*entity.ChallengeParticipant
+	for rows.Next() {
+		participant := &entity.ChallengeParticipant{}
+		err := rows.Scan(
+			&participant.ChallengeID, &participant.UserID, &participant.Username,
+			&participant.HabitID, &participant.JoinedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan challenge participant: %w", err)
+		}
+		participants = append(participants, participant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate challenge participants: %w", err)
+	}
+
+	return participants, nil
+}
+
+func (r *challengeRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "DELETE FROM challenge_participants WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete challenge participants: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM challenges WHERE creator_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to delete challenges: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func scanChallenge(row pgx.Row) (*entity.Challenge, error) {
+	challenge := &entity.Challenge{}
+	err := row.Scan(
+		&challenge.ID, &challenge.CreatorID, &challenge.Name, &challenge.Description,
+		&challenge.StartDate, &challenge.EndDate, &challenge.InviteCode, &challenge.ParticipantCount,
+		&challenge.CreatedAt, &challenge.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+func scanChallenges(rows pgx.Rows) ([]*entity.Challenge, error) {
+	defer rows.Close()
+
+	var challenges []*entity.Challenge
+	for rows.Next() {
+		challenge, err := scanChallenge(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan challenge: %w", err)
+		}
+		challenges = append(challenges, challenge)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate challenges: %w", err)
+	}
+
+	return challenges, nil
+}
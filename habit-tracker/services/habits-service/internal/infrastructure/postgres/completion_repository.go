@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"habits-service/internal/domain/entity"
 	"habits-service/internal/domain/repository"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -22,6 +21,12 @@ func NewHabitConfirmationRepository(pool *pgxpool.Pool) repository.HabitConfirma
 }
 
 func (r *habitConfirmationRepository) Create(ctx context.Context, confirmation *entity.HabitConfirmation) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		INSERT INTO habit_confirmations (
 			id, habit_id, user_id, confirmed_at, confirmed_for_date, notes, created_at
@@ -30,7 +35,7 @@ func (r *habitConfirmationRepository) Create(ctx context.Context, confirmation *
 		)
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	_, err = tx.Exec(ctx, query,
 		confirmation.ID,
 		confirmation.HabitID,
 		confirmation.UserID,
@@ -44,10 +49,34 @@ func (r *habitConfirmationRepository) Create(ctx context.Context, confirmation *
 		return fmt.Errorf("failed to create habit confirmation: %w", err)
 	}
 
+	if len(confirmation.AttachmentIDs) > 0 {
+		// A concurrent confirmation that linked one of the uploads first leaves fewer rows to update
+		result, err := tx.Exec(ctx, `
+			UPDATE attachments
+			SET confirmation_id = $3, attached_at = NOW()
+			WHERE id = ANY($1) AND user_id = $2 AND attached_at IS NULL
+		`, confirmation.AttachmentIDs, confirmation.UserID, confirmation.ID)
+		if err != nil {
+			return fmt.Errorf("failed to attach attachments: %w", err)
+		}
+		if int(result.RowsAffected()) != len(confirmation.AttachmentIDs) {
+			return fmt.Errorf("%w attachment_ids: attachments must be your own uploads not used by another confirmation",
+				repository.ErrInvalidArgument)
+		}
+	}
+
+	if err := recordConfirmationRollups(ctx, tx, confirmation); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return nil
 }
 
-func (r *habitConfirmationRepository) GetByHabitID(ctx context.Context, habitID uuid.UUID, limit, offset int32) ([]*entity.HabitConfirmation, error) {
+func (r *habitConfirmationRepository) GetByHabitID(ctx context.Context, habitID uuid.UUID, limit int32, after *entity.PageCursor) ([]*entity.HabitConfirmation, error) {
 	if limit <= 0 {
 		limit = 30 // Default limit
 	}
@@ -57,11 +86,16 @@ func (r *habitConfirmationRepository) GetByHabitID(ctx context.Context, habitID
 			id, habit_id, user_id, confirmed_at, confirmed_for_date::TEXT, notes, created_at
 		FROM habit_confirmations
 		WHERE habit_id = $1
-		ORDER BY confirmed_for_date DESC
-		LIMIT $2 OFFSET $3
 	`
+	args := []interface{}{habitID, limit}
 
-	rows, err := r.pool.Query(ctx, query, habitID, limit, offset)
+	if after != nil {
+		query += " AND (confirmed_for_date, id) < ($3::DATE, $4)"
+		args = append(args, after.Key, after.ID)
+	}
+	query += " ORDER BY confirmed_for_date DESC, id DESC LIMIT $2"
+
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get habit confirmations: %w", err)
 	}
@@ -92,6 +126,92 @@ func (r *habitConfirmationRepository) GetByHabitID(ctx context.Context, habitID
 	return confirmations, nil
 }
 
+func (r *habitConfirmationRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitConfirmation, error) {
+	query := `
+		SELECT
+			id, habit_id, user_id, confirmed_at, confirmed_for_date::TEXT, notes, created_at
+		FROM habit_confirmations
+		WHERE user_id = $1
+		ORDER BY confirmed_for_date DESC, habit_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user confirmations: %w", err)
+	}
+	defer rows.Close()
+
+	var confirmations []*entity.HabitConfirmation
+	for rows.Next() {
+		confirmation := &entity.HabitConfirmation{}
+		err := rows.Scan(
+			&confirmation.ID,
+			&confirmation.HabitID,
+			&confirmation.UserID,
+			&confirmation.ConfirmedAt,
+			&confirmation.ConfirmedForDate,
+			&confirmation.Notes,
+			&confirmation.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan confirmation: %w", err)
+		}
+		confirmations = append(confirmations, confirmation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate confirmations: %w", err)
+	}
+
+	return confirmations, nil
+}
+
+func (r *habitConfirmationRepository) StreamByUserID(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID, fromDate, toDate *string,
+	fn func(*entity.HabitConfirmation) error) error {
+	query := `
+		SELECT
+			id, habit_id, user_id, confirmed_at, confirmed_for_date::TEXT, notes, created_at
+		FROM habit_confirmations
+		WHERE user_id = $1
+		  AND ($2::UUID IS NULL OR habit_id = $2)
+		  AND ($3::DATE IS NULL OR confirmed_for_date >= $3)
+		  AND ($4::DATE IS NULL OR confirmed_for_date <= $4)
+		ORDER BY confirmed_for_date, habit_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, habitID, fromDate, toDate)
+	if err != nil {
+		return fmt.Errorf("failed to get user confirmations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		confirmation := &entity.HabitConfirmation{}
+		err := rows.Scan(
+			&confirmation.ID,
+			&confirmation.HabitID,
+			&confirmation.UserID,
+			&confirmation.ConfirmedAt,
+			&confirmation.ConfirmedForDate,
+			&confirmation.Notes,
+			&confirmation.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan confirmation: %w", err)
+		}
+
+		if err := fn(confirmation); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate confirmations: %w", err)
+	}
+
+	return nil
+}
+
 func (r *habitConfirmationRepository) CountByHabitID(ctx context.Context, habitID uuid.UUID) (int32, error) {
 	query := `
 		SELECT COUNT(*) FROM habit_confirmations WHERE habit_id = $1
@@ -106,6 +226,35 @@ func (r *habitConfirmationRepository) CountByHabitID(ctx context.Context, habitI
 	return count, nil
 }
 
+func (r *habitConfirmationRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int32, error) {
+	query := `
+		SELECT COUNT(*) FROM habit_confirmations WHERE user_id = $1
+	`
+
+	var count int32
+	err := r.pool.QueryRow(ctx, query, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count confirmations: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *habitConfirmationRepository) CountByHabitIDBetween(ctx context.Context, habitID uuid.UUID, fromDate, toDate string) (int32, error) {
+	query := `
+		SELECT COUNT(*) FROM habit_confirmations
+		WHERE habit_id = $1 AND confirmed_for_date BETWEEN $2::DATE AND $3::DATE
+	`
+
+	var count int32
+	err := r.pool.QueryRow(ctx, query, habitID, fromDate, toDate).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count confirmations: %w", err)
+	}
+
+	return count, nil
+}
+
 func (r *habitConfirmationRepository) GetLatestByHabitID(ctx context.Context, habitID uuid.UUID) (*entity.HabitConfirmation, error) {
 	query := `
 		SELECT
@@ -154,74 +303,42 @@ func (r *habitConfirmationRepository) ExistsForDate(ctx context.Context, habitID
 	return exists, nil
 }
 
-func (r *habitConfirmationRepository) GetStats(ctx context.Context, habitID uuid.UUID) (*repository.HabitStats, error) {
-	// Get basic stats
-	statsQuery := `
+func (r *habitConfirmationRepository) GetAllByHabitID(ctx context.Context, habitID uuid.UUID) ([]*entity.HabitConfirmation, error) {
+	query := `
 		SELECT
-			COUNT(*) as total_confirmations,
-			MIN(confirmed_at) as first_confirmation,
-			MAX(confirmed_at) as last_confirmation
+			id, habit_id, user_id, confirmed_at, confirmed_for_date::TEXT, notes, created_at
 		FROM habit_confirmations
 		WHERE habit_id = $1
+		ORDER BY confirmed_for_date ASC
 	`
 
-	stats := &repository.HabitStats{}
-	var firstConfirmation, lastConfirmation *time.Time
-
-	err := r.pool.QueryRow(ctx, statsQuery, habitID).Scan(
-		&stats.TotalConfirmations,
-		&firstConfirmation,
-		&lastConfirmation,
-	)
+	rows, err := r.pool.Query(ctx, query, habitID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get basic stats: %w", err)
-	}
-
-	stats.FirstConfirmation = firstConfirmation
-	stats.LastConfirmation = lastConfirmation
-
-	streakQuery := `
-		WITH confirmation_dates AS (
-			SELECT
-				confirmed_for_date,
-				confirmed_for_date::date - ROW_NUMBER() OVER (ORDER BY confirmed_for_date)::int AS streak_group
-			FROM habit_confirmations
-			WHERE habit_id = $1
-			ORDER BY confirmed_for_date
-		),
-		streaks AS (
-			SELECT
-				COUNT(*) as streak_length,
-				MAX(confirmed_for_date) as last_date
-			FROM confirmation_dates
-			GROUP BY streak_group
-		)
-		SELECT
-			COALESCE(MAX(streak_length), 0) as longest_streak,
-			COALESCE(
-				(SELECT streak_length FROM streaks WHERE last_date = (SELECT MAX(confirmed_for_date) FROM habit_confirmations WHERE habit_id = $1)),
-				0
-			) as current_streak
-		FROM streaks
-	`
-
-	err = r.pool.QueryRow(ctx, streakQuery, habitID).Scan(
-		&stats.LongestStreak,
-		&stats.CurrentStreak,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get streak stats: %w", err)
+		return nil, fmt.Errorf("failed to get habit confirmations: %w", err)
 	}
+	defer rows.Close()
 
-	if lastConfirmation != nil && firstConfirmation != nil {
-		daysSinceStart := int32(time.Since(*firstConfirmation).Hours() / 24)
-		if daysSinceStart > 0 {
-			stats.CompletionRate = float64(stats.TotalConfirmations) / float64(daysSinceStart) * 100
-			if stats.CompletionRate > 100 {
-				stats.CompletionRate = 100
-			}
+	var confirmations []*entity.HabitConfirmation
+	for rows.Next() {
+		confirmation := &entity.HabitConfirmation{}
+		err := rows.Scan(
+			&confirmation.ID,
+			&confirmation.HabitID,
+			&confirmation.UserID,
+			&confirmation.ConfirmedAt,
+			&confirmation.ConfirmedForDate,
+			&confirmation.Notes,
+			&confirmation.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan confirmation: %w", err)
 		}
+		confirmations = append(confirmations, confirmation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate confirmations: %w", err)
 	}
 
-	return stats, nil
+	return confirmations, nil
 }
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type feedRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewFeedRepository creates a new PostgreSQL activity feed repository
+func NewFeedRepository(pool *pgxpool.Pool) repository.FeedRepository {
+	return &feedRepository{pool: pool}
+}
+
+func (r *feedRepository) FanOut(ctx context.Context, item *entity.FeedItem) (int64, error) {
+	// The habit is checked in the same statement, so a habit made private meanwhile is not fanned out
+	query := `
+		INSERT INTO feed_items (
+			user_id, actor_id, habit_id, event_id, kind,
+			confirmed_for_date, streak, achievement_code, occurred_at
+		)
+		SELECT f.follower_id, $1, $2, $3, $4, $5::DATE, $6, $7, $8
+		FROM user_follows f
+		JOIN habits h ON h.id = $2 AND h.user_id = $1 AND h.visibility <> 'private'
+		WHERE f.followee_id = $1 AND f.status = 'accepted'
+		ON CONFLICT (user_id, event_id) DO NOTHING
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		item.ActorID, item.HabitID, item.EventID, item.Kind,
+		item.ConfirmedForDate, item.Streak, item.AchievementCode, item.OccurredAt,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fan out feed item: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *feedRepository) GetPage(ctx context.Context, userID uuid.UUID, limit int32, after *entity.PageCursor) ([]*entity.FeedItem, error) {
+	query := `
+		SELECT
+			fi.id, fi.user_id, fi.actor_id, fi.habit_id, fi.event_id, fi.kind,
+			fi.confirmed_for_date::TEXT, fi.streak, fi.achievement_code, fi.occurred_at,
+			f.followee_username, h.name, h.visibility
+		FROM feed_items fi
+		JOIN user_follows f ON f.follower_id = fi.user_id AND f.followee_id = fi.actor_id AND f.status = 'accepted'
+		JOIN habits h ON h.id = fi.habit_id AND h.is_active = true AND h.visibility <> 'private'
+		WHERE fi.user_id = $1
+	`
+	args := []interface{}{userID, limit}
+
+	if after != nil {
+		query += " AND (fi.occurred_at, fi.id) < ($3::TIMESTAMP, $4)"
+		args = append(args, after.Key, after.ID)
+	}
+	query += " ORDER BY fi.occurred_at DESC, fi.id DESC LIMIT $2"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feed items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*entity.FeedItem
+	for rows.Next() {
+		item := &entity.FeedItem{}
+		err := rows.Scan(
+			&item.ID, &item.UserID, &item.ActorID, &item.HabitID, &item.EventID, &item.Kind,
+			&item.ConfirmedForDate, &item.Streak, &item.AchievementCode, &item.OccurredAt,
+			&item.ActorUsername, &item.HabitName, &item.HabitVisibility,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feed item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate feed items: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *feedRepository) DeleteByActor(ctx context.Context, userID, actorID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM feed_items WHERE user_id = $1 AND actor_id = $2", userID, actorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete feed items: %w", err)
+	}
+
+	return nil
+}
+
+func (r *feedRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM feed_items WHERE user_id = $1 OR actor_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete feed items: %w", err)
+	}
+
+	return nil
+}
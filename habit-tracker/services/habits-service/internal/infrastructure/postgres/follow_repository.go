@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const followColumns = `
+	follower_id, follower_username, followee_id, followee_username, status, created_at, responded_at
+`
+
+type followRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewFollowRepository creates a new PostgreSQL follow repository
+func NewFollowRepository(pool *pgxpool.Pool) repository.FollowRepository {
+	return &followRepository{pool: pool}
+}
+
+func (r *followRepository) Create(ctx context.Context, follow *entity.Follow) error {
+	query := `
+		INSERT INTO user_follows (
+			follower_id, follower_username, followee_id, followee_username, status, created_at
+		) VALUES ($1, $2, $3, $4, 'pending', $5)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		follow.FollowerID,
+		follow.FollowerUsername,
+		follow.FolloweeID,
+		follow.FolloweeUsername,
+		follow.CreatedAt,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("follow %w", repository.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to create follow: %w", err)
+	}
+
+	follow.Status = entity.FollowStatusPending
+	follow.RespondedAt = nil
+
+	return nil
+}
+
+func (r *followRepository) Get(ctx context.Context, followerID, followeeID uuid.UUID) (*entity.Follow, error) {
+	query := `SELECT ` + followColumns + `
+		FROM user_follows
+		WHERE follower_id = $1 AND followee_id = $2
+	`
+
+	follow, err := scanFollow(r.pool.QueryRow(ctx, query, followerID, followeeID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("follow %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get follow: %w", err)
+	}
+
+	return follow, nil
+}
+
+func (r *followRepository) Accept(ctx context.Context, followerID, followeeID uuid.UUID, respondedAt time.Time) error {
+	query := `
+		UPDATE user_follows SET status = 'accepted', responded_at = $3
+		WHERE follower_id = $1 AND followee_id = $2 AND status = 'pending'
+	`
+
+	result, err := r.pool.Exec(ctx, query, followerID, followeeID, respondedAt)
+	if err != nil {
+		return fmt.Errorf("failed to accept follow request: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("follow request %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *followRepository) IsFollowing(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM user_follows
+			WHERE follower_id = $1 AND followee_id = $2 AND status = 'accepted'
+		)
+	`
+
+	var following bool
+	if err := r.pool.QueryRow(ctx, query, followerID, followeeID).Scan(&following); err != nil {
+		return false, fmt.Errorf("failed to check follow: %w", err)
+	}
+
+	return following, nil
+}
+
+func (r *followRepository) GetFollowers(ctx context.Context, followeeID uuid.UUID, status entity.FollowStatus) ([]*entity.Follow, error) {
+	query := `SELECT ` + followColumns + `
+		FROM user_follows
+		WHERE followee_id = $1 AND status = $2
+		ORDER BY created_at DESC, follower_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, followeeID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get followers: %w", err)
+	}
+
+	return scanFollows(rows)
+}
+
+func (r *followRepository) GetFollowing(ctx context.Context, followerID uuid.UUID) ([]*entity.Follow, error) {
+	query := `SELECT ` + followColumns + `
+		FROM user_follows
+		WHERE follower_id = $1
+		ORDER BY created_at DESC, followee_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, followerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get followed users: %w", err)
+	}
+
+	return scanFollows(rows)
+}
+
+func (r *followRepository) Delete(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx,
+		"DELETE FROM user_follows WHERE follower_id = $1 AND followee_id = $2",
+		followerID, followeeID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete follow: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("follow %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *followRepository) DeletePending(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx,
+		"DELETE FROM user_follows WHERE follower_id = $1 AND followee_id = $2 AND status = 'pending'",
+		followerID, followeeID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete follow request: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("follow request %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *followRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM user_follows WHERE follower_id = $1 OR followee_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete follows: %w", err)
+	}
+
+	return nil
+}
+
+func scanFollow(row pgx.Row) (*entity.Follow, error) {
+	follow := &entity.Follow{}
+	err := row.Scan(
+		&follow.FollowerID, &follow.FollowerUsername, &follow.FolloweeID, &follow.FolloweeUsername,
+		&follow.Status, &follow.CreatedAt, &follow.RespondedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return follow, nil
+}
+
+func scanFollows(rows pgx.Rows) ([]*entity.Follow, error) {
+	defer rows.Close()
+
+	var follows []*entity.Follow
+	for rows.Next() {
+		follow, err := scanFollow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan follow: %w", err)
+		}
+		follows = append(follows, follow)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate follows: %w", err)
+	}
+
+	return follows, nil
+}
@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type habitGroupRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewHabitGroupRepository creates a new PostgreSQL habit group repository
+func NewHabitGroupRepository(pool *pgxpool.Pool) repository.HabitGroupRepository {
+	return &habitGroupRepository{pool: pool}
+}
+
+func (r *habitGroupRepository) Create(ctx context.Context, group *entity.HabitGroup) error {
+	query := `
+		INSERT INTO habit_groups (
+			id, user_id, name, color, icon, position, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5,
+			(SELECT COALESCE(MAX(position), -1) + 1 FROM habit_groups WHERE user_id = $2),
+			$6, $7
+		)
+		RETURNING position
+	`
+
+	err := r.pool.QueryRow(ctx, query,
+		group.ID,
+		group.UserID,
+		group.Name,
+		group.Color,
+		group.Icon,
+		group.CreatedAt,
+		group.UpdatedAt,
+	).Scan(&group.Position)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("habit group with this name %w", repository.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to create habit group: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitGroupRepository) GetByIDAndUserID(ctx context.Context, groupID, userID uuid.UUID) (*entity.HabitGroup, error) {
+	query := `
+		SELECT
+			g.id, g.user_id, g.name, g.color, g.icon, g.position,
+			(SELECT COUNT(*) FROM habits h WHERE h.group_id = g.id)::INTEGER,
+			g.created_at, g.updated_at
+		FROM habit_groups g
+		WHERE g.id = $1 AND g.user_id = $2
+	`
+
+	group := &entity.HabitGroup{}
+	err := r.pool.QueryRow(ctx, query, groupID, userID).Scan(
+		&group.ID, &group.UserID, &group.Name, &group.Color, &group.Icon, &group.Position,
+		&group.HabitCount,
+		&group.CreatedAt, &group.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("habit group %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get habit group: %w", err)
+	}
+
+	return group, nil
+}
+
+func (r *habitGroupRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitGroup, error) {
+	query := `
+		SELECT
+			g.id, g.user_id, g.name, g.color, g.icon, g.position,
+			(SELECT COUNT(*) FROM habits h WHERE h.group_id = g.id)::INTEGER,
+			g.created_at, g.updated_at
+		FROM habit_groups g
+		WHERE g.user_id = $1
+		ORDER BY g.position, g.id
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habit groups: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []*entity.HabitGroup
+	for rows.Next() {
+		group := &entity.HabitGroup{}
+		err := rows.Scan(
+			&group.ID, &group.UserID, &group.Name, &group.Color, &group.Icon, &group.Position,
+			&group.HabitCount,
+			&group.CreatedAt, &group.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit group: %w", err)
+		}
+		groups = append(groups, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate habit groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+func (r *habitGroupRepository) Update(ctx context.Context, group *entity.HabitGroup) error {
+	query := `
+		UPDATE habit_groups SET
+			name = $1,
+			color = $2,
+			icon = $3,
+			updated_at = $4
+		WHERE id = $5 AND user_id = $6
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		group.Name, group.Color, group.Icon, time.Now().UTC(), group.ID, group.UserID,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("habit group with this name %w", repository.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to update habit group: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("habit group %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *habitGroupRepository) Delete(ctx context.Context, groupID, userID uuid.UUID) error {
+	query := `
+		DELETE FROM habit_groups WHERE id = $1 AND user_id = $2
+	`
+
+	result, err := r.pool.Exec(ctx, query, groupID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete habit group: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("habit group %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *habitGroupRepository) Reorder(ctx context.Context, userID uuid.UUID, groupIDs []uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := reorder(ctx, tx, "habit_groups", userID, groupIDs); err != nil {
+		if err == errReorderUnknownID {
+			return fmt.Errorf("habit group %w", repository.ErrNotFound)
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitGroupRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM habit_groups WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete habit groups: %w", err)
+	}
+
+	return nil
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,241 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type habitImportRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewHabitImportRepository creates a new PostgreSQL habit import repository
+func NewHabitImportRepository(pool *pgxpool.Pool) repository.HabitImportRepository {
+	return &habitImportRepository{pool: pool}
+}
+
+const habitImportColumns = `
+	id, user_id, source, status,
+	total_habits, total_confirmations, skipped_rows,
+	habits_imported, confirmations_imported,
+	error, created_at, finished_at
+`
+
+func scanHabitImport(row pgx.Row) (*entity.HabitImport, error) {
+	habitImport := &entity.HabitImport{}
+	err := row.Scan(
+		&habitImport.ID, &habitImport.UserID, &habitImport.Source, &habitImport.Status,
+		&habitImport.TotalHabits, &habitImport.TotalConfirmations, &habitImport.SkippedRows,
+		&habitImport.HabitsImported, &habitImport.ConfirmationsImported,
+		&habitImport.Error, &habitImport.CreatedAt, &habitImport.FinishedAt,
+	)
+	return habitImport, err
+}
+
+func (r *habitImportRepository) Create(ctx context.Context, habitImport *entity.HabitImport) error {
+	query := `
+		INSERT INTO habit_imports (
+			id, user_id, source, status,
+			total_habits, total_confirmations, skipped_rows, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, query,
+		habitImport.ID, habitImport.UserID, habitImport.Source, habitImport.Status,
+		habitImport.TotalHabits, habitImport.TotalConfirmations, habitImport.SkippedRows, habitImport.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create habit import: %w", err)
+	}
+
+	if err := insertImportIssues(ctx, tx, habitImport.ID, habitImport.Issues); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitImportRepository) GetByID(ctx context.Context, importID uuid.UUID) (*entity.HabitImport, error) {
+	query := `SELECT ` + habitImportColumns + ` FROM habit_imports WHERE id = $1`
+
+	habitImport, err := scanHabitImport(r.pool.QueryRow(ctx, query, importID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("habit import %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get habit import: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT row_number, habit_name, reason
+		FROM habit_import_issues
+		WHERE import_id = $1
+		ORDER BY position
+	`, importID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habit import issues: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		issue := &entity.ImportIssue{}
+		if err := rows.Scan(&issue.Row, &issue.HabitName, &issue.Reason); err != nil {
+			return nil, fmt.Errorf("failed to scan habit import issue: %w", err)
+		}
+		habitImport.Issues = append(habitImport.Issues, issue)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating habit import issues: %w", err)
+	}
+
+	return habitImport, nil
+}
+
+func (r *habitImportRepository) GetInProgressByUserID(ctx context.Context, userID uuid.UUID) (*entity.HabitImport, error) {
+	query := `
+		SELECT ` + habitImportColumns + `
+		FROM habit_imports
+		WHERE user_id = $1 AND status IN ('pending', 'processing')
+	`
+
+	habitImport, err := scanHabitImport(r.pool.QueryRow(ctx, query, userID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get habit import in progress: %w", err)
+	}
+
+	return habitImport, nil
+}
+
+func (r *habitImportRepository) MarkProcessing(ctx context.Context, importID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `UPDATE habit_imports SET status = 'processing' WHERE id = $1`, importID)
+	if err != nil {
+		return fmt.Errorf("failed to mark habit import as processing: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitImportRepository) AddProgress(ctx context.Context, importID uuid.UUID, confirmations int32) error {
+	query := `
+		UPDATE habit_imports
+		SET habits_imported = habits_imported + 1,
+		    confirmations_imported = confirmations_imported + $2
+		WHERE id = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, query, importID, confirmations); err != nil {
+		return fmt.Errorf("failed to update habit import progress: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitImportRepository) AddIssues(ctx context.Context, importID uuid.UUID, issues []*entity.ImportIssue) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertImportIssues(ctx, tx, importID, issues); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// insertImportIssues appends issues after the ones already stored for the import
+func insertImportIssues(ctx context.Context, tx pgx.Tx, importID uuid.UUID, issues []*entity.ImportIssue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	var position int32
+	err := tx.QueryRow(ctx, `
+		SELECT COALESCE(MAX(position), 0) FROM habit_import_issues WHERE import_id = $1
+	`, importID).Scan(&position)
+	if err != nil {
+		return fmt.Errorf("failed to get habit import issue position: %w", err)
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"habit_import_issues"},
+		[]string{"import_id", "position", "row_number", "habit_name", "reason"},
+		pgx.CopyFromSlice(len(issues), func(i int) ([]any, error) {
+			issue := issues[i]
+			return []any{importID, position + int32(i) + 1, issue.Row, issue.HabitName, issue.Reason}, nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save habit import issues: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitImportRepository) MarkCompleted(ctx context.Context, importID uuid.UUID) error {
+	query := `UPDATE habit_imports SET status = 'completed', finished_at = NOW() WHERE id = $1`
+
+	if _, err := r.pool.Exec(ctx, query, importID); err != nil {
+		return fmt.Errorf("failed to mark habit import as completed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitImportRepository) MarkFailed(ctx context.Context, importID uuid.UUID, errMsg string) error {
+	query := `UPDATE habit_imports SET status = 'failed', error = $2, finished_at = NOW() WHERE id = $1`
+
+	if _, err := r.pool.Exec(ctx, query, importID, errMsg); err != nil {
+		return fmt.Errorf("failed to mark habit import as failed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitImportRepository) FailInProgress(ctx context.Context, errMsg string) (int64, error) {
+	query := `
+		UPDATE habit_imports
+		SET status = 'failed', error = $1, finished_at = NOW()
+		WHERE status IN ('pending', 'processing')
+	`
+
+	result, err := r.pool.Exec(ctx, query, errMsg)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail habit imports in progress: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (r *habitImportRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	if _, err := r.pool.Exec(ctx, `DELETE FROM habit_imports WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete habit imports: %w", err)
+	}
+
+	return nil
+}
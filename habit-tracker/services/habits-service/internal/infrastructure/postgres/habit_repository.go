@@ -22,31 +22,141 @@ func NewHabitRepository(pool *pgxpool.Pool) repository.HabitRepository {
 }
 
 func (r *habitRepository) Create(ctx context.Context, habit *entity.Habit) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertHabit(ctx, tx, habit); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitRepository) Import(ctx context.Context, habit *entity.Habit, confirmations []*entity.HabitConfirmation) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertHabit(ctx, tx, habit); err != nil {
+		return err
+	}
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"habit_confirmations"},
+		[]string{"id", "habit_id", "user_id", "confirmed_at", "confirmed_for_date", "notes", "created_at"},
+		pgx.CopyFromSlice(len(confirmations), func(i int) ([]any, error) {
+			c := confirmations[i]
+			return []any{c.ID, c.HabitID, c.UserID, c.ConfirmedAt, c.ConfirmedForDate, c.Notes, c.CreatedAt}, nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to copy confirmations: %w", err)
+	}
+
+	if err := backfillRollups(ctx, tx, habit); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// insertHabit inserts a habit on top of the manual order together with its first schedule version and tags
+func insertHabit(ctx context.Context, tx pgx.Tx, habit *entity.Habit) error {
 	query := `
 		INSERT INTO habits (
 			id, user_id, name, description, color,
 			schedule_type, interval_days, weekly_days, timezone_offset_hours,
 			streak, next_deadline_utc, confirmed_for_current_period, last_confirmed_at,
-			is_active, created_at, updated_at
+			is_active, created_at, updated_at,
+			group_id, icon, position, visibility
 		) VALUES (
 			$1, $2, $3, $4, $5,
 			$6, $7, $8, $9,
 			$10, $11, $12, $13,
-			$14, $15, $16
+			$14, $15, $16,
+			$17, $18, (SELECT COALESCE(MIN(position), 1) - 1 FROM habits WHERE user_id = $2), $19
 		)
+		RETURNING position
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	err := tx.QueryRow(ctx, query,
 		habit.ID, habit.UserID, habit.Name, habit.Description, habit.Color,
 		habit.ScheduleType, habit.IntervalDays, habit.WeeklyDays, habit.TimezoneOffsetHours,
 		habit.Streak, habit.NextDeadlineUTC, habit.ConfirmedForCurrentPeriod, habit.LastConfirmedAt,
 		habit.IsActive, habit.CreatedAt, habit.UpdatedAt,
-	)
+		habit.GroupID, habit.Icon, habit.Visibility,
+	).Scan(&habit.Position)
 
 	if err != nil {
 		return fmt.Errorf("failed to create habit: %w", err)
 	}
 
+	if err := saveScheduleVersion(ctx, tx, habit, habit.GetLocalDate(habit.CreatedAt)); err != nil {
+		return err
+	}
+
+	return saveTags(ctx, tx, habit)
+}
+
+// backfillRollups adds the confirmations of a newly imported habit to the analytics rollups.
+// Imported history carries no time of day, so the hourly rollups are left alone
+func backfillRollups(ctx context.Context, tx pgx.Tx, habit *entity.Habit) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO analytics_daily_rollups (user_id, local_date, completed, updated_at)
+		SELECT user_id, confirmed_for_date, COUNT(*), NOW()
+		FROM habit_confirmations
+		WHERE habit_id = $1
+		GROUP BY user_id, confirmed_for_date
+		ON CONFLICT (user_id, local_date) DO UPDATE SET
+			completed = analytics_daily_rollups.completed + EXCLUDED.completed,
+			updated_at = NOW()
+	`, habit.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update daily rollups: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_habit_rollups (habit_id, user_id, confirmed_days)
+		SELECT habit_id, user_id, COUNT(*)
+		FROM habit_confirmations
+		WHERE habit_id = $1
+		GROUP BY habit_id, user_id
+	`, habit.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update habit rollup: %w", err)
+	}
+
+	// Pair the habit with every other habit of the user confirmed on the same days
+	_, err = tx.Exec(ctx, `
+		INSERT INTO analytics_habit_pair_rollups (habit_a_id, habit_b_id, user_id, days_together)
+		SELECT LEAST(a.habit_id, b.habit_id), GREATEST(a.habit_id, b.habit_id), a.user_id, COUNT(*)
+		FROM habit_confirmations a
+		JOIN habit_confirmations b
+		  ON b.user_id = a.user_id
+		 AND b.confirmed_for_date = a.confirmed_for_date
+		 AND b.habit_id <> a.habit_id
+		WHERE a.habit_id = $1
+		GROUP BY 1, 2, 3
+		ON CONFLICT (habit_a_id, habit_b_id) DO UPDATE SET
+			days_together = analytics_habit_pair_rollups.days_together + EXCLUDED.days_together
+	`, habit.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update habit pair rollups: %w", err)
+	}
+
 	return nil
 }
 
@@ -56,7 +166,9 @@ func (r *habitRepository) GetByID(ctx context.Context, habitID uuid.UUID) (*enti
 			id, user_id, name, description, color,
 			schedule_type, interval_days, weekly_days, timezone_offset_hours,
 			streak, next_deadline_utc, confirmed_for_current_period, last_confirmed_at,
-			is_active, created_at, updated_at
+			is_active, created_at, updated_at, archived_at,
+			group_id, icon, position, visibility,
+			ARRAY(SELECT tag FROM habit_tags t WHERE t.habit_id = habits.id ORDER BY tag)
 		FROM habits
 		WHERE id = $1
 	`
@@ -66,12 +178,13 @@ func (r *habitRepository) GetByID(ctx context.Context, habitID uuid.UUID) (*enti
 		&habit.ID, &habit.UserID, &habit.Name, &habit.Description, &habit.Color,
 		&habit.ScheduleType, &habit.IntervalDays, &habit.WeeklyDays, &habit.TimezoneOffsetHours,
 		&habit.Streak, &habit.NextDeadlineUTC, &habit.ConfirmedForCurrentPeriod, &habit.LastConfirmedAt,
-		&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt,
+		&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt, &habit.ArchivedAt,
+		&habit.GroupID, &habit.Icon, &habit.Position, &habit.Visibility, &habit.Tags,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("habit not found")
+			return nil, repository.ErrHabitNotFound
 		}
 		return nil, fmt.Errorf("failed to get habit: %w", err)
 	}
@@ -85,7 +198,9 @@ func (r *habitRepository) GetByIDAndUserID(ctx context.Context, habitID, userID
 			id, user_id, name, description, color,
 			schedule_type, interval_days, weekly_days, timezone_offset_hours,
 			streak, next_deadline_utc, confirmed_for_current_period, last_confirmed_at,
-			is_active, created_at, updated_at
+			is_active, created_at, updated_at, archived_at,
+			group_id, icon, position, visibility,
+			ARRAY(SELECT tag FROM habit_tags t WHERE t.habit_id = habits.id ORDER BY tag)
 		FROM habits
 		WHERE id = $1 AND user_id = $2
 	`
@@ -95,12 +210,13 @@ func (r *habitRepository) GetByIDAndUserID(ctx context.Context, habitID, userID
 		&habit.ID, &habit.UserID, &habit.Name, &habit.Description, &habit.Color,
 		&habit.ScheduleType, &habit.IntervalDays, &habit.WeeklyDays, &habit.TimezoneOffsetHours,
 		&habit.Streak, &habit.NextDeadlineUTC, &habit.ConfirmedForCurrentPeriod, &habit.LastConfirmedAt,
-		&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt,
+		&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt, &habit.ArchivedAt,
+		&habit.GroupID, &habit.Icon, &habit.Position, &habit.Visibility, &habit.Tags,
 	)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("habit not found or unauthorized")
+			return nil, repository.ErrHabitNotFound
 		}
 		return nil, fmt.Errorf("failed to get habit: %w", err)
 	}
@@ -108,21 +224,20 @@ func (r *habitRepository) GetByIDAndUserID(ctx context.Context, habitID, userID
 	return habit, nil
 }
 
-func (r *habitRepository) GetByUserID(ctx context.Context, userID uuid.UUID, activeOnly bool) ([]*entity.Habit, error) {
+func (r *habitRepository) GetByUserID(ctx context.Context, userID uuid.UUID, status entity.HabitStatus) ([]*entity.Habit, error) {
 	query := `
 		SELECT
 			id, user_id, name, description, color,
 			schedule_type, interval_days, weekly_days, timezone_offset_hours,
 			streak, next_deadline_utc, confirmed_for_current_period, last_confirmed_at,
-			is_active, created_at, updated_at
+			is_active, created_at, updated_at, archived_at,
+			group_id, icon, position, visibility,
+			ARRAY(SELECT tag FROM habit_tags t WHERE t.habit_id = habits.id ORDER BY tag)
 		FROM habits
 		WHERE user_id = $1
 	`
 
-	if activeOnly {
-		query += " AND is_active = TRUE"
-	}
-
+	query += habitStatusCondition(status)
 	query += " ORDER BY created_at DESC"
 
 	rows, err := r.pool.Query(ctx, query, userID)
@@ -138,7 +253,58 @@ func (r *habitRepository) GetByUserID(ctx context.Context, userID uuid.UUID, act
 			&habit.ID, &habit.UserID, &habit.Name, &habit.Description, &habit.Color,
 			&habit.ScheduleType, &habit.IntervalDays, &habit.WeeklyDays, &habit.TimezoneOffsetHours,
 			&habit.Streak, &habit.NextDeadlineUTC, &habit.ConfirmedForCurrentPeriod, &habit.LastConfirmedAt,
-			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt,
+			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt, &habit.ArchivedAt,
+			&habit.GroupID, &habit.Icon, &habit.Position, &habit.Visibility, &habit.Tags,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit: %w", err)
+		}
+		habits = append(habits, habit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate habits: %w", err)
+	}
+
+	return habits, nil
+}
+
+func (r *habitRepository) GetPageByUserID(ctx context.Context, userID uuid.UUID, filter entity.HabitFilter, limit int32, after *entity.PageCursor) ([]*entity.Habit, error) {
+	query := `
+		SELECT
+			id, user_id, name, description, color,
+			schedule_type, interval_days, weekly_days, timezone_offset_hours,
+			streak, next_deadline_utc, confirmed_for_current_period, last_confirmed_at,
+			is_active, created_at, updated_at, archived_at,
+			group_id, icon, position, visibility,
+			ARRAY(SELECT tag FROM habit_tags t WHERE t.habit_id = habits.id ORDER BY tag)
+		FROM habits
+		WHERE user_id = $1
+	`
+	args := []interface{}{userID, limit}
+
+	query += habitFilterCondition(filter, &args)
+	if after != nil {
+		args = append(args, after.Key, after.ID)
+		query += fmt.Sprintf(" AND (position, id) > ($%d::INTEGER, $%d)", len(args)-1, len(args))
+	}
+	query += " ORDER BY position, id LIMIT $2"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habits: %w", err)
+	}
+	defer rows.Close()
+
+	var habits []*entity.Habit
+	for rows.Next() {
+		habit := &entity.Habit{}
+		err := rows.Scan(
+			&habit.ID, &habit.UserID, &habit.Name, &habit.Description, &habit.Color,
+			&habit.ScheduleType, &habit.IntervalDays, &habit.WeeklyDays, &habit.TimezoneOffsetHours,
+			&habit.Streak, &habit.NextDeadlineUTC, &habit.ConfirmedForCurrentPeriod, &habit.LastConfirmedAt,
+			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt, &habit.ArchivedAt,
+			&habit.GroupID, &habit.Icon, &habit.Position, &habit.Visibility, &habit.Tags,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan habit: %w", err)
@@ -153,6 +319,154 @@ func (r *habitRepository) GetByUserID(ctx context.Context, userID uuid.UUID, act
 	return habits, nil
 }
 
+func (r *habitRepository) CountByUserID(ctx context.Context, userID uuid.UUID, filter entity.HabitFilter) (int32, error) {
+	args := []interface{}{userID}
+	query := `
+		SELECT COUNT(*) FROM habits WHERE user_id = $1
+	` + habitFilterCondition(filter, &args)
+
+	var count int32
+	err := r.pool.QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count habits: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *habitRepository) GetSharedWithUser(ctx context.Context, partnerID uuid.UUID) ([]*entity.SharedHabit, error) {
+	query := `
+		SELECT
+			p.id, p.owner_username,
+			h.id, h.user_id, h.name, h.description, h.color,
+			h.schedule_type, h.interval_days, h.weekly_days, h.timezone_offset_hours,
+			h.streak, h.next_deadline_utc, h.confirmed_for_current_period, h.last_confirmed_at,
+			h.is_active, h.created_at, h.updated_at, h.archived_at,
+			h.group_id, h.icon, h.position, h.visibility,
+			ARRAY(SELECT tag FROM habit_tags t WHERE t.habit_id = h.id ORDER BY tag)
+		FROM habit_partners p
+		JOIN habits h ON h.id = p.habit_id
+		WHERE p.partner_id = $1 AND p.status = 'accepted' AND h.is_active = true
+		ORDER BY p.owner_username, h.position, h.id
+	`
+
+	rows, err := r.pool.Query(ctx, query, partnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shared habits: %w", err)
+	}
+	defer rows.Close()
+
+	var shared []*entity.SharedHabit
+	for rows.Next() {
+		habit := &entity.Habit{}
+		item := &entity.SharedHabit{Habit: habit}
+		err := rows.Scan(
+			&item.PartnershipID, &item.OwnerUsername,
+			&habit.ID, &habit.UserID, &habit.Name, &habit.Description, &habit.Color,
+			&habit.ScheduleType, &habit.IntervalDays, &habit.WeeklyDays, &habit.TimezoneOffsetHours,
+			&habit.Streak, &habit.NextDeadlineUTC, &habit.ConfirmedForCurrentPeriod, &habit.LastConfirmedAt,
+			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt, &habit.ArchivedAt,
+			&habit.GroupID, &habit.Icon, &habit.Position, &habit.Visibility, &habit.Tags,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan shared habit: %w", err)
+		}
+		shared = append(shared, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate shared habits: %w", err)
+	}
+
+	return shared, nil
+}
+
+// habitStatusCondition returns the WHERE clause fragment selecting habits by status
+func habitStatusCondition(status entity.HabitStatus) string {
+	switch status {
+	case entity.HabitStatusActive:
+		return " AND is_active = TRUE"
+	case entity.HabitStatusArchived:
+		return " AND is_active = FALSE"
+	default:
+		return ""
+	}
+}
+
+// habitFilterCondition returns the WHERE clause fragment selecting habits by filter,
+// appending its parameters to args
+func habitFilterCondition(filter entity.HabitFilter, args *[]interface{}) string {
+	condition := habitStatusCondition(filter.Status)
+
+	if filter.GroupID != nil {
+		if *filter.GroupID == uuid.Nil {
+			condition += " AND group_id IS NULL"
+		} else {
+			*args = append(*args, *filter.GroupID)
+			condition += fmt.Sprintf(" AND group_id = $%d", len(*args))
+		}
+	}
+
+	if filter.Tag != nil {
+		*args = append(*args, *filter.Tag)
+		condition += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM habit_tags t WHERE t.habit_id = habits.id AND t.tag = $%d)", len(*args))
+	}
+
+	return condition
+}
+
+func (r *habitRepository) Reorder(ctx context.Context, userID uuid.UUID, habitIDs []uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := reorder(ctx, tx, "habits", userID, habitIDs); err != nil {
+		if err == errReorderUnknownID {
+			return repository.ErrHabitNotFound
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitRepository) GetTagsByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.TagUsage, error) {
+	query := `
+		SELECT tag, COUNT(*)::INTEGER
+		FROM habit_tags
+		WHERE user_id = $1
+		GROUP BY tag
+		ORDER BY tag
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []*entity.TagUsage
+	for rows.Next() {
+		tag := &entity.TagUsage{}
+		if err := rows.Scan(&tag.Tag, &tag.HabitCount); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate tags: %w", err)
+	}
+
+	return tags, nil
+}
+
 func (r *habitRepository) Update(ctx context.Context, habit *entity.Habit) error {
 	query := `
 		UPDATE habits SET
@@ -163,13 +477,23 @@ func (r *habitRepository) Update(ctx context.Context, habit *entity.Habit) error
 			interval_days = $5,
 			weekly_days = $6,
 			timezone_offset_hours = $7,
-			updated_at = $8
-		WHERE id = $9
+			group_id = $8,
+			icon = $9,
+			visibility = $10,
+			updated_at = $11
+		WHERE id = $12
 	`
 
-	result, err := r.pool.Exec(ctx, query,
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, query,
 		habit.Name, habit.Description, habit.Color,
 		habit.ScheduleType, habit.IntervalDays, habit.WeeklyDays, habit.TimezoneOffsetHours,
+		habit.GroupID, habit.Icon, habit.Visibility,
 		time.Now().UTC(), habit.ID,
 	)
 
@@ -178,32 +502,193 @@ func (r *habitRepository) Update(ctx context.Context, habit *entity.Habit) error
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("habit not found")
+		return repository.ErrHabitNotFound
+	}
+
+	if err := saveScheduleVersion(ctx, tx, habit, habit.GetCurrentLocalDate()); err != nil {
+		return err
+	}
+
+	if err := saveTags(ctx, tx, habit); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// saveScheduleVersion records the habit's schedule from the given local date unless it equals
+// the latest recorded one. Several changes on the same day keep only the last schedule
+func saveScheduleVersion(ctx context.Context, tx pgx.Tx, habit *entity.Habit, effectiveFrom string) error {
+	query := `
+		INSERT INTO habit_schedule_versions (habit_id, schedule_type, interval_days, weekly_days, effective_from)
+		SELECT $1, $2::schedule_type, $3::INTEGER, $4::INTEGER[], $5::DATE
+		WHERE NOT EXISTS (
+			SELECT 1 FROM (
+				SELECT schedule_type, interval_days, weekly_days
+				FROM habit_schedule_versions
+				WHERE habit_id = $1
+				ORDER BY effective_from DESC
+				LIMIT 1
+			) latest
+			WHERE latest.schedule_type = $2
+			  AND latest.interval_days IS NOT DISTINCT FROM $3
+			  AND latest.weekly_days IS NOT DISTINCT FROM $4
+		)
+		ON CONFLICT (habit_id, effective_from) DO UPDATE SET
+			schedule_type = EXCLUDED.schedule_type,
+			interval_days = EXCLUDED.interval_days,
+			weekly_days = EXCLUDED.weekly_days,
+			created_at = NOW()
+	`
+
+	_, err := tx.Exec(ctx, query, habit.ID, habit.ScheduleType, habit.IntervalDays, habit.WeeklyDays, effectiveFrom)
+	if err != nil {
+		return fmt.Errorf("failed to save schedule version: %w", err)
+	}
+
+	return nil
+}
+
+// saveTags replaces the tags of a habit with habit.Tags
+func saveTags(ctx context.Context, tx pgx.Tx, habit *entity.Habit) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM habit_tags WHERE habit_id = $1`, habit.ID); err != nil {
+		return fmt.Errorf("failed to delete habit tags: %w", err)
+	}
+
+	if len(habit.Tags) == 0 {
+		return nil
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO habit_tags (habit_id, user_id, tag)
+		SELECT $1, $2, UNNEST($3::TEXT[])
+	`, habit.ID, habit.UserID, habit.Tags)
+	if err != nil {
+		return fmt.Errorf("failed to save habit tags: %w", err)
 	}
 
 	return nil
 }
 
-func (r *habitRepository) Delete(ctx context.Context, habitID uuid.UUID) error {
+func (r *habitRepository) GetScheduleVersions(ctx context.Context, habitID uuid.UUID) ([]*entity.ScheduleVersion, error) {
+	query := `
+		SELECT habit_id, schedule_type, interval_days, weekly_days, effective_from::TEXT
+		FROM habit_schedule_versions
+		WHERE habit_id = $1
+		ORDER BY effective_from ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule versions: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []*entity.ScheduleVersion
+	for rows.Next() {
+		version := &entity.ScheduleVersion{}
+		err := rows.Scan(
+			&version.HabitID,
+			&version.ScheduleType,
+			&version.IntervalDays,
+			&version.WeeklyDays,
+			&version.EffectiveFrom,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schedule version: %w", err)
+		}
+		versions = append(versions, version)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate schedule versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+func (r *habitRepository) Archive(ctx context.Context, habitID uuid.UUID) error {
 	query := `
 		UPDATE habits SET
 			is_active = FALSE,
+			archived_at = $1,
 			updated_at = $1
-		WHERE id = $2
+		WHERE id = $2 AND is_active = TRUE
 	`
 
 	result, err := r.pool.Exec(ctx, query, time.Now().UTC(), habitID)
 	if err != nil {
-		return fmt.Errorf("failed to delete habit: %w", err)
+		return fmt.Errorf("failed to archive habit: %w", err)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("habit not found")
+		return repository.ErrHabitNotFound
 	}
 
 	return nil
 }
 
+func (r *habitRepository) Unarchive(ctx context.Context, habitID uuid.UUID, nextDeadline time.Time) error {
+	query := `
+		UPDATE habits SET
+			is_active = TRUE,
+			archived_at = NULL,
+			next_deadline_utc = $1,
+			confirmed_for_current_period = FALSE,
+			updated_at = $2
+		WHERE id = $3 AND is_active = FALSE
+	`
+
+	result, err := r.pool.Exec(ctx, query, nextDeadline, time.Now().UTC(), habitID)
+	if err != nil {
+		return fmt.Errorf("failed to unarchive habit: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return repository.ErrHabitNotFound
+	}
+
+	return nil
+}
+
+func (r *habitRepository) Purge(ctx context.Context, habitID uuid.UUID) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Purged history must no longer count in analytics
+	if err := removeHabitRollups(ctx, tx, habitID); err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(ctx, `DELETE FROM habit_confirmations WHERE habit_id = $1`, habitID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete habit confirmations: %w", err)
+	}
+	deletedConfirmations := result.RowsAffected()
+
+	result, err = tx.Exec(ctx, `DELETE FROM habits WHERE id = $1 AND is_active = FALSE`, habitID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete habit: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return 0, repository.ErrHabitNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return deletedConfirmations, nil
+}
+
 func (r *habitRepository) UpdateStreakAndDeadline(ctx context.Context, habitID uuid.UUID, streak int32, nextDeadline time.Time, confirmed bool) error {
 	query := `
 		UPDATE habits SET
@@ -222,7 +707,7 @@ func (r *habitRepository) UpdateStreakAndDeadline(ctx context.Context, habitID u
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("habit not found")
+		return repository.ErrHabitNotFound
 	}
 
 	return nil
@@ -234,11 +719,21 @@ func (r *habitRepository) GetHabitsWithMissedDeadlines(ctx context.Context) ([]*
 			id, user_id, name, description, color,
 			schedule_type, interval_days, weekly_days, timezone_offset_hours,
 			streak, next_deadline_utc, confirmed_for_current_period, last_confirmed_at,
-			is_active, created_at, updated_at
+			is_active, created_at, updated_at, archived_at,
+			group_id, icon, position, visibility,
+			ARRAY(SELECT tag FROM habit_tags t WHERE t.habit_id = habits.id ORDER BY tag)
 		FROM habits
 		WHERE is_active = TRUE
 		  AND confirmed_for_current_period = FALSE
 		  AND next_deadline_utc <= $1
+		  -- Only a pause covering the local day of the missed deadline keeps the streak, a deadline
+		  -- missed before the pause started still counts
+		  AND NOT EXISTS (
+			SELECT 1 FROM habit_pauses p
+			WHERE p.habit_id = habits.id
+			  AND p.resumed_at IS NULL
+			  AND (habits.next_deadline_utc + make_interval(hours => habits.timezone_offset_hours))::DATE BETWEEN p.start_date AND p.end_date
+		  )
 		ORDER BY next_deadline_utc ASC
 	`
 
@@ -255,7 +750,8 @@ func (r *habitRepository) GetHabitsWithMissedDeadlines(ctx context.Context) ([]*
 			&habit.ID, &habit.UserID, &habit.Name, &habit.Description, &habit.Color,
 			&habit.ScheduleType, &habit.IntervalDays, &habit.WeeklyDays, &habit.TimezoneOffsetHours,
 			&habit.Streak, &habit.NextDeadlineUTC, &habit.ConfirmedForCurrentPeriod, &habit.LastConfirmedAt,
-			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt,
+			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt, &habit.ArchivedAt,
+			&habit.GroupID, &habit.Icon, &habit.Position, &habit.Visibility, &habit.Tags,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan habit: %w", err)
@@ -276,16 +772,24 @@ func (r *habitRepository) GetHabitsToResetConfirmation(ctx context.Context, from
 			id, user_id, name, description, color,
 			schedule_type, interval_days, weekly_days, timezone_offset_hours,
 			streak, next_deadline_utc, confirmed_for_current_period, last_confirmed_at,
-			is_active, created_at, updated_at
+			is_active, created_at, updated_at, archived_at,
+			group_id, icon, position, visibility,
+			ARRAY(SELECT tag FROM habit_tags t WHERE t.habit_id = habits.id ORDER BY tag)
 		FROM habits
 		WHERE is_active = TRUE
 		  AND confirmed_for_current_period = TRUE
 		  AND next_deadline_utc >= $1
 		  AND next_deadline_utc <= $2
+		  AND NOT EXISTS (
+			SELECT 1 FROM habit_pauses p
+			WHERE p.habit_id = habits.id
+			  AND p.resumed_at IS NULL
+			  AND ($3 + make_interval(hours => habits.timezone_offset_hours))::DATE BETWEEN p.start_date AND p.end_date
+		  )
 		ORDER BY next_deadline_utc ASC
 	`
 
-	rows, err := r.pool.Query(ctx, query, fromTime, toTime)
+	rows, err := r.pool.Query(ctx, query, fromTime, toTime, time.Now().UTC())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get habits to reset confirmation: %w", err)
 	}
@@ -298,7 +802,8 @@ func (r *habitRepository) GetHabitsToResetConfirmation(ctx context.Context, from
 			&habit.ID, &habit.UserID, &habit.Name, &habit.Description, &habit.Color,
 			&habit.ScheduleType, &habit.IntervalDays, &habit.WeeklyDays, &habit.TimezoneOffsetHours,
 			&habit.Streak, &habit.NextDeadlineUTC, &habit.ConfirmedForCurrentPeriod, &habit.LastConfirmedAt,
-			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt,
+			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt, &habit.ArchivedAt,
+			&habit.GroupID, &habit.Icon, &habit.Position, &habit.Visibility, &habit.Tags,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan habit: %w", err)
@@ -319,11 +824,19 @@ func (r *habitRepository) GetConfirmedHabitsWithExpiredDeadlines(ctx context.Con
 			id, user_id, name, description, color,
 			schedule_type, interval_days, weekly_days, timezone_offset_hours,
 			streak, next_deadline_utc, confirmed_for_current_period, last_confirmed_at,
-			is_active, created_at, updated_at
+			is_active, created_at, updated_at, archived_at,
+			group_id, icon, position, visibility,
+			ARRAY(SELECT tag FROM habit_tags t WHERE t.habit_id = habits.id ORDER BY tag)
 		FROM habits
 		WHERE is_active = TRUE
 		  AND confirmed_for_current_period = TRUE
 		  AND next_deadline_utc < $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM habit_pauses p
+			WHERE p.habit_id = habits.id
+			  AND p.resumed_at IS NULL
+			  AND ($1 + make_interval(hours => habits.timezone_offset_hours))::DATE BETWEEN p.start_date AND p.end_date
+		  )
 		ORDER BY next_deadline_utc ASC
 	`
 
@@ -340,7 +853,8 @@ func (r *habitRepository) GetConfirmedHabitsWithExpiredDeadlines(ctx context.Con
 			&habit.ID, &habit.UserID, &habit.Name, &habit.Description, &habit.Color,
 			&habit.ScheduleType, &habit.IntervalDays, &habit.WeeklyDays, &habit.TimezoneOffsetHours,
 			&habit.Streak, &habit.NextDeadlineUTC, &habit.ConfirmedForCurrentPeriod, &habit.LastConfirmedAt,
-			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt,
+			&habit.IsActive, &habit.CreatedAt, &habit.UpdatedAt, &habit.ArchivedAt,
+			&habit.GroupID, &habit.Icon, &habit.Position, &habit.Visibility, &habit.Tags,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan habit: %w", err)
@@ -369,8 +883,20 @@ func (r *habitRepository) ResetConfirmationFlag(ctx context.Context, habitID uui
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("habit not found")
+		return repository.ErrHabitNotFound
 	}
 
 	return nil
 }
+
+// DeleteByUserID permanently deletes all habits of a user, confirmations are removed by cascade
+func (r *habitRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	query := `DELETE FROM habits WHERE user_id = $1`
+
+	result, err := r.pool.Exec(ctx, query, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete habits of user: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,241 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type journalRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewJournalRepository creates a new PostgreSQL journal repository
+func NewJournalRepository(pool *pgxpool.Pool) repository.JournalRepository {
+	return &journalRepository{pool: pool}
+}
+
+const journalEntryColumns = `
+	e.id, e.user_id, e.entry_date::TEXT, e.mood, e.energy, e.content, e.tags,
+	ARRAY(SELECT confirmation_id FROM journal_entry_confirmations l WHERE l.entry_id = e.id ORDER BY confirmation_id),
+	e.created_at, e.updated_at
+`
+
+// noteHeadlineOptions marks matches like Markdown bold, clients render snippets as text
+const noteHeadlineOptions = `StartSel=**, StopSel=**, MaxWords=30, MinWords=10, MaxFragments=2`
+
+func scanJournalEntry(row pgx.Row) (*entity.JournalEntry, error) {
+	entry := &entity.JournalEntry{}
+	var mood, energy *int16
+	err := row.Scan(
+		&entry.ID, &entry.UserID, &entry.Date, &mood, &energy, &entry.Content, &entry.Tags,
+		&entry.ConfirmationIDs,
+		&entry.CreatedAt, &entry.UpdatedAt,
+	)
+	if mood != nil {
+		value := int32(*mood)
+		entry.Mood = &value
+	}
+	if energy != nil {
+		value := int32(*energy)
+		entry.Energy = &value
+	}
+	return entry, err
+}
+
+func (r *journalRepository) Upsert(ctx context.Context, entry *entity.JournalEntry) error {
+	query := `
+		INSERT INTO journal_entries (user_id, entry_date, mood, energy, content, tags)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, entry_date) DO UPDATE SET
+			mood = EXCLUDED.mood,
+			energy = EXCLUDED.energy,
+			content = EXCLUDED.content,
+			tags = EXCLUDED.tags,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query,
+		entry.UserID, entry.Date, entry.Mood, entry.Energy, entry.Content, entry.Tags,
+	).Scan(&entry.ID, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save journal entry: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM journal_entry_confirmations WHERE entry_id = $1`, entry.ID); err != nil {
+		return fmt.Errorf("failed to unlink journal entry confirmations: %w", err)
+	}
+
+	if len(entry.ConfirmationIDs) > 0 {
+		result, err := tx.Exec(ctx, `
+			INSERT INTO journal_entry_confirmations (entry_id, confirmation_id)
+			SELECT $1::UUID, id
+			FROM habit_confirmations
+			WHERE id = ANY($2) AND user_id = $3 AND confirmed_for_date = $4
+		`, entry.ID, entry.ConfirmationIDs, entry.UserID, entry.Date)
+		if err != nil {
+			return fmt.Errorf("failed to link journal entry confirmations: %w", err)
+		}
+
+		if result.RowsAffected() != int64(len(entry.ConfirmationIDs)) {
+			return fmt.Errorf("%w confirmation_ids: confirmations must be yours and of the entry's date", repository.ErrInvalidArgument)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *journalRepository) GetByDate(ctx context.Context, userID uuid.UUID, date string) (*entity.JournalEntry, error) {
+	query := `SELECT ` + journalEntryColumns + ` FROM journal_entries e WHERE e.user_id = $1 AND e.entry_date = $2`
+
+	entry, err := scanJournalEntry(r.pool.QueryRow(ctx, query, userID, date))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("journal entry %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get journal entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (r *journalRepository) GetRange(ctx context.Context, userID uuid.UUID, fromDate, toDate string, tag *string) ([]*entity.JournalEntry, error) {
+	query := `
+		SELECT ` + journalEntryColumns + `
+		FROM journal_entries e
+		WHERE e.user_id = $1
+		  AND e.entry_date BETWEEN $2 AND $3
+		  AND ($4::TEXT IS NULL OR e.tags @> ARRAY[$4::TEXT])
+		ORDER BY e.entry_date
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, fromDate, toDate, tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get journal entries: %w", err)
+	}
+
+	return scanJournalEntries(rows)
+}
+
+func (r *journalRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.JournalEntry, error) {
+	query := `
+		SELECT ` + journalEntryColumns + `
+		FROM journal_entries e
+		WHERE e.user_id = $1
+		ORDER BY e.entry_date
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get journal entries: %w", err)
+	}
+
+	return scanJournalEntries(rows)
+}
+
+func scanJournalEntries(rows pgx.Rows) ([]*entity.JournalEntry, error) {
+	defer rows.Close()
+
+	var entries []*entity.JournalEntry
+	for rows.Next() {
+		entry, err := scanJournalEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating journal entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (r *journalRepository) Delete(ctx context.Context, userID uuid.UUID, date string) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM journal_entries WHERE user_id = $1 AND entry_date = $2`, userID, date)
+	if err != nil {
+		return fmt.Errorf("failed to delete journal entry: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("journal entry %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Search matches journal entries through their generated search vector and confirmation notes
+// through the expression index, both use the 'simple' configuration
+func (r *journalRepository) Search(ctx context.Context, userID uuid.UUID, query string, limit int32) ([]*entity.NoteSearchResult, error) {
+	sql := `
+		WITH q AS (SELECT websearch_to_tsquery('simple', $2) AS query)
+		SELECT kind, id, date::TEXT, habit_id, snippet, rank
+		FROM (
+			SELECT
+				'journal' AS kind, e.id, e.entry_date AS date, NULL::UUID AS habit_id,
+				ts_headline('simple', e.content, q.query, $4) AS snippet,
+				ts_rank(e.search_vector, q.query) AS rank
+			FROM journal_entries e, q
+			WHERE e.user_id = $1 AND e.search_vector @@ q.query
+
+			UNION ALL
+
+			SELECT
+				'confirmation', c.id, c.confirmed_for_date, c.habit_id,
+				ts_headline('simple', c.notes, q.query, $4),
+				ts_rank(to_tsvector('simple', COALESCE(c.notes, '')), q.query)
+			FROM habit_confirmations c, q
+			WHERE c.user_id = $1 AND to_tsvector('simple', COALESCE(c.notes, '')) @@ q.query
+		) matches
+		ORDER BY matches.rank DESC, matches.date DESC
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, sql, userID, query, limit, noteHeadlineOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search notes: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*entity.NoteSearchResult
+	for rows.Next() {
+		result := &entity.NoteSearchResult{}
+		var rank float32
+		if err := rows.Scan(&result.Kind, &result.ID, &result.Date, &result.HabitID, &result.Snippet, &rank); err != nil {
+			return nil, fmt.Errorf("failed to scan note search result: %w", err)
+		}
+		result.Rank = float64(rank)
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating note search results: %w", err)
+	}
+
+	return results, nil
+}
+
+func (r *journalRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	if _, err := r.pool.Exec(ctx, `DELETE FROM journal_entries WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete journal entries: %w", err)
+	}
+
+	return nil
+}
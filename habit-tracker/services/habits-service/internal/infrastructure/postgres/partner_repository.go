@@ -0,0 +1,276 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const partnerColumns = `
+	p.id, p.habit_id, h.name, p.owner_id, p.owner_username, p.partner_id, p.partner_username,
+	p.status, p.last_nudged_at, p.created_at, p.responded_at
+`
+
+type habitPartnerRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewHabitPartnerRepository creates a new PostgreSQL habit partner repository
+func NewHabitPartnerRepository(pool *pgxpool.Pool) repository.HabitPartnerRepository {
+	return &habitPartnerRepository{pool: pool}
+}
+
+func (r *habitPartnerRepository) Create(ctx context.Context, partner *entity.HabitPartner, declinedBefore time.Time) error {
+	// Re-inviting after a decline reopens the same row, so the unique pair stays intact
+	query := `
+		INSERT INTO habit_partners (
+			id, habit_id, owner_id, partner_id, owner_username, partner_username, status, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, 'pending', $7)
+		ON CONFLICT (habit_id, partner_id) DO UPDATE SET
+			owner_username = EXCLUDED.owner_username,
+			partner_username = EXCLUDED.partner_username,
+			status = 'pending',
+			last_nudged_at = NULL,
+			created_at = EXCLUDED.created_at,
+			responded_at = NULL
+		WHERE habit_partners.status = 'declined'
+		  AND habit_partners.responded_at <= $8
+		RETURNING id
+	`
+
+	err := r.pool.QueryRow(ctx, query,
+		partner.ID,
+		partner.HabitID,
+		partner.OwnerID,
+		partner.PartnerID,
+		partner.OwnerUsername,
+		partner.PartnerUsername,
+		partner.CreatedAt,
+		declinedBefore,
+	).Scan(&partner.ID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return r.conflictError(ctx, partner.HabitID, partner.PartnerID)
+		}
+		return fmt.Errorf("failed to create habit partner: %w", err)
+	}
+
+	partner.Status = entity.PartnerStatusPending
+	partner.LastNudgedAt = nil
+	partner.RespondedAt = nil
+
+	return nil
+}
+
+// conflictError tells why an invitation was not created over the existing one
+func (r *habitPartnerRepository) conflictError(ctx context.Context, habitID, partnerID uuid.UUID) error {
+	var status entity.PartnerStatus
+	err := r.pool.QueryRow(ctx,
+		"SELECT status FROM habit_partners WHERE habit_id = $1 AND partner_id = $2",
+		habitID, partnerID,
+	).Scan(&status)
+	if err != nil {
+		return fmt.Errorf("failed to get habit partner: %w", err)
+	}
+
+	if status == entity.PartnerStatusDeclined {
+		return fmt.Errorf("%w: the user declined to partner on this habit recently", repository.ErrTooManyRequests)
+	}
+	return fmt.Errorf("habit partner %w", repository.ErrAlreadyExists)
+}
+
+func (r *habitPartnerRepository) GetByID(ctx context.Context, partnershipID uuid.UUID) (*entity.HabitPartner, error) {
+	query := `SELECT ` + partnerColumns + `
+		FROM habit_partners p
+		JOIN habits h ON h.id = p.habit_id
+		WHERE p.id = $1
+	`
+
+	partner, err := scanPartner(r.pool.QueryRow(ctx, query, partnershipID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("habit partner %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get habit partner: %w", err)
+	}
+
+	return partner, nil
+}
+
+func (r *habitPartnerRepository) GetAccepted(ctx context.Context, habitID, partnerID uuid.UUID) (*entity.HabitPartner, error) {
+	query := `SELECT ` + partnerColumns + `
+		FROM habit_partners p
+		JOIN habits h ON h.id = p.habit_id
+		WHERE p.habit_id = $1 AND p.partner_id = $2 AND p.status = 'accepted'
+	`
+
+	partner, err := scanPartner(r.pool.QueryRow(ctx, query, habitID, partnerID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("habit partner %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get habit partner: %w", err)
+	}
+
+	return partner, nil
+}
+
+func (r *habitPartnerRepository) GetByHabitID(ctx context.Context, habitID uuid.UUID) ([]*entity.HabitPartner, error) {
+	query := `SELECT ` + partnerColumns + `
+		FROM habit_partners p
+		JOIN habits h ON h.id = p.habit_id
+		WHERE p.habit_id = $1 AND p.status IN ('pending', 'accepted')
+		ORDER BY p.created_at, p.id
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habit partners: %w", err)
+	}
+
+	return scanPartners(rows)
+}
+
+func (r *habitPartnerRepository) GetPendingByPartnerID(ctx context.Context, partnerID uuid.UUID) ([]*entity.HabitPartner, error) {
+	query := `SELECT ` + partnerColumns + `
+		FROM habit_partners p
+		JOIN habits h ON h.id = p.habit_id
+		WHERE p.partner_id = $1 AND p.status = 'pending' AND h.is_active = true
+		ORDER BY p.created_at DESC, p.id DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, partnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get partner invitations: %w", err)
+	}
+
+	return scanPartners(rows)
+}
+
+func (r *habitPartnerRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPartner, error) {
+	query := `SELECT ` + partnerColumns + `
+		FROM habit_partners p
+		JOIN habits h ON h.id = p.habit_id
+		WHERE p.owner_id = $1 OR p.partner_id = $1
+		ORDER BY p.created_at, p.id
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user partnerships: %w", err)
+	}
+
+	return scanPartners(rows)
+}
+
+func (r *habitPartnerRepository) CountByHabitID(ctx context.Context, habitID uuid.UUID) (int32, error) {
+	var count int32
+	err := r.pool.QueryRow(ctx,
+		"SELECT COUNT(*) FROM habit_partners WHERE habit_id = $1 AND status IN ('pending', 'accepted')",
+		habitID,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count habit partners: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *habitPartnerRepository) Respond(ctx context.Context, partnershipID, partnerID uuid.UUID, status entity.PartnerStatus, respondedAt time.Time) error {
+	query := `
+		UPDATE habit_partners
+		SET status = $3, responded_at = $4
+		WHERE id = $1 AND partner_id = $2 AND status = 'pending'
+	`
+
+	result, err := r.pool.Exec(ctx, query, partnershipID, partnerID, status, respondedAt)
+	if err != nil {
+		return fmt.Errorf("failed to respond to partner invitation: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("partner invitation %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *habitPartnerRepository) RecordNudge(ctx context.Context, partnershipID uuid.UUID, nudgedAt, notBefore time.Time) (bool, error) {
+	// The condition is checked in the same statement so concurrent nudges can't both pass
+	query := `
+		UPDATE habit_partners
+		SET last_nudged_at = $2
+		WHERE id = $1 AND (last_nudged_at IS NULL OR last_nudged_at <= $3)
+	`
+
+	result, err := r.pool.Exec(ctx, query, partnershipID, nudgedAt, notBefore)
+	if err != nil {
+		return false, fmt.Errorf("failed to record nudge: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (r *habitPartnerRepository) Delete(ctx context.Context, partnershipID, userID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx,
+		"DELETE FROM habit_partners WHERE id = $1 AND (owner_id = $2 OR partner_id = $2)",
+		partnershipID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete habit partner: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("habit partner %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *habitPartnerRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM habit_partners WHERE owner_id = $1 OR partner_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete habit partners: %w", err)
+	}
+
+	return nil
+}
+
+func scanPartner(row pgx.Row) (*entity.HabitPartner, error) {
+	partner := &entity.HabitPartner{}
+	err := row.Scan(
+		&partner.ID, &partner.HabitID, &partner.HabitName,
+		&partner.OwnerID, &partner.OwnerUsername, &partner.PartnerID, &partner.PartnerUsername,
+		&partner.Status, &partner.LastNudgedAt, &partner.CreatedAt, &partner.RespondedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return partner, nil
+}
+
+func scanPartners(rows pgx.Rows) ([]*entity.HabitPartner, error) {
+	defer rows.Close()
+
+	var partners []*entity.HabitPartner
+	for rows.Next() {
+		partner, err := scanPartner(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit partner: %w", err)
+		}
+		partners = append(partners, partner)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate habit partners: %w", err)
+	}
+
+	return partners, nil
+}
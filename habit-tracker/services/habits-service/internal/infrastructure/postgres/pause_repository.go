@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type habitPauseRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewHabitPauseRepository creates a new PostgreSQL habit pause repository
+func NewHabitPauseRepository(pool *pgxpool.Pool) repository.HabitPauseRepository {
+	return &habitPauseRepository{pool: pool}
+}
+
+func (r *habitPauseRepository) CreateBatch(ctx context.Context, pauses []*entity.HabitPause) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO habit_pauses (
+			id, habit_id, user_id, start_date, end_date, reason, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	for _, pause := range pauses {
+		_, err := tx.Exec(ctx, query,
+			pause.ID,
+			pause.HabitID,
+			pause.UserID,
+			pause.StartDate,
+			pause.EndDate,
+			pause.Reason,
+			pause.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create habit pause: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitPauseRepository) GetByHabitID(ctx context.Context, habitID uuid.UUID) ([]*entity.HabitPause, error) {
+	query := `
+		SELECT
+			id, habit_id, user_id, start_date::TEXT, end_date::TEXT, reason, resumed_at, created_at
+		FROM habit_pauses
+		WHERE habit_id = $1
+		ORDER BY start_date DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habit pauses: %w", err)
+	}
+
+	return scanPauses(rows)
+}
+
+func (r *habitPauseRepository) GetPendingByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPause, error) {
+	query := `
+		SELECT
+			id, habit_id, user_id, start_date::TEXT, end_date::TEXT, reason, resumed_at, created_at
+		FROM habit_pauses
+		WHERE user_id = $1 AND resumed_at IS NULL
+		ORDER BY start_date, habit_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending pauses: %w", err)
+	}
+
+	return scanPauses(rows)
+}
+
+func (r *habitPauseRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPause, error) {
+	query := `
+		SELECT
+			id, habit_id, user_id, start_date::TEXT, end_date::TEXT, reason, resumed_at, created_at
+		FROM habit_pauses
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user pauses: %w", err)
+	}
+
+	return scanPauses(rows)
+}
+
+func (r *habitPauseRepository) ExistsOverlapping(ctx context.Context, habitID uuid.UUID, startDate, endDate string) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM habit_pauses
+			WHERE habit_id = $1
+			  AND resumed_at IS NULL
+			  AND start_date <= $3
+			  AND end_date >= $2
+		)
+	`
+
+	var exists bool
+	err := r.pool.QueryRow(ctx, query, habitID, startDate, endDate).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check overlapping pauses: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (r *habitPauseRepository) GetEnded(ctx context.Context, now time.Time) ([]*entity.HabitPause, error) {
+	query := `
+		SELECT
+			p.id, p.habit_id, p.user_id, p.start_date::TEXT, p.end_date::TEXT, p.reason, p.resumed_at, p.created_at
+		FROM habit_pauses p
+		JOIN habits h ON h.id = p.habit_id
+		WHERE p.resumed_at IS NULL
+		  AND p.end_date < ($1 + make_interval(hours => h.timezone_offset_hours))::DATE
+		ORDER BY p.end_date ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ended pauses: %w", err)
+	}
+
+	return scanPauses(rows)
+}
+
+func (r *habitPauseRepository) Resume(ctx context.Context, pause *entity.HabitPause, endDate string, nextDeadline time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now().UTC()
+
+	result, err := tx.Exec(ctx, `
+		UPDATE habit_pauses SET
+			end_date = $1,
+			resumed_at = $2
+		WHERE id = $3 AND resumed_at IS NULL
+	`, endDate, now, pause.ID)
+	if err != nil {
+		return fmt.Errorf("failed to close habit pause: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("pause %w", repository.ErrNotFound)
+	}
+
+	// Archived habits get a fresh deadline when they are unarchived
+	_, err = tx.Exec(ctx, `
+		UPDATE habits SET
+			next_deadline_utc = $1,
+			confirmed_for_current_period = FALSE,
+			updated_at = $2
+		WHERE id = $3 AND is_active = TRUE
+	`, nextDeadline, now, pause.HabitID)
+	if err != nil {
+		return fmt.Errorf("failed to update habit deadline: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitPauseRepository) Delete(ctx context.Context, pauseID uuid.UUID) error {
+	query := `
+		DELETE FROM habit_pauses WHERE id = $1 AND resumed_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, pauseID)
+	if err != nil {
+		return fmt.Errorf("failed to delete habit pause: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("pause %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func scanPauses(rows pgx.Rows) ([]*entity.HabitPause, error) {
+	defer rows.Close()
+
+	var pauses []*entity.HabitPause
+	for rows.Next() {
+		pause := &entity.HabitPause{}
+		err := rows.Scan(
+			&pause.ID,
+			&pause.HabitID,
+			&pause.UserID,
+			&pause.StartDate,
+			&pause.EndDate,
+			&pause.Reason,
+			&pause.ResumedAt,
+			&pause.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pause: %w", err)
+		}
+		pauses = append(pauses, pause)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate pauses: %w", err)
+	}
+
+	return pauses, nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// errReorderUnknownID is returned when a listed id does not belong to the user
+var errReorderUnknownID = errors.New("unknown id in reorder")
+
+// reorder rewrites the manual order of a user's rows in table. The listed ids move to the top
+// in the given order, the remaining rows keep their relative order after them.
+// Rows are locked so that concurrent reorders and inserts can't interleave
+func reorder(ctx context.Context, tx pgx.Tx, table string, userID uuid.UUID, ids []uuid.UUID) error {
+	rows, err := tx.Query(ctx, "SELECT id FROM "+table+" WHERE user_id = $1 ORDER BY position, id FOR UPDATE", userID)
+	if err != nil {
+		return fmt.Errorf("failed to lock %s: %w", table, err)
+	}
+
+	var current []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan %s id: %w", table, err)
+		}
+		current = append(current, id)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate %s: %w", table, err)
+	}
+
+	owned := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		owned[id] = true
+	}
+
+	listed := make(map[uuid.UUID]bool, len(ids))
+	order := make([]uuid.UUID, 0, len(current))
+	for _, id := range ids {
+		if !owned[id] {
+			return errReorderUnknownID
+		}
+		listed[id] = true
+		order = append(order, id)
+	}
+
+	for _, id := range current {
+		if !listed[id] {
+			order = append(order, id)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE `+table+` t SET position = o.position - 1
+		FROM UNNEST($2::UUID[]) WITH ORDINALITY AS o(id, position)
+		WHERE t.id = o.id AND t.user_id = $1
+	`, userID, order)
+	if err != nil {
+		return fmt.Errorf("failed to reorder %s: %w", table, err)
+	}
+
+	return nil
+}
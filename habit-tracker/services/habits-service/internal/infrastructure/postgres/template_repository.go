@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type habitTemplateRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewHabitTemplateRepository creates a new PostgreSQL habit template repository
+func NewHabitTemplateRepository(pool *pgxpool.Pool) repository.HabitTemplateRepository {
+	return &habitTemplateRepository{pool: pool}
+}
+
+func (r *habitTemplateRepository) Create(ctx context.Context, template *entity.HabitTemplate) error {
+	query := `
+		INSERT INTO habit_templates (
+			id, user_id, name, description, color, icon, category, tags,
+			schedule_type, interval_days, weekly_days, suggested_target, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	tags := template.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	_, err := r.pool.Exec(ctx, query,
+		template.ID,
+		template.UserID,
+		template.Name,
+		template.Description,
+		template.Color,
+		template.Icon,
+		template.Category,
+		tags,
+		template.ScheduleType,
+		template.IntervalDays,
+		template.WeeklyDays,
+		template.SuggestedTarget,
+		template.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create habit template: %w", err)
+	}
+
+	return nil
+}
+
+func (r *habitTemplateRepository) GetVisible(ctx context.Context, templateID, userID uuid.UUID) (*entity.HabitTemplate, error) {
+	query := `
+		SELECT
+			id, user_id, name, description, color, icon, category, tags,
+			schedule_type, interval_days, weekly_days, suggested_target, created_at
+		FROM habit_templates
+		WHERE id = $1 AND (user_id IS NULL OR user_id = $2)
+	`
+
+	template := &entity.HabitTemplate{}
+	err := r.pool.QueryRow(ctx, query, templateID, userID).Scan(
+		&template.ID, &template.UserID, &template.Name, &template.Description,
+		&template.Color, &template.Icon, &template.Category, &template.Tags,
+		&template.ScheduleType, &template.IntervalDays, &template.WeeklyDays,
+		&template.SuggestedTarget, &template.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("habit template %w", repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get habit template: %w", err)
+	}
+
+	return template, nil
+}
+
+func (r *habitTemplateRepository) GetVisibleByUserID(ctx context.Context, userID uuid.UUID, category *string) ([]*entity.HabitTemplate, error) {
+	query := `
+		SELECT
+			id, user_id, name, description, color, icon, category, tags,
+			schedule_type, interval_days, weekly_days, suggested_target, created_at
+		FROM habit_templates
+		WHERE (user_id IS NULL OR user_id = $1)
+	`
+	args := []interface{}{userID}
+
+	if category != nil {
+		args = append(args, *category)
+		query += fmt.Sprintf(" AND category = $%d", len(args))
+	}
+
+	// Catalog first by category, then private templates in the order they were saved
+	query += " ORDER BY user_id IS NOT NULL, category, name, created_at, id"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habit templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*entity.HabitTemplate
+	for rows.Next() {
+		template := &entity.HabitTemplate{}
+		err := rows.Scan(
+			&template.ID, &template.UserID, &template.Name, &template.Description,
+			&template.Color, &template.Icon, &template.Category, &template.Tags,
+			&template.ScheduleType, &template.IntervalDays, &template.WeeklyDays,
+			&template.SuggestedTarget, &template.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate habit templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+func (r *habitTemplateRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int32, error) {
+	var count int32
+	err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM habit_templates WHERE user_id = $1", userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count habit templates: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *habitTemplateRepository) Delete(ctx context.Context, templateID, userID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, "DELETE FROM habit_templates WHERE id = $1 AND user_id = $2", templateID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete habit template: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("habit template %w", repository.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *habitTemplateRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, "DELETE FROM habit_templates WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete habit templates: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// streakScale separates completions from the streak in a score,
+// so a single sorted set ranks by completions first and streak second
+const streakScale = 1_000_000
+
+// LeaderboardStorage keeps challenge leaderboards in Redis sorted sets
+type LeaderboardStorage struct {
+	client *redis.Client
+}
+
+// NewLeaderboardStorage creates a new leaderboard storage
+func NewLeaderboardStorage(client *redis.Client) *LeaderboardStorage {
+	return &LeaderboardStorage{
+		client: client,
+	}
+}
+
+// leaderboardKey generates Redis key for a challenge leaderboard
+func (s *LeaderboardStorage) leaderboardKey(challengeID uuid.UUID) string {
+	return fmt.Sprintf("challenge:%s:leaderboard", challengeID.String())
+}
+
+// SetScore stores the completions and current streak of a participant
+func (s *LeaderboardStorage) SetScore(ctx context.Context, challengeID, userID uuid.UUID, completions, streak int32) error {
+	member := redis.Z{Score: encodeScore(completions, streak), Member: userID.String()}
+	if err := s.client.ZAdd(ctx, s.leaderboardKey(challengeID), member).Err(); err != nil {
+		return fmt.Errorf("failed to set leaderboard score: %w", err)
+	}
+
+	return nil
+}
+
+// Replace rebuilds a leaderboard from scratch in a single transaction
+func (s *LeaderboardStorage) Replace(ctx context.Context, challengeID uuid.UUID, entries []*entity.LeaderboardEntry) error {
+	key := s.leaderboardKey(challengeID)
+
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+
+		if len(entries) == 0 {
+			return nil
+		}
+
+		members := make([]redis.Z, len(entries))
+		for i, entry := range entries {
+			members[i] = redis.Z{
+				Score:  encodeScore(entry.Completions, entry.CurrentStreak),
+				Member: entry.UserID.String(),
+			}
+		}
+		pipe.ZAdd(ctx, key, members...)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replace leaderboard: %w", err)
+	}
+
+	return nil
+}
+
+// Remove removes a participant from a leaderboard
+func (s *LeaderboardStorage) Remove(ctx context.Context, challengeID, userID uuid.UUID) error {
+	if err := s.client.ZRem(ctx, s.leaderboardKey(challengeID), userID.String()).Err(); err != nil {
+		return fmt.Errorf("failed to remove leaderboard entry: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a leaderboard
+func (s *LeaderboardStorage) Delete(ctx context.Context, challengeID uuid.UUID) error {
+	if err := s.client.Del(ctx, s.leaderboardKey(challengeID)).Err(); err != nil {
+		return fmt.Errorf("failed to delete leaderboard: %w", err)
+	}
+
+	return nil
+}
+
+// Count returns the number of participants on a leaderboard
+func (s *LeaderboardStorage) Count(ctx context.Context, challengeID uuid.UUID) (int32, error) {
+	count, err := s.client.ZCard(ctx, s.leaderboardKey(challengeID)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count leaderboard entries: %w", err)
+	}
+
+	return int32(count), nil
+}
+
+// GetRange retrieves up to limit entries starting at offset, best first.
+// Participants with equal scores share a rank
+func (s *LeaderboardStorage) GetRange(ctx context.Context, challengeID uuid.UUID, offset, limit int32) ([]*entity.LeaderboardEntry, error) {
+	key := s.leaderboardKey(challengeID)
+
+	members, err := s.client.ZRevRangeWithScores(ctx, key, int64(offset), int64(offset)+int64(limit)-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard entries: %w", err)
+	}
+
+	entries := make([]*entity.LeaderboardEntry, 0, len(members))
+	for i, member := range members {
+		entry, err := decodeEntry(member)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case i == 0:
+			// The page may start inside a tie, count everyone strictly ahead
+			entry.Rank, err = s.rankOf(ctx, key, member.Score)
+			if err != nil {
+				return nil, err
+			}
+		case member.Score == members[i-1].Score:
+			entry.Rank = entries[i-1].Rank
+		default:
+			entry.Rank = offset + int32(i) + 1
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// GetEntry retrieves the entry of a participant, nil when the user is not on the leaderboard
+func (s *LeaderboardStorage) GetEntry(ctx context.Context, challengeID, userID uuid.UUID) (*entity.LeaderboardEntry, error) {
+	key := s.leaderboardKey(challengeID)
+
+	score, err := s.client.ZScore(ctx, key, userID.String()).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get leaderboard entry: %w", err)
+	}
+
+	entry, err := decodeEntry(redis.Z{Score: score, Member: userID.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Rank, err = s.rankOf(ctx, key, score)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// rankOf returns the 1-based rank of a score, one more than the number of strictly better scores
+func (s *LeaderboardStorage) rankOf(ctx context.Context, key string, score float64) (int32, error) {
+	ahead, err := s.client.ZCount(ctx, key, "("+strconv.FormatFloat(score, 'f', -1, 64), "+inf").Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to rank leaderboard entry: %w", err)
+	}
+
+	return int32(ahead) + 1, nil
+}
+
+func encodeScore(completions, streak int32) float64 {
+	if streak >= streakScale {
+		streak = streakScale - 1
+	}
+	return float64(completions)*streakScale + float64(streak)
+}
+
+func decodeEntry(member redis.Z) (*entity.LeaderboardEntry, error) {
+	userID, err := uuid.Parse(fmt.Sprint(member.Member))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse leaderboard member: %w", err)
+	}
+
+	completions := math.Floor(member.Score / streakScale)
+
+	return &entity.LeaderboardEntry{
+		UserID:        userID,
+		Completions:   int32(completions),
+		CurrentStreak: int32(member.Score - completions*streakScale),
+	}, nil
+}
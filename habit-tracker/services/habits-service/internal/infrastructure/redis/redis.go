@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"habits-service/internal/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NewRedisClient creates a new Redis client
+func NewRedisClient(cfg *config.RedisConfig) (*redis.Client, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:         cfg.GetRedisAddr(),
+		Password:     cfg.Password,
+		DB:           cfg.DB,
+		MaxRetries:   cfg.MaxRetries,
+		PoolSize:     cfg.PoolSize,
+		MinIdleConns: cfg.MinIdleConns,
+		DialTimeout:  cfg.DialTimeout,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return client, nil
+}
+
+// Close closes the Redis client connection
+func Close(client *redis.Client) error {
+	if client != nil {
+		return client.Close()
+	}
+	return nil
+}
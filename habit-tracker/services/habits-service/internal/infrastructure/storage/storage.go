@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"habits-service/internal/config"
+	"io"
+)
+
+// ErrNotFound is returned when opening a key that does not exist
+var ErrNotFound = errors.New("object not found")
+
+// Storage keeps attachment files under keys like "user-id/attachment-id"
+type Storage interface {
+	// Put stores data under key, replacing an existing object
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+
+	// Open opens the object stored under key for reading
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the object stored under key, missing objects are ignored
+	Delete(ctx context.Context, key string) error
+}
+
+// New creates the storage backend selected in the configuration
+func New(ctx context.Context, cfg *config.AttachmentsConfig) (Storage, error) {
+	switch cfg.Backend {
+	case "", "local":
+		return NewLocalStorage(cfg.Dir)
+	case "s3":
+		s3, err := NewS3Storage(&cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		if err := s3.EnsureBucket(ctx); err != nil {
+			return nil, err
+		}
+		return s3, nil
+	default:
+		return nil, fmt.Errorf("unknown attachment storage backend %q", cfg.Backend)
+	}
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"habits-service/internal/infrastructure/kafka"
+	userpb "habits-service/proto/user/v1"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type achievementService struct {
+	achievementRepo  repository.AchievementRepository
+	confirmationRepo repository.HabitConfirmationRepository
+	analyticsRepo    repository.AnalyticsRepository
+	userClient       userpb.UserServiceClient
+	producer         *kafka.Producer
+}
+
+// NewAchievementService creates a new achievement service.
+// Rules come from the catalog, unlocks are published as habit events for notification-service
+func NewAchievementService(
+	achievementRepo repository.AchievementRepository,
+	confirmationRepo repository.HabitConfirmationRepository,
+	analyticsRepo repository.AnalyticsRepository,
+	userClient userpb.UserServiceClient,
+	producer *kafka.Producer,
+) service.AchievementService {
+	return &achievementService{
+		achievementRepo:  achievementRepo,
+		confirmationRepo: confirmationRepo,
+		analyticsRepo:    analyticsRepo,
+		userClient:       userClient,
+		producer:         producer,
+	}
+}
+
+func (s *achievementService) HandleConfirmation(ctx context.Context, habit *entity.Habit, confirmation *entity.HabitConfirmation) {
+	unlocked, err := s.achievementRepo.GetByUserID(ctx, habit.UserID)
+	if err != nil {
+		fmt.Printf("Failed to get achievements of user %s: %v\n", habit.UserID, err)
+		return
+	}
+
+	unlockedCodes := make(map[string]bool, len(unlocked))
+	for _, u := range unlocked {
+		unlockedCodes[u.Code] = true
+	}
+
+	var locked []*entity.Achievement
+	for _, achievement := range achievementCatalog {
+		if !unlockedCodes[achievement.Code] {
+			locked = append(locked, achievement)
+		}
+	}
+
+	if len(locked) == 0 {
+		return
+	}
+
+	facts, err := s.loadFacts(ctx, habit, confirmation.ConfirmedForDate, locked)
+	if err != nil {
+		fmt.Printf("Failed to evaluate achievements of user %s: %v\n", habit.UserID, err)
+		return
+	}
+
+	var newlyUnlocked []*entity.Achievement
+	for _, achievement := range locked {
+		if facts[achievement.Rule.Kind] < achievement.Rule.Threshold {
+			continue
+		}
+
+		habitID := habit.ID
+		u := &entity.UnlockedAchievement{
+			UserID:     habit.UserID,
+			Code:       achievement.Code,
+			HabitID:    &habitID,
+			UnlockedAt: time.Now().UTC(),
+		}
+
+		// A concurrent evaluation may have unlocked it meanwhile, only the inserting one notifies
+		inserted, err := s.achievementRepo.Unlock(ctx, u)
+		if err != nil {
+			fmt.Printf("Failed to unlock achievement %s for user %s: %v\n", achievement.Code, habit.UserID, err)
+			continue
+		}
+
+		if inserted {
+			unlocked = append(unlocked, u)
+			newlyUnlocked = append(newlyUnlocked, achievement)
+		}
+	}
+
+	if len(newlyUnlocked) == 0 {
+		return
+	}
+
+	s.notifyUnlocked(ctx, habit, unlocked, newlyUnlocked)
+}
+
+func (s *achievementService) HandleStreakBroken(ctx context.Context, habit *entity.Habit, lostStreak int32) {
+	if err := s.achievementRepo.RecordStreakBreak(ctx, habit.ID, habit.UserID, lostStreak, time.Now().UTC()); err != nil {
+		fmt.Printf("Failed to record streak break of habit %s: %v\n", habit.ID, err)
+	}
+}
+
+func (s *achievementService) GetProgress(ctx context.Context, userID uuid.UUID) (*entity.AchievementProgress, error) {
+	unlocked, err := s.achievementRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	xp, err := s.totalXP(ctx, userID, unlocked)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockedByCode := make(map[string]*entity.UnlockedAchievement, len(unlocked))
+	for _, u := range unlocked {
+		unlockedByCode[u.Code] = u
+	}
+
+	statuses := make([]*entity.AchievementStatus, len(achievementCatalog))
+	for i, achievement := range achievementCatalog {
+		statuses[i] = &entity.AchievementStatus{
+			Achievement: achievement,
+			Unlocked:    unlockedByCode[achievement.Code],
+		}
+	}
+
+	level, levelXP, nextLevelXP := levelForXP(xp)
+
+	return &entity.AchievementProgress{
+		XP:           xp,
+		Level:        level,
+		LevelXP:      levelXP,
+		NextLevelXP:  nextLevelXP,
+		Achievements: statuses,
+	}, nil
+}
+
+func (s *achievementService) PurgeUserData(ctx context.Context, userID uuid.UUID) error {
+	return s.achievementRepo.DeleteByUserID(ctx, userID)
+}
+
+// loadFacts loads the facts the rules of the locked achievements check, as of date
+func (s *achievementService) loadFacts(ctx context.Context, habit *entity.Habit, date string, locked []*entity.Achievement) (map[entity.AchievementRuleKind]int32, error) {
+	thresholds := make(map[entity.AchievementRuleKind]int32)
+	for _, achievement := range locked {
+		if achievement.Rule.Threshold > thresholds[achievement.Rule.Kind] {
+			thresholds[achievement.Rule.Kind] = achievement.Rule.Threshold
+		}
+	}
+
+	facts := make(map[entity.AchievementRuleKind]int32, len(thresholds))
+	for kind, threshold := range thresholds {
+		switch kind {
+		case entity.AchievementRuleConfirmations:
+			count, err := s.confirmationRepo.CountByUserID(ctx, habit.UserID)
+			if err != nil {
+				return nil, err
+			}
+			facts[kind] = count
+
+		case entity.AchievementRuleStreak:
+			facts[kind] = habit.Streak
+
+		case entity.AchievementRulePerfectDays:
+			days, err := s.countPerfectDays(ctx, habit.UserID, date, threshold)
+			if err != nil {
+				return nil, err
+			}
+			facts[kind] = days
+
+		case entity.AchievementRuleComeback:
+			broken, err := s.achievementRepo.HasStreakBreak(ctx, habit.ID)
+			if err != nil {
+				return nil, err
+			}
+			if broken {
+				facts[kind] = habit.Streak
+			}
+		}
+	}
+
+	return facts, nil
+}
+
+// countPerfectDays counts consecutive days up to date, at most limit, on which the user
+// confirmed at least one habit and missed none
+func (s *achievementService) countPerfectDays(ctx context.Context, userID uuid.UUID, date string, limit int32) (int32, error) {
+	to, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return 0, fmt.Errorf("%w date: %w", service.ErrInvalidArgument, err)
+	}
+
+	from := to.AddDate(0, 0, -int(limit-1))
+	points, err := s.analyticsRepo.GetCompletionPoints(ctx, userID, entity.TrendGranularityDay, from.Format(dateLayout), date)
+	if err != nil {
+		return 0, err
+	}
+
+	byDate := make(map[string]*entity.CompletionPoint, len(points))
+	for _, point := range points {
+		byDate[point.PeriodStart] = point
+	}
+
+	var days int32
+	for day := to; days < limit; day = day.AddDate(0, 0, -1) {
+		point, ok := byDate[day.Format(dateLayout)]
+		if !ok || point.Completed == 0 || point.Missed > 0 {
+			break
+		}
+		days++
+	}
+
+	return days, nil
+}
+
+// totalXP returns the XP of the confirmations and unlocked achievements of a user
+func (s *achievementService) totalXP(ctx context.Context, userID uuid.UUID, unlocked []*entity.UnlockedAchievement) (int32, error) {
+	confirmations, err := s.confirmationRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return confirmations*confirmationXP + achievementsXP(unlocked), nil
+}
+
+// notifyUnlocked publishes an event for every achievement the habit newly unlocked
+func (s *achievementService) notifyUnlocked(ctx context.Context, habit *entity.Habit, unlocked []*entity.UnlockedAchievement, newlyUnlocked []*entity.Achievement) {
+	userID := habit.UserID
+	resp, err := s.userClient.GetUser(ctx, &userpb.GetUserRequest{UserId: userID.String()})
+	if err != nil {
+		fmt.Printf("Failed to notify user %s of achievements: %v\n", userID, err)
+		return
+	}
+	user := resp.User
+
+	xp, err := s.totalXP(ctx, userID, unlocked)
+	if err != nil {
+		fmt.Printf("Failed to notify user %s of achievements: %v\n", userID, err)
+		return
+	}
+	level, _, _ := levelForXP(xp)
+
+	now := time.Now().UTC()
+	for _, achievement := range newlyUnlocked {
+		event := &kafka.AchievementUnlockedEvent{
+			EventID:                kafka.NewEventID(),
+			UserID:                 user.Id,
+			Email:                  user.Email,
+			Username:               user.Username,
+			FirstName:              user.FirstName,
+			AchievementCode:        achievement.Code,
+			AchievementName:        achievement.Name,
+			AchievementDescription: achievement.Description,
+			XP:                     achievement.XP,
+			Level:                  level,
+			UnlockedAt:             now,
+			HabitID:                habit.ID.String(),
+		}
+
+		if err := s.producer.PublishAchievementUnlockedEvent(ctx, event); err != nil {
+			fmt.Printf("Failed to notify user %s of achievement %s: %v\n", userID, achievement.Code, err)
+		}
+	}
+}
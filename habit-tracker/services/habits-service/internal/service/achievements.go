@@ -0,0 +1,108 @@
+package service
+
+import (
+	"habits-service/internal/domain/entity"
+	"math"
+)
+
+// confirmationXP is granted for every confirmation on top of the XP of achievements
+const confirmationXP = 10
+
+// levelStepXP is the XP between level 1 and 2, every following level costs one step more
+const levelStepXP = 100
+
+// achievementCatalog lists every achievement with the rule that unlocks it.
+// Codes are stored with unlocked achievements and must never change
+var achievementCatalog = []*entity.Achievement{
+	{
+		Code:        "first_confirmation",
+		Name:        "First Step",
+		Description: "Confirm a habit for the first time",
+		XP:          50,
+		Rule:        entity.AchievementRule{Kind: entity.AchievementRuleConfirmations, Threshold: 1},
+	},
+	{
+		Code:        "streak_7",
+		Name:        "One Week Strong",
+		Description: "Reach a streak of 7 on a habit",
+		XP:          100,
+		Rule:        entity.AchievementRule{Kind: entity.AchievementRuleStreak, Threshold: 7},
+	},
+	{
+		Code:        "streak_30",
+		Name:        "Monthly Master",
+		Description: "Reach a streak of 30 on a habit",
+		XP:          300,
+		Rule:        entity.AchievementRule{Kind: entity.AchievementRuleStreak, Threshold: 30},
+	},
+	{
+		Code:        "streak_100",
+		Name:        "Centurion",
+		Description: "Reach a streak of 100 on a habit",
+		XP:          1000,
+		Rule:        entity.AchievementRule{Kind: entity.AchievementRuleStreak, Threshold: 100},
+	},
+	{
+		Code:        "perfect_week",
+		Name:        "Perfect Week",
+		Description: "Confirm habits 7 days in a row without missing a single deadline",
+		XP:          200,
+		Rule:        entity.AchievementRule{Kind: entity.AchievementRulePerfectDays, Threshold: 7},
+	},
+	{
+		Code:        "comeback",
+		Name:        "Comeback",
+		Description: "Build a streak of 3 on a habit after losing a streak on it",
+		XP:          150,
+		Rule:        entity.AchievementRule{Kind: entity.AchievementRuleComeback, Threshold: 3},
+	},
+}
+
+// achievementsXP returns the XP of the unlocked achievements of the catalog
+func achievementsXP(unlocked []*entity.UnlockedAchievement) int32 {
+	byCode := make(map[string]*entity.Achievement, len(achievementCatalog))
+	for _, achievement := range achievementCatalog {
+		byCode[achievement.Code] = achievement
+	}
+
+	var xp int32
+	for _, u := range unlocked {
+		if achievement, ok := byCode[u.Code]; ok {
+			xp += achievement.XP
+		}
+	}
+	return xp
+}
+
+// levelForXP returns the level reached with xp and the XP bounds of that level.
+// Level n starts at levelStepXP * n*(n-1)/2: 0, 100, 300, 600, 1000, ...
+func levelForXP(xp int32) (level, levelXP, nextLevelXP int32) {
+	if xp < 0 {
+		xp = 0
+	}
+
+	n := int32((1 + math.Sqrt(1+8*float64(xp)/levelStepXP)) / 2)
+	// Guard against rounding at the exact bounds
+	for levelStartXP(n+1) <= xp {
+		n++
+	}
+	for n > 1 && levelStartXP(n) > xp {
+		n--
+	}
+
+	return n, levelStartXP(n), levelStartXP(n + 1)
+}
+
+func levelStartXP(level int32) int32 {
+	return levelStepXP * level * (level - 1) / 2
+}
+
+// findAchievement returns the achievement of the catalog with the code, nil when there is none
+func findAchievement(code string) *entity.Achievement {
+	for _, achievement := range achievementCatalog {
+		if achievement.Code == code {
+			return achievement
+		}
+	}
+	return nil
+}
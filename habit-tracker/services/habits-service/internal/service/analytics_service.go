@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxTrendDays limits the range of a single trend request
+	maxTrendDays = 731
+
+	// minTrendPoints is the number of buckets with activity needed to tell a direction
+	minTrendPoints = 3
+
+	// trendThreshold is the slope in percentage points per bucket above which a trend is not stable
+	trendThreshold = 1.0
+
+	maxCorrelations = 50
+)
+
+type analyticsService struct {
+	analyticsRepo repository.AnalyticsRepository
+}
+
+// NewAnalyticsService creates a new analytics service
+func NewAnalyticsService(analyticsRepo repository.AnalyticsRepository) service.AnalyticsService {
+	return &analyticsService{
+		analyticsRepo: analyticsRepo,
+	}
+}
+
+func (s *analyticsService) GetCompletionTrend(ctx context.Context, userID uuid.UUID, granularity entity.TrendGranularity, fromDate, toDate string) (*entity.CompletionTrend, error) {
+	switch granularity {
+	case entity.TrendGranularityDay, entity.TrendGranularityWeek, entity.TrendGranularityMonth:
+	default:
+		return nil, fmt.Errorf("%w granularity: must be day, week or month", service.ErrInvalidArgument)
+	}
+
+	from, err := time.Parse(dateLayout, fromDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w from_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	to, err := time.Parse(dateLayout, toDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w to_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w to_date: must not be before from_date", service.ErrInvalidArgument)
+	}
+
+	if to.Sub(from) >= maxTrendDays*24*time.Hour {
+		return nil, fmt.Errorf("%w date range: must not exceed %d days", service.ErrInvalidArgument, maxTrendDays)
+	}
+
+	points, err := s.analyticsRepo.GetCompletionPoints(ctx, userID, granularity, fromDate, toDate)
+	if err != nil {
+		return nil, err
+	}
+
+	trend := &entity.CompletionTrend{Points: points}
+
+	var completed, total int32
+	for _, point := range points {
+		point.Rate = completionRate(point.Completed, point.Completed+point.Missed)
+		completed += point.Completed
+		total += point.Completed + point.Missed
+	}
+	trend.OverallRate = completionRate(completed, total)
+	trend.Slope, trend.Direction = trendDirection(points)
+
+	return trend, nil
+}
+
+// trendDirection fits a least squares line through the rates of buckets with activity.
+// Buckets without any activity are skipped rather than counted as gaps
+func trendDirection(points []*entity.CompletionPoint) (float64, entity.TrendDirection) {
+	var n, sumX, sumY, sumXY, sumXX float64
+	for _, point := range points {
+		if point.Completed+point.Missed == 0 {
+			continue
+		}
+		x := n
+		n++
+		sumX += x
+		sumY += point.Rate
+		sumXY += x * point.Rate
+		sumXX += x * x
+	}
+
+	if n < minTrendPoints {
+		return 0, entity.TrendInsufficientData
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0, entity.TrendInsufficientData
+	}
+
+	slope := (n*sumXY - sumX*sumY) / denominator
+
+	switch {
+	case slope >= trendThreshold:
+		return slope, entity.TrendImproving
+	case slope <= -trendThreshold:
+		return slope, entity.TrendDeclining
+	default:
+		return slope, entity.TrendStable
+	}
+}
+
+func (s *analyticsService) GetWeekdayBreakdown(ctx context.Context, userID uuid.UUID) (*entity.WeekdayBreakdown, error) {
+	rates, err := s.analyticsRepo.GetWeekdayRates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Always return all seven days, including the ones without activity
+	breakdown := &entity.WeekdayBreakdown{Days: make([]*entity.WeekdayRate, 7)}
+	for i := range breakdown.Days {
+		breakdown.Days[i] = &entity.WeekdayRate{Weekday: int32(i)}
+	}
+
+	for _, rate := range rates {
+		if rate.Weekday < 0 || rate.Weekday > 6 {
+			continue
+		}
+		rate.Rate = completionRate(rate.Completed, rate.Completed+rate.Missed)
+		breakdown.Days[rate.Weekday] = rate
+	}
+
+	// Days without activity are neither best nor worst
+	for _, day := range breakdown.Days {
+		if day.Completed+day.Missed == 0 {
+			continue
+		}
+		weekday := day.Weekday
+		if breakdown.BestWeekday == nil || day.Rate > breakdown.Days[*breakdown.BestWeekday].Rate {
+			breakdown.BestWeekday = &weekday
+		}
+		if breakdown.WorstWeekday == nil || day.Rate < breakdown.Days[*breakdown.WorstWeekday].Rate {
+			breakdown.WorstWeekday = &weekday
+		}
+	}
+
+	return breakdown, nil
+}
+
+func (s *analyticsService) GetHourDistribution(ctx context.Context, userID uuid.UUID) ([]int32, error) {
+	return s.analyticsRepo.GetHourDistribution(ctx, userID)
+}
+
+func (s *analyticsService) GetHabitCorrelations(ctx context.Context, userID uuid.UUID, limit int32) ([]*entity.HabitCorrelation, error) {
+	if limit <= 0 || limit > maxCorrelations {
+		limit = maxCorrelations
+	}
+
+	return s.analyticsRepo.GetCorrelations(ctx, userID, limit)
+}
+
+func (s *analyticsService) GetMoodCorrelations(ctx context.Context, userID uuid.UUID, fromDate, toDate string) (*entity.MoodSummary, error) {
+	from, err := time.Parse(dateLayout, fromDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w from_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	to, err := time.Parse(dateLayout, toDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w to_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w to_date: must not be before from_date", service.ErrInvalidArgument)
+	}
+
+	if to.Sub(from) >= maxTrendDays*24*time.Hour {
+		return nil, fmt.Errorf("%w date range: must not exceed %d days", service.ErrInvalidArgument, maxTrendDays)
+	}
+
+	summary, err := s.analyticsRepo.GetMoodCorrelations(ctx, userID, fromDate, toDate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, correlation := range summary.Habits {
+		if correlation.AvgMoodDone != nil && correlation.AvgMoodNotDone != nil {
+			difference := *correlation.AvgMoodDone - *correlation.AvgMoodNotDone
+			correlation.Difference = &difference
+		}
+	}
+
+	// Habits going along with the best mood first, habits without a comparison last
+	sort.SliceStable(summary.Habits, func(i, j int) bool {
+		a, b := summary.Habits[i].Difference, summary.Habits[j].Difference
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return *a > *b
+	})
+
+	return summary, nil
+}
+
+func (s *analyticsService) RebuildRollups(ctx context.Context, since time.Time) error {
+	userIDs, err := s.analyticsRepo.GetConfirmingUserIDs(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.analyticsRepo.RebuildUserRollups(ctx, userID); err != nil {
+			fmt.Printf("Failed to rebuild analytics rollups of user %s: %v\n", userID, err)
+			failed++
+		}
+	}
+
+	fmt.Printf("Rebuilt analytics rollups of %d users, %d failed\n", len(userIDs)-failed, failed)
+
+	if failed > 0 {
+		return fmt.Errorf("failed to rebuild analytics rollups of %d users", failed)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"habits-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// rebuildRecorder records rollup rebuilds and fails them for some users
+type rebuildRecorder struct {
+	repository.AnalyticsRepository
+
+	userIDs []uuid.UUID
+	failFor map[uuid.UUID]bool
+	rebuilt []uuid.UUID
+	since   time.Time
+}
+
+func (r *rebuildRecorder) GetConfirmingUserIDs(_ context.Context, since time.Time) ([]uuid.UUID, error) {
+	r.since = since
+	return r.userIDs, nil
+}
+
+func (r *rebuildRecorder) RebuildUserRollups(_ context.Context, userID uuid.UUID) error {
+	if r.failFor[userID] {
+		return errors.New("could not serialize access")
+	}
+	r.rebuilt = append(r.rebuilt, userID)
+	return nil
+}
+
+func TestRebuildRollupsContinuesPastFailedUsers(t *testing.T) {
+	first, failing, last := uuid.New(), uuid.New(), uuid.New()
+	repo := &rebuildRecorder{
+		userIDs: []uuid.UUID{first, failing, last},
+		failFor: map[uuid.UUID]bool{failing: true},
+	}
+
+	// The failed user is reported so that the next run covers it again
+	if err := NewAnalyticsService(repo).RebuildRollups(context.Background(), time.Now()); err == nil {
+		t.Error("RebuildRollups() error = nil, want the failed user reported")
+	}
+
+	if len(repo.rebuilt) != 2 || repo.rebuilt[0] != first || repo.rebuilt[1] != last {
+		t.Errorf("rebuilt %v, want [%s %s]", repo.rebuilt, first, last)
+	}
+}
+
+func TestRebuildRollupsStopsWhenCancelled(t *testing.T) {
+	repo := &rebuildRecorder{userIDs: []uuid.UUID{uuid.New(), uuid.New()}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := NewAnalyticsService(repo).RebuildRollups(ctx, time.Now()); !errors.Is(err, context.Canceled) {
+		t.Errorf("RebuildRollups() error = %v, want %v", err, context.Canceled)
+	}
+	if len(repo.rebuilt) != 0 {
+		t.Errorf("rebuilt %d users after cancellation", len(repo.rebuilt))
+	}
+}
+
+func TestRebuildRollupsOnlyCoversRecentlyConfirmingUsers(t *testing.T) {
+	userID := uuid.New()
+	repo := &rebuildRecorder{userIDs: []uuid.UUID{userID}}
+	since := time.Date(2026, 10, 17, 3, 0, 0, 0, time.UTC)
+
+	if err := NewAnalyticsService(repo).RebuildRollups(context.Background(), since); err != nil {
+		t.Fatalf("RebuildRollups() error = %v", err)
+	}
+
+	if !repo.since.Equal(since) {
+		t.Errorf("users looked up since %v, want %v", repo.since, since)
+	}
+	if len(repo.rebuilt) != 1 || repo.rebuilt[0] != userID {
+		t.Errorf("rebuilt %v, want [%s]", repo.rebuilt, userID)
+	}
+}
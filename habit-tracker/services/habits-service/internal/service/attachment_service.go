@@ -0,0 +1,331 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"habits-service/internal/infrastructure/storage"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxAttachmentSize matches the upload limit of the gateway
+	maxAttachmentSize = 10 << 20
+
+	// maxAttachmentPixels rejects images that would take too much memory to decode
+	maxAttachmentPixels = 40_000_000
+
+	maxAttachmentsPerConfirmation = 4
+
+	thumbnailMaxSide = 320
+	thumbnailQuality = 80
+
+	orphanedCleanupBatch = 100
+)
+
+// attachmentContentTypes are the image formats that can be decoded for thumbnails
+var attachmentContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
+type attachmentService struct {
+	attachmentRepo repository.AttachmentRepository
+	storage        storage.Storage
+	quotaBytes     int64
+	unattachedTTL  time.Duration
+}
+
+// NewAttachmentService creates a new attachment service
+func NewAttachmentService(
+	attachmentRepo repository.AttachmentRepository,
+	storage storage.Storage,
+	quotaBytes int64,
+	unattachedTTL time.Duration,
+) service.AttachmentService {
+	return &attachmentService{
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+		quotaBytes:     quotaBytes,
+		unattachedTTL:  unattachedTTL,
+	}
+}
+
+// attachmentKey is the storage key of an uploaded image
+func attachmentKey(attachment *entity.Attachment) string {
+	return fmt.Sprintf("%s/%s", attachment.UserID, attachment.ID)
+}
+
+// thumbnailKey is the storage key of the JPEG thumbnail of an image
+func thumbnailKey(attachment *entity.Attachment) string {
+	return fmt.Sprintf("%s/%s_thumb.jpg", attachment.UserID, attachment.ID)
+}
+
+func (s *attachmentService) Upload(ctx context.Context, userID uuid.UUID, data []byte) (*entity.Attachment, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w file: must not be empty", service.ErrInvalidArgument)
+	}
+	if len(data) > maxAttachmentSize {
+		return nil, fmt.Errorf("%w file: must be at most %d MB", service.ErrInvalidArgument, maxAttachmentSize>>20)
+	}
+
+	contentType := http.DetectContentType(data)
+	if !attachmentContentTypes[contentType] {
+		return nil, fmt.Errorf("%w file: must be a JPEG or PNG image", service.ErrInvalidArgument)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w file: image could not be read", service.ErrInvalidArgument)
+	}
+	if config.Width*config.Height > maxAttachmentPixels {
+		return nil, fmt.Errorf("%w file: image must have at most %d megapixels", service.ErrInvalidArgument, maxAttachmentPixels/1_000_000)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w file: image could not be read", service.ErrInvalidArgument)
+	}
+
+	var thumb bytes.Buffer
+	if err := jpeg.Encode(&thumb, thumbnail(img, thumbnailMaxSide), &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	attachment := &entity.Attachment{
+		ID:                 uuid.New(),
+		UserID:             userID,
+		ContentType:        contentType,
+		SizeBytes:          int64(len(data)),
+		ThumbnailSizeBytes: int64(thumb.Len()),
+		Width:              int32(config.Width),
+		Height:             int32(config.Height),
+		CreatedAt:          time.Now().UTC(),
+	}
+
+	// The row reserves the quota before anything is stored
+	saved, err := s.attachmentRepo.CreateWithinQuota(ctx, attachment, s.quotaBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !saved {
+		return nil, fmt.Errorf("attachment storage %w", service.ErrQuotaExceeded)
+	}
+
+	if err := s.store(ctx, attachment, data, thumb.Bytes()); err != nil {
+		s.remove(ctx, []*entity.Attachment{attachment})
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (s *attachmentService) store(ctx context.Context, attachment *entity.Attachment, data, thumb []byte) error {
+	if err := s.storage.Put(ctx, attachmentKey(attachment), data, attachment.ContentType); err != nil {
+		return err
+	}
+
+	return s.storage.Put(ctx, thumbnailKey(attachment), thumb, "image/jpeg")
+}
+
+func (s *attachmentService) Get(ctx context.Context, attachmentID uuid.UUID) (*entity.Attachment, error) {
+	return s.attachmentRepo.GetByID(ctx, attachmentID)
+}
+
+func (s *attachmentService) Open(ctx context.Context, attachment *entity.Attachment, thumbnail bool) (io.ReadCloser, error) {
+	key := attachmentKey(attachment)
+	if thumbnail {
+		key = thumbnailKey(attachment)
+	}
+
+	reader, err := s.storage.Open(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("attachment %w", service.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return reader, nil
+}
+
+func (s *attachmentService) GetUsage(ctx context.Context, userID uuid.UUID) (*entity.AttachmentUsage, error) {
+	used, err := s.attachmentRepo.GetUsedBytes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.AttachmentUsage{
+		UsedBytes:  used,
+		QuotaBytes: s.quotaBytes,
+	}, nil
+}
+
+func (s *attachmentService) ValidateAttachable(ctx context.Context, userID uuid.UUID, attachmentIDs []uuid.UUID) error {
+	if len(attachmentIDs) == 0 {
+		return nil
+	}
+
+	if len(attachmentIDs) > maxAttachmentsPerConfirmation {
+		return fmt.Errorf("%w attachment_ids: at most %d attachments per confirmation", service.ErrInvalidArgument, maxAttachmentsPerConfirmation)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(attachmentIDs))
+	for _, id := range attachmentIDs {
+		if seen[id] {
+			return fmt.Errorf("%w attachment_ids: duplicate attachment", service.ErrInvalidArgument)
+		}
+		seen[id] = true
+	}
+
+	count, err := s.attachmentRepo.CountAttachable(ctx, userID, attachmentIDs)
+	if err != nil {
+		return err
+	}
+	if count != len(attachmentIDs) {
+		return fmt.Errorf("%w attachment_ids: attachments must be your own uploads not used by another confirmation", service.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func (s *attachmentService) GetAttachmentIDs(ctx context.Context, confirmationIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	return s.attachmentRepo.GetIDsByConfirmationIDs(ctx, confirmationIDs)
+}
+
+func (s *attachmentService) CleanupOrphaned(ctx context.Context) error {
+	unusedBefore := time.Now().UTC().Add(-s.unattachedTTL)
+
+	var removed int
+	for {
+		attachments, err := s.attachmentRepo.GetOrphaned(ctx, unusedBefore, orphanedCleanupBatch)
+		if err != nil {
+			return err
+		}
+
+		deleted, err := s.remove(ctx, attachments)
+		removed += deleted
+		if err != nil {
+			return err
+		}
+
+		// A batch with failures is retried on the next run
+		if len(attachments) < orphanedCleanupBatch || deleted < len(attachments) {
+			break
+		}
+	}
+
+	if removed > 0 {
+		fmt.Printf("Removed %d orphaned attachments\n", removed)
+	}
+	return nil
+}
+
+func (s *attachmentService) ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.Attachment, error) {
+	return s.attachmentRepo.GetByUserID(ctx, userID)
+}
+
+func (s *attachmentService) PurgeUserData(ctx context.Context, userID uuid.UUID) error {
+	attachments, err := s.attachmentRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.remove(ctx, attachments)
+	if err != nil {
+		return err
+	}
+	if deleted < len(attachments) {
+		return fmt.Errorf("failed to delete %d attachments of user %s from storage", len(attachments)-deleted, userID)
+	}
+
+	return nil
+}
+
+// remove deletes the files of attachments and then their rows. Rows whose files could not be
+// deleted are kept so that a later run retries them, returns the number of removed attachments
+func (s *attachmentService) remove(ctx context.Context, attachments []*entity.Attachment) (int, error) {
+	ids := make([]uuid.UUID, 0, len(attachments))
+	for _, attachment := range attachments {
+		if err := s.storage.Delete(ctx, attachmentKey(attachment)); err != nil {
+			fmt.Printf("Failed to delete attachment %s from storage: %v\n", attachment.ID, err)
+			continue
+		}
+		if err := s.storage.Delete(ctx, thumbnailKey(attachment)); err != nil {
+			fmt.Printf("Failed to delete thumbnail of attachment %s from storage: %v\n", attachment.ID, err)
+			continue
+		}
+		ids = append(ids, attachment.ID)
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	if err := s.attachmentRepo.Delete(ctx, ids); err != nil {
+		return 0, err
+	}
+
+	return len(ids), nil
+}
+
+// thumbnail scales an image down so that its longer side is at most maxSide, averaging the
+// pixels each thumbnail pixel covers. Transparent areas are filled with white for JPEG
+func thumbnail(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	thumbWidth, thumbHeight := width, height
+	if width > maxSide || height > maxSide {
+		if width >= height {
+			thumbWidth = maxSide
+			thumbHeight = max(1, height*maxSide/width)
+		} else {
+			thumbHeight = maxSide
+			thumbWidth = max(1, width*maxSide/height)
+		}
+	}
+
+	thumb := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+	for y := 0; y < thumbHeight; y++ {
+		y0 := bounds.Min.Y + y*height/thumbHeight
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*height/thumbHeight)
+
+		for x := 0; x < thumbWidth; x++ {
+			x0 := bounds.Min.X + x*width/thumbWidth
+			x1 := max(x0+1, bounds.Min.X+(x+1)*width/thumbWidth)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+
+			// Colors are premultiplied, adding the missing alpha blends onto white
+			white := 0xffff - a/n
+			thumb.SetRGBA(x, y, color.RGBA{
+				R: uint8((r/n + white) >> 8),
+				G: uint8((g/n + white) >> 8),
+				B: uint8((b/n + white) >> 8),
+				A: 0xff,
+			})
+		}
+	}
+
+	return thumb
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"habits-service/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxCalendarDays limits the range of a single calendar request
+const maxCalendarDays = 366
+
+// buildCalendar resolves the state of every local date in [from, to]. Periods must be
+// evaluated with the same confirmations and pauses so that the calendar matches the stats.
+// Until is the last evaluated date, it is before today for archived habits. Confirmation notes
+// are private to the owner and only included with includeNotes, attached photos are always included
+func buildCalendar(
+	periods []evaluatedPeriod,
+	confirmations []*entity.HabitConfirmation,
+	attachmentIDs map[uuid.UUID][]uuid.UUID,
+	pauses []*entity.HabitPause,
+	from, to, today, until time.Time,
+	includeNotes bool,
+) []*entity.CalendarDay {
+	confirmationsByDate := make(map[string]*entity.HabitConfirmation, len(confirmations))
+	for _, confirmation := range confirmations {
+		confirmationsByDate[confirmation.ConfirmedForDate] = confirmation
+	}
+
+	dueByDate := make(map[string]evaluatedPeriod, len(periods))
+	for _, period := range periods {
+		if period.due {
+			dueByDate[period.end.Format(dateLayout)] = period
+		}
+	}
+
+	var days []*entity.CalendarDay
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		key := date.Format(dateLayout)
+		period, due := dueByDate[key]
+
+		day := &entity.CalendarDay{
+			Date: key,
+			Due:  due,
+		}
+
+		confirmation, confirmed := confirmationsByDate[key]
+
+		switch {
+		case date.After(today):
+			day.State = entity.CalendarDayFuture
+		case date.After(until):
+			day.State = entity.CalendarDayFrozen
+		case confirmed:
+			day.State = entity.CalendarDayDone
+			day.AttachmentIDs = attachmentIDs[confirmation.ID]
+			if includeNotes {
+				day.Notes = confirmation.Notes
+			}
+		case pausedOn(pauses, date):
+			day.State = entity.CalendarDayFrozen
+		case due && period.counted && !period.completed:
+			day.State = entity.CalendarDayMissed
+		case due && !period.completed && date.Equal(until):
+			day.State = entity.CalendarDayPending
+		default:
+			day.State = entity.CalendarDayNotDue
+		}
+
+		days = append(days, day)
+	}
+
+	return days
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// calendarFeedTokenBytes is the length of feed tokens before hex encoding
+const calendarFeedTokenBytes = 32
+
+type calendarFeedService struct {
+	feedRepo         repository.CalendarFeedRepository
+	habitRepo        repository.HabitRepository
+	confirmationRepo repository.HabitConfirmationRepository
+}
+
+// NewCalendarFeedService creates a new calendar feed service
+func NewCalendarFeedService(
+	feedRepo repository.CalendarFeedRepository,
+	habitRepo repository.HabitRepository,
+	confirmationRepo repository.HabitConfirmationRepository,
+) service.CalendarFeedService {
+	return &calendarFeedService{
+		feedRepo:         feedRepo,
+		habitRepo:        habitRepo,
+		confirmationRepo: confirmationRepo,
+	}
+}
+
+func (s *calendarFeedService) RotateToken(ctx context.Context, userID uuid.UUID) (string, error) {
+	bytes := make([]byte, calendarFeedTokenBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+
+	hash := sha256.Sum256(bytes)
+	if err := s.feedRepo.Save(ctx, userID, hash[:], time.Now().UTC()); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
+func (s *calendarFeedService) RevokeToken(ctx context.Context, userID uuid.UUID) error {
+	deleted, err := s.feedRepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("calendar feed %w", service.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *calendarFeedService) RenderFeed(ctx context.Context, token string) ([]byte, error) {
+	bytes, err := hex.DecodeString(token)
+	if err != nil || len(bytes) != calendarFeedTokenBytes {
+		return nil, fmt.Errorf("calendar feed %w", service.ErrNotFound)
+	}
+
+	hash := sha256.Sum256(bytes)
+	userID, err := s.feedRepo.GetUserID(ctx, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	habits, err := s.habitRepo.GetByUserID(ctx, userID, entity.HabitStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmations, err := s.confirmationRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	datesByHabit := make(map[uuid.UUID][]string)
+	for _, confirmation := range confirmations {
+		datesByHabit[confirmation.HabitID] = append(datesByHabit[confirmation.HabitID], confirmation.ConfirmedForDate)
+	}
+
+	calendarHabits := make([]*calendarHabit, 0, len(habits))
+	for _, habit := range habits {
+		versions, err := scheduleVersions(ctx, s.habitRepo, habit)
+		if err != nil {
+			return nil, err
+		}
+
+		dates := datesByHabit[habit.ID]
+		sort.Strings(dates)
+
+		calendarHabits = append(calendarHabits, &calendarHabit{
+			habit:    habit,
+			versions: versions,
+			dates:    dates,
+		})
+	}
+
+	return renderCalendar(calendarHabits, time.Now()), nil
+}
+
+func (s *calendarFeedService) PurgeUserData(ctx context.Context, userID uuid.UUID) error {
+	_, err := s.feedRepo.DeleteByUserID(ctx, userID)
+	return err
+}
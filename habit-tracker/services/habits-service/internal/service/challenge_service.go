@@ -0,0 +1,513 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"habits-service/internal/infrastructure/redis"
+	userpb "habits-service/proto/user/v1"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxChallengeNameLength = 100
+	maxChallengeDays       = 365
+
+	defaultLeaderboardLimit = 50
+	maxLeaderboardLimit     = 100
+
+	inviteCodeLength   = 8
+	inviteCodeAttempts = 5
+)
+
+// inviteCodeAlphabet leaves out characters that are easy to mix up, like 0/O and 1/I
+const inviteCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+type challengeService struct {
+	challengeRepo    repository.ChallengeRepository
+	habitRepo        repository.HabitRepository
+	confirmationRepo repository.HabitConfirmationRepository
+	leaderboards     *redis.LeaderboardStorage
+	userClient       userpb.UserServiceClient
+}
+
+// NewChallengeService creates a new challenge service.
+// Challenges live in PostgreSQL, their leaderboards in Redis sorted sets rebuilt from PostgreSQL when lost
+func NewChallengeService(
+	challengeRepo repository.ChallengeRepository,
+	habitRepo repository.HabitRepository,
+	confirmationRepo repository.HabitConfirmationRepository,
+	leaderboards *redis.LeaderboardStorage,
+	userClient userpb.UserServiceClient,
+) service.ChallengeService {
+	return &challengeService{
+		challengeRepo:    challengeRepo,
+		habitRepo:        habitRepo,
+		confirmationRepo: confirmationRepo,
+		leaderboards:     leaderboards,
+		userClient:       userClient,
+	}
+}
+
+func (s *challengeService) CreateChallenge(ctx context.Context, creatorID uuid.UUID, name string, description *string, startDate, endDate string) (*entity.Challenge, error) {
+	name = strings.TrimSpace(name)
+	if err := validateChallengeName(name); err != nil {
+		return nil, err
+	}
+
+	if err := validateChallengeWindow(startDate, endDate); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	challenge := &entity.Challenge{
+		ID:          uuid.New(),
+		CreatorID:   creatorID,
+		Name:        name,
+		Description: clearIfEmpty(description),
+		StartDate:   startDate,
+		EndDate:     endDate,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err := s.withInviteCode(challenge, func() error {
+		return s.challengeRepo.Create(ctx, challenge)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+func (s *challengeService) GetChallenge(ctx context.Context, challengeID, userID uuid.UUID) (*entity.Challenge, error) {
+	return s.getVisibleChallenge(ctx, challengeID, userID)
+}
+
+func (s *challengeService) ListChallenges(ctx context.Context, userID uuid.UUID) ([]*entity.Challenge, error) {
+	return s.challengeRepo.GetByUserID(ctx, userID)
+}
+
+func (s *challengeService) UpdateChallenge(ctx context.Context, challengeID, creatorID uuid.UUID, name, description, startDate, endDate *string, regenerateInviteCode bool) (*entity.Challenge, error) {
+	challenge, err := s.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.CreatorID != creatorID {
+		return nil, fmt.Errorf("challenge %w", service.ErrNotFound)
+	}
+
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		if err := validateChallengeName(trimmed); err != nil {
+			return nil, err
+		}
+		challenge.Name = trimmed
+	}
+
+	if description != nil {
+		challenge.Description = clearIfEmpty(description)
+	}
+
+	windowChanged := false
+	if startDate != nil && *startDate != challenge.StartDate {
+		challenge.StartDate = *startDate
+		windowChanged = true
+	}
+
+	if endDate != nil && *endDate != challenge.EndDate {
+		challenge.EndDate = *endDate
+		windowChanged = true
+	}
+
+	if windowChanged {
+		if err := validateChallengeWindow(challenge.StartDate, challenge.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	challenge.UpdatedAt = time.Now().UTC()
+
+	update := func() error {
+		return s.challengeRepo.Update(ctx, challenge)
+	}
+
+	if regenerateInviteCode {
+		err = s.withInviteCode(challenge, update)
+	} else {
+		err = update()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Confirmations outside the old window now count, or no longer do
+	if windowChanged {
+		if err := s.rebuildLeaderboard(ctx, challenge); err != nil {
+			fmt.Printf("Failed to rebuild leaderboard of challenge %s: %v\n", challenge.ID, err)
+		}
+	}
+
+	return challenge, nil
+}
+
+func (s *challengeService) DeleteChallenge(ctx context.Context, challengeID, creatorID uuid.UUID) error {
+	if err := s.challengeRepo.Delete(ctx, challengeID, creatorID); err != nil {
+		return err
+	}
+
+	if err := s.leaderboards.Delete(ctx, challengeID); err != nil {
+		fmt.Printf("Failed to delete leaderboard of challenge %s: %v\n", challengeID, err)
+	}
+
+	return nil
+}
+
+func (s *challengeService) JoinChallenge(ctx context.Context, userID uuid.UUID, inviteCode string, habitID uuid.UUID) (*entity.Challenge, error) {
+	inviteCode = strings.ToUpper(strings.TrimSpace(inviteCode))
+	if inviteCode == "" {
+		return nil, fmt.Errorf("%w invite_code: must not be empty", service.ErrInvalidArgument)
+	}
+
+	challenge, err := s.challengeRepo.GetByInviteCode(ctx, inviteCode)
+	if err != nil {
+		return nil, err
+	}
+
+	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if habit.IsArchived() {
+		return nil, fmt.Errorf("%w habit: archived habits can't join a challenge", service.ErrInvalidArgument)
+	}
+
+	today := habit.GetCurrentLocalDate()
+	if challenge.HasEnded(today) {
+		return nil, fmt.Errorf("%w challenge: challenge has ended", service.ErrInvalidArgument)
+	}
+
+	resp, err := s.userClient.GetUser(ctx, &userpb.GetUserRequest{UserId: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s: %w", userID, err)
+	}
+
+	participant := &entity.ChallengeParticipant{
+		ChallengeID: challenge.ID,
+		UserID:      userID,
+		Username:    resp.User.Username,
+		HabitID:     habitID,
+		JoinedAt:    time.Now().UTC(),
+	}
+
+	if err := s.challengeRepo.AddParticipant(ctx, participant); err != nil {
+		return nil, err
+	}
+
+	challenge.ParticipantCount++
+
+	// Confirmations made before joining but inside the window count too
+	entry, err := s.scoreHabit(ctx, challenge, habit, today)
+	if err == nil {
+		err = s.leaderboards.SetScore(ctx, challenge.ID, userID, entry.Completions, entry.CurrentStreak)
+	}
+	if err != nil {
+		fmt.Printf("Failed to add user %s to leaderboard of challenge %s: %v\n", userID, challenge.ID, err)
+	}
+
+	return challenge, nil
+}
+
+func (s *challengeService) LeaveChallenge(ctx context.Context, challengeID, userID uuid.UUID) error {
+	if err := s.challengeRepo.RemoveParticipant(ctx, challengeID, userID); err != nil {
+		return err
+	}
+
+	if err := s.leaderboards.Remove(ctx, challengeID, userID); err != nil {
+		fmt.Printf("Failed to remove user %s from leaderboard of challenge %s: %v\n", userID, challengeID, err)
+	}
+
+	return nil
+}
+
+func (s *challengeService) GetLeaderboard(ctx context.Context, challengeID, userID uuid.UUID, offset, limit int32) (*entity.Leaderboard, error) {
+	if offset < 0 {
+		return nil, fmt.Errorf("%w offset: must not be negative", service.ErrInvalidArgument)
+	}
+
+	if limit <= 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if limit > maxLeaderboardLimit {
+		limit = maxLeaderboardLimit
+	}
+
+	challenge, err := s.getVisibleChallenge(ctx, challengeID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := s.challengeRepo.GetParticipants(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	usernames := make(map[uuid.UUID]string, len(participants))
+	for _, participant := range participants {
+		usernames[participant.UserID] = participant.Username
+	}
+
+	// PostgreSQL is the source of truth, a lost or drifted sorted set is rebuilt from it
+	count, err := s.leaderboards.Count(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if int(count) != len(participants) {
+		if err := s.rebuildLeaderboard(ctx, challenge); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := s.leaderboards.GetRange(ctx, challengeID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		entry.Username = usernames[entry.UserID]
+	}
+
+	me, err := s.leaderboards.GetEntry(ctx, challengeID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if me != nil {
+		me.Username = usernames[userID]
+	}
+
+	return &entity.Leaderboard{
+		Entries:           entries,
+		Me:                me,
+		TotalParticipants: int32(len(participants)),
+	}, nil
+}
+
+func (s *challengeService) RecordProgress(ctx context.Context, habit *entity.Habit, date string) {
+	challenges, err := s.challengeRepo.GetByHabitID(ctx, habit.ID)
+	if err != nil {
+		fmt.Printf("Failed to get challenges of habit %s: %v\n", habit.ID, err)
+		return
+	}
+
+	for _, challenge := range challenges {
+		// Finished leaderboards stay as they were when the window closed
+		if !challenge.CoversDate(date) {
+			continue
+		}
+
+		entry, err := s.scoreHabit(ctx, challenge, habit, date)
+		if err == nil {
+			err = s.leaderboards.SetScore(ctx, challenge.ID, habit.UserID, entry.Completions, entry.CurrentStreak)
+		}
+		if err != nil {
+			fmt.Printf("Failed to update leaderboard of challenge %s for habit %s: %v\n", challenge.ID, habit.ID, err)
+		}
+	}
+}
+
+func (s *challengeService) RemoveHabit(ctx context.Context, habitID uuid.UUID) {
+	challenges, err := s.challengeRepo.GetByHabitID(ctx, habitID)
+	if err != nil {
+		fmt.Printf("Failed to get challenges of habit %s: %v\n", habitID, err)
+		return
+	}
+
+	if len(challenges) == 0 {
+		return
+	}
+
+	habit, err := s.habitRepo.GetByID(ctx, habitID)
+	if err != nil {
+		fmt.Printf("Failed to get habit %s: %v\n", habitID, err)
+		return
+	}
+
+	for _, challenge := range challenges {
+		if err := s.leaderboards.Remove(ctx, challenge.ID, habit.UserID); err != nil {
+			fmt.Printf("Failed to remove habit %s from leaderboard of challenge %s: %v\n", habitID, challenge.ID, err)
+		}
+	}
+}
+
+func (s *challengeService) PurgeUserData(ctx context.Context, userID uuid.UUID) error {
+	challenges, err := s.challengeRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.challengeRepo.DeleteByUserID(ctx, userID); err != nil {
+		return err
+	}
+
+	for _, challenge := range challenges {
+		if challenge.CreatorID == userID {
+			err = s.leaderboards.Delete(ctx, challenge.ID)
+		} else {
+			err = s.leaderboards.Remove(ctx, challenge.ID, userID)
+		}
+		if err != nil {
+			fmt.Printf("Failed to purge user %s from leaderboard of challenge %s: %v\n", userID, challenge.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// getVisibleChallenge retrieves a challenge if the user created or joined it
+func (s *challengeService) getVisibleChallenge(ctx context.Context, challengeID, userID uuid.UUID) (*entity.Challenge, error) {
+	challenge, err := s.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.CreatorID == userID {
+		return challenge, nil
+	}
+
+	if _, err := s.challengeRepo.GetParticipant(ctx, challengeID, userID); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, fmt.Errorf("challenge %w", service.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+// scoreHabit counts the confirmations of a habit inside the window. The current streak counts
+// once the window started as of date, so a streak built before the challenge doesn't rank early joiners
+func (s *challengeService) scoreHabit(ctx context.Context, challenge *entity.Challenge, habit *entity.Habit, date string) (*entity.LeaderboardEntry, error) {
+	completions, err := s.confirmationRepo.CountByHabitIDBetween(ctx, habit.ID, challenge.StartDate, challenge.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &entity.LeaderboardEntry{
+		UserID:      habit.UserID,
+		Completions: completions,
+	}
+
+	if date >= challenge.StartDate {
+		entry.CurrentStreak = habit.Streak
+	}
+
+	return entry, nil
+}
+
+// rebuildLeaderboard recalculates every score of a challenge from PostgreSQL
+func (s *challengeService) rebuildLeaderboard(ctx context.Context, challenge *entity.Challenge) error {
+	participants, err := s.challengeRepo.GetParticipants(ctx, challenge.ID)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]*entity.LeaderboardEntry, 0, len(participants))
+	for _, participant := range participants {
+		habit, err := s.habitRepo.GetByID(ctx, participant.HabitID)
+		if err != nil {
+			return err
+		}
+
+		entry, err := s.scoreHabit(ctx, challenge, habit, habit.GetCurrentLocalDate())
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return s.leaderboards.Replace(ctx, challenge.ID, entries)
+}
+
+// withInviteCode assigns a fresh invite code and saves, retrying while the code is taken
+func (s *challengeService) withInviteCode(challenge *entity.Challenge, save func() error) error {
+	for attempt := 0; attempt < inviteCodeAttempts; attempt++ {
+		code, err := generateInviteCode()
+		if err != nil {
+			return err
+		}
+		challenge.InviteCode = code
+
+		err = save()
+		if !errors.Is(err, service.ErrAlreadyExists) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("failed to generate a unique invite code")
+}
+
+func generateInviteCode() (string, error) {
+	alphabetSize := big.NewInt(int64(len(inviteCodeAlphabet)))
+
+	code := make([]byte, inviteCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate invite code: %w", err)
+		}
+		code[i] = inviteCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
+
+func validateChallengeName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w name: must not be empty", service.ErrInvalidArgument)
+	}
+	if len([]rune(name)) > maxChallengeNameLength {
+		return fmt.Errorf("%w name: must be at most %d characters", service.ErrInvalidArgument, maxChallengeNameLength)
+	}
+	return nil
+}
+
+func validateChallengeWindow(startDate, endDate string) error {
+	start, err := time.Parse(dateLayout, startDate)
+	if err != nil {
+		return fmt.Errorf("%w start_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	end, err := time.Parse(dateLayout, endDate)
+	if err != nil {
+		return fmt.Errorf("%w end_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	if end.Before(start) {
+		return fmt.Errorf("%w end_date: must not be before start_date", service.ErrInvalidArgument)
+	}
+
+	if days := int(end.Sub(start).Hours()/24) + 1; days > maxChallengeDays {
+		return fmt.Errorf("%w end_date: a challenge lasts at most %d days", service.ErrInvalidArgument, maxChallengeDays)
+	}
+
+	// A day of slack, since participants' local dates can lag behind UTC
+	if end.Before(time.Now().UTC().AddDate(0, 0, -1).Truncate(24 * time.Hour)) {
+		return fmt.Errorf("%w end_date: must not be in the past", service.ErrInvalidArgument)
+	}
+
+	return nil
+}
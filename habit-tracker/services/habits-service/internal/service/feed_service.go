@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"habits-service/internal/infrastructure/kafka"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// feedCursorLayout formats the occurred_at of the last item of a page into its cursor
+const feedCursorLayout = time.RFC3339Nano
+
+type feedService struct {
+	feedRepo repository.FeedRepository
+	producer *kafka.Producer
+}
+
+// NewFeedService creates a new activity feed service.
+// Confirmations are published as habit events, the feed consumer fans them out
+func NewFeedService(feedRepo repository.FeedRepository, producer *kafka.Producer) service.FeedService {
+	return &feedService{
+		feedRepo: feedRepo,
+		producer: producer,
+	}
+}
+
+func (s *feedService) PublishConfirmation(ctx context.Context, habit *entity.Habit, confirmation *entity.HabitConfirmation) {
+	if !habit.IsVisibleToFollowers() {
+		return
+	}
+
+	event := &kafka.HabitConfirmedEvent{
+		EventID:          kafka.NewEventID(),
+		UserID:           habit.UserID.String(),
+		HabitID:          habit.ID.String(),
+		ConfirmationID:   confirmation.ID.String(),
+		ConfirmedForDate: confirmation.ConfirmedForDate,
+		Streak:           habit.Streak,
+		ConfirmedAt:      confirmation.ConfirmedAt,
+	}
+
+	if err := s.producer.PublishHabitConfirmedEvent(ctx, event); err != nil {
+		fmt.Printf("Failed to publish confirmation of habit %s: %v\n", habit.ID, err)
+	}
+}
+
+func (s *feedService) FanOut(ctx context.Context, item *entity.FeedItem) error {
+	if item.Kind != entity.FeedItemConfirmation && item.Kind != entity.FeedItemMilestone {
+		return fmt.Errorf("%w feed item kind %q", service.ErrInvalidArgument, item.Kind)
+	}
+
+	_, err := s.feedRepo.FanOut(ctx, item)
+	return err
+}
+
+func (s *feedService) GetFeed(ctx context.Context, userID uuid.UUID, page entity.PageRequest) (*entity.FeedPage, error) {
+	if page.After != nil {
+		if _, err := time.Parse(feedCursorLayout, page.After.Key); err != nil {
+			return nil, service.ErrInvalidPageToken
+		}
+	}
+
+	// One extra row tells whether there is a next page
+	items, err := s.feedRepo.GetPage(ctx, userID, page.Size+1, page.After)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity.FeedPage{Items: items}
+	if int32(len(items)) > page.Size {
+		result.Items = items[:page.Size]
+		last := result.Items[len(result.Items)-1]
+		result.Next = &entity.PageCursor{Key: last.OccurredAt.UTC().Format(feedCursorLayout), ID: last.ID}
+	}
+
+	for _, item := range result.Items {
+		if item.AchievementCode == nil {
+			continue
+		}
+		if achievement := findAchievement(*item.AchievementCode); achievement != nil {
+			item.AchievementName = &achievement.Name
+		}
+	}
+
+	return result, nil
+}
+
+func (s *feedService) PurgeUserData(ctx context.Context, userID uuid.UUID) error {
+	return s.feedRepo.DeleteByUserID(ctx, userID)
+}
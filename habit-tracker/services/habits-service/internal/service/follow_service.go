@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	userpb "habits-service/proto/user/v1"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type followService struct {
+	followRepo repository.FollowRepository
+	feedRepo   repository.FeedRepository
+	userClient userpb.UserServiceClient
+}
+
+// NewFollowService creates a new follow service. Users are resolved through user-service
+func NewFollowService(
+	followRepo repository.FollowRepository,
+	feedRepo repository.FeedRepository,
+	userClient userpb.UserServiceClient,
+) service.FollowService {
+	return &followService{
+		followRepo: followRepo,
+		feedRepo:   feedRepo,
+		userClient: userClient,
+	}
+}
+
+func (s *followService) Follow(ctx context.Context, followerID uuid.UUID, username string) (*entity.Follow, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, fmt.Errorf("%w username: must not be empty", service.ErrInvalidArgument)
+	}
+
+	resp, err := s.userClient.GetUserByUsername(ctx, &userpb.GetUserByUsernameRequest{Username: username})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("%w username: user not found", service.ErrInvalidArgument)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	followee := resp.User
+	if !followee.IsActive || followee.DeletionScheduledAt != nil {
+		return nil, fmt.Errorf("%w username: user not found", service.ErrInvalidArgument)
+	}
+
+	followeeID, err := uuid.Parse(followee.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user id: %w", err)
+	}
+
+	if followeeID == followerID {
+		return nil, fmt.Errorf("%w username: you can't follow yourself", service.ErrInvalidArgument)
+	}
+
+	followerResp, err := s.userClient.GetUser(ctx, &userpb.GetUserRequest{UserId: followerID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s: %w", followerID, err)
+	}
+
+	follow := &entity.Follow{
+		FollowerID:       followerID,
+		FollowerUsername: followerResp.User.Username,
+		FolloweeID:       followeeID,
+		FolloweeUsername: followee.Username,
+		CreatedAt:        time.Now().UTC(),
+	}
+
+	if err := s.followRepo.Create(ctx, follow); err != nil {
+		return nil, err
+	}
+
+	return follow, nil
+}
+
+func (s *followService) RespondToRequest(ctx context.Context, followeeID, followerID uuid.UUID, accept bool) (*entity.Follow, error) {
+	if !accept {
+		return nil, s.followRepo.DeletePending(ctx, followerID, followeeID)
+	}
+
+	if err := s.followRepo.Accept(ctx, followerID, followeeID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	return s.followRepo.Get(ctx, followerID, followeeID)
+}
+
+func (s *followService) Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	return s.deleteFollow(ctx, followerID, followeeID)
+}
+
+func (s *followService) RemoveFollower(ctx context.Context, followeeID, followerID uuid.UUID) error {
+	return s.deleteFollow(ctx, followerID, followeeID)
+}
+
+// deleteFollow deletes a follow with the followee's activity in the follower's feed.
+// Feeds are filtered by follows on read, so a failed cleanup only leaves unreachable rows
+func (s *followService) deleteFollow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	if err := s.followRepo.Delete(ctx, followerID, followeeID); err != nil {
+		return err
+	}
+
+	if err := s.feedRepo.DeleteByActor(ctx, followerID, followeeID); err != nil {
+		fmt.Printf("Failed to clean up feed of user %s: %v\n", followerID, err)
+	}
+
+	return nil
+}
+
+func (s *followService) ListFollowers(ctx context.Context, userID uuid.UUID) ([]*entity.Follow, error) {
+	return s.followRepo.GetFollowers(ctx, userID, entity.FollowStatusAccepted)
+}
+
+func (s *followService) ListFollowing(ctx context.Context, userID uuid.UUID) ([]*entity.Follow, error) {
+	return s.followRepo.GetFollowing(ctx, userID)
+}
+
+func (s *followService) ListRequests(ctx context.Context, userID uuid.UUID) ([]*entity.Follow, error) {
+	return s.followRepo.GetFollowers(ctx, userID, entity.FollowStatusPending)
+}
+
+func (s *followService) IsFollowing(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error) {
+	return s.followRepo.IsFollowing(ctx, followerID, followeeID)
+}
+
+func (s *followService) PurgeUserData(ctx context.Context, userID uuid.UUID) error {
+	return s.followRepo.DeleteByUserID(ctx, userID)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const maxGroupNameLength = 100
+
+type habitGroupService struct {
+	groupRepo repository.HabitGroupRepository
+}
+
+// NewHabitGroupService creates a new habit group service
+func NewHabitGroupService(groupRepo repository.HabitGroupRepository) service.HabitGroupService {
+	return &habitGroupService{
+		groupRepo: groupRepo,
+	}
+}
+
+func (s *habitGroupService) CreateGroup(ctx context.Context, userID uuid.UUID, name string, color, icon *string) (*entity.HabitGroup, error) {
+	name = strings.TrimSpace(name)
+	if err := validateGroupName(name); err != nil {
+		return nil, err
+	}
+
+	icon, err := normalizeIcon(icon)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	group := &entity.HabitGroup{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Color:     clearIfEmpty(color),
+		Icon:      icon,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.groupRepo.Create(ctx, group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *habitGroupService) ListGroups(ctx context.Context, userID uuid.UUID) ([]*entity.HabitGroup, error) {
+	return s.groupRepo.GetByUserID(ctx, userID)
+}
+
+func (s *habitGroupService) UpdateGroup(ctx context.Context, groupID, userID uuid.UUID, name, color, icon *string) (*entity.HabitGroup, error) {
+	group, err := s.groupRepo.GetByIDAndUserID(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		if err := validateGroupName(trimmed); err != nil {
+			return nil, err
+		}
+		group.Name = trimmed
+	}
+
+	if color != nil {
+		group.Color = clearIfEmpty(color)
+	}
+
+	if icon != nil {
+		group.Icon, err = normalizeIcon(icon)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.groupRepo.Update(ctx, group); err != nil {
+		return nil, err
+	}
+
+	group.UpdatedAt = time.Now().UTC()
+
+	return group, nil
+}
+
+func (s *habitGroupService) DeleteGroup(ctx context.Context, groupID, userID uuid.UUID) error {
+	return s.groupRepo.Delete(ctx, groupID, userID)
+}
+
+func (s *habitGroupService) ReorderGroups(ctx context.Context, userID uuid.UUID, groupIDs []uuid.UUID) ([]*entity.HabitGroup, error) {
+	if err := validateReorderIDs(groupIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepo.Reorder(ctx, userID, groupIDs); err != nil {
+		return nil, err
+	}
+
+	return s.groupRepo.GetByUserID(ctx, userID)
+}
+
+func validateGroupName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w name: must not be empty", service.ErrInvalidArgument)
+	}
+	if len([]rune(name)) > maxGroupNameLength {
+		return fmt.Errorf("%w name: must be at most %d characters", service.ErrInvalidArgument, maxGroupNameLength)
+	}
+	return nil
+}
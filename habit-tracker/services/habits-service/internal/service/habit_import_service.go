@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const importJobTimeout = 30 * time.Minute
+
+type habitImportService struct {
+	importRepo   repository.HabitImportRepository
+	habitRepo    repository.HabitRepository
+	habitService service.HabitService
+}
+
+// NewHabitImportService creates a new habit import service
+func NewHabitImportService(
+	importRepo repository.HabitImportRepository,
+	habitRepo repository.HabitRepository,
+	habitService service.HabitService,
+) service.HabitImportService {
+	return &habitImportService{
+		importRepo:   importRepo,
+		habitRepo:    habitRepo,
+		habitService: habitService,
+	}
+}
+
+func (s *habitImportService) ImportHabits(ctx context.Context, userID uuid.UUID, source entity.HabitImportSource, data []byte,
+	timezone string, dryRun bool) (*entity.HabitImport, error) {
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w data: file is empty", service.ErrInvalidArgument)
+	}
+
+	timezoneOffset, err := ConvertTimezoneToOffset(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := parseImport(source, data, timezoneOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.skipExisting(ctx, userID, plan); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	habitImport := &entity.HabitImport{
+		UserID:      userID,
+		Source:      source,
+		Status:      entity.HabitImportStatusPending,
+		DryRun:      dryRun,
+		TotalHabits: int32(len(plan.habits)),
+		SkippedRows: plan.skippedRows,
+		Habits:      plan.habits,
+		Issues:      plan.issues,
+		CreatedAt:   now,
+	}
+	for _, habit := range plan.habits {
+		habitImport.TotalConfirmations += int32(len(habit.Dates))
+	}
+
+	if dryRun {
+		habitImport.Status = entity.HabitImportStatusCompleted
+		habitImport.FinishedAt = &now
+		return habitImport, nil
+	}
+
+	inProgress, err := s.importRepo.GetInProgressByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if inProgress != nil {
+		return nil, fmt.Errorf("habit import in progress %w", service.ErrAlreadyExists)
+	}
+
+	habitImport.ID = uuid.New()
+	if err := s.importRepo.Create(ctx, habitImport); err != nil {
+		// Lost the race with a concurrent request
+		if inProgress, getErr := s.importRepo.GetInProgressByUserID(ctx, userID); getErr == nil && inProgress != nil {
+			return nil, fmt.Errorf("habit import in progress %w", service.ErrAlreadyExists)
+		}
+		return nil, err
+	}
+
+	go s.runImport(habitImport.ID, userID, plan.habits, timezoneOffset)
+
+	return habitImport, nil
+}
+
+// skipExisting drops habits named like one the user already has, so that an import can be repeated
+func (s *habitImportService) skipExisting(ctx context.Context, userID uuid.UUID, plan *importPlan) error {
+	existing, err := s.habitRepo.GetByUserID(ctx, userID, entity.HabitStatusAll)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(existing))
+	for _, habit := range existing {
+		names[strings.ToLower(habit.Name)] = true
+	}
+
+	habits := plan.habits[:0]
+	for _, habit := range plan.habits {
+		if names[strings.ToLower(habit.Name)] {
+			plan.issue(nil, habit.Name, "a habit with this name already exists")
+			continue
+		}
+		habits = append(habits, habit)
+	}
+	plan.habits = habits
+
+	return nil
+}
+
+func (s *habitImportService) GetImport(ctx context.Context, userID, importID uuid.UUID) (*entity.HabitImport, error) {
+	habitImport, err := s.importRepo.GetByID(ctx, importID)
+	if err != nil {
+		return nil, err
+	}
+
+	if habitImport.UserID != userID {
+		return nil, fmt.Errorf("habit import %w", service.ErrNotFound)
+	}
+
+	return habitImport, nil
+}
+
+func (s *habitImportService) FailInterrupted(ctx context.Context) error {
+	failed, err := s.importRepo.FailInProgress(ctx, "import was interrupted, please upload the file again")
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		fmt.Printf("Marked %d interrupted habit imports as failed\n", failed)
+	}
+	return nil
+}
+
+// runImport creates the mapped habits one by one, it runs detached from the request.
+// A habit that fails is reported as an issue and the others are still imported
+func (s *habitImportService) runImport(importID, userID uuid.UUID, habits []*entity.ImportedHabit, timezoneOffset int32) {
+	ctx, cancel := context.WithTimeout(context.Background(), importJobTimeout)
+	defer cancel()
+
+	if err := s.importRepo.MarkProcessing(ctx, importID); err != nil {
+		fmt.Printf("Failed to mark habit import %s as processing: %v\n", importID, err)
+	}
+
+	for _, imported := range habits {
+		if ctx.Err() != nil {
+			s.fail(importID, "import timed out")
+			return
+		}
+
+		if _, err := s.habitService.ImportHabit(ctx, userID, imported, timezoneOffset); err != nil {
+			fmt.Printf("Failed to import habit %q of import %s: %v\n", imported.Name, importID, err)
+
+			name := imported.Name
+			issue := &entity.ImportIssue{HabitName: &name, Reason: "habit could not be saved"}
+			if err := s.importRepo.AddIssues(ctx, importID, []*entity.ImportIssue{issue}); err != nil {
+				fmt.Printf("Failed to record issue of habit import %s: %v\n", importID, err)
+			}
+			continue
+		}
+
+		if err := s.importRepo.AddProgress(ctx, importID, int32(len(imported.Dates))); err != nil {
+			fmt.Printf("Failed to update progress of habit import %s: %v\n", importID, err)
+		}
+	}
+
+	if err := s.importRepo.MarkCompleted(ctx, importID); err != nil {
+		fmt.Printf("Failed to mark habit import %s as completed: %v\n", importID, err)
+	}
+}
+
+// fail marks a job as failed with a fresh context, the job's own may have expired
+func (s *habitImportService) fail(importID uuid.UUID, reason string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := s.importRepo.MarkFailed(ctx, importID, reason); err != nil {
+		fmt.Printf("Failed to mark habit import %s as failed: %v\n", importID, err)
+	}
+}
@@ -2,28 +2,75 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"habits-service/internal/domain/entity"
 	"habits-service/internal/domain/repository"
 	"habits-service/internal/domain/service"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// exportFeedPageSize is the number of feed items read at once for a data export
+const exportFeedPageSize = 500
+
 type habitService struct {
-	habitRepo        repository.HabitRepository
-	confirmationRepo repository.HabitConfirmationRepository
+	habitRepo          repository.HabitRepository
+	confirmationRepo   repository.HabitConfirmationRepository
+	pauseRepo          repository.HabitPauseRepository
+	analyticsRepo      repository.AnalyticsRepository
+	groupRepo          repository.HabitGroupRepository
+	templateRepo       repository.HabitTemplateRepository
+	importRepo         repository.HabitImportRepository
+	partnerService     service.HabitPartnerService
+	challengeService   service.ChallengeService
+	achievementService service.AchievementService
+	followService      service.FollowService
+	feedService        service.FeedService
+	calendarService    service.CalendarFeedService
+	journalService     service.JournalService
+	attachmentService  service.AttachmentService
 }
 
 // NewHabitService creates a new habit service
 func NewHabitService(
 	habitRepo repository.HabitRepository,
 	confirmationRepo repository.HabitConfirmationRepository,
+	pauseRepo repository.HabitPauseRepository,
+	analyticsRepo repository.AnalyticsRepository,
+	groupRepo repository.HabitGroupRepository,
+	templateRepo repository.HabitTemplateRepository,
+	importRepo repository.HabitImportRepository,
+	partnerService service.HabitPartnerService,
+	challengeService service.ChallengeService,
+	achievementService service.AchievementService,
+	followService service.FollowService,
+	feedService service.FeedService,
+	calendarService service.CalendarFeedService,
+	journalService service.JournalService,
+	attachmentService service.AttachmentService,
 ) service.HabitService {
 	return &habitService{
-		habitRepo:        habitRepo,
-		confirmationRepo: confirmationRepo,
+		habitRepo:          habitRepo,
+		confirmationRepo:   confirmationRepo,
+		pauseRepo:          pauseRepo,
+		analyticsRepo:      analyticsRepo,
+		groupRepo:          groupRepo,
+		templateRepo:       templateRepo,
+		importRepo:         importRepo,
+		partnerService:     partnerService,
+		challengeService:   challengeService,
+		achievementService: achievementService,
+		followService:      followService,
+		feedService:        feedService,
+		calendarService:    calendarService,
+		journalService:     journalService,
+		attachmentService:  attachmentService,
 	}
 }
 
@@ -31,7 +78,7 @@ func NewHabitService(
 func ConvertTimezoneToOffset(timezone string) (int32, error) {
 	loc, err := time.LoadLocation(timezone)
 	if err != nil {
-		return 0, fmt.Errorf("invalid timezone: %w", err)
+		return 0, fmt.Errorf("%w timezone: %w", service.ErrInvalidArgument, err)
 	}
 
 	now := time.Now().In(loc)
@@ -129,7 +176,8 @@ func (s *habitService) findNextWeeklyDeadline(fromTime time.Time, weeklyDays []i
 }
 
 func (s *habitService) CreateHabit(ctx context.Context, userID uuid.UUID, name string, description, color *string,
-	scheduleType entity.ScheduleType, intervalDays *int32, weeklyDays []int32, timezone string) (*entity.Habit, error) {
+	scheduleType entity.ScheduleType, intervalDays *int32, weeklyDays []int32, timezone string,
+	groupID *uuid.UUID, icon *string, tags []string, visibility *entity.HabitVisibility) (*entity.Habit, error) {
 
 	if scheduleType == entity.ScheduleTypeInterval && (intervalDays == nil || *intervalDays <= 0) {
 		return nil, fmt.Errorf("interval_days is required and must be positive for interval schedule")
@@ -144,6 +192,26 @@ func (s *habitService) CreateHabit(ctx context.Context, userID uuid.UUID, name s
 		return nil, fmt.Errorf("failed to convert timezone: %w", err)
 	}
 
+	tags, err = normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	icon, err = normalizeIcon(icon)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID, err = s.resolveGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	habitVisibility, err := resolveVisibility(visibility, entity.HabitVisibilityPrivate)
+	if err != nil {
+		return nil, err
+	}
+
 	confirmedForCurrentPeriod := false
 	if scheduleType == entity.ScheduleTypeWeekly {
 		// Get current time in user's timezone
@@ -170,6 +238,10 @@ func (s *habitService) CreateHabit(ctx context.Context, userID uuid.UUID, name s
 		Name:                      name,
 		Description:               description,
 		Color:                     color,
+		Icon:                      icon,
+		GroupID:                   groupID,
+		Tags:                      tags,
+		Visibility:                habitVisibility,
 		ScheduleType:              scheduleType,
 		IntervalDays:              intervalDays,
 		WeeklyDays:                weeklyDays,
@@ -192,26 +264,336 @@ func (s *habitService) CreateHabit(ctx context.Context, userID uuid.UUID, name s
 	return habit, nil
 }
 
+func (s *habitService) ImportHabit(ctx context.Context, userID uuid.UUID, imported *entity.ImportedHabit, timezoneOffset int32) (*entity.Habit, error) {
+	if imported.ScheduleType == entity.ScheduleTypeInterval && (imported.IntervalDays == nil || *imported.IntervalDays <= 0) {
+		return nil, fmt.Errorf("interval_days is required and must be positive for interval schedule")
+	}
+
+	if imported.ScheduleType == entity.ScheduleTypeWeekly && len(imported.WeeklyDays) == 0 {
+		return nil, fmt.Errorf("weekly_days is required for weekly schedule")
+	}
+
+	now := time.Now().UTC()
+	offset := time.Duration(timezoneOffset) * time.Hour
+
+	habit := &entity.Habit{
+		ID:                  uuid.New(),
+		UserID:              userID,
+		Name:                imported.Name,
+		Description:         imported.Description,
+		Color:               imported.Color,
+		Visibility:          entity.HabitVisibilityPrivate,
+		ScheduleType:        imported.ScheduleType,
+		IntervalDays:        imported.IntervalDays,
+		WeeklyDays:          imported.WeeklyDays,
+		TimezoneOffsetHours: timezoneOffset,
+		IsActive:            true,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	// Imported days carry no time of day, they are confirmed at local noon
+	confirmations := make([]*entity.HabitConfirmation, 0, len(imported.Dates))
+	for _, date := range imported.Dates {
+		day, err := time.Parse(dateLayout, date)
+		if err != nil {
+			return nil, fmt.Errorf("%w date %q: expected YYYY-MM-DD", service.ErrInvalidArgument, date)
+		}
+
+		confirmations = append(confirmations, &entity.HabitConfirmation{
+			ID:               uuid.New(),
+			HabitID:          habit.ID,
+			UserID:           userID,
+			ConfirmedAt:      day.Add(12*time.Hour - offset),
+			ConfirmedForDate: date,
+			CreatedAt:        now,
+		})
+	}
+
+	// The habit is backdated to its first day so that its schedule covers the history
+	if len(confirmations) > 0 {
+		first, _ := time.Parse(dateLayout, imported.Dates[0])
+		habit.CreatedAt = first.Add(-offset)
+
+		last := confirmations[len(confirmations)-1].ConfirmedAt
+		habit.LastConfirmedAt = &last
+	}
+
+	today, _, err := evaluationDates(habit)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []*entity.ScheduleVersion{{
+		HabitID:       habit.ID,
+		ScheduleType:  habit.ScheduleType,
+		IntervalDays:  habit.IntervalDays,
+		WeeklyDays:    habit.WeeklyDays,
+		EffectiveFrom: habit.GetLocalDate(habit.CreatedAt),
+	}}
+	habit.Streak = computeHabitStats(versions, confirmations, nil, today).CurrentStreak
+
+	// A period confirmed in the other tracker stays confirmed, as if confirmed here
+	if habit.LastConfirmedAt != nil {
+		if next := s.CalculateNextDeadline(habit, *habit.LastConfirmedAt); next.After(now) {
+			habit.NextDeadlineUTC = next
+			habit.ConfirmedForCurrentPeriod = true
+		}
+	}
+
+	if !habit.ConfirmedForCurrentPeriod {
+		habit.NextDeadlineUTC = s.CalculateInitialDeadline(habit, now)
+		// Weekly habits not scheduled today have nothing to confirm until their next day
+		habit.ConfirmedForCurrentPeriod = habit.GetLocalDate(habit.NextDeadlineUTC) != habit.GetLocalDate(now)
+	}
+
+	if err := s.habitRepo.Import(ctx, habit, confirmations); err != nil {
+		return nil, fmt.Errorf("failed to import habit: %w", err)
+	}
+
+	return habit, nil
+}
+
+// resolveGroup checks that the group belongs to the user. uuid.Nil means no group
+func (s *habitService) resolveGroup(ctx context.Context, userID uuid.UUID, groupID *uuid.UUID) (*uuid.UUID, error) {
+	if groupID == nil || *groupID == uuid.Nil {
+		return nil, nil
+	}
+
+	if _, err := s.groupRepo.GetByIDAndUserID(ctx, *groupID, userID); err != nil {
+		return nil, err
+	}
+
+	return groupID, nil
+}
+
+// resolveVisibility validates a requested visibility, nil keeps current
+func resolveVisibility(visibility *entity.HabitVisibility, current entity.HabitVisibility) (entity.HabitVisibility, error) {
+	if visibility == nil {
+		return current, nil
+	}
+
+	switch *visibility {
+	case entity.HabitVisibilityPrivate, entity.HabitVisibilityFriends, entity.HabitVisibilityPublic:
+		return *visibility, nil
+	default:
+		return "", fmt.Errorf("%w visibility: must be private, friends or public", service.ErrInvalidArgument)
+	}
+}
+
 func (s *habitService) GetHabit(ctx context.Context, habitID, userID uuid.UUID) (*entity.Habit, error) {
+	habit, err := s.getReadableHabit(ctx, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.markPaused(ctx, habit.UserID, habit); err != nil {
+		return nil, err
+	}
+
+	return habit, nil
+}
+
+// getReadableHabit retrieves a habit of the user, or an active habit of another user the user can see.
+// Anything else is reported the same way as a missing habit
+func (s *habitService) getReadableHabit(ctx context.Context, habitID, userID uuid.UUID) (*entity.Habit, error) {
 	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
+	if !errors.Is(err, service.ErrNotFound) {
+		return habit, err
+	}
+	notFound := err
+
+	habit, err = s.habitRepo.GetByID(ctx, habitID)
 	if err != nil {
+		if errors.Is(err, service.ErrHabitNotFound) {
+			return nil, notFound
+		}
 		return nil, err
 	}
 
+	if !habit.IsActive {
+		return nil, notFound
+	}
+
+	visible, err := s.isVisibleTo(ctx, habit, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !visible {
+		return nil, notFound
+	}
+
 	return habit, nil
 }
 
-func (s *habitService) ListHabits(ctx context.Context, userID uuid.UUID, activeOnly bool) ([]*entity.Habit, int32, error) {
-	habits, err := s.habitRepo.GetByUserID(ctx, userID, activeOnly)
+// isVisibleTo reports whether a user other than the owner can see a habit: any user for public habits,
+// accepted followers of the owner for friends-only habits and accepted partners for any habit
+func (s *habitService) isVisibleTo(ctx context.Context, habit *entity.Habit, userID uuid.UUID) (bool, error) {
+	switch habit.Visibility {
+	case entity.HabitVisibilityPublic:
+		return true, nil
+	case entity.HabitVisibilityFriends:
+		following, err := s.followService.IsFollowing(ctx, userID, habit.UserID)
+		if err != nil || following {
+			return following, err
+		}
+	}
+
+	return s.partnerService.IsPartner(ctx, habit.ID, userID)
+}
+
+func (s *habitService) ListSharedHabits(ctx context.Context, userID uuid.UUID) ([]*entity.SharedHabit, error) {
+	shared, err := s.habitRepo.GetSharedWithUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	habitsByOwner := make(map[uuid.UUID][]*entity.Habit)
+	for _, item := range shared {
+		habitsByOwner[item.Habit.UserID] = append(habitsByOwner[item.Habit.UserID], item.Habit)
+	}
+
+	for ownerID, habits := range habitsByOwner {
+		if err := s.markPaused(ctx, ownerID, habits...); err != nil {
+			return nil, err
+		}
+	}
+
+	return shared, nil
+}
+
+func (s *habitService) ListHabits(ctx context.Context, userID uuid.UUID, filter entity.HabitFilter, page entity.PageRequest) (*entity.HabitPage, error) {
+	if page.After != nil {
+		// Positions are compared as INTEGER, larger values would fail in the query
+		if _, err := strconv.ParseInt(page.After.Key, 10, 32); err != nil {
+			return nil, service.ErrInvalidPageToken
+		}
+	}
+
+	if filter.Tag != nil {
+		tag := strings.ToLower(strings.TrimSpace(*filter.Tag))
+		filter.Tag = &tag
+	}
+
+	// One extra row tells whether there is a next page
+	habits, err := s.habitRepo.GetPageByUserID(ctx, userID, filter, page.Size+1, page.After)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+
+	result := &entity.HabitPage{Habits: habits}
+	if int32(len(habits)) > page.Size {
+		result.Habits = habits[:page.Size]
+		last := result.Habits[len(result.Habits)-1]
+		result.Next = &entity.PageCursor{Key: strconv.Itoa(int(last.Position)), ID: last.ID}
 	}
 
-	return habits, int32(len(habits)), nil
+	if page.IncludeTotal {
+		count, err := s.habitRepo.CountByUserID(ctx, userID, filter)
+		if err != nil {
+			return nil, err
+		}
+		result.TotalCount = &count
+	}
+
+	if err := s.markPaused(ctx, userID, result.Habits...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *habitService) ReorderHabits(ctx context.Context, userID uuid.UUID, habitIDs []uuid.UUID) error {
+	if err := validateReorderIDs(habitIDs); err != nil {
+		return err
+	}
+
+	return s.habitRepo.Reorder(ctx, userID, habitIDs)
+}
+
+func (s *habitService) ListTags(ctx context.Context, userID uuid.UUID) ([]*entity.TagUsage, error) {
+	return s.habitRepo.GetTagsByUserID(ctx, userID)
+}
+
+func (s *habitService) GetTodayAgenda(ctx context.Context, userID uuid.UUID, atRiskWithin time.Duration) (*entity.Agenda, error) {
+	habits, err := s.habitRepo.GetByUserID(ctx, userID, entity.HabitStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.markPaused(ctx, userID, habits...); err != nil {
+		return nil, err
+	}
+
+	// Earliest deadline first, the order users have to act in
+	sort.SliceStable(habits, func(i, j int) bool {
+		if !habits[i].NextDeadlineUTC.Equal(habits[j].NextDeadlineUTC) {
+			return habits[i].NextDeadlineUTC.Before(habits[j].NextDeadlineUTC)
+		}
+		return habits[i].Name < habits[j].Name
+	})
+
+	now := time.Now().UTC()
+	agenda := &entity.Agenda{}
+
+	for _, habit := range habits {
+		today := habit.GetLocalDate(now)
+		item := &entity.AgendaItem{
+			Habit:    habit,
+			DueDate:  habit.GetLocalDate(habit.NextDeadlineUTC),
+			TimeLeft: habit.NextDeadlineUTC.Sub(now),
+		}
+
+		switch {
+		case habit.LastConfirmedAt != nil && habit.GetLocalDate(*habit.LastConfirmedAt) == today:
+			agenda.Done = append(agenda.Done, item)
+		case habit.IsPaused || item.DueDate > today:
+			agenda.Upcoming = append(agenda.Upcoming, item)
+		case item.TimeLeft <= atRiskWithin:
+			agenda.AtRisk = append(agenda.AtRisk, item)
+		default:
+			agenda.Due = append(agenda.Due, item)
+		}
+	}
+
+	return agenda, nil
+}
+
+// markPaused flags habits covered by a pending pause today. The current period of a
+// paused habit is treated as confirmed so clients don't ask for a confirmation
+func (s *habitService) markPaused(ctx context.Context, userID uuid.UUID, habits ...*entity.Habit) error {
+	pauses, err := s.pauseRepo.GetPendingByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if len(pauses) == 0 {
+		return nil
+	}
+
+	pausesByHabit := make(map[uuid.UUID][]*entity.HabitPause)
+	for _, pause := range pauses {
+		pausesByHabit[pause.HabitID] = append(pausesByHabit[pause.HabitID], pause)
+	}
+
+	for _, habit := range habits {
+		today := habit.GetCurrentLocalDate()
+		for _, pause := range pausesByHabit[habit.ID] {
+			if pause.CoversDate(today) {
+				habit.IsPaused = true
+				habit.ConfirmedForCurrentPeriod = true
+				break
+			}
+		}
+	}
+
+	return nil
 }
 
 func (s *habitService) UpdateHabit(ctx context.Context, habitID, userID uuid.UUID, name *string, description, color *string,
-	scheduleType *entity.ScheduleType, intervalDays *int32, weeklyDays []int32, timezone *string) (*entity.Habit, error) {
+	scheduleType *entity.ScheduleType, intervalDays *int32, weeklyDays []int32, timezone *string,
+	groupID *uuid.UUID, icon *string, tags []string, visibility *entity.HabitVisibility) (*entity.Habit, error) {
 
 	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
 	if err != nil {
@@ -230,6 +612,32 @@ func (s *habitService) UpdateHabit(ctx context.Context, habitID, userID uuid.UUI
 		habit.Color = color
 	}
 
+	if icon != nil {
+		habit.Icon, err = normalizeIcon(icon)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if groupID != nil {
+		habit.GroupID, err = s.resolveGroup(ctx, userID, groupID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if tags != nil {
+		habit.Tags, err = normalizeTags(tags)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	habit.Visibility, err = resolveVisibility(visibility, habit.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
 	needsDeadlineRecalc := false
 
 	if scheduleType != nil {
@@ -280,20 +688,81 @@ func (s *habitService) UpdateHabit(ctx context.Context, habitID, userID uuid.UUI
 }
 
 func (s *habitService) DeleteHabit(ctx context.Context, habitID, userID uuid.UUID) error {
-	_, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
+	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
 	if err != nil {
 		return err
 	}
 
-	return s.habitRepo.Delete(ctx, habitID)
+	if habit.IsArchived() {
+		return nil
+	}
+
+	return s.habitRepo.Archive(ctx, habitID)
+}
+
+func (s *habitService) ArchiveHabit(ctx context.Context, habitID, userID uuid.UUID) (*entity.Habit, error) {
+	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if habit.IsArchived() {
+		return nil, service.ErrHabitArchived
+	}
+
+	if err := s.habitRepo.Archive(ctx, habitID); err != nil {
+		return nil, err
+	}
+
+	return s.habitRepo.GetByID(ctx, habitID)
+}
+
+func (s *habitService) UnarchiveHabit(ctx context.Context, habitID, userID uuid.UUID) (*entity.Habit, error) {
+	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !habit.IsArchived() {
+		return nil, service.ErrHabitNotArchived
+	}
+
+	// The old deadline passed while the habit was archived, start a new period from now
+	nextDeadline := s.CalculateInitialDeadline(habit, time.Now().UTC())
+
+	if err := s.habitRepo.Unarchive(ctx, habitID, nextDeadline); err != nil {
+		return nil, err
+	}
+
+	return s.habitRepo.GetByID(ctx, habitID)
 }
 
-func (s *habitService) ConfirmHabit(ctx context.Context, habitID, userID uuid.UUID, notes *string) (*entity.Habit, *entity.HabitConfirmation, error) {
+func (s *habitService) PurgeHabit(ctx context.Context, habitID, userID uuid.UUID) (int64, error) {
+	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !habit.IsArchived() {
+		return 0, fmt.Errorf("%w, it must be archived before purging", service.ErrHabitNotArchived)
+	}
+
+	// Participants are deleted with the habit, their leaderboard entries must go first
+	s.challengeService.RemoveHabit(ctx, habitID)
+
+	return s.habitRepo.Purge(ctx, habitID)
+}
+
+func (s *habitService) ConfirmHabit(ctx context.Context, habitID, userID uuid.UUID, notes *string, attachmentIDs []uuid.UUID) (*entity.Habit, *entity.HabitConfirmation, error) {
 	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if habit.IsArchived() {
+		return nil, nil, service.ErrHabitArchived
+	}
+
 	if habit.ConfirmedForCurrentPeriod {
 		return nil, nil, fmt.Errorf("habit already confirmed for current period")
 	}
@@ -310,6 +779,10 @@ func (s *habitService) ConfirmHabit(ctx context.Context, habitID, userID uuid.UU
 		return nil, nil, fmt.Errorf("habit already confirmed for date %s", currentDate)
 	}
 
+	if err := s.attachmentService.ValidateAttachable(ctx, userID, attachmentIDs); err != nil {
+		return nil, nil, err
+	}
+
 	confirmation := &entity.HabitConfirmation{
 		ID:               uuid.New(),
 		HabitID:          habitID,
@@ -318,8 +791,11 @@ func (s *habitService) ConfirmHabit(ctx context.Context, habitID, userID uuid.UU
 		ConfirmedForDate: currentDate,
 		Notes:            notes,
 		CreatedAt:        time.Now().UTC(),
+		AttachmentIDs:    attachmentIDs,
 	}
 
+	// The attachments are linked with the confirmation, a concurrent confirmation that used one of
+	// them since the validation makes this fail as a whole
 	if err := s.confirmationRepo.Create(ctx, confirmation); err != nil {
 		return nil, nil, fmt.Errorf("failed to create confirmation: %w", err)
 	}
@@ -335,40 +811,518 @@ func (s *habitService) ConfirmHabit(ctx context.Context, habitID, userID uuid.UU
 		return nil, nil, fmt.Errorf("failed to update habit: %w", err)
 	}
 
+	s.challengeService.RecordProgress(ctx, habit, currentDate)
+	s.feedService.PublishConfirmation(ctx, habit, confirmation)
+	s.achievementService.HandleConfirmation(ctx, habit, confirmation)
+
 	return habit, confirmation, nil
 }
 
-func (s *habitService) GetHabitHistory(ctx context.Context, habitID, userID uuid.UUID, limit, offset int32) ([]*entity.HabitConfirmation, int32, error) {
-	_, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
+func (s *habitService) PauseHabits(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID, startDate, endDate string, reason *string) ([]*entity.HabitPause, error) {
+	start, err := time.Parse("2006-01-02", startDate)
 	if err != nil {
-		return nil, 0, err
+		return nil, fmt.Errorf("%w start_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
 	}
 
-	confirmations, err := s.confirmationRepo.GetByHabitID(ctx, habitID, limit, offset)
+	end, err := time.Parse("2006-01-02", endDate)
 	if err != nil {
-		return nil, 0, err
+		return nil, fmt.Errorf("%w end_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	if end.Before(start) {
+		return nil, fmt.Errorf("%w end_date: must not be before start_date", service.ErrInvalidArgument)
+	}
+
+	var habits []*entity.Habit
+	if habitID != nil {
+		habit, err := s.habitRepo.GetByIDAndUserID(ctx, *habitID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if habit.IsArchived() {
+			return nil, service.ErrHabitArchived
+		}
+		habits = []*entity.Habit{habit}
+	} else {
+		habits, err = s.habitRepo.GetByUserID(ctx, userID, entity.HabitStatusActive)
+		if err != nil {
+			return nil, err
+		}
+		if len(habits) == 0 {
+			return nil, service.ErrNothingToPause
+		}
+	}
+
+	now := time.Now().UTC()
+	pauses := make([]*entity.HabitPause, 0, len(habits))
+	for _, habit := range habits {
+		if endDate < habit.GetCurrentLocalDate() {
+			return nil, fmt.Errorf("%w end_date: must not be in the past", service.ErrInvalidArgument)
+		}
+
+		overlaps, err := s.pauseRepo.ExistsOverlapping(ctx, habit.ID, startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		if overlaps {
+			return nil, service.ErrAlreadyPaused
+		}
+
+		pauses = append(pauses, &entity.HabitPause{
+			ID:        uuid.New(),
+			HabitID:   habit.ID,
+			UserID:    userID,
+			StartDate: startDate,
+			EndDate:   endDate,
+			Reason:    reason,
+			CreatedAt: now,
+		})
+	}
+
+	if err := s.pauseRepo.CreateBatch(ctx, pauses); err != nil {
+		return nil, fmt.Errorf("failed to create pauses: %w", err)
+	}
+
+	return pauses, nil
+}
+
+func (s *habitService) ResumeHabits(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID) (int32, error) {
+	if habitID != nil {
+		if _, err := s.habitRepo.GetByIDAndUserID(ctx, *habitID, userID); err != nil {
+			return 0, err
+		}
+	}
+
+	pauses, err := s.ListPauses(ctx, userID, habitID)
+	if err != nil {
+		return 0, err
+	}
+
+	var resumed int32
+	for _, pause := range pauses {
+		habit, err := s.habitRepo.GetByID(ctx, pause.HabitID)
+		if err != nil {
+			return resumed, err
+		}
+
+		today := habit.GetCurrentLocalDate()
+
+		// Upcoming pauses are cancelled, ongoing ones end today
+		if pause.StartDate > today {
+			err = s.pauseRepo.Delete(ctx, pause.ID)
+		} else {
+			err = s.pauseRepo.Resume(ctx, pause, today, s.CalculateInitialDeadline(habit, time.Now().UTC()))
+		}
+		if err != nil {
+			return resumed, fmt.Errorf("failed to resume habit %s: %w", habit.ID, err)
+		}
+
+		resumed++
 	}
 
-	count, err := s.confirmationRepo.CountByHabitID(ctx, habitID)
+	return resumed, nil
+}
+
+func (s *habitService) ListPauses(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID) ([]*entity.HabitPause, error) {
+	pauses, err := s.pauseRepo.GetPendingByUserID(ctx, userID)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+
+	if habitID == nil {
+		return pauses, nil
+	}
+
+	filtered := make([]*entity.HabitPause, 0, len(pauses))
+	for _, pause := range pauses {
+		if pause.HabitID == *habitID {
+			filtered = append(filtered, pause)
+		}
 	}
 
-	return confirmations, count, nil
+	return filtered, nil
 }
 
-func (s *habitService) GetHabitStats(ctx context.Context, habitID, userID uuid.UUID) (*repository.HabitStats, error) {
+func (s *habitService) GetHabitHistory(ctx context.Context, habitID, userID uuid.UUID, page entity.PageRequest) (*entity.HistoryPage, error) {
+	if page.After != nil {
+		if _, err := time.Parse(dateLayout, page.After.Key); err != nil {
+			return nil, service.ErrInvalidPageToken
+		}
+	}
+
 	_, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	stats, err := s.confirmationRepo.GetStats(ctx, habitID)
+	// One extra row tells whether there is a next page
+	confirmations, err := s.confirmationRepo.GetByHabitID(ctx, habitID, page.Size+1, page.After)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity.HistoryPage{Confirmations: confirmations}
+	if int32(len(confirmations)) > page.Size {
+		result.Confirmations = confirmations[:page.Size]
+		last := result.Confirmations[len(result.Confirmations)-1]
+		result.Next = &entity.PageCursor{Key: last.ConfirmedForDate, ID: last.ID}
+	}
+
+	confirmationIDs := make([]uuid.UUID, len(result.Confirmations))
+	for i, confirmation := range result.Confirmations {
+		confirmationIDs[i] = confirmation.ID
+	}
+	attachmentIDs, err := s.attachmentService.GetAttachmentIDs(ctx, confirmationIDs)
 	if err != nil {
 		return nil, err
 	}
+	for _, confirmation := range result.Confirmations {
+		confirmation.AttachmentIDs = attachmentIDs[confirmation.ID]
+	}
 
-	return stats, nil
+	if page.IncludeTotal {
+		count, err := s.confirmationRepo.CountByHabitID(ctx, habitID)
+		if err != nil {
+			return nil, err
+		}
+		result.TotalCount = &count
+	}
+
+	if page.After == nil {
+		result.Pauses, err = s.pauseRepo.GetByHabitID(ctx, habitID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (s *habitService) GetHabitStats(ctx context.Context, habitID, userID uuid.UUID) (*entity.HabitStats, error) {
+	habit, err := s.getReadableHabit(ctx, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := scheduleVersions(ctx, s.habitRepo, habit)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmations, err := s.confirmationRepo.GetAllByHabitID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	pauses, err := s.pauseRepo.GetByHabitID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, until, err := evaluationDates(habit)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeHabitStats(versions, confirmations, pauses, until), nil
+}
+
+func (s *habitService) GetHabitCalendar(ctx context.Context, habitID, userID uuid.UUID, fromDate, toDate string) ([]*entity.CalendarDay, error) {
+	from, err := time.Parse(dateLayout, fromDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w from_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	to, err := time.Parse(dateLayout, toDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w to_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w to_date: must not be before from_date", service.ErrInvalidArgument)
+	}
+
+	if to.Sub(from) >= maxCalendarDays*24*time.Hour {
+		return nil, fmt.Errorf("%w date range: must not exceed %d days", service.ErrInvalidArgument, maxCalendarDays)
+	}
+
+	habit, err := s.getReadableHabit(ctx, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := scheduleVersions(ctx, s.habitRepo, habit)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmations, err := s.confirmationRepo.GetAllByHabitID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	pauses, err := s.pauseRepo.GetByHabitID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	today, until, err := evaluationDates(habit)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make([]string, len(confirmations))
+	for i, confirmation := range confirmations {
+		dates[i] = confirmation.ConfirmedForDate
+	}
+
+	// Periods are generated past today so that upcoming due dates are marked
+	horizon := until
+	if habit.ArchivedAt == nil && to.After(horizon) {
+		horizon = to
+	}
+
+	periods := evaluatePeriods(buildSchedulePeriods(versions, horizon), dates, pauses, until)
+
+	var inRange []uuid.UUID
+	for _, confirmation := range confirmations {
+		if fromDate <= confirmation.ConfirmedForDate && confirmation.ConfirmedForDate <= toDate {
+			inRange = append(inRange, confirmation.ID)
+		}
+	}
+	attachmentIDs, err := s.attachmentService.GetAttachmentIDs(ctx, inRange)
+	if err != nil {
+		return nil, err
+	}
+
+	// Partners, followers and viewers of public habits see the states and photos but not the owner's notes
+	return buildCalendar(periods, confirmations, attachmentIDs, pauses, from, to, today, until, habit.UserID == userID), nil
+}
+
+func (s *habitService) OpenAttachment(ctx context.Context, attachmentID, userID uuid.UUID, thumbnail bool) (*entity.Attachment, io.ReadCloser, error) {
+	attachment, err := s.attachmentService.Get(ctx, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if attachment.UserID != userID {
+		if attachment.HabitID == nil {
+			return nil, nil, fmt.Errorf("attachment %w", service.ErrNotFound)
+		}
+
+		if _, err := s.getReadableHabit(ctx, *attachment.HabitID, userID); err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return nil, nil, fmt.Errorf("attachment %w", service.ErrNotFound)
+			}
+			return nil, nil, err
+		}
+	}
+
+	reader, err := s.attachmentService.Open(ctx, attachment, thumbnail)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, reader, nil
+}
+
+// evaluationDates returns today's local date of the habit and the last date its schedule
+// is evaluated for. Archived habits are evaluated up to the day they were archived
+func evaluationDates(habit *entity.Habit) (time.Time, time.Time, error) {
+	today, err := time.Parse(dateLayout, habit.GetCurrentLocalDate())
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse local date: %w", err)
+	}
+
+	until := today
+	if habit.ArchivedAt != nil {
+		until, err = time.Parse(dateLayout, habit.GetLocalDate(*habit.ArchivedAt))
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("failed to parse archive date: %w", err)
+		}
+	}
+
+	return today, until, nil
+}
+
+// scheduleVersions returns the schedule history of a habit. Habits without recorded
+// history fall back to their current schedule since creation
+func scheduleVersions(ctx context.Context, habitRepo repository.HabitRepository, habit *entity.Habit) ([]*entity.ScheduleVersion, error) {
+	versions, err := habitRepo.GetScheduleVersions(ctx, habit.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		versions = []*entity.ScheduleVersion{{
+			HabitID:       habit.ID,
+			ScheduleType:  habit.ScheduleType,
+			IntervalDays:  habit.IntervalDays,
+			WeeklyDays:    habit.WeeklyDays,
+			EffectiveFrom: habit.GetLocalDate(habit.CreatedAt),
+		}}
+	}
+
+	return versions, nil
+}
+
+func (s *habitService) ExportUserHabits(ctx context.Context, userID uuid.UUID) (*entity.UserDataExport, error) {
+	var err error
+	data := &entity.UserDataExport{}
+
+	if data.Habits, err = s.habitRepo.GetByUserID(ctx, userID, entity.HabitStatusAll); err != nil {
+		return nil, err
+	}
+
+	if data.Confirmations, err = s.confirmationRepo.GetByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if data.Pauses, err = s.pauseRepo.GetByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if data.Groups, err = s.groupRepo.GetByUserID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.templateRepo.GetVisibleByUserID(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, template := range templates {
+		if template.IsPrivate() {
+			data.Templates = append(data.Templates, template)
+		}
+	}
+
+	if data.Partners, err = s.partnerService.ExportUserData(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if data.Challenges, err = s.challengeService.ListChallenges(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if data.Achievements, err = s.achievementService.GetProgress(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if data.Follows, err = s.exportFollows(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	page := entity.PageRequest{Size: exportFeedPageSize}
+	for {
+		feed, err := s.feedService.GetFeed(ctx, userID, page)
+		if err != nil {
+			return nil, err
+		}
+		data.FeedItems = append(data.FeedItems, feed.Items...)
+		if feed.Next == nil {
+			break
+		}
+		page.After = feed.Next
+	}
+
+	if data.JournalEntries, err = s.journalService.ExportUserData(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if data.Attachments, err = s.attachmentService.ExportUserData(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	attachmentIDs := make(map[uuid.UUID][]uuid.UUID)
+	for _, attachment := range data.Attachments {
+		if attachment.ConfirmationID != nil {
+			attachmentIDs[*attachment.ConfirmationID] = append(attachmentIDs[*attachment.ConfirmationID], attachment.ID)
+		}
+	}
+	for _, confirmation := range data.Confirmations {
+		confirmation.AttachmentIDs = attachmentIDs[confirmation.ID]
+	}
+
+	return data, nil
+}
+
+// exportFollows retrieves the followers, pending follow requests and followed users of a user
+func (s *habitService) exportFollows(ctx context.Context, userID uuid.UUID) ([]*entity.Follow, error) {
+	followers, err := s.followService.ListFollowers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := s.followService.ListRequests(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	following, err := s.followService.ListFollowing(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	follows := append(followers, requests...)
+	return append(follows, following...), nil
+}
+
+func (s *habitService) PurgeUserData(ctx context.Context, userID uuid.UUID) (int64, error) {
+	// Challenge participants are deleted with their habits, the challenges have to be purged first
+	if err := s.challengeService.PurgeUserData(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	deleted, err := s.habitRepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.analyticsRepo.DeleteByUserID(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.groupRepo.DeleteByUserID(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.templateRepo.DeleteByUserID(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.partnerService.PurgeUserData(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.achievementService.PurgeUserData(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.followService.PurgeUserData(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.feedService.PurgeUserData(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.calendarService.PurgeUserData(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.importRepo.DeleteByUserID(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.journalService.PurgeUserData(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if err := s.attachmentService.PurgeUserData(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
 }
 
 func (s *habitService) ProcessMissedDeadlines(ctx context.Context) error {
@@ -378,6 +1332,9 @@ func (s *habitService) ProcessMissedDeadlines(ctx context.Context) error {
 	}
 
 	for _, habit := range habits {
+		missedDate := habit.GetLocalDate(habit.NextDeadlineUTC)
+		lostStreak := habit.Streak
+
 		habit.Streak = 0
 
 		habit.NextDeadlineUTC = s.CalculateNextDeadline(habit, time.Now().UTC())
@@ -389,6 +1346,16 @@ func (s *habitService) ProcessMissedDeadlines(ctx context.Context) error {
 			continue
 		}
 
+		if err := s.analyticsRepo.RecordMissed(ctx, habit.UserID, missedDate); err != nil {
+			fmt.Printf("Failed to record missed deadline of habit %s in analytics: %v\n", habit.ID, err)
+		}
+
+		if lostStreak > 0 {
+			s.partnerService.NotifyStreakBroken(ctx, habit, lostStreak, missedDate)
+			s.challengeService.RecordProgress(ctx, habit, missedDate)
+			s.achievementService.HandleStreakBroken(ctx, habit, lostStreak)
+		}
+
 		fmt.Printf("Reset streak for habit %s (user: %s)\n", habit.ID, habit.UserID)
 	}
 
@@ -456,3 +1423,33 @@ func (s *habitService) ProcessExpiredConfirmedDeadlines(ctx context.Context) err
 
 	return nil
 }
+
+// ProcessEndedPauses moves habits whose pause has ended to a fresh deadline,
+// the streak kept through the pause is preserved
+func (s *habitService) ProcessEndedPauses(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	pauses, err := s.pauseRepo.GetEnded(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to get ended pauses: %w", err)
+	}
+
+	for _, pause := range pauses {
+		habit, err := s.habitRepo.GetByID(ctx, pause.HabitID)
+		if err != nil {
+			fmt.Printf("Failed to get paused habit %s: %v\n", pause.HabitID, err)
+			continue
+		}
+
+		nextDeadline := s.CalculateInitialDeadline(habit, now)
+
+		if err := s.pauseRepo.Resume(ctx, pause, pause.EndDate, nextDeadline); err != nil {
+			fmt.Printf("Failed to resume habit %s after pause: %v\n", habit.ID, err)
+			continue
+		}
+
+		fmt.Printf("Resumed habit %s after pause (user: %s)\n", habit.ID, habit.UserID)
+	}
+
+	return nil
+}
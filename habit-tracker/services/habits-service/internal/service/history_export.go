@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/service"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// historyCSVHeader are the columns of a CSV history export, one row per confirmation
+var historyCSVHeader = []string{"date", "habit_id", "habit_name", "confirmed_at", "notes"}
+
+// historyHabitRecord is a habit as written to a JSON history export
+type historyHabitRecord struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Description  *string    `json:"description,omitempty"`
+	Color        *string    `json:"color,omitempty"`
+	Icon         *string    `json:"icon,omitempty"`
+	Tags         []string   `json:"tags,omitempty"`
+	ScheduleType string     `json:"schedule_type"`
+	IntervalDays *int32     `json:"interval_days,omitempty"`
+	WeeklyDays   []int32    `json:"weekly_days,omitempty"`
+	Streak       int32      `json:"streak"`
+	IsActive     bool       `json:"is_active"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ArchivedAt   *time.Time `json:"archived_at,omitempty"`
+}
+
+// historyConfirmationRecord is a confirmation as written to a JSON history export
+type historyConfirmationRecord struct {
+	Date        string    `json:"date"`
+	HabitID     string    `json:"habit_id"`
+	ConfirmedAt time.Time `json:"confirmed_at"`
+	Notes       *string   `json:"notes,omitempty"`
+}
+
+func (s *habitService) ExportHistory(ctx context.Context, userID uuid.UUID, habitID *uuid.UUID, fromDate, toDate string,
+	format entity.HistoryExportFormat, w io.Writer) error {
+
+	from, err := optionalDate(fromDate, "from_date")
+	if err != nil {
+		return err
+	}
+
+	to, err := optionalDate(toDate, "to_date")
+	if err != nil {
+		return err
+	}
+
+	if from != nil && to != nil && *to < *from {
+		return fmt.Errorf("%w to_date: must not be before from_date", service.ErrInvalidArgument)
+	}
+
+	var habits []*entity.Habit
+	if habitID != nil {
+		habit, err := s.habitRepo.GetByIDAndUserID(ctx, *habitID, userID)
+		if err != nil {
+			return err
+		}
+		habits = []*entity.Habit{habit}
+	} else {
+		habits, err = s.habitRepo.GetByUserID(ctx, userID, entity.HabitStatusAll)
+		if err != nil {
+			return err
+		}
+	}
+
+	stream := func(fn func(*entity.HabitConfirmation) error) error {
+		return s.confirmationRepo.StreamByUserID(ctx, userID, habitID, from, to, fn)
+	}
+
+	switch format {
+	case entity.HistoryExportFormatCSV:
+		return writeHistoryCSV(w, habits, stream)
+	case entity.HistoryExportFormatJSON:
+		return writeHistoryJSON(w, habits, stream)
+	default:
+		return fmt.Errorf("%w format: must be csv or json", service.ErrInvalidArgument)
+	}
+}
+
+// optionalDate validates a date filter, an empty date leaves the range open
+func optionalDate(value, name string) (*string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if _, err := time.Parse(dateLayout, value); err != nil {
+		return nil, fmt.Errorf("%w %s: expected YYYY-MM-DD", service.ErrInvalidArgument, name)
+	}
+
+	return &value, nil
+}
+
+func writeHistoryCSV(w io.Writer, habits []*entity.Habit, stream func(func(*entity.HabitConfirmation) error) error) error {
+	names := make(map[uuid.UUID]string, len(habits))
+	for _, habit := range habits {
+		names[habit.ID] = habit.Name
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(historyCSVHeader); err != nil {
+		return err
+	}
+
+	err := stream(func(confirmation *entity.HabitConfirmation) error {
+		notes := ""
+		if confirmation.Notes != nil {
+			notes = *confirmation.Notes
+		}
+
+		return writer.Write([]string{
+			confirmation.ConfirmedForDate,
+			confirmation.HabitID.String(),
+			spreadsheetSafe(names[confirmation.HabitID]),
+			confirmation.ConfirmedAt.UTC().Format(time.RFC3339),
+			spreadsheetSafe(notes),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// spreadsheetSafe prevents user text from being evaluated as a formula when the CSV is opened in a spreadsheet
+func spreadsheetSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// writeHistoryJSON writes {"habits": [...], "confirmations": [...]}, confirmations are encoded one by one
+func writeHistoryJSON(w io.Writer, habits []*entity.Habit, stream func(func(*entity.HabitConfirmation) error) error) error {
+	records := make([]historyHabitRecord, len(habits))
+	for i, habit := range habits {
+		records[i] = historyHabitRecord{
+			ID:           habit.ID.String(),
+			Name:         habit.Name,
+			Description:  habit.Description,
+			Color:        habit.Color,
+			Icon:         habit.Icon,
+			Tags:         habit.Tags,
+			ScheduleType: string(habit.ScheduleType),
+			IntervalDays: habit.IntervalDays,
+			WeeklyDays:   habit.WeeklyDays,
+			Streak:       habit.Streak,
+			IsActive:     habit.IsActive,
+			CreatedAt:    habit.CreatedAt,
+			ArchivedAt:   habit.ArchivedAt,
+		}
+	}
+
+	habitsJSON, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, `{"habits":%s,"confirmations":[`, habitsJSON); err != nil {
+		return err
+	}
+
+	first := true
+	err = stream(func(confirmation *entity.HabitConfirmation) error {
+		record, err := json.Marshal(historyConfirmationRecord{
+			Date:        confirmation.ConfirmedForDate,
+			HabitID:     confirmation.HabitID.String(),
+			ConfirmedAt: confirmation.ConfirmedAt.UTC(),
+			Notes:       confirmation.Notes,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		_, err = w.Write(record)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}\n")
+	return err
+}
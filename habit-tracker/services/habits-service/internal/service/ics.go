@@ -0,0 +1,251 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icsDateTimeLayout = "20060102T150405"
+
+	// icsLineLimit is the maximum length of a content line in octets, longer lines are folded
+	icsLineLimit = 75
+
+	// icsCompletedDays limits completion markers to recent occurrences to keep feeds small
+	icsCompletedDays = 90
+)
+
+// Occurrences are shown in the last hour before the deadline at the end of the local day
+const (
+	icsEventStart = "T230000"
+	icsEventEnd   = "T235900"
+)
+
+var icsWeekdays = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// calendarHabit is an active habit with what is needed to render its occurrences
+type calendarHabit struct {
+	habit    *entity.Habit
+	versions []*entity.ScheduleVersion // Ordered by EffectiveFrom
+	dates    []string                  // Sorted local dates of confirmations
+}
+
+// renderCalendar renders habits as an iCalendar document. Every schedule version of a habit
+// becomes a recurring event on its due dates in the habit's timezone, bounded by the next version.
+// Completed occurrences are overridden with a confirmed status and a check mark
+func renderCalendar(habits []*calendarHabit, now time.Time) []byte {
+	var w icsWriter
+
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", "-//Habit Tracker//Habits//EN")
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.line("X-WR-CALNAME", "Habits")
+	w.line("REFRESH-INTERVAL;VALUE=DURATION", "PT1H")
+	w.line("X-PUBLISHED-TTL", "PT1H")
+
+	offsets := make(map[int32]bool)
+	for _, h := range habits {
+		if !offsets[h.habit.TimezoneOffsetHours] {
+			offsets[h.habit.TimezoneOffsetHours] = true
+			w.timezone(h.habit.TimezoneOffsetHours)
+		}
+	}
+
+	dtstamp := now.UTC().Format(icsDateTimeLayout) + "Z"
+	for _, h := range habits {
+		w.habit(h, dtstamp)
+	}
+
+	w.line("END", "VCALENDAR")
+
+	return w.b.Bytes()
+}
+
+type icsWriter struct {
+	b bytes.Buffer
+}
+
+// timezone writes a fixed offset timezone, habits store an offset rather than a zone with DST rules
+func (w *icsWriter) timezone(offsetHours int32) {
+	offset := fmt.Sprintf("%+03d00", offsetHours)
+
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", icsTimezoneID(offsetHours))
+	w.line("BEGIN", "STANDARD")
+	w.line("DTSTART", "19700101T000000")
+	w.line("TZOFFSETFROM", offset)
+	w.line("TZOFFSETTO", offset)
+	w.line("TZNAME", "UTC"+offset)
+	w.line("END", "STANDARD")
+	w.line("END", "VTIMEZONE")
+}
+
+func (w *icsWriter) habit(h *calendarHabit, dtstamp string) {
+	habit := h.habit
+	tzid := icsTimezoneID(habit.TimezoneOffsetHours)
+
+	today, err := time.Parse(dateLayout, habit.GetCurrentLocalDate())
+	if err != nil {
+		return
+	}
+	completedFrom := today.AddDate(0, 0, -icsCompletedDays)
+
+	for i, version := range h.versions {
+		from, err := time.Parse(dateLayout, version.EffectiveFrom)
+		if err != nil {
+			continue
+		}
+
+		var to *time.Time
+		if i+1 < len(h.versions) {
+			next, err := time.Parse(dateLayout, h.versions[i+1].EffectiveFrom)
+			if err == nil {
+				last := next.AddDate(0, 0, -1)
+				to = &last
+			}
+		}
+
+		first, rrule, ok := icsRecurrence(version, from)
+		if !ok || (to != nil && first.After(*to)) {
+			continue
+		}
+
+		if to != nil {
+			// UNTIL is in UTC when the start carries a timezone
+			deadline := time.Date(to.Year(), to.Month(), to.Day(), 23, 59, 59, 0, time.UTC)
+			until := deadline.Add(-time.Duration(habit.TimezoneOffsetHours) * time.Hour)
+			rrule += ";UNTIL=" + until.Format(icsDateTimeLayout) + "Z"
+		}
+
+		uid := fmt.Sprintf("%s-%s@habit-tracker", habit.ID, version.EffectiveFrom)
+
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", uid)
+		w.line("DTSTAMP", dtstamp)
+		w.eventTimes(tzid, first)
+		w.line("RRULE", rrule)
+		w.line("SUMMARY", icsEscape(habit.Name))
+		if habit.Description != nil && *habit.Description != "" {
+			w.line("DESCRIPTION", icsEscape(*habit.Description))
+		}
+		w.line("TRANSP", "TRANSPARENT")
+		w.line("END", "VEVENT")
+
+		periodsTo := today
+		if to != nil && to.Before(periodsTo) {
+			periodsTo = *to
+		}
+
+		for _, period := range buildSchedulePeriods(h.versions[i:i+1], periodsTo) {
+			if !period.due || period.end.Before(completedFrom) || !datesInRange(h.dates, period.start, period.end) {
+				continue
+			}
+
+			w.line("BEGIN", "VEVENT")
+			w.line("UID", uid)
+			w.line("DTSTAMP", dtstamp)
+			w.line("RECURRENCE-ID;TZID="+tzid, period.end.Format("20060102")+icsEventStart)
+			w.eventTimes(tzid, period.end)
+			w.line("SUMMARY", icsEscape("✓ "+habit.Name))
+			w.line("STATUS", "CONFIRMED")
+			w.line("TRANSP", "TRANSPARENT")
+			w.line("END", "VEVENT")
+		}
+	}
+}
+
+func (w *icsWriter) eventTimes(tzid string, day time.Time) {
+	date := day.Format("20060102")
+	w.line("DTSTART;TZID="+tzid, date+icsEventStart)
+	w.line("DTEND;TZID="+tzid, date+icsEventEnd)
+}
+
+// line writes a content line, folding it at icsLineLimit octets without splitting characters
+func (w *icsWriter) line(name, value string) {
+	line := name + ":" + value
+	limit := icsLineLimit
+
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.b.WriteString(line[:cut])
+		w.b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icsLineLimit - 1
+	}
+
+	w.b.WriteString(line)
+	w.b.WriteString("\r\n")
+}
+
+// icsRecurrence returns the first due date of a schedule version on or after from, with its recurrence rule.
+// Due dates match the ends of the periods of buildSchedulePeriods
+func icsRecurrence(version *entity.ScheduleVersion, from time.Time) (time.Time, string, bool) {
+	switch version.ScheduleType {
+	case entity.ScheduleTypeInterval:
+		if version.IntervalDays == nil || *version.IntervalDays <= 0 {
+			return time.Time{}, "", false
+		}
+
+		days := int(*version.IntervalDays)
+		rrule := "FREQ=DAILY"
+		if days > 1 {
+			rrule += fmt.Sprintf(";INTERVAL=%d", days)
+		}
+		return from.AddDate(0, 0, days-1), rrule, true
+
+	case entity.ScheduleTypeWeekly:
+		scheduled := make(map[time.Weekday]bool, len(version.WeeklyDays))
+		for _, day := range version.WeeklyDays {
+			if day >= 0 && day < 7 {
+				scheduled[time.Weekday(day)] = true
+			}
+		}
+		if len(scheduled) == 0 {
+			return time.Time{}, "", false
+		}
+
+		weekdays := make([]int, 0, len(scheduled))
+		for day := range scheduled {
+			weekdays = append(weekdays, int(day))
+		}
+		sort.Ints(weekdays)
+
+		byDay := make([]string, len(weekdays))
+		for i, day := range weekdays {
+			byDay[i] = icsWeekdays[day]
+		}
+
+		first := from
+		for !scheduled[first.Weekday()] {
+			first = first.AddDate(0, 0, 1)
+		}
+		return first, "FREQ=WEEKLY;BYDAY=" + strings.Join(byDay, ","), true
+	}
+
+	return time.Time{}, "", false
+}
+
+// icsTimezoneID returns the IANA name of a fixed offset zone. Etc/GMT names have the sign inverted
+func icsTimezoneID(offsetHours int32) string {
+	if offsetHours == 0 {
+		return "Etc/GMT"
+	}
+	return fmt.Sprintf("Etc/GMT%+d", -offsetHours)
+}
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", "")
+
+// icsEscape escapes a TEXT value
+func icsEscape(s string) string {
+	return icsTextEscaper.Replace(s)
+}
@@ -0,0 +1,658 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/service"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxImportHabits      = 200
+	maxImportIssues      = 500              // Further issues are only counted in SkippedRows
+	maxImportArchiveFile = 32 * 1024 * 1024 // Uncompressed size limit of a file inside a ZIP
+	maxHabitNameLength   = 255
+)
+
+// importPlan is an import file mapped to habits, with the rows that were skipped
+type importPlan struct {
+	habits      []*entity.ImportedHabit
+	issues      []*entity.ImportIssue
+	skippedRows int32
+
+	offset time.Duration // Timezone offset of the user, for timestamps in the file
+	today  string        // Local date of the user, later dates are skipped
+
+	byName map[string]*importedHabitBuilder
+	order  []*importedHabitBuilder
+}
+
+// importedHabitBuilder collects the days of a habit while the file is read
+type importedHabitBuilder struct {
+	habit   *entity.ImportedHabit
+	dates   map[string]bool
+	skipped bool
+}
+
+func newImportPlan(timezoneOffset int32) *importPlan {
+	offset := time.Duration(timezoneOffset) * time.Hour
+	return &importPlan{
+		offset: offset,
+		today:  time.Now().UTC().Add(offset).Format(dateLayout),
+		byName: make(map[string]*importedHabitBuilder),
+	}
+}
+
+// parseImport maps an export of another tracker to habits
+func parseImport(source entity.HabitImportSource, data []byte, timezoneOffset int32) (*importPlan, error) {
+	plan := newImportPlan(timezoneOffset)
+
+	var err error
+	switch source {
+	case entity.HabitImportSourceLoop:
+		err = parseLoop(plan, data)
+	case entity.HabitImportSourceHabitica:
+		err = parseHabitica(plan, data)
+	case entity.HabitImportSourceStreaks:
+		err = parseStreaks(plan, data)
+	default:
+		return nil, fmt.Errorf("%w source: must be loop, habitica or streaks", service.ErrInvalidArgument)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plan.finish()
+	return plan, nil
+}
+
+// habit returns the habit of the given name, creating it as a daily habit on first use.
+// Nil is returned for habits that are skipped
+func (p *importPlan) habit(row int, name string) *importedHabitBuilder {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		p.skip(row, "", "habit name is empty")
+		return nil
+	}
+	if utf8.RuneCountInString(name) > maxHabitNameLength {
+		name = string([]rune(name)[:maxHabitNameLength])
+	}
+
+	key := strings.ToLower(name)
+	if builder, ok := p.byName[key]; ok {
+		if builder.skipped {
+			return nil
+		}
+		return builder
+	}
+
+	builder := &importedHabitBuilder{
+		habit: &entity.ImportedHabit{
+			Name:         name,
+			ScheduleType: entity.ScheduleTypeInterval,
+			IntervalDays: int32Ptr(1),
+		},
+		dates: make(map[string]bool),
+	}
+	p.byName[key] = builder
+	p.order = append(p.order, builder)
+	return builder
+}
+
+// skipHabit drops a habit with all its rows, reporting why
+func (p *importPlan) skipHabit(builder *importedHabitBuilder, reason string) {
+	builder.skipped = true
+	p.issue(nil, builder.habit.Name, reason)
+}
+
+// skip reports a row of the file that was not imported. Row 0 means the issue has no row
+func (p *importPlan) skip(row int, habitName, reason string) {
+	p.skippedRows++
+
+	var rowNumber *int32
+	if row > 0 {
+		rowNumber = int32Ptr(int32(row))
+	}
+	p.issue(rowNumber, habitName, reason)
+}
+
+func (p *importPlan) issue(row *int32, habitName, reason string) {
+	if len(p.issues) >= maxImportIssues {
+		return
+	}
+
+	issue := &entity.ImportIssue{Row: row, Reason: reason}
+	if habitName != "" {
+		issue.HabitName = &habitName
+	}
+	p.issues = append(p.issues, issue)
+}
+
+// addDate records a day the habit was done
+func (p *importPlan) addDate(builder *importedHabitBuilder, row int, date string) {
+	if date > p.today {
+		p.skip(row, builder.habit.Name, fmt.Sprintf("date %s is in the future", date))
+		return
+	}
+	builder.dates[date] = true
+}
+
+// finish sorts the collected days and drops skipped habits and habits over the limit
+func (p *importPlan) finish() {
+	for _, builder := range p.order {
+		if builder.skipped {
+			continue
+		}
+
+		if len(p.habits) == maxImportHabits {
+			p.issue(nil, builder.habit.Name, fmt.Sprintf("at most %d habits can be imported at once", maxImportHabits))
+			continue
+		}
+
+		dates := make([]string, 0, len(builder.dates))
+		for date := range builder.dates {
+			dates = append(dates, date)
+		}
+		sort.Strings(dates)
+
+		builder.habit.Dates = dates
+		p.habits = append(p.habits, builder.habit)
+	}
+}
+
+// localDate converts a timestamp of the file to the user's local date
+func (p *importPlan) localDate(t time.Time) string {
+	return t.UTC().Add(p.offset).Format(dateLayout)
+}
+
+// parseImportDate accepts plain dates and timestamps, timestamps are converted to the user's local date
+func (p *importPlan) parseImportDate(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+
+	for _, layout := range []string{dateLayout, "20060102", "2006/01/02", "2006-01-02 15:04:05", "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format(dateLayout), true
+		}
+	}
+
+	for _, layout := range []string{time.RFC3339, time.RFC3339Nano} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return p.localDate(t), true
+		}
+	}
+
+	return "", false
+}
+
+// normalizeImportColor keeps colors in "#RRGGBB" format, anything else is dropped
+func normalizeImportColor(value string) *string {
+	value = strings.TrimSpace(value)
+	if len(value) != 7 || value[0] != '#' {
+		return nil
+	}
+	if _, err := strconv.ParseUint(value[1:], 16, 32); err != nil {
+		return nil
+	}
+
+	color := strings.ToUpper(value)
+	return &color
+}
+
+func optionalString(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func int32Ptr(value int32) *int32 {
+	return &value
+}
+
+// csvTable is a CSV file with a header row
+type csvTable struct {
+	reader  *csv.Reader
+	columns map[string]int // Lowercased header name to column index
+	header  []string
+}
+
+func newCSVTable(data []byte) (*csvTable, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w data: failed to read CSV header: %v", service.ErrInvalidArgument, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	return &csvTable{reader: reader, columns: columns, header: header}, nil
+}
+
+// column returns the index of the first of the given columns present in the header, -1 if none is
+func (t *csvTable) column(names ...string) int {
+	for _, name := range names {
+		if i, ok := t.columns[name]; ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// next reads the next record and its line number, io.EOF ends the file
+func (t *csvTable) next() ([]string, int, error) {
+	record, err := t.reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, 0, io.EOF
+		}
+		return nil, 0, fmt.Errorf("%w data: %v", service.ErrInvalidArgument, err)
+	}
+
+	line, _ := t.reader.FieldPos(0)
+	return record, line, nil
+}
+
+func field(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// parseLoop reads a Loop Habit Tracker export: either the ZIP with Habits.csv and Checkmarks.csv,
+// or Checkmarks.csv alone. Checkmarks.csv has a Date column followed by one column per habit
+func parseLoop(plan *importPlan, data []byte) error {
+	checkmarks := data
+	var habitsCSV []byte
+
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		files, err := readZipFiles(data, "Habits.csv", "Checkmarks.csv")
+		if err != nil {
+			return err
+		}
+
+		checkmarks = files["Checkmarks.csv"]
+		if checkmarks == nil {
+			return fmt.Errorf("%w data: Checkmarks.csv not found in the archive", service.ErrInvalidArgument)
+		}
+		habitsCSV = files["Habits.csv"]
+	}
+
+	if habitsCSV != nil {
+		if err := parseLoopHabits(plan, habitsCSV); err != nil {
+			return err
+		}
+	}
+
+	table, err := newCSVTable(checkmarks)
+	if err != nil {
+		return err
+	}
+
+	dateColumn := table.column("date")
+	if dateColumn != 0 || len(table.header) < 2 {
+		return fmt.Errorf("%w data: expected Loop Checkmarks.csv with a Date column followed by habit columns", service.ErrInvalidArgument)
+	}
+
+	habits := make([]*importedHabitBuilder, len(table.header))
+	for i := 1; i < len(table.header); i++ {
+		habits[i] = plan.habit(0, table.header[i])
+		if habits[i] != nil && habitsCSV == nil {
+			habits[i].habit.Note = optionalString("Habits.csv was not uploaded, imported as a daily habit")
+		}
+	}
+
+	for {
+		record, line, err := table.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		date, ok := plan.parseImportDate(field(record, 0))
+		if !ok {
+			plan.skip(line, "", fmt.Sprintf("invalid date %q", field(record, 0)))
+			continue
+		}
+
+		for i := 1; i < len(record) && i < len(habits); i++ {
+			if habits[i] == nil {
+				continue
+			}
+
+			switch value := strings.ToUpper(field(record, i)); value {
+			case "2", "YES_MANUAL":
+				plan.addDate(habits[i], line, date)
+			case "", "0", "-1", "1", "3", "NO", "UNKNOWN", "YES_AUTO", "SKIP":
+				// Automatic checkmarks are implied by the frequency, Loop didn't record them as done
+			default:
+				plan.skip(line, habits[i].habit.Name, fmt.Sprintf("unrecognized checkmark %q", value))
+			}
+		}
+	}
+}
+
+// parseLoopHabits reads the schedules of Loop habits from Habits.csv. A frequency of
+// N repetitions in M days maps exactly only for N = 1, otherwise it is approximated
+func parseLoopHabits(plan *importPlan, data []byte) error {
+	table, err := newCSVTable(data)
+	if err != nil {
+		return err
+	}
+
+	nameColumn := table.column("name")
+	if nameColumn < 0 {
+		return fmt.Errorf("%w data: Habits.csv has no Name column", service.ErrInvalidArgument)
+	}
+	descriptionColumn := table.column("description")
+	questionColumn := table.column("question")
+	colorColumn := table.column("color")
+	typeColumn := table.column("type")
+	numeratorColumn := table.column("numrepetitions", "frequencynumerator")
+	denominatorColumn := table.column("interval", "frequencydenominator")
+
+	for {
+		record, line, err := table.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		builder := plan.habit(line, field(record, nameColumn))
+		if builder == nil {
+			continue
+		}
+
+		habit := builder.habit
+		habit.Description = optionalString(field(record, descriptionColumn))
+		if habit.Description == nil {
+			habit.Description = optionalString(field(record, questionColumn))
+		}
+		habit.Color = normalizeImportColor(field(record, colorColumn))
+
+		switch strings.ToUpper(field(record, typeColumn)) {
+		case "1", "NUMERICAL":
+			plan.skipHabit(builder, "measurable habits are not supported")
+			continue
+		}
+
+		repetitions, errRepetitions := strconv.Atoi(field(record, numeratorColumn))
+		interval, errInterval := strconv.Atoi(field(record, denominatorColumn))
+		if errRepetitions != nil || errInterval != nil || repetitions <= 0 || interval <= 0 {
+			habit.Note = optionalString("frequency not recognized, imported as a daily habit")
+			continue
+		}
+
+		days := interval / repetitions
+		if days < 1 {
+			days = 1
+		}
+		habit.IntervalDays = int32Ptr(int32(days))
+
+		if repetitions > 1 && repetitions != interval {
+			habit.Note = optionalString(fmt.Sprintf("%d times in %d days imported as every %d days", repetitions, interval, days))
+		}
+	}
+}
+
+// readZipFiles reads the named top level files of a ZIP archive, missing files are omitted.
+// Loop also writes per-habit files into subdirectories, those are not read
+func readZipFiles(data []byte, names ...string) (map[string][]byte, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w data: failed to open ZIP archive: %v", service.ErrInvalidArgument, err)
+	}
+
+	files := make(map[string][]byte, len(names))
+	for _, file := range archive.File {
+		for _, name := range names {
+			if file.Name != name {
+				continue
+			}
+
+			content, err := readZipFile(file)
+			if err != nil {
+				return nil, err
+			}
+			files[name] = content
+		}
+	}
+
+	return files, nil
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w data: failed to open %s: %v", service.ErrInvalidArgument, file.Name, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, maxImportArchiveFile+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w data: failed to read %s: %v", service.ErrInvalidArgument, file.Name, err)
+	}
+	if len(content) > maxImportArchiveFile {
+		return nil, fmt.Errorf("%w data: %s is too large", service.ErrInvalidArgument, file.Name)
+	}
+
+	return content, nil
+}
+
+// habiticaExport is the Habitica user data export, or the response of its tasks API
+type habiticaExport struct {
+	Tasks struct {
+		Habits []*habiticaTask `json:"habits"`
+		Dailys []*habiticaTask `json:"dailys"`
+	} `json:"tasks"`
+	Data []*habiticaTask `json:"data"`
+}
+
+type habiticaTask struct {
+	Type      string          `json:"type"`
+	Text      string          `json:"text"`
+	Notes     string          `json:"notes"`
+	Frequency string          `json:"frequency"`
+	EveryX    *int32          `json:"everyX"`
+	Repeat    map[string]bool `json:"repeat"`
+	History   []struct {
+		Date      json.RawMessage `json:"date"`
+		Completed *bool           `json:"completed"`
+	} `json:"history"`
+}
+
+// habiticaWeekdays maps the keys of a Habitica repeat object to weekdays, 0 is Sunday
+var habiticaWeekdays = map[string]int32{"su": 0, "m": 1, "t": 2, "w": 3, "th": 4, "f": 5, "s": 6}
+
+// parseHabitica reads Habitica dailies with their history. Habitica habits are counters
+// without a schedule and are reported as skipped, to-dos and rewards are ignored
+func parseHabitica(plan *importPlan, data []byte) error {
+	var export habiticaExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return fmt.Errorf("%w data: expected Habitica JSON export: %v", service.ErrInvalidArgument, err)
+	}
+
+	dailies := export.Tasks.Dailys
+	habits := export.Tasks.Habits
+	for _, task := range export.Data {
+		switch task.Type {
+		case "daily":
+			dailies = append(dailies, task)
+		case "habit":
+			habits = append(habits, task)
+		}
+	}
+
+	if len(dailies) == 0 && len(habits) == 0 {
+		return fmt.Errorf("%w data: no Habitica dailies found", service.ErrInvalidArgument)
+	}
+
+	for _, task := range habits {
+		plan.skip(0, strings.TrimSpace(task.Text), "Habitica habits have no schedule, only dailies are imported")
+	}
+
+	for _, task := range dailies {
+		builder := plan.habit(0, task.Text)
+		if builder == nil {
+			continue
+		}
+
+		builder.habit.Description = optionalString(task.Notes)
+		if !mapHabiticaSchedule(plan, builder, task) {
+			continue
+		}
+
+		for _, entry := range task.History {
+			date, ok := habiticaDate(plan, entry.Date)
+			if !ok {
+				plan.skip(0, builder.habit.Name, fmt.Sprintf("invalid history date %s", entry.Date))
+				continue
+			}
+
+			if entry.Completed == nil {
+				plan.skip(0, builder.habit.Name, fmt.Sprintf("history entry of %s has no completion state", date))
+				continue
+			}
+
+			if *entry.Completed {
+				plan.addDate(builder, 0, date)
+			}
+		}
+	}
+
+	return nil
+}
+
+// mapHabiticaSchedule maps the repeat settings of a daily, returns false if the daily was skipped
+func mapHabiticaSchedule(plan *importPlan, builder *importedHabitBuilder, task *habiticaTask) bool {
+	habit := builder.habit
+
+	everyX := int32(1)
+	if task.EveryX != nil {
+		everyX = *task.EveryX
+	}
+	if everyX <= 0 {
+		plan.skipHabit(builder, "daily never repeats")
+		return false
+	}
+
+	switch task.Frequency {
+	case "", "daily":
+		habit.IntervalDays = int32Ptr(everyX)
+	case "weekly":
+		for key, enabled := range task.Repeat {
+			if day, ok := habiticaWeekdays[key]; ok && enabled {
+				habit.WeeklyDays = append(habit.WeeklyDays, day)
+			}
+		}
+		if len(habit.WeeklyDays) == 0 {
+			plan.skipHabit(builder, "weekly daily has no days selected")
+			return false
+		}
+
+		sort.Slice(habit.WeeklyDays, func(i, j int) bool { return habit.WeeklyDays[i] < habit.WeeklyDays[j] })
+		habit.ScheduleType = entity.ScheduleTypeWeekly
+		habit.IntervalDays = nil
+		if everyX > 1 {
+			habit.Note = optionalString(fmt.Sprintf("every %d weeks imported as every week", everyX))
+		}
+	case "monthly":
+		habit.IntervalDays = int32Ptr(30 * everyX)
+		habit.Note = optionalString(fmt.Sprintf("monthly repeat (every %d) imported as every %d days", everyX, 30*everyX))
+	case "yearly":
+		habit.IntervalDays = int32Ptr(365 * everyX)
+		habit.Note = optionalString(fmt.Sprintf("yearly repeat (every %d) imported as every %d days", everyX, 365*everyX))
+	default:
+		plan.skipHabit(builder, fmt.Sprintf("unsupported frequency %q", task.Frequency))
+		return false
+	}
+
+	return true
+}
+
+// habiticaDate reads a history date, stored either as Unix milliseconds or as a timestamp string
+func habiticaDate(plan *importPlan, raw json.RawMessage) (string, bool) {
+	var millis int64
+	if err := json.Unmarshal(raw, &millis); err == nil {
+		return plan.localDate(time.UnixMilli(millis)), true
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", false
+	}
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return plan.localDate(time.UnixMilli(millis)), true
+	}
+
+	return plan.parseImportDate(value)
+}
+
+// parseStreaks reads a Streaks CSV export with one row per entry. The export carries no
+// schedules, so habits are imported as daily. Rows of other entry types than completions are ignored
+func parseStreaks(plan *importPlan, data []byte) error {
+	table, err := newCSVTable(data)
+	if err != nil {
+		return err
+	}
+
+	nameColumn := table.column("title", "task", "habit", "name")
+	dateColumn := table.column("entry_date", "date")
+	if nameColumn < 0 || dateColumn < 0 {
+		return fmt.Errorf("%w data: expected Streaks CSV with title and entry_date columns", service.ErrInvalidArgument)
+	}
+	typeColumn := table.column("entry_type", "type")
+
+	for {
+		record, line, err := table.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		builder := plan.habit(line, field(record, nameColumn))
+		if builder == nil {
+			continue
+		}
+		if builder.habit.Note == nil {
+			builder.habit.Note = optionalString("Streaks exports no schedules, imported as a daily habit")
+		}
+
+		if entryType := strings.ToLower(field(record, typeColumn)); entryType != "" && !strings.Contains(entryType, "complete") {
+			continue
+		}
+
+		date, ok := plan.parseImportDate(field(record, dateColumn))
+		if !ok {
+			plan.skip(line, builder.habit.Name, fmt.Sprintf("invalid date %q", field(record, dateColumn)))
+			continue
+		}
+
+		plan.addDate(builder, line, date)
+	}
+}
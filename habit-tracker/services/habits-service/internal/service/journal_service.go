@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxJournalDays limits the range of a single list request
+	maxJournalDays = 366
+
+	maxJournalContentLength = 10000
+	maxSearchQueryLength    = 200
+	defaultSearchResults    = 20
+	maxSearchResults        = 100
+)
+
+type journalService struct {
+	journalRepo repository.JournalRepository
+}
+
+// NewJournalService creates a new journal service
+func NewJournalService(journalRepo repository.JournalRepository) service.JournalService {
+	return &journalService{
+		journalRepo: journalRepo,
+	}
+}
+
+func (s *journalService) SaveEntry(ctx context.Context, entry *entity.JournalEntry) (*entity.JournalEntry, error) {
+	date, err := time.Parse(dateLayout, entry.Date)
+	if err != nil {
+		return nil, fmt.Errorf("%w date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	// The furthest ahead timezone is UTC+14, no local date can be after tomorrow in UTC
+	if date.After(time.Now().UTC().AddDate(0, 0, 1)) {
+		return nil, fmt.Errorf("%w date: must not be in the future", service.ErrInvalidArgument)
+	}
+
+	if err := validateRating(entry.Mood, "mood"); err != nil {
+		return nil, err
+	}
+	if err := validateRating(entry.Energy, "energy"); err != nil {
+		return nil, err
+	}
+
+	if entry.Content != nil {
+		content := strings.TrimSpace(*entry.Content)
+		if len([]rune(content)) > maxJournalContentLength {
+			return nil, fmt.Errorf("%w content: must be at most %d characters", service.ErrInvalidArgument, maxJournalContentLength)
+		}
+		if content == "" {
+			entry.Content = nil
+		} else {
+			entry.Content = &content
+		}
+	}
+
+	entry.Tags, err = normalizeTags(entry.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool, len(entry.ConfirmationIDs))
+	confirmationIDs := make([]uuid.UUID, 0, len(entry.ConfirmationIDs))
+	for _, id := range entry.ConfirmationIDs {
+		if !seen[id] {
+			seen[id] = true
+			confirmationIDs = append(confirmationIDs, id)
+		}
+	}
+	entry.ConfirmationIDs = confirmationIDs
+
+	if err := s.journalRepo.Upsert(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// validateRating checks an optional 1-5 rating
+func validateRating(rating *int32, name string) error {
+	if rating != nil && (*rating < 1 || *rating > 5) {
+		return fmt.Errorf("%w %s: must be between 1 and 5", service.ErrInvalidArgument, name)
+	}
+	return nil
+}
+
+func (s *journalService) GetEntry(ctx context.Context, userID uuid.UUID, date string) (*entity.JournalEntry, error) {
+	if _, err := time.Parse(dateLayout, date); err != nil {
+		return nil, fmt.Errorf("%w date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	return s.journalRepo.GetByDate(ctx, userID, date)
+}
+
+func (s *journalService) ListEntries(ctx context.Context, userID uuid.UUID, fromDate, toDate, tag string) ([]*entity.JournalEntry, error) {
+	from, err := time.Parse(dateLayout, fromDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w from_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	to, err := time.Parse(dateLayout, toDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w to_date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w to_date: must not be before from_date", service.ErrInvalidArgument)
+	}
+
+	if to.Sub(from) >= maxJournalDays*24*time.Hour {
+		return nil, fmt.Errorf("%w date range: must not exceed %d days", service.ErrInvalidArgument, maxJournalDays)
+	}
+
+	var tagFilter *string
+	if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+		tagFilter = &tag
+	}
+
+	return s.journalRepo.GetRange(ctx, userID, fromDate, toDate, tagFilter)
+}
+
+func (s *journalService) DeleteEntry(ctx context.Context, userID uuid.UUID, date string) error {
+	if _, err := time.Parse(dateLayout, date); err != nil {
+		return fmt.Errorf("%w date: expected YYYY-MM-DD", service.ErrInvalidArgument)
+	}
+
+	return s.journalRepo.Delete(ctx, userID, date)
+}
+
+func (s *journalService) SearchNotes(ctx context.Context, userID uuid.UUID, query string, limit int32) ([]*entity.NoteSearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("%w query: must not be empty", service.ErrInvalidArgument)
+	}
+	if len([]rune(query)) > maxSearchQueryLength {
+		return nil, fmt.Errorf("%w query: must be at most %d characters", service.ErrInvalidArgument, maxSearchQueryLength)
+	}
+
+	if limit <= 0 {
+		limit = defaultSearchResults
+	}
+	if limit > maxSearchResults {
+		limit = maxSearchResults
+	}
+
+	return s.journalRepo.Search(ctx, userID, query, limit)
+}
+
+func (s *journalService) ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.JournalEntry, error) {
+	return s.journalRepo.GetByUserID(ctx, userID)
+}
+
+func (s *journalService) PurgeUserData(ctx context.Context, userID uuid.UUID) error {
+	return s.journalRepo.DeleteByUserID(ctx, userID)
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"fmt"
+	"habits-service/internal/domain/service"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxTagsPerHabit = 20
+	maxTagLength    = 50
+	maxIconLength   = 64
+)
+
+// normalizeTags trims and lowercases tags, drops duplicates and sorts them
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, fmt.Errorf("%w tag: must not be empty", service.ErrInvalidArgument)
+		}
+		if len([]rune(tag)) > maxTagLength {
+			return nil, fmt.Errorf("%w tag: must be at most %d characters", service.ErrInvalidArgument, maxTagLength)
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	if len(normalized) > maxTagsPerHabit {
+		return nil, fmt.Errorf("%w tags: at most %d tags", service.ErrInvalidArgument, maxTagsPerHabit)
+	}
+
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// normalizeIcon trims an icon name, an empty name clears the icon
+func normalizeIcon(icon *string) (*string, error) {
+	if icon == nil {
+		return nil, nil
+	}
+
+	trimmed := strings.TrimSpace(*icon)
+	if trimmed == "" {
+		return nil, nil
+	}
+	if len(trimmed) > maxIconLength {
+		return nil, fmt.Errorf("%w icon: must be at most %d characters", service.ErrInvalidArgument, maxIconLength)
+	}
+
+	return &trimmed, nil
+}
+
+// clearIfEmpty maps an empty string to nil so that optional fields can be cleared
+func clearIfEmpty(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
+
+// validateReorderIDs rejects empty and duplicate ids of a reorder request
+func validateReorderIDs(ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("%w order: at least one id is required", service.ErrInvalidArgument)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return fmt.Errorf("%w order: duplicate id %s", service.ErrInvalidArgument, id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
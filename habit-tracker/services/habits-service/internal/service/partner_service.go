@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"habits-service/internal/infrastructure/kafka"
+	userpb "habits-service/proto/user/v1"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxPartnersPerHabit = 10
+	nudgeInterval       = 12 * time.Hour
+
+	// reinviteCooldown keeps a declined invitation declined, so that the invitee isn't emailed again and again
+	reinviteCooldown = 30 * 24 * time.Hour
+)
+
+type habitPartnerService struct {
+	partnerRepo repository.HabitPartnerRepository
+	habitRepo   repository.HabitRepository
+	pauseRepo   repository.HabitPauseRepository
+	userClient  userpb.UserServiceClient
+	producer    *kafka.Producer
+}
+
+// NewHabitPartnerService creates a new habit partner service.
+// Users are resolved through user-service, notifications are published as habit events
+func NewHabitPartnerService(
+	partnerRepo repository.HabitPartnerRepository,
+	habitRepo repository.HabitRepository,
+	pauseRepo repository.HabitPauseRepository,
+	userClient userpb.UserServiceClient,
+	producer *kafka.Producer,
+) service.HabitPartnerService {
+	return &habitPartnerService{
+		partnerRepo: partnerRepo,
+		habitRepo:   habitRepo,
+		pauseRepo:   pauseRepo,
+		userClient:  userClient,
+		producer:    producer,
+	}
+}
+
+func (s *habitPartnerService) InvitePartner(ctx context.Context, habitID, ownerID uuid.UUID, username string) (*entity.HabitPartner, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, fmt.Errorf("%w username: must not be empty", service.ErrInvalidArgument)
+	}
+
+	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !habit.IsActive {
+		return nil, fmt.Errorf("%w habit: archived habits can't be shared", service.ErrInvalidArgument)
+	}
+
+	count, err := s.partnerRepo.CountByHabitID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	if count >= maxPartnersPerHabit {
+		return nil, fmt.Errorf("%w habit: at most %d partners per habit", service.ErrInvalidArgument, maxPartnersPerHabit)
+	}
+
+	resp, err := s.userClient.GetUserByUsername(ctx, &userpb.GetUserByUsernameRequest{Username: username})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("%w username: user not found", service.ErrInvalidArgument)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	invitee := resp.User
+	if !invitee.IsActive || invitee.DeletionScheduledAt != nil {
+		return nil, fmt.Errorf("%w username: user not found", service.ErrInvalidArgument)
+	}
+
+	partnerID, err := uuid.Parse(invitee.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user id: %w", err)
+	}
+
+	if partnerID == ownerID {
+		return nil, fmt.Errorf("%w username: you can't invite yourself", service.ErrInvalidArgument)
+	}
+
+	owner, err := s.getUser(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	partner := &entity.HabitPartner{
+		ID:              uuid.New(),
+		HabitID:         habitID,
+		HabitName:       habit.Name,
+		OwnerID:         ownerID,
+		OwnerUsername:   owner.Username,
+		PartnerID:       partnerID,
+		PartnerUsername: invitee.Username,
+		CreatedAt:       time.Now().UTC(),
+	}
+
+	if err := s.partnerRepo.Create(ctx, partner, partner.CreatedAt.Add(-reinviteCooldown)); err != nil {
+		return nil, err
+	}
+
+	event := &kafka.HabitPartnerInvitedEvent{
+		EventID:          kafka.NewEventID(),
+		InvitationID:     partner.ID.String(),
+		HabitID:          habitID.String(),
+		HabitName:        habit.Name,
+		OwnerUsername:    owner.Username,
+		PartnerUserID:    invitee.Id,
+		PartnerEmail:     invitee.Email,
+		PartnerUsername:  invitee.Username,
+		PartnerFirstName: invitee.FirstName,
+		InvitedAt:        partner.CreatedAt,
+	}
+
+	if err := s.producer.PublishHabitPartnerInvitedEvent(ctx, event); err != nil {
+		fmt.Printf("Failed to publish partner invitation %s: %v\n", partner.ID, err)
+	}
+
+	return partner, nil
+}
+
+func (s *habitPartnerService) ListPartners(ctx context.Context, habitID, ownerID uuid.UUID) ([]*entity.HabitPartner, error) {
+	if _, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, ownerID); err != nil {
+		return nil, err
+	}
+
+	return s.partnerRepo.GetByHabitID(ctx, habitID)
+}
+
+func (s *habitPartnerService) ListInvitations(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPartner, error) {
+	return s.partnerRepo.GetPendingByPartnerID(ctx, userID)
+}
+
+func (s *habitPartnerService) RespondToInvitation(ctx context.Context, invitationID, userID uuid.UUID, accept bool) (*entity.HabitPartner, error) {
+	newStatus := entity.PartnerStatusDeclined
+	if accept {
+		newStatus = entity.PartnerStatusAccepted
+	}
+
+	if err := s.partnerRepo.Respond(ctx, invitationID, userID, newStatus, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	return s.partnerRepo.GetByID(ctx, invitationID)
+}
+
+func (s *habitPartnerService) RemovePartner(ctx context.Context, partnershipID, userID uuid.UUID) error {
+	return s.partnerRepo.Delete(ctx, partnershipID, userID)
+}
+
+func (s *habitPartnerService) Nudge(ctx context.Context, habitID, partnerID uuid.UUID) (time.Time, error) {
+	partner, err := s.partnerRepo.GetAccepted(ctx, habitID, partnerID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	habit, err := s.habitRepo.GetByID(ctx, habitID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !habit.IsActive {
+		return time.Time{}, fmt.Errorf("%w nudge: habit is archived", service.ErrInvalidArgument)
+	}
+
+	if habit.ConfirmedForCurrentPeriod {
+		return time.Time{}, fmt.Errorf("%w nudge: habit is already done for the current period", service.ErrInvalidArgument)
+	}
+
+	pauses, err := s.pauseRepo.GetByHabitID(ctx, habitID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	today := habit.GetCurrentLocalDate()
+	for _, pause := range pauses {
+		if pause.CoversDate(today) {
+			return time.Time{}, fmt.Errorf("%w nudge: habit is paused", service.ErrInvalidArgument)
+		}
+	}
+
+	owner, err := s.getUser(ctx, habit.UserID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := time.Now().UTC()
+	recorded, err := s.partnerRepo.RecordNudge(ctx, partner.ID, now, now.Add(-nudgeInterval))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !recorded {
+		return time.Time{}, fmt.Errorf("%w: a habit can be nudged once every %d hours", service.ErrTooManyRequests, int(nudgeInterval.Hours()))
+	}
+
+	event := &kafka.HabitNudgedEvent{
+		EventID:         kafka.NewEventID(),
+		HabitID:         habitID.String(),
+		HabitName:       habit.Name,
+		PartnerUsername: partner.PartnerUsername,
+		OwnerUserID:     owner.Id,
+		OwnerEmail:      owner.Email,
+		OwnerUsername:   owner.Username,
+		OwnerFirstName:  owner.FirstName,
+		NudgedAt:        now,
+	}
+
+	if err := s.producer.PublishHabitNudgedEvent(ctx, event); err != nil {
+		return time.Time{}, err
+	}
+
+	return now, nil
+}
+
+func (s *habitPartnerService) IsPartner(ctx context.Context, habitID, userID uuid.UUID) (bool, error) {
+	if _, err := s.partnerRepo.GetAccepted(ctx, habitID, userID); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *habitPartnerService) NotifyStreakBroken(ctx context.Context, habit *entity.Habit, lostStreak int32, missedDate string) {
+	partners, err := s.partnerRepo.GetByHabitID(ctx, habit.ID)
+	if err != nil {
+		fmt.Printf("Failed to get partners of habit %s: %v\n", habit.ID, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, partner := range partners {
+		if !partner.IsAccepted() {
+			continue
+		}
+
+		user, err := s.getUser(ctx, partner.PartnerID)
+		if err != nil {
+			fmt.Printf("Failed to notify partner %s of habit %s: %v\n", partner.PartnerID, habit.ID, err)
+			continue
+		}
+
+		event := &kafka.HabitStreakBrokenEvent{
+			EventID:          kafka.NewEventID(),
+			HabitID:          habit.ID.String(),
+			HabitName:        habit.Name,
+			LostStreak:       lostStreak,
+			MissedDate:       missedDate,
+			OwnerUsername:    partner.OwnerUsername,
+			PartnerUserID:    user.Id,
+			PartnerEmail:     user.Email,
+			PartnerUsername:  user.Username,
+			PartnerFirstName: user.FirstName,
+			BrokenAt:         now,
+		}
+
+		if err := s.producer.PublishHabitStreakBrokenEvent(ctx, event); err != nil {
+			fmt.Printf("Failed to notify partner %s of habit %s: %v\n", partner.PartnerID, habit.ID, err)
+		}
+	}
+}
+
+func (s *habitPartnerService) ExportUserData(ctx context.Context, userID uuid.UUID) ([]*entity.HabitPartner, error) {
+	return s.partnerRepo.GetByUserID(ctx, userID)
+}
+
+func (s *habitPartnerService) PurgeUserData(ctx context.Context, userID uuid.UUID) error {
+	return s.partnerRepo.DeleteByUserID(ctx, userID)
+}
+
+// getUser retrieves a user from user-service
+func (s *habitPartnerService) getUser(ctx context.Context, userID uuid.UUID) (*userpb.User, error) {
+	resp, err := s.userClient.GetUser(ctx, &userpb.GetUserRequest{UserId: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s: %w", userID, err)
+	}
+
+	return resp.User, nil
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	userpb "habits-service/proto/user/v1"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type profileService struct {
+	habitRepo  repository.HabitRepository
+	userClient userpb.UserServiceClient
+}
+
+// NewProfileService creates a new public profile service. Users and their opt-in are resolved through user-service
+func NewProfileService(habitRepo repository.HabitRepository, userClient userpb.UserServiceClient) service.ProfileService {
+	return &profileService{
+		habitRepo:  habitRepo,
+		userClient: userClient,
+	}
+}
+
+func (s *profileService) GetPublicProfile(ctx context.Context, username string) (*entity.PublicProfile, error) {
+	profile, userID, err := s.getProfile(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	habits, err := s.habitRepo.GetByUserID(ctx, userID, entity.HabitStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, habit := range habits {
+		if habit.Visibility == entity.HabitVisibilityPublic {
+			profile.Habits = append(profile.Habits, habit)
+		}
+	}
+
+	sort.SliceStable(profile.Habits, func(i, j int) bool {
+		return profile.Habits[i].Position < profile.Habits[j].Position
+	})
+
+	return profile, nil
+}
+
+func (s *profileService) GetPublicHabit(ctx context.Context, username string, habitID uuid.UUID) (*entity.PublicProfile, error) {
+	profile, userID, err := s.getProfile(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	habit, err := s.habitRepo.GetByIDAndUserID(ctx, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !habit.IsActive || habit.Visibility != entity.HabitVisibilityPublic {
+		return nil, service.ErrHabitNotFound
+	}
+
+	profile.Habits = []*entity.Habit{habit}
+
+	return profile, nil
+}
+
+// getProfile resolves an active user who opted in to a public profile.
+// Users who didn't are reported the same as unknown users
+func (s *profileService) getProfile(ctx context.Context, username string) (*entity.PublicProfile, uuid.UUID, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, uuid.Nil, fmt.Errorf("%w username: must not be empty", service.ErrInvalidArgument)
+	}
+
+	resp, err := s.userClient.GetUserByUsername(ctx, &userpb.GetUserByUsernameRequest{Username: username})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, uuid.Nil, fmt.Errorf("profile %w", service.ErrNotFound)
+		}
+		return nil, uuid.Nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	user := resp.User
+	if !user.IsActive || user.DeletionScheduledAt != nil || !user.PublicProfile {
+		return nil, uuid.Nil, fmt.Errorf("profile %w", service.ErrNotFound)
+	}
+
+	userID, err := uuid.Parse(user.Id)
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("failed to parse user id: %w", err)
+	}
+
+	return &entity.PublicProfile{
+		Username:    user.Username,
+		FirstName:   user.FirstName,
+		MemberSince: user.CreatedAt.AsTime(),
+	}, userID, nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"habits-service/internal/domain/entity"
+	"sort"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// schedulePeriod is a span of local dates in which one confirmation is expected.
+// Weekly periods end on a scheduled weekday, interval periods last IntervalDays days
+type schedulePeriod struct {
+	start time.Time
+	end   time.Time // Last day of the period, inclusive
+	due   bool      // False when the period was cut short by a schedule change or has not ended by the last generated day
+}
+
+// buildSchedulePeriods generates the periods of a habit from its schedule versions
+// through the local date until. Versions must be ordered by EffectiveFrom
+func buildSchedulePeriods(versions []*entity.ScheduleVersion, until time.Time) []schedulePeriod {
+	var periods []schedulePeriod
+
+	for i, version := range versions {
+		from, err := time.Parse(dateLayout, version.EffectiveFrom)
+		if err != nil {
+			continue
+		}
+
+		to := until
+		if i+1 < len(versions) {
+			next, err := time.Parse(dateLayout, versions[i+1].EffectiveFrom)
+			if err == nil && next.AddDate(0, 0, -1).Before(to) {
+				to = next.AddDate(0, 0, -1)
+			}
+		}
+
+		if from.After(to) {
+			continue
+		}
+
+		switch version.ScheduleType {
+		case entity.ScheduleTypeInterval:
+			periods = append(periods, intervalPeriods(version, from, to)...)
+		case entity.ScheduleTypeWeekly:
+			periods = append(periods, weeklyPeriods(version, from, to)...)
+		}
+	}
+
+	return periods
+}
+
+func intervalPeriods(version *entity.ScheduleVersion, from, to time.Time) []schedulePeriod {
+	if version.IntervalDays == nil || *version.IntervalDays <= 0 {
+		return nil
+	}
+
+	days := int(*version.IntervalDays)
+
+	var periods []schedulePeriod
+	for start := from; !start.After(to); start = start.AddDate(0, 0, days) {
+		end := start.AddDate(0, 0, days-1)
+		due := true
+		if end.After(to) {
+			end = to
+			due = false
+		}
+		periods = append(periods, schedulePeriod{start: start, end: end, due: due})
+	}
+
+	return periods
+}
+
+func weeklyPeriods(version *entity.ScheduleVersion, from, to time.Time) []schedulePeriod {
+	if len(version.WeeklyDays) == 0 {
+		return nil
+	}
+
+	scheduled := make(map[time.Weekday]bool, len(version.WeeklyDays))
+	for _, day := range version.WeeklyDays {
+		scheduled[time.Weekday(day)] = true
+	}
+
+	var periods []schedulePeriod
+	start := from
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if scheduled[day.Weekday()] {
+			periods = append(periods, schedulePeriod{start: start, end: day, due: true})
+			start = day.AddDate(0, 0, 1)
+		}
+	}
+
+	if !start.After(to) {
+		periods = append(periods, schedulePeriod{start: start, end: to, due: false})
+	}
+
+	return periods
+}
+
+// evaluatedPeriod is a schedule period checked against confirmations and pauses
+type evaluatedPeriod struct {
+	schedulePeriod
+	completed bool
+	counted   bool // Whether the period is expected to be confirmed by today
+}
+
+// evaluatePeriods marks completed periods and the ones that count towards rates and streaks.
+// A period counts once it is due and has ended before today, or earlier if it was already
+// completed. Periods due on a paused day are skipped unless completed.
+// Dates are the sorted local dates of confirmations
+func evaluatePeriods(periods []schedulePeriod, dates []string, pauses []*entity.HabitPause, today time.Time) []evaluatedPeriod {
+	evaluated := make([]evaluatedPeriod, len(periods))
+	for i, period := range periods {
+		completed := datesInRange(dates, period.start, period.end)
+		evaluated[i] = evaluatedPeriod{
+			schedulePeriod: period,
+			completed:      completed,
+			counted:        completed || (period.due && period.end.Before(today) && !pausedOn(pauses, period.end)),
+		}
+	}
+	return evaluated
+}
+
+// datesInRange reports whether any of the sorted dates falls within [start, end]
+func datesInRange(dates []string, start, end time.Time) bool {
+	i := sort.SearchStrings(dates, start.Format(dateLayout))
+	return i < len(dates) && dates[i] <= end.Format(dateLayout)
+}
+
+// pausedOn reports whether a pause covers the given local date
+func pausedOn(pauses []*entity.HabitPause, day time.Time) bool {
+	date := day.Format(dateLayout)
+	for _, pause := range pauses {
+		if pause.StartDate <= date && date <= pause.EndDate {
+			return true
+		}
+	}
+	return false
+}
+
+// weekStart returns the Monday of the week containing day
+func weekStart(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// monthStart returns the first day of the month containing day
+func monthStart(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,497 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"habits-service/internal/domain/entity"
+)
+
+// 2026-01-05 is a Monday
+
+func day(t *testing.T, date string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse(dateLayout, date)
+	if err != nil {
+		t.Fatalf("invalid date %q: %v", date, err)
+	}
+	return parsed
+}
+
+func intervalVersion(from string, days int32) *entity.ScheduleVersion {
+	return &entity.ScheduleVersion{
+		ScheduleType:  entity.ScheduleTypeInterval,
+		IntervalDays:  &days,
+		EffectiveFrom: from,
+	}
+}
+
+func weeklyVersion(from string, weekdays ...time.Weekday) *entity.ScheduleVersion {
+	days := make([]int32, len(weekdays))
+	for i, weekday := range weekdays {
+		days[i] = int32(weekday)
+	}
+	return &entity.ScheduleVersion{
+		ScheduleType:  entity.ScheduleTypeWeekly,
+		WeeklyDays:    days,
+		EffectiveFrom: from,
+	}
+}
+
+func pause(start, end string) *entity.HabitPause {
+	return &entity.HabitPause{StartDate: start, EndDate: end}
+}
+
+// testPeriod is a schedule period written with dates for readable test tables
+type testPeriod struct {
+	start, end string
+	due        bool
+}
+
+func toTestPeriods(periods []schedulePeriod) []testPeriod {
+	result := make([]testPeriod, len(periods))
+	for i, period := range periods {
+		result[i] = testPeriod{
+			start: period.start.Format(dateLayout),
+			end:   period.end.Format(dateLayout),
+			due:   period.due,
+		}
+	}
+	return result
+}
+
+func TestBuildSchedulePeriods(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []*entity.ScheduleVersion
+		until    string
+		want     []testPeriod
+	}{
+		{
+			name:     "interval ending on until",
+			versions: []*entity.ScheduleVersion{intervalVersion("2026-01-05", 2)},
+			until:    "2026-01-10",
+			want: []testPeriod{
+				{"2026-01-05", "2026-01-06", true},
+				{"2026-01-07", "2026-01-08", true},
+				{"2026-01-09", "2026-01-10", true},
+			},
+		},
+		{
+			name:     "interval with unfinished last period",
+			versions: []*entity.ScheduleVersion{intervalVersion("2026-01-05", 3)},
+			until:    "2026-01-09",
+			want: []testPeriod{
+				{"2026-01-05", "2026-01-07", true},
+				{"2026-01-08", "2026-01-09", false},
+			},
+		},
+		{
+			name:     "daily interval",
+			versions: []*entity.ScheduleVersion{intervalVersion("2026-01-05", 1)},
+			until:    "2026-01-07",
+			want: []testPeriod{
+				{"2026-01-05", "2026-01-05", true},
+				{"2026-01-06", "2026-01-06", true},
+				{"2026-01-07", "2026-01-07", true},
+			},
+		},
+		{
+			name:     "weekly starting on a scheduled day",
+			versions: []*entity.ScheduleVersion{weeklyVersion("2026-01-05", time.Monday, time.Thursday)},
+			until:    "2026-01-11",
+			want: []testPeriod{
+				{"2026-01-05", "2026-01-05", true},
+				{"2026-01-06", "2026-01-08", true},
+				{"2026-01-09", "2026-01-11", false},
+			},
+		},
+		{
+			name:     "weekly starting mid-week",
+			versions: []*entity.ScheduleVersion{weeklyVersion("2026-01-07", time.Monday)},
+			until:    "2026-01-12",
+			want: []testPeriod{
+				{"2026-01-07", "2026-01-12", true},
+			},
+		},
+		{
+			name: "interval changed to weekly",
+			versions: []*entity.ScheduleVersion{
+				intervalVersion("2026-01-05", 3),
+				weeklyVersion("2026-01-10", time.Friday),
+			},
+			until: "2026-01-16",
+			want: []testPeriod{
+				{"2026-01-05", "2026-01-07", true},
+				{"2026-01-08", "2026-01-09", false},
+				{"2026-01-10", "2026-01-16", true},
+			},
+		},
+		{
+			name: "weekly changed to interval",
+			versions: []*entity.ScheduleVersion{
+				weeklyVersion("2026-01-05", time.Monday),
+				intervalVersion("2026-01-08", 1),
+			},
+			until: "2026-01-09",
+			want: []testPeriod{
+				{"2026-01-05", "2026-01-05", true},
+				{"2026-01-06", "2026-01-07", false},
+				{"2026-01-08", "2026-01-08", true},
+				{"2026-01-09", "2026-01-09", true},
+			},
+		},
+		{
+			name: "version effective after until",
+			versions: []*entity.ScheduleVersion{
+				intervalVersion("2026-01-05", 1),
+				weeklyVersion("2026-01-10", time.Monday),
+			},
+			until: "2026-01-06",
+			want: []testPeriod{
+				{"2026-01-05", "2026-01-05", true},
+				{"2026-01-06", "2026-01-06", true},
+			},
+		},
+		{
+			name: "version replaced on its first day",
+			versions: []*entity.ScheduleVersion{
+				intervalVersion("2026-01-05", 1),
+				intervalVersion("2026-01-06", 7),
+				intervalVersion("2026-01-06", 2),
+			},
+			until: "2026-01-07",
+			want: []testPeriod{
+				{"2026-01-05", "2026-01-05", true},
+				{"2026-01-06", "2026-01-07", true},
+			},
+		},
+		{
+			name:     "interval without days",
+			versions: []*entity.ScheduleVersion{{ScheduleType: entity.ScheduleTypeInterval, EffectiveFrom: "2026-01-05"}},
+			until:    "2026-01-10",
+		},
+		{
+			name:     "weekly without days",
+			versions: []*entity.ScheduleVersion{weeklyVersion("2026-01-05")},
+			until:    "2026-01-10",
+		},
+		{
+			name:  "no versions",
+			until: "2026-01-10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toTestPeriods(buildSchedulePeriods(tt.versions, day(t, tt.until)))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d periods %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("period %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEvaluatePeriods(t *testing.T) {
+	type evaluation struct {
+		completed, counted bool
+	}
+
+	tests := []struct {
+		name    string
+		periods []testPeriod
+		dates   []string
+		pauses  []*entity.HabitPause
+		today   string
+		want    []evaluation
+	}{
+		{
+			name:    "confirmed on first and last day",
+			periods: []testPeriod{{"2026-01-05", "2026-01-07", true}, {"2026-01-08", "2026-01-10", true}},
+			dates:   []string{"2026-01-05", "2026-01-10"},
+			today:   "2026-01-11",
+			want:    []evaluation{{true, true}, {true, true}},
+		},
+		{
+			name:    "confirmations outside the period",
+			periods: []testPeriod{{"2026-01-06", "2026-01-08", true}},
+			dates:   []string{"2026-01-05", "2026-01-09"},
+			today:   "2026-01-11",
+			want:    []evaluation{{false, true}},
+		},
+		{
+			name:    "period ending today",
+			periods: []testPeriod{{"2026-01-05", "2026-01-05", true}, {"2026-01-06", "2026-01-06", true}},
+			today:   "2026-01-06",
+			want:    []evaluation{{false, true}, {false, false}},
+		},
+		{
+			name:    "period ending today already confirmed",
+			periods: []testPeriod{{"2026-01-06", "2026-01-06", true}},
+			dates:   []string{"2026-01-06"},
+			today:   "2026-01-06",
+			want:    []evaluation{{true, true}},
+		},
+		{
+			name:    "period cut short by a schedule change",
+			periods: []testPeriod{{"2026-01-05", "2026-01-06", false}, {"2026-01-07", "2026-01-08", false}},
+			dates:   []string{"2026-01-08"},
+			today:   "2026-01-11",
+			want:    []evaluation{{false, false}, {true, true}},
+		},
+		{
+			name:    "paused on the last day",
+			periods: []testPeriod{{"2026-01-05", "2026-01-07", true}, {"2026-01-08", "2026-01-10", true}},
+			dates:   []string{"2026-01-09"},
+			pauses:  []*entity.HabitPause{pause("2026-01-07", "2026-01-10")},
+			today:   "2026-01-11",
+			want:    []evaluation{{false, false}, {true, true}},
+		},
+		{
+			name:    "pause ending before the last day",
+			periods: []testPeriod{{"2026-01-05", "2026-01-07", true}},
+			pauses:  []*entity.HabitPause{pause("2026-01-01", "2026-01-06")},
+			today:   "2026-01-11",
+			want:    []evaluation{{false, true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := make([]schedulePeriod, len(tt.periods))
+			for i, period := range tt.periods {
+				periods[i] = schedulePeriod{start: day(t, period.start), end: day(t, period.end), due: period.due}
+			}
+
+			got := evaluatePeriods(periods, tt.dates, tt.pauses, day(t, tt.today))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d periods, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if (evaluation{got[i].completed, got[i].counted}) != tt.want[i] {
+					t.Errorf("period %d: completed %t counted %t, want completed %t counted %t",
+						i, got[i].completed, got[i].counted, tt.want[i].completed, tt.want[i].counted)
+				}
+			}
+		})
+	}
+}
+
+func TestComputeHabitStats(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []*entity.ScheduleVersion
+		dates    []string
+		pauses   []*entity.HabitPause
+		today    string
+
+		wantExpected, wantCompleted       int32
+		wantCurrentStreak, wantLongest    int32
+		wantRate                          float64
+		wantPausedDays                    int32
+		wantWeeklyRates, wantMonthlyRates []entity.PeriodRate
+	}{
+		{
+			name:              "daily interval with a missed day",
+			versions:          []*entity.ScheduleVersion{intervalVersion("2026-01-05", 1)},
+			dates:             []string{"2026-01-05", "2026-01-06", "2026-01-08", "2026-01-09", "2026-01-10"},
+			today:             "2026-01-10",
+			wantExpected:      6,
+			wantCompleted:     5,
+			wantCurrentStreak: 3,
+			wantLongest:       3,
+			wantRate:          500.0 / 6,
+			wantWeeklyRates:   []entity.PeriodRate{{PeriodStart: "2026-01-05", Expected: 6, Completed: 5, Rate: 500.0 / 6}},
+		},
+		{
+			name:              "daily interval with today still open",
+			versions:          []*entity.ScheduleVersion{intervalVersion("2026-01-05", 1)},
+			dates:             []string{"2026-01-05", "2026-01-06"},
+			today:             "2026-01-07",
+			wantExpected:      2,
+			wantCompleted:     2,
+			wantCurrentStreak: 2,
+			wantLongest:       2,
+			wantRate:          100,
+		},
+		{
+			name:              "daily interval with a pause",
+			versions:          []*entity.ScheduleVersion{intervalVersion("2026-01-05", 1)},
+			dates:             []string{"2026-01-05", "2026-01-06", "2026-01-09"},
+			pauses:            []*entity.HabitPause{pause("2026-01-07", "2026-01-08")},
+			today:             "2026-01-10",
+			wantExpected:      3,
+			wantCompleted:     3,
+			wantCurrentStreak: 3,
+			wantLongest:       3,
+			wantRate:          100,
+			wantPausedDays:    2,
+		},
+		{
+			name:              "interval confirmed on period boundaries",
+			versions:          []*entity.ScheduleVersion{intervalVersion("2026-01-05", 3)},
+			dates:             []string{"2026-01-07", "2026-01-11"},
+			today:             "2026-01-12",
+			wantExpected:      3,
+			wantCompleted:     2,
+			wantCurrentStreak: 1,
+			wantLongest:       1,
+			wantRate:          200.0 / 3,
+		},
+		{
+			name:              "interval across a month boundary",
+			versions:          []*entity.ScheduleVersion{intervalVersion("2026-01-30", 1)},
+			dates:             []string{"2026-01-30", "2026-01-31", "2026-02-01"},
+			today:             "2026-02-02",
+			wantExpected:      3,
+			wantCompleted:     3,
+			wantCurrentStreak: 3,
+			wantLongest:       3,
+			wantRate:          100,
+			wantWeeklyRates:   []entity.PeriodRate{{PeriodStart: "2026-01-26", Expected: 3, Completed: 3, Rate: 100}},
+			wantMonthlyRates: []entity.PeriodRate{
+				{PeriodStart: "2026-01-01", Expected: 2, Completed: 2, Rate: 100},
+				{PeriodStart: "2026-02-01", Expected: 1, Completed: 1, Rate: 100},
+			},
+		},
+		{
+			name:              "weekly with a missed week",
+			versions:          []*entity.ScheduleVersion{weeklyVersion("2026-01-05", time.Monday, time.Thursday)},
+			dates:             []string{"2026-01-05", "2026-01-07", "2026-01-13"},
+			today:             "2026-01-16",
+			wantExpected:      4,
+			wantCompleted:     3,
+			wantCurrentStreak: 1,
+			wantLongest:       2,
+			wantRate:          75,
+			wantWeeklyRates: []entity.PeriodRate{
+				{PeriodStart: "2026-01-05", Expected: 2, Completed: 2, Rate: 100},
+				{PeriodStart: "2026-01-12", Expected: 2, Completed: 1, Rate: 50},
+			},
+		},
+		{
+			name:              "weekly paused on a scheduled day",
+			versions:          []*entity.ScheduleVersion{weeklyVersion("2026-01-05", time.Monday)},
+			dates:             []string{"2026-01-05", "2026-01-19"},
+			pauses:            []*entity.HabitPause{pause("2026-01-12", "2026-01-12")},
+			today:             "2026-01-20",
+			wantExpected:      2,
+			wantCompleted:     2,
+			wantCurrentStreak: 2,
+			wantLongest:       2,
+			wantRate:          100,
+			wantPausedDays:    1,
+		},
+		{
+			name: "interval changed to weekly mid-history",
+			versions: []*entity.ScheduleVersion{
+				intervalVersion("2026-01-05", 1),
+				weeklyVersion("2026-01-08", time.Friday),
+			},
+			dates:             []string{"2026-01-05", "2026-01-07", "2026-01-09", "2026-01-14"},
+			today:             "2026-01-20",
+			wantExpected:      5,
+			wantCompleted:     4,
+			wantCurrentStreak: 3,
+			wantLongest:       3,
+			wantRate:          80,
+		},
+		{
+			name: "weekly changed to interval mid-history",
+			versions: []*entity.ScheduleVersion{
+				weeklyVersion("2026-01-05", time.Wednesday),
+				intervalVersion("2026-01-09", 2),
+			},
+			// The unfinished week before the change neither breaks nor extends the streak
+			dates:             []string{"2026-01-07", "2026-01-10"},
+			today:             "2026-01-13",
+			wantExpected:      3,
+			wantCompleted:     2,
+			wantCurrentStreak: 0,
+			wantLongest:       2,
+			wantRate:          200.0 / 3,
+		},
+		{
+			name:  "no schedule",
+			dates: []string{"2026-01-05"},
+			today: "2026-01-10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confirmations := make([]*entity.HabitConfirmation, len(tt.dates))
+			for i, date := range tt.dates {
+				confirmations[i] = &entity.HabitConfirmation{
+					ConfirmedAt:      day(t, date).Add(20 * time.Hour),
+					ConfirmedForDate: date,
+				}
+			}
+
+			stats := computeHabitStats(tt.versions, confirmations, tt.pauses, day(t, tt.today))
+
+			if stats.TotalConfirmations != int32(len(tt.dates)) {
+				t.Errorf("TotalConfirmations = %d, want %d", stats.TotalConfirmations, len(tt.dates))
+			}
+			if stats.ExpectedPeriods != tt.wantExpected || stats.CompletedPeriods != tt.wantCompleted {
+				t.Errorf("periods = %d/%d, want %d/%d",
+					stats.CompletedPeriods, stats.ExpectedPeriods, tt.wantCompleted, tt.wantExpected)
+			}
+			if stats.CurrentStreak != tt.wantCurrentStreak {
+				t.Errorf("CurrentStreak = %d, want %d", stats.CurrentStreak, tt.wantCurrentStreak)
+			}
+			if stats.LongestStreak != tt.wantLongest {
+				t.Errorf("LongestStreak = %d, want %d", stats.LongestStreak, tt.wantLongest)
+			}
+			if !almostEqual(stats.CompletionRate, tt.wantRate) {
+				t.Errorf("CompletionRate = %f, want %f", stats.CompletionRate, tt.wantRate)
+			}
+			if stats.PausedDays != tt.wantPausedDays {
+				t.Errorf("PausedDays = %d, want %d", stats.PausedDays, tt.wantPausedDays)
+			}
+			if tt.wantWeeklyRates != nil {
+				checkRates(t, "WeeklyRates", stats.WeeklyRates, tt.wantWeeklyRates)
+			}
+			if tt.wantMonthlyRates != nil {
+				checkRates(t, "MonthlyRates", stats.MonthlyRates, tt.wantMonthlyRates)
+			}
+
+			if len(tt.dates) > 0 {
+				first, last := confirmations[0].ConfirmedAt, confirmations[len(confirmations)-1].ConfirmedAt
+				if stats.FirstConfirmation == nil || !stats.FirstConfirmation.Equal(first) {
+					t.Errorf("FirstConfirmation = %v, want %v", stats.FirstConfirmation, first)
+				}
+				if stats.LastConfirmation == nil || !stats.LastConfirmation.Equal(last) {
+					t.Errorf("LastConfirmation = %v, want %v", stats.LastConfirmation, last)
+				}
+			}
+		})
+	}
+}
+
+func checkRates(t *testing.T, name string, got []*entity.PeriodRate, want []entity.PeriodRate) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %d buckets, want %d", name, len(got), len(want))
+	}
+	for i := range got {
+		if got[i].PeriodStart != want[i].PeriodStart ||
+			got[i].Expected != want[i].Expected ||
+			got[i].Completed != want[i].Completed ||
+			!almostEqual(got[i].Rate, want[i].Rate) {
+			t.Errorf("%s[%d] = %+v, want %+v", name, i, *got[i], want[i])
+		}
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
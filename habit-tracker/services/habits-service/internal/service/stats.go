@@ -0,0 +1,124 @@
+package service
+
+import (
+	"habits-service/internal/domain/entity"
+	"time"
+)
+
+// computeHabitStats evaluates confirmations against the periods expected by the habit's
+// schedule history. Periods that don't count neither extend nor break a streak
+func computeHabitStats(
+	versions []*entity.ScheduleVersion,
+	confirmations []*entity.HabitConfirmation,
+	pauses []*entity.HabitPause,
+	today time.Time,
+) *entity.HabitStats {
+	stats := &entity.HabitStats{
+		TotalConfirmations: int32(len(confirmations)),
+	}
+
+	// Confirmations are ordered by date
+	dates := make([]string, len(confirmations))
+	for i, confirmation := range confirmations {
+		dates[i] = confirmation.ConfirmedForDate
+
+		confirmedAt := confirmation.ConfirmedAt
+		if stats.FirstConfirmation == nil || confirmedAt.Before(*stats.FirstConfirmation) {
+			stats.FirstConfirmation = &confirmedAt
+		}
+		if stats.LastConfirmation == nil || confirmedAt.After(*stats.LastConfirmation) {
+			stats.LastConfirmation = &confirmedAt
+		}
+	}
+
+	weekly := newRateBuckets()
+	monthly := newRateBuckets()
+
+	var streak int32
+	for _, period := range evaluatePeriods(buildSchedulePeriods(versions, today), dates, pauses, today) {
+		if !period.counted {
+			continue
+		}
+
+		completed := period.completed
+
+		stats.ExpectedPeriods++
+		weekly.add(weekStart(period.end), completed)
+		monthly.add(monthStart(period.end), completed)
+
+		if completed {
+			stats.CompletedPeriods++
+			streak++
+			if streak > stats.LongestStreak {
+				stats.LongestStreak = streak
+			}
+		} else {
+			streak = 0
+		}
+	}
+
+	stats.CurrentStreak = streak
+	stats.CompletionRate = completionRate(stats.CompletedPeriods, stats.ExpectedPeriods)
+	stats.WeeklyRates = weekly.rates()
+	stats.MonthlyRates = monthly.rates()
+
+	if len(versions) > 0 {
+		if from, err := time.Parse(dateLayout, versions[0].EffectiveFrom); err == nil {
+			stats.PausedDays = countPausedDays(pauses, from, today)
+		}
+	}
+
+	return stats
+}
+
+func completionRate(completed, expected int32) float64 {
+	if expected == 0 {
+		return 0
+	}
+	return float64(completed) / float64(expected) * 100
+}
+
+// countPausedDays counts days within [from, to] covered by pauses
+func countPausedDays(pauses []*entity.HabitPause, from, to time.Time) int32 {
+	var days int32
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if pausedOn(pauses, day) {
+			days++
+		}
+	}
+	return days
+}
+
+// rateBuckets accumulates expected and completed periods per calendar week or month
+type rateBuckets struct {
+	order   []time.Time
+	buckets map[time.Time]*entity.PeriodRate
+}
+
+func newRateBuckets() *rateBuckets {
+	return &rateBuckets{buckets: make(map[time.Time]*entity.PeriodRate)}
+}
+
+func (b *rateBuckets) add(start time.Time, completed bool) {
+	bucket, ok := b.buckets[start]
+	if !ok {
+		bucket = &entity.PeriodRate{PeriodStart: start.Format(dateLayout)}
+		b.buckets[start] = bucket
+		b.order = append(b.order, start)
+	}
+
+	bucket.Expected++
+	if completed {
+		bucket.Completed++
+	}
+}
+
+func (b *rateBuckets) rates() []*entity.PeriodRate {
+	rates := make([]*entity.PeriodRate, len(b.order))
+	for i, start := range b.order {
+		bucket := b.buckets[start]
+		bucket.Rate = completionRate(bucket.Completed, bucket.Expected)
+		rates[i] = bucket
+	}
+	return rates
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/repository"
+	"habits-service/internal/domain/service"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxTemplatesPerUser      = 100
+	maxSuggestedTargetLength = 100
+)
+
+type habitTemplateService struct {
+	templateRepo repository.HabitTemplateRepository
+	habitService service.HabitService
+}
+
+// NewHabitTemplateService creates a new habit template service.
+// Habits are created through the habit service so that they get the same validation and deadlines
+func NewHabitTemplateService(templateRepo repository.HabitTemplateRepository, habitService service.HabitService) service.HabitTemplateService {
+	return &habitTemplateService{
+		templateRepo: templateRepo,
+		habitService: habitService,
+	}
+}
+
+func (s *habitTemplateService) ListTemplates(ctx context.Context, userID uuid.UUID, category *string) ([]*entity.HabitTemplate, error) {
+	if category != nil {
+		normalized := strings.ToLower(strings.TrimSpace(*category))
+		category = &normalized
+	}
+
+	return s.templateRepo.GetVisibleByUserID(ctx, userID, category)
+}
+
+func (s *habitTemplateService) CreateHabitFromTemplate(ctx context.Context, templateID, userID uuid.UUID, timezone string,
+	name *string, groupID *uuid.UUID) (*entity.Habit, error) {
+
+	template, err := s.templateRepo.GetVisible(ctx, templateID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	habitName := template.Name
+	if name != nil && strings.TrimSpace(*name) != "" {
+		habitName = strings.TrimSpace(*name)
+	}
+
+	return s.habitService.CreateHabit(
+		ctx, userID, habitName, template.Description, template.Color,
+		template.ScheduleType, template.IntervalDays, template.WeeklyDays, timezone,
+		groupID, template.Icon, template.Tags, nil,
+	)
+}
+
+func (s *habitTemplateService) SaveHabitAsTemplate(ctx context.Context, habitID, userID uuid.UUID, name, suggestedTarget *string) (*entity.HabitTemplate, error) {
+	habit, err := s.habitService.GetHabit(ctx, habitID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.templateRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxTemplatesPerUser {
+		return nil, fmt.Errorf("%w template: at most %d private templates per user", service.ErrInvalidArgument, maxTemplatesPerUser)
+	}
+
+	templateName := habit.Name
+	if name != nil && strings.TrimSpace(*name) != "" {
+		templateName = strings.TrimSpace(*name)
+	}
+
+	suggestedTarget = clearIfEmpty(suggestedTarget)
+	if suggestedTarget != nil && len([]rune(*suggestedTarget)) > maxSuggestedTargetLength {
+		return nil, fmt.Errorf("%w suggested_target: must be at most %d characters", service.ErrInvalidArgument, maxSuggestedTargetLength)
+	}
+
+	template := &entity.HabitTemplate{
+		ID:              uuid.New(),
+		UserID:          &userID,
+		Name:            templateName,
+		Description:     habit.Description,
+		Color:           habit.Color,
+		Icon:            habit.Icon,
+		Tags:            habit.Tags,
+		ScheduleType:    habit.ScheduleType,
+		IntervalDays:    habit.IntervalDays,
+		WeeklyDays:      habit.WeeklyDays,
+		SuggestedTarget: suggestedTarget,
+		CreatedAt:       time.Now().UTC(),
+	}
+
+	if err := s.templateRepo.Create(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *habitTemplateService) DeleteTemplate(ctx context.Context, templateID, userID uuid.UUID) error {
+	return s.templateRepo.Delete(ctx, templateID, userID)
+}
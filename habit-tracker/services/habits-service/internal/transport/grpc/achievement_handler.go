@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *HabitServiceHandler) ListAchievements(ctx context.Context, req *pb.ListAchievementsRequest) (*pb.ListAchievementsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	progress, err := h.achievementService.GetProgress(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list achievements: %v", err))
+	}
+
+	return mapAchievementProgressToProto(progress), nil
+}
+
+func mapAchievementProgressToProto(progress *entity.AchievementProgress) *pb.ListAchievementsResponse {
+	achievements := make([]*pb.Achievement, len(progress.Achievements))
+	for i, item := range progress.Achievements {
+		achievement := &pb.Achievement{
+			Code:        item.Achievement.Code,
+			Name:        item.Achievement.Name,
+			Description: item.Achievement.Description,
+			Xp:          item.Achievement.XP,
+			Unlocked:    item.Unlocked != nil,
+		}
+
+		if item.Unlocked != nil {
+			achievement.UnlockedAt = timestamppb.New(item.Unlocked.UnlockedAt)
+			if item.Unlocked.HabitID != nil {
+				habitID := item.Unlocked.HabitID.String()
+				achievement.HabitId = &habitID
+			}
+		}
+
+		achievements[i] = achievement
+	}
+
+	return &pb.ListAchievementsResponse{
+		Xp:           progress.XP,
+		Level:        progress.Level,
+		LevelXp:      progress.LevelXP,
+		NextLevelXp:  progress.NextLevelXP,
+		Achievements: achievements,
+	}
+}
@@ -0,0 +1,215 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/service"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type HabitAnalyticsHandler struct {
+	pb.UnimplementedHabitAnalyticsServiceServer
+	analyticsService service.AnalyticsService
+}
+
+func NewHabitAnalyticsHandler(analyticsService service.AnalyticsService) *HabitAnalyticsHandler {
+	return &HabitAnalyticsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+func mapTrendGranularityFromProto(granularity pb.TrendGranularity) entity.TrendGranularity {
+	switch granularity {
+	case pb.TrendGranularity_TREND_GRANULARITY_DAY:
+		return entity.TrendGranularityDay
+	case pb.TrendGranularity_TREND_GRANULARITY_MONTH:
+		return entity.TrendGranularityMonth
+	default:
+		return entity.TrendGranularityWeek
+	}
+}
+
+func mapTrendDirectionToProto(direction entity.TrendDirection) pb.TrendDirection {
+	switch direction {
+	case entity.TrendImproving:
+		return pb.TrendDirection_TREND_DIRECTION_IMPROVING
+	case entity.TrendDeclining:
+		return pb.TrendDirection_TREND_DIRECTION_DECLINING
+	case entity.TrendStable:
+		return pb.TrendDirection_TREND_DIRECTION_STABLE
+	case entity.TrendInsufficientData:
+		return pb.TrendDirection_TREND_DIRECTION_INSUFFICIENT_DATA
+	default:
+		return pb.TrendDirection_TREND_DIRECTION_UNSPECIFIED
+	}
+}
+
+func parseAnalyticsUserID(userID string) (uuid.UUID, error) {
+	if userID == "" {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	parsed, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	return parsed, nil
+}
+
+func (h *HabitAnalyticsHandler) GetCompletionTrend(ctx context.Context, req *pb.GetCompletionTrendRequest) (*pb.GetCompletionTrendResponse, error) {
+	userID, err := parseAnalyticsUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.FromDate == "" || req.ToDate == "" {
+		return nil, status.Error(codes.InvalidArgument, "from_date and to_date are required")
+	}
+
+	trend, err := h.analyticsService.GetCompletionTrend(ctx, userID, mapTrendGranularityFromProto(req.Granularity), req.FromDate, req.ToDate)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidArgument) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get completion trend: %v", err))
+	}
+
+	points := make([]*pb.CompletionPoint, len(trend.Points))
+	for i, point := range trend.Points {
+		points[i] = &pb.CompletionPoint{
+			PeriodStart:    point.PeriodStart,
+			Completed:      point.Completed,
+			Missed:         point.Missed,
+			CompletionRate: point.Rate,
+		}
+	}
+
+	return &pb.GetCompletionTrendResponse{
+		Points:      points,
+		OverallRate: trend.OverallRate,
+		Direction:   mapTrendDirectionToProto(trend.Direction),
+		Slope:       trend.Slope,
+	}, nil
+}
+
+func (h *HabitAnalyticsHandler) GetWeekdayBreakdown(ctx context.Context, req *pb.GetWeekdayBreakdownRequest) (*pb.GetWeekdayBreakdownResponse, error) {
+	userID, err := parseAnalyticsUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown, err := h.analyticsService.GetWeekdayBreakdown(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get weekday breakdown: %v", err))
+	}
+
+	weekdays := make([]*pb.WeekdayCompletionRate, len(breakdown.Days))
+	for i, day := range breakdown.Days {
+		weekdays[i] = &pb.WeekdayCompletionRate{
+			Weekday:        day.Weekday,
+			Completed:      day.Completed,
+			Missed:         day.Missed,
+			CompletionRate: day.Rate,
+		}
+	}
+
+	return &pb.GetWeekdayBreakdownResponse{
+		Weekdays:     weekdays,
+		BestWeekday:  breakdown.BestWeekday,
+		WorstWeekday: breakdown.WorstWeekday,
+	}, nil
+}
+
+func (h *HabitAnalyticsHandler) GetHourDistribution(ctx context.Context, req *pb.GetHourDistributionRequest) (*pb.GetHourDistributionResponse, error) {
+	userID, err := parseAnalyticsUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := h.analyticsService.GetHourDistribution(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get hour distribution: %v", err))
+	}
+
+	return &pb.GetHourDistributionResponse{
+		Confirmations: hours,
+	}, nil
+}
+
+func (h *HabitAnalyticsHandler) GetHabitCorrelations(ctx context.Context, req *pb.GetHabitCorrelationsRequest) (*pb.GetHabitCorrelationsResponse, error) {
+	userID, err := parseAnalyticsUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit int32
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+
+	correlations, err := h.analyticsService.GetHabitCorrelations(ctx, userID, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get habit correlations: %v", err))
+	}
+
+	protoCorrelations := make([]*pb.HabitCorrelation, len(correlations))
+	for i, correlation := range correlations {
+		protoCorrelations[i] = &pb.HabitCorrelation{
+			HabitAId:     correlation.HabitAID.String(),
+			HabitAName:   correlation.HabitAName,
+			HabitBId:     correlation.HabitBID.String(),
+			HabitBName:   correlation.HabitBName,
+			DaysTogether: correlation.DaysTogether,
+			Score:        correlation.Score,
+		}
+	}
+
+	return &pb.GetHabitCorrelationsResponse{
+		Correlations: protoCorrelations,
+	}, nil
+}
+
+func (h *HabitAnalyticsHandler) GetMoodCorrelations(ctx context.Context, req *pb.GetMoodCorrelationsRequest) (*pb.GetMoodCorrelationsResponse, error) {
+	userID, err := parseAnalyticsUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.FromDate == "" || req.ToDate == "" {
+		return nil, status.Error(codes.InvalidArgument, "from_date and to_date are required")
+	}
+
+	summary, err := h.analyticsService.GetMoodCorrelations(ctx, userID, req.FromDate, req.ToDate)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidArgument) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get mood correlations: %v", err))
+	}
+
+	habits := make([]*pb.MoodCorrelation, len(summary.Habits))
+	for i, correlation := range summary.Habits {
+		habits[i] = &pb.MoodCorrelation{
+			HabitId:        correlation.HabitID.String(),
+			HabitName:      correlation.HabitName,
+			DaysDone:       correlation.DaysDone,
+			AvgMoodDone:    correlation.AvgMoodDone,
+			DaysNotDone:    correlation.DaysNotDone,
+			AvgMoodNotDone: correlation.AvgMoodNotDone,
+			Difference:     correlation.Difference,
+		}
+	}
+
+	return &pb.GetMoodCorrelationsResponse{
+		RatedDays:   summary.RatedDays,
+		AverageMood: summary.AverageMood,
+		Habits:      habits,
+	}, nil
+}
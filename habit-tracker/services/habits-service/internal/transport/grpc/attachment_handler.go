@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/service"
+	pb "habits-service/proto/habits/v1"
+	"io"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// attachmentChunkSize is the size of attachment chunks streamed to clients
+const attachmentChunkSize = 64 * 1024
+
+func mapAttachmentToProto(attachment *entity.Attachment) *pb.Attachment {
+	a := &pb.Attachment{
+		Id:          attachment.ID.String(),
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		Width:       attachment.Width,
+		Height:      attachment.Height,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+	}
+
+	if attachment.ConfirmationID != nil {
+		confirmationID := attachment.ConfirmationID.String()
+		a.ConfirmationId = &confirmationID
+	}
+
+	return a
+}
+
+func (h *HabitServiceHandler) UploadAttachment(ctx context.Context, req *pb.UploadAttachmentRequest) (*pb.UploadAttachmentResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	attachment, err := h.attachmentService.Upload(ctx, userID, req.Data)
+	if err != nil {
+		if errors.Is(err, service.ErrQuotaExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, mapOrganizeError("failed to upload attachment", err)
+	}
+
+	return &pb.UploadAttachmentResponse{
+		Attachment: mapAttachmentToProto(attachment),
+	}, nil
+}
+
+func (h *HabitServiceHandler) DownloadAttachment(req *pb.DownloadAttachmentRequest, stream pb.HabitService_DownloadAttachmentServer) error {
+	if req.UserId == "" {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if req.AttachmentId == "" {
+		return status.Error(codes.InvalidArgument, "attachment_id is required")
+	}
+
+	attachmentID, err := uuid.Parse(req.AttachmentId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid attachment_id")
+	}
+
+	attachment, reader, err := h.habitService.OpenAttachment(stream.Context(), attachmentID, userID, req.Thumbnail)
+	if err != nil {
+		return mapOrganizeError("failed to download attachment", err)
+	}
+	defer reader.Close()
+
+	contentType := attachment.ContentType
+	if req.Thumbnail {
+		contentType = "image/jpeg"
+	}
+
+	buf := make([]byte, attachmentChunkSize)
+	sent := false
+	for {
+		n, err := io.ReadFull(reader, buf)
+		done := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !done {
+			if sent {
+				// The client already received part of the file, it sees a truncated download
+				return status.Error(codes.Internal, "attachment download interrupted")
+			}
+			return mapOrganizeError("failed to download attachment", err)
+		}
+
+		if n > 0 || !sent {
+			chunk := &pb.AttachmentChunk{Data: buf[:n]}
+			if !sent {
+				chunk.ContentType = &contentType
+			}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			sent = true
+		}
+
+		if done {
+			return nil
+		}
+	}
+}
+
+func (h *HabitServiceHandler) GetAttachmentUsage(ctx context.Context, req *pb.GetAttachmentUsageRequest) (*pb.GetAttachmentUsageResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	usage, err := h.attachmentService.GetUsage(ctx, userID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to get attachment usage", err)
+	}
+
+	return &pb.GetAttachmentUsageResponse{
+		UsedBytes:  usage.UsedBytes,
+		QuotaBytes: usage.QuotaBytes,
+	}, nil
+}
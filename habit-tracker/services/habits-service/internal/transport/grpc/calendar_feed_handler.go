@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *HabitServiceHandler) RotateCalendarFeedToken(ctx context.Context, req *pb.RotateCalendarFeedTokenRequest) (*pb.RotateCalendarFeedTokenResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	token, err := h.calendarService.RotateToken(ctx, userID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to rotate calendar feed token", err)
+	}
+
+	return &pb.RotateCalendarFeedTokenResponse{
+		Token: token,
+	}, nil
+}
+
+func (h *HabitServiceHandler) RevokeCalendarFeedToken(ctx context.Context, req *pb.RevokeCalendarFeedTokenRequest) (*pb.RevokeCalendarFeedTokenResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if err := h.calendarService.RevokeToken(ctx, userID); err != nil {
+		return nil, mapOrganizeError("failed to revoke calendar feed token", err)
+	}
+
+	return &pb.RevokeCalendarFeedTokenResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *HabitServiceHandler) GetCalendarFeed(ctx context.Context, req *pb.GetCalendarFeedRequest) (*pb.GetCalendarFeedResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	calendar, err := h.calendarService.RenderFeed(ctx, req.Token)
+	if err != nil {
+		return nil, mapOrganizeError("failed to render calendar feed", err)
+	}
+
+	return &pb.GetCalendarFeedResponse{
+		Calendar: string(calendar),
+	}, nil
+}
@@ -0,0 +1,267 @@
+package grpc
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mapChallengeToProto(challenge *entity.Challenge) *pb.Challenge {
+	return &pb.Challenge{
+		Id:               challenge.ID.String(),
+		CreatorId:        challenge.CreatorID.String(),
+		Name:             challenge.Name,
+		Description:      challenge.Description,
+		StartDate:        challenge.StartDate,
+		EndDate:          challenge.EndDate,
+		InviteCode:       challenge.InviteCode,
+		ParticipantCount: challenge.ParticipantCount,
+		CreatedAt:        timestamppb.New(challenge.CreatedAt),
+		UpdatedAt:        timestamppb.New(challenge.UpdatedAt),
+	}
+}
+
+func mapLeaderboardEntryToProto(entry *entity.LeaderboardEntry) *pb.ChallengeLeaderboardEntry {
+	return &pb.ChallengeLeaderboardEntry{
+		Rank:          entry.Rank,
+		UserId:        entry.UserID.String(),
+		Username:      entry.Username,
+		Completions:   entry.Completions,
+		CurrentStreak: entry.CurrentStreak,
+	}
+}
+
+func (h *HabitServiceHandler) CreateChallenge(ctx context.Context, req *pb.CreateChallengeRequest) (*pb.CreateChallengeResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	challenge, err := h.challengeService.CreateChallenge(ctx, userID, req.Name, req.Description, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, mapOrganizeError("failed to create challenge", err)
+	}
+
+	return &pb.CreateChallengeResponse{
+		Challenge: mapChallengeToProto(challenge),
+	}, nil
+}
+
+func (h *HabitServiceHandler) GetChallenge(ctx context.Context, req *pb.GetChallengeRequest) (*pb.GetChallengeResponse, error) {
+	if req.ChallengeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	challengeID, err := uuid.Parse(req.ChallengeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid challenge_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	challenge, err := h.challengeService.GetChallenge(ctx, challengeID, userID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to get challenge", err)
+	}
+
+	return &pb.GetChallengeResponse{
+		Challenge: mapChallengeToProto(challenge),
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListChallenges(ctx context.Context, req *pb.ListChallengesRequest) (*pb.ListChallengesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	challenges, err := h.challengeService.ListChallenges(ctx, userID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to list challenges", err)
+	}
+
+	protoChallenges := make([]*pb.Challenge, len(challenges))
+	for i, challenge := range challenges {
+		protoChallenges[i] = mapChallengeToProto(challenge)
+	}
+
+	return &pb.ListChallengesResponse{
+		Challenges: protoChallenges,
+	}, nil
+}
+
+func (h *HabitServiceHandler) UpdateChallenge(ctx context.Context, req *pb.UpdateChallengeRequest) (*pb.UpdateChallengeResponse, error) {
+	if req.ChallengeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	challengeID, err := uuid.Parse(req.ChallengeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid challenge_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	challenge, err := h.challengeService.UpdateChallenge(ctx, challengeID, userID,
+		req.Name, req.Description, req.StartDate, req.EndDate, req.RegenerateInviteCode)
+	if err != nil {
+		return nil, mapOrganizeError("failed to update challenge", err)
+	}
+
+	return &pb.UpdateChallengeResponse{
+		Challenge: mapChallengeToProto(challenge),
+	}, nil
+}
+
+func (h *HabitServiceHandler) DeleteChallenge(ctx context.Context, req *pb.DeleteChallengeRequest) (*pb.DeleteChallengeResponse, error) {
+	if req.ChallengeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	challengeID, err := uuid.Parse(req.ChallengeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid challenge_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if err := h.challengeService.DeleteChallenge(ctx, challengeID, userID); err != nil {
+		return nil, mapOrganizeError("failed to delete challenge", err)
+	}
+
+	return &pb.DeleteChallengeResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *HabitServiceHandler) JoinChallenge(ctx context.Context, req *pb.JoinChallengeRequest) (*pb.JoinChallengeResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.HabitId == "" {
+		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	challenge, err := h.challengeService.JoinChallenge(ctx, userID, req.InviteCode, habitID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to join challenge", err)
+	}
+
+	return &pb.JoinChallengeResponse{
+		Challenge: mapChallengeToProto(challenge),
+	}, nil
+}
+
+func (h *HabitServiceHandler) LeaveChallenge(ctx context.Context, req *pb.LeaveChallengeRequest) (*pb.LeaveChallengeResponse, error) {
+	if req.ChallengeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	challengeID, err := uuid.Parse(req.ChallengeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid challenge_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if err := h.challengeService.LeaveChallenge(ctx, challengeID, userID); err != nil {
+		return nil, mapOrganizeError("failed to leave challenge", err)
+	}
+
+	return &pb.LeaveChallengeResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *HabitServiceHandler) GetChallengeLeaderboard(ctx context.Context, req *pb.GetChallengeLeaderboardRequest) (*pb.GetChallengeLeaderboardResponse, error) {
+	if req.ChallengeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	challengeID, err := uuid.Parse(req.ChallengeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid challenge_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	leaderboard, err := h.challengeService.GetLeaderboard(ctx, challengeID, userID, req.Offset, req.Limit)
+	if err != nil {
+		return nil, mapOrganizeError("failed to get challenge leaderboard", err)
+	}
+
+	entries := make([]*pb.ChallengeLeaderboardEntry, len(leaderboard.Entries))
+	for i, entry := range leaderboard.Entries {
+		entries[i] = mapLeaderboardEntryToProto(entry)
+	}
+
+	resp := &pb.GetChallengeLeaderboardResponse{
+		Entries:           entries,
+		TotalParticipants: leaderboard.TotalParticipants,
+	}
+
+	if leaderboard.Me != nil {
+		resp.Me = mapLeaderboardEntryToProto(leaderboard.Me)
+	}
+
+	return resp, nil
+}
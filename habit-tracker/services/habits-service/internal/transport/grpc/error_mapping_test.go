@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"habits-service/internal/domain/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMapErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		mapper func(string, error) error
+		err    error
+		want   codes.Code
+	}{
+		{
+			name:   "invalid argument",
+			mapper: mapOrganizeError,
+			err:    fmt.Errorf("%w name: must not be empty", service.ErrInvalidArgument),
+			want:   codes.InvalidArgument,
+		},
+		{
+			name:   "not found",
+			mapper: mapOrganizeError,
+			err:    fmt.Errorf("habit group %w", service.ErrNotFound),
+			want:   codes.NotFound,
+		},
+		{
+			name:   "already exists",
+			mapper: mapOrganizeError,
+			err:    fmt.Errorf("habit group with this name %w", service.ErrAlreadyExists),
+			want:   codes.AlreadyExists,
+		},
+		{
+			name:   "message only resembling a known error",
+			mapper: mapOrganizeError,
+			err:    errors.New("invalid connection: peer not found or unauthorized"),
+			want:   codes.Internal,
+		},
+		{
+			name:   "rate limited partner request",
+			mapper: mapPartnerError,
+			err:    fmt.Errorf("%w: a habit can be nudged once every 4 hours", service.ErrTooManyRequests),
+			want:   codes.ResourceExhausted,
+		},
+		{
+			name:   "partner request falls back to the organize mapping",
+			mapper: mapPartnerError,
+			err:    fmt.Errorf("habit partner %w", service.ErrNotFound),
+			want:   codes.NotFound,
+		},
+		{
+			name:   "archiving a missing habit",
+			mapper: mapArchiveError,
+			err:    service.ErrHabitNotFound,
+			want:   codes.NotFound,
+		},
+		{
+			name:   "purging an active habit",
+			mapper: mapArchiveError,
+			err:    fmt.Errorf("%w, it must be archived before purging", service.ErrHabitNotArchived),
+			want:   codes.FailedPrecondition,
+		},
+		{
+			name:   "archiving an archived habit",
+			mapper: mapArchiveError,
+			err:    service.ErrHabitArchived,
+			want:   codes.FailedPrecondition,
+		},
+		{
+			name:   "wrapped repository failure",
+			mapper: mapArchiveError,
+			err:    fmt.Errorf("failed to archive habit: %w", errors.New("connection refused")),
+			want:   codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := status.Code(tt.mapper("failed", tt.err))
+			if got != tt.want {
+				t.Errorf("code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
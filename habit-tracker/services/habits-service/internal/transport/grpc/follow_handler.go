@@ -0,0 +1,280 @@
+package grpc
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mapFollowStatusToProto(followStatus entity.FollowStatus) pb.FollowStatus {
+	switch followStatus {
+	case entity.FollowStatusPending:
+		return pb.FollowStatus_FOLLOW_STATUS_PENDING
+	case entity.FollowStatusAccepted:
+		return pb.FollowStatus_FOLLOW_STATUS_ACCEPTED
+	default:
+		return pb.FollowStatus_FOLLOW_STATUS_UNSPECIFIED
+	}
+}
+
+func mapFollowToProto(follow *entity.Follow) *pb.Follow {
+	f := &pb.Follow{
+		FollowerId:       follow.FollowerID.String(),
+		FollowerUsername: follow.FollowerUsername,
+		FolloweeId:       follow.FolloweeID.String(),
+		FolloweeUsername: follow.FolloweeUsername,
+		Status:           mapFollowStatusToProto(follow.Status),
+		CreatedAt:        timestamppb.New(follow.CreatedAt),
+	}
+
+	if follow.RespondedAt != nil {
+		f.RespondedAt = timestamppb.New(*follow.RespondedAt)
+	}
+
+	return f
+}
+
+func mapFollowsToProto(follows []*entity.Follow) []*pb.Follow {
+	protoFollows := make([]*pb.Follow, len(follows))
+	for i, follow := range follows {
+		protoFollows[i] = mapFollowToProto(follow)
+	}
+	return protoFollows
+}
+
+func mapFeedItemKindToProto(kind entity.FeedItemKind) pb.FeedItemKind {
+	switch kind {
+	case entity.FeedItemConfirmation:
+		return pb.FeedItemKind_FEED_ITEM_KIND_CONFIRMATION
+	case entity.FeedItemMilestone:
+		return pb.FeedItemKind_FEED_ITEM_KIND_MILESTONE
+	default:
+		return pb.FeedItemKind_FEED_ITEM_KIND_UNSPECIFIED
+	}
+}
+
+func mapFeedItemToProto(item *entity.FeedItem) *pb.FeedItem {
+	return &pb.FeedItem{
+		Id:               item.ID.String(),
+		Kind:             mapFeedItemKindToProto(item.Kind),
+		ActorId:          item.ActorID.String(),
+		ActorUsername:    item.ActorUsername,
+		HabitId:          item.HabitID.String(),
+		HabitName:        item.HabitName,
+		HabitVisibility:  mapVisibilityToProto(item.HabitVisibility),
+		ConfirmedForDate: item.ConfirmedForDate,
+		Streak:           item.Streak,
+		AchievementCode:  item.AchievementCode,
+		AchievementName:  item.AchievementName,
+		OccurredAt:       timestamppb.New(item.OccurredAt),
+	}
+}
+
+func (h *HabitServiceHandler) FollowUser(ctx context.Context, req *pb.FollowUserRequest) (*pb.FollowUserResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	follow, err := h.followService.Follow(ctx, userID, req.Username)
+	if err != nil {
+		return nil, mapOrganizeError("failed to follow user", err)
+	}
+
+	return &pb.FollowUserResponse{
+		Follow: mapFollowToProto(follow),
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListFollowers(ctx context.Context, req *pb.ListFollowersRequest) (*pb.ListFollowersResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	followers, err := h.followService.ListFollowers(ctx, userID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to list followers", err)
+	}
+
+	return &pb.ListFollowersResponse{
+		Followers: mapFollowsToProto(followers),
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListFollowing(ctx context.Context, req *pb.ListFollowingRequest) (*pb.ListFollowingResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	following, err := h.followService.ListFollowing(ctx, userID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to list followed users", err)
+	}
+
+	return &pb.ListFollowingResponse{
+		Following: mapFollowsToProto(following),
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListFollowRequests(ctx context.Context, req *pb.ListFollowRequestsRequest) (*pb.ListFollowRequestsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	requests, err := h.followService.ListRequests(ctx, userID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to list follow requests", err)
+	}
+
+	return &pb.ListFollowRequestsResponse{
+		Requests: mapFollowsToProto(requests),
+	}, nil
+}
+
+func (h *HabitServiceHandler) RespondToFollowRequest(ctx context.Context, req *pb.RespondToFollowRequestRequest) (*pb.RespondToFollowRequestResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.FollowerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "follower_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	followerID, err := uuid.Parse(req.FollowerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid follower_id")
+	}
+
+	follow, err := h.followService.RespondToRequest(ctx, userID, followerID, req.Accept)
+	if err != nil {
+		return nil, mapOrganizeError("failed to respond to follow request", err)
+	}
+
+	resp := &pb.RespondToFollowRequestResponse{}
+	if follow != nil {
+		resp.Follow = mapFollowToProto(follow)
+	}
+
+	return resp, nil
+}
+
+func (h *HabitServiceHandler) UnfollowUser(ctx context.Context, req *pb.UnfollowUserRequest) (*pb.UnfollowUserResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.FolloweeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "followee_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	followeeID, err := uuid.Parse(req.FolloweeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid followee_id")
+	}
+
+	if err := h.followService.Unfollow(ctx, userID, followeeID); err != nil {
+		return nil, mapOrganizeError("failed to unfollow user", err)
+	}
+
+	return &pb.UnfollowUserResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *HabitServiceHandler) RemoveFollower(ctx context.Context, req *pb.RemoveFollowerRequest) (*pb.RemoveFollowerResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.FollowerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "follower_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	followerID, err := uuid.Parse(req.FollowerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid follower_id")
+	}
+
+	if err := h.followService.RemoveFollower(ctx, userID, followerID); err != nil {
+		return nil, mapOrganizeError("failed to remove follower", err)
+	}
+
+	return &pb.RemoveFollowerResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *HabitServiceHandler) GetActivityFeed(ctx context.Context, req *pb.GetActivityFeedRequest) (*pb.GetActivityFeedResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	after, err := decodePageToken(feedPageToken, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	page := entity.PageRequest{
+		Size:  pageSize(req.PageSize, 20, 100),
+		After: after,
+	}
+
+	feed, err := h.feedService.GetFeed(ctx, userID, page)
+	if err != nil {
+		return nil, mapOrganizeError("failed to get activity feed", err)
+	}
+
+	items := make([]*pb.FeedItem, len(feed.Items))
+	for i, item := range feed.Items {
+		items[i] = mapFeedItemToProto(item)
+	}
+
+	return &pb.GetActivityFeedResponse{
+		Items:         items,
+		NextPageToken: encodePageToken(feedPageToken, feed.Next),
+	}, nil
+}
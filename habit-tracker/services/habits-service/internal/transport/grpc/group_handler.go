@@ -0,0 +1,250 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/service"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mapGroupToProto(group *entity.HabitGroup) *pb.HabitGroup {
+	return &pb.HabitGroup{
+		Id:         group.ID.String(),
+		UserId:     group.UserID.String(),
+		Name:       group.Name,
+		Color:      group.Color,
+		Icon:       group.Icon,
+		Position:   group.Position,
+		HabitCount: group.HabitCount,
+		CreatedAt:  timestamppb.New(group.CreatedAt),
+		UpdatedAt:  timestamppb.New(group.UpdatedAt),
+	}
+}
+
+func mapGroupsToProto(groups []*entity.HabitGroup) []*pb.HabitGroup {
+	protoGroups := make([]*pb.HabitGroup, len(groups))
+	for i, group := range groups {
+		protoGroups[i] = mapGroupToProto(group)
+	}
+	return protoGroups
+}
+
+func parseOptionalGroupID(groupID *string) (*uuid.UUID, error) {
+	if groupID == nil || *groupID == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(*groupID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid group_id")
+	}
+
+	return &id, nil
+}
+
+func parseIDs(ids []string, field string) ([]uuid.UUID, error) {
+	parsed := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		var err error
+		parsed[i], err = uuid.Parse(id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, id))
+		}
+	}
+	return parsed, nil
+}
+
+// mapOrganizeError maps errors of requests touching groups, tags and order to gRPC codes
+func mapOrganizeError(msg string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
+func (h *HabitServiceHandler) ReorderHabits(ctx context.Context, req *pb.ReorderHabitsRequest) (*pb.ReorderHabitsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	habitIDs, err := parseIDs(req.HabitIds, "habit_id")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.habitService.ReorderHabits(ctx, userID, habitIDs); err != nil {
+		return nil, mapOrganizeError("failed to reorder habits", err)
+	}
+
+	return &pb.ReorderHabitsResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListHabitTags(ctx context.Context, req *pb.ListHabitTagsRequest) (*pb.ListHabitTagsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	tags, err := h.habitService.ListTags(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list tags: %v", err))
+	}
+
+	protoTags := make([]*pb.TagUsage, len(tags))
+	for i, tag := range tags {
+		protoTags[i] = &pb.TagUsage{
+			Tag:        tag.Tag,
+			HabitCount: tag.HabitCount,
+		}
+	}
+
+	return &pb.ListHabitTagsResponse{
+		Tags: protoTags,
+	}, nil
+}
+
+func (h *HabitServiceHandler) CreateHabitGroup(ctx context.Context, req *pb.CreateHabitGroupRequest) (*pb.CreateHabitGroupResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	group, err := h.groupService.CreateGroup(ctx, userID, req.Name, req.Color, req.Icon)
+	if err != nil {
+		return nil, mapOrganizeError("failed to create habit group", err)
+	}
+
+	return &pb.CreateHabitGroupResponse{
+		Group: mapGroupToProto(group),
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListHabitGroups(ctx context.Context, req *pb.ListHabitGroupsRequest) (*pb.ListHabitGroupsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	groups, err := h.groupService.ListGroups(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list habit groups: %v", err))
+	}
+
+	return &pb.ListHabitGroupsResponse{
+		Groups: mapGroupsToProto(groups),
+	}, nil
+}
+
+func (h *HabitServiceHandler) UpdateHabitGroup(ctx context.Context, req *pb.UpdateHabitGroupRequest) (*pb.UpdateHabitGroupResponse, error) {
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid group_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	group, err := h.groupService.UpdateGroup(ctx, groupID, userID, req.Name, req.Color, req.Icon)
+	if err != nil {
+		return nil, mapOrganizeError("failed to update habit group", err)
+	}
+
+	return &pb.UpdateHabitGroupResponse{
+		Group: mapGroupToProto(group),
+	}, nil
+}
+
+func (h *HabitServiceHandler) DeleteHabitGroup(ctx context.Context, req *pb.DeleteHabitGroupRequest) (*pb.DeleteHabitGroupResponse, error) {
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid group_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if err := h.groupService.DeleteGroup(ctx, groupID, userID); err != nil {
+		return nil, mapOrganizeError("failed to delete habit group", err)
+	}
+
+	return &pb.DeleteHabitGroupResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *HabitServiceHandler) ReorderHabitGroups(ctx context.Context, req *pb.ReorderHabitGroupsRequest) (*pb.ReorderHabitGroupsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	groupIDs, err := parseIDs(req.GroupIds, "group_id")
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := h.groupService.ReorderGroups(ctx, userID, groupIDs)
+	if err != nil {
+		return nil, mapOrganizeError("failed to reorder habit groups", err)
+	}
+
+	return &pb.ReorderHabitGroupsResponse{
+		Groups: mapGroupsToProto(groups),
+	}, nil
+}
@@ -2,10 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"habits-service/internal/domain/entity"
 	"habits-service/internal/domain/service"
 	pb "habits-service/proto/habits/v1"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -15,12 +17,42 @@ import (
 
 type HabitServiceHandler struct {
 	pb.UnimplementedHabitServiceServer
-	habitService service.HabitService
+	habitService       service.HabitService
+	groupService       service.HabitGroupService
+	templateService    service.HabitTemplateService
+	partnerService     service.HabitPartnerService
+	challengeService   service.ChallengeService
+	achievementService service.AchievementService
+	followService      service.FollowService
+	feedService        service.FeedService
+	profileService     service.ProfileService
+	calendarService    service.CalendarFeedService
+	importService      service.HabitImportService
+	journalService     service.JournalService
+	attachmentService  service.AttachmentService
 }
 
-func NewHabitServiceHandler(habitService service.HabitService) *HabitServiceHandler {
+func NewHabitServiceHandler(habitService service.HabitService, groupService service.HabitGroupService,
+	templateService service.HabitTemplateService, partnerService service.HabitPartnerService,
+	challengeService service.ChallengeService, achievementService service.AchievementService,
+	followService service.FollowService, feedService service.FeedService,
+	profileService service.ProfileService, calendarService service.CalendarFeedService,
+	importService service.HabitImportService, journalService service.JournalService,
+	attachmentService service.AttachmentService) *HabitServiceHandler {
 	return &HabitServiceHandler{
-		habitService: habitService,
+		habitService:       habitService,
+		groupService:       groupService,
+		templateService:    templateService,
+		partnerService:     partnerService,
+		challengeService:   challengeService,
+		achievementService: achievementService,
+		followService:      followService,
+		feedService:        feedService,
+		profileService:     profileService,
+		calendarService:    calendarService,
+		importService:      importService,
+		journalService:     journalService,
+		attachmentService:  attachmentService,
 	}
 }
 
@@ -46,6 +78,55 @@ func mapScheduleTypeFromProto(scheduleType pb.ScheduleType) entity.ScheduleType
 	}
 }
 
+func mapVisibilityToProto(visibility entity.HabitVisibility) pb.HabitVisibility {
+	switch visibility {
+	case entity.HabitVisibilityPrivate:
+		return pb.HabitVisibility_HABIT_VISIBILITY_PRIVATE
+	case entity.HabitVisibilityFriends:
+		return pb.HabitVisibility_HABIT_VISIBILITY_FRIENDS
+	case entity.HabitVisibilityPublic:
+		return pb.HabitVisibility_HABIT_VISIBILITY_PUBLIC
+	default:
+		return pb.HabitVisibility_HABIT_VISIBILITY_UNSPECIFIED
+	}
+}
+
+// mapVisibilityFromProto returns nil for an unset visibility. Unspecified maps to an empty
+// visibility, which the service rejects
+func mapVisibilityFromProto(visibility *pb.HabitVisibility) *entity.HabitVisibility {
+	if visibility == nil {
+		return nil
+	}
+
+	var v entity.HabitVisibility
+	switch *visibility {
+	case pb.HabitVisibility_HABIT_VISIBILITY_PRIVATE:
+		v = entity.HabitVisibilityPrivate
+	case pb.HabitVisibility_HABIT_VISIBILITY_FRIENDS:
+		v = entity.HabitVisibilityFriends
+	case pb.HabitVisibility_HABIT_VISIBILITY_PUBLIC:
+		v = entity.HabitVisibilityPublic
+	}
+	return &v
+}
+
+func mapHabitStatusFromProto(filter pb.HabitStatusFilter, activeOnly *bool) entity.HabitStatus {
+	switch filter {
+	case pb.HabitStatusFilter_HABIT_STATUS_FILTER_ACTIVE:
+		return entity.HabitStatusActive
+	case pb.HabitStatusFilter_HABIT_STATUS_FILTER_ARCHIVED:
+		return entity.HabitStatusArchived
+	case pb.HabitStatusFilter_HABIT_STATUS_FILTER_ALL:
+		return entity.HabitStatusAll
+	}
+
+	// Deprecated active_only is honoured only when status is not set
+	if activeOnly != nil && *activeOnly {
+		return entity.HabitStatusActive
+	}
+	return entity.HabitStatusAll
+}
+
 func mapHabitToProto(habit *entity.Habit) *pb.Habit {
 	h := &pb.Habit{
 		Id:                        habit.ID.String(),
@@ -57,6 +138,7 @@ func mapHabitToProto(habit *entity.Habit) *pb.Habit {
 		NextDeadlineUtc:           timestamppb.New(habit.NextDeadlineUTC),
 		ConfirmedForCurrentPeriod: habit.ConfirmedForCurrentPeriod,
 		IsActive:                  habit.IsActive,
+		IsPaused:                  habit.IsPaused,
 		CreatedAt:                 timestamppb.New(habit.CreatedAt),
 		UpdatedAt:                 timestamppb.New(habit.UpdatedAt),
 	}
@@ -81,6 +163,20 @@ func mapHabitToProto(habit *entity.Habit) *pb.Habit {
 		h.LastConfirmedAt = timestamppb.New(*habit.LastConfirmedAt)
 	}
 
+	if habit.ArchivedAt != nil {
+		h.ArchivedAt = timestamppb.New(*habit.ArchivedAt)
+	}
+
+	if habit.GroupID != nil {
+		groupID := habit.GroupID.String()
+		h.GroupId = &groupID
+	}
+
+	h.Icon = habit.Icon
+	h.Position = habit.Position
+	h.Tags = habit.Tags
+	h.Visibility = mapVisibilityToProto(habit.Visibility)
+
 	return h
 }
 
@@ -98,9 +194,97 @@ func mapConfirmationToProto(confirmation *entity.HabitConfirmation) *pb.HabitCon
 		c.Notes = confirmation.Notes
 	}
 
+	for _, attachmentID := range confirmation.AttachmentIDs {
+		c.AttachmentIds = append(c.AttachmentIds, attachmentID.String())
+	}
+
 	return c
 }
 
+func mapPauseToProto(pause *entity.HabitPause) *pb.HabitPause {
+	p := &pb.HabitPause{
+		Id:        pause.ID.String(),
+		HabitId:   pause.HabitID.String(),
+		UserId:    pause.UserID.String(),
+		StartDate: pause.StartDate,
+		EndDate:   pause.EndDate,
+		Reason:    pause.Reason,
+		CreatedAt: timestamppb.New(pause.CreatedAt),
+	}
+
+	if pause.ResumedAt != nil {
+		p.ResumedAt = timestamppb.New(*pause.ResumedAt)
+	}
+
+	return p
+}
+
+func mapPausesToProto(pauses []*entity.HabitPause) []*pb.HabitPause {
+	protoPauses := make([]*pb.HabitPause, len(pauses))
+	for i, pause := range pauses {
+		protoPauses[i] = mapPauseToProto(pause)
+	}
+	return protoPauses
+}
+
+func mapPeriodRatesToProto(rates []*entity.PeriodRate) []*pb.PeriodCompletionRate {
+	protoRates := make([]*pb.PeriodCompletionRate, len(rates))
+	for i, rate := range rates {
+		protoRates[i] = &pb.PeriodCompletionRate{
+			PeriodStart:    rate.PeriodStart,
+			Expected:       rate.Expected,
+			Completed:      rate.Completed,
+			CompletionRate: rate.Rate,
+		}
+	}
+	return protoRates
+}
+
+func mapCalendarDayStateToProto(state entity.CalendarDayState) pb.CalendarDayState {
+	switch state {
+	case entity.CalendarDayDone:
+		return pb.CalendarDayState_CALENDAR_DAY_STATE_DONE
+	case entity.CalendarDayMissed:
+		return pb.CalendarDayState_CALENDAR_DAY_STATE_MISSED
+	case entity.CalendarDayPending:
+		return pb.CalendarDayState_CALENDAR_DAY_STATE_PENDING
+	case entity.CalendarDayNotDue:
+		return pb.CalendarDayState_CALENDAR_DAY_STATE_NOT_DUE
+	case entity.CalendarDayFrozen:
+		return pb.CalendarDayState_CALENDAR_DAY_STATE_FROZEN
+	case entity.CalendarDayFuture:
+		return pb.CalendarDayState_CALENDAR_DAY_STATE_FUTURE
+	default:
+		return pb.CalendarDayState_CALENDAR_DAY_STATE_UNSPECIFIED
+	}
+}
+
+func mapAgendaItemsToProto(items []*entity.AgendaItem) []*pb.AgendaItem {
+	protoItems := make([]*pb.AgendaItem, len(items))
+	for i, item := range items {
+		protoItems[i] = &pb.AgendaItem{
+			Habit:                mapHabitToProto(item.Habit),
+			DueDate:              item.DueDate,
+			SecondsUntilDeadline: int64(item.TimeLeft / time.Second),
+		}
+	}
+	return protoItems
+}
+
+// parseOptionalHabitID parses an optional habit_id, nil means all habits of the user
+func parseOptionalHabitID(habitID *string) (*uuid.UUID, error) {
+	if habitID == nil || *habitID == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(*habitID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	return &id, nil
+}
+
 // RPC Handlers
 
 func (h *HabitServiceHandler) CreateHabit(ctx context.Context, req *pb.CreateHabitRequest) (*pb.CreateHabitResponse, error) {
@@ -139,13 +323,19 @@ func (h *HabitServiceHandler) CreateHabit(ctx context.Context, req *pb.CreateHab
 		intervalDays = req.IntervalDays
 	}
 
+	groupID, err := parseOptionalGroupID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
 	habit, err := h.habitService.CreateHabit(
 		ctx, userID, req.Name, description, color,
 		scheduleType, intervalDays, req.WeeklyDays, req.Timezone,
+		groupID, req.Icon, req.Tags, mapVisibilityFromProto(req.Visibility),
 	)
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create habit: %v", err))
+		return nil, mapOrganizeError("failed to create habit", err)
 	}
 
 	return &pb.CreateHabitResponse{
@@ -192,24 +382,82 @@ func (h *HabitServiceHandler) ListHabits(ctx context.Context, req *pb.ListHabits
 		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
 	}
 
-	activeOnly := false
-	if req.ActiveOnly != nil {
-		activeOnly = *req.ActiveOnly
+	after, err := decodePageToken(habitsPageToken, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	page := entity.PageRequest{
+		Size:         pageSize(req.PageSize, 50, 200),
+		After:        after,
+		IncludeTotal: req.IncludeTotalCount,
+	}
+
+	filter := entity.HabitFilter{
+		Status: mapHabitStatusFromProto(req.Status, req.ActiveOnly),
+		Tag:    req.Tag,
+	}
+
+	if req.GroupId != nil {
+		if *req.GroupId == "none" {
+			filter.GroupID = &uuid.Nil
+		} else {
+			groupID, err := uuid.Parse(*req.GroupId)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid group_id")
+			}
+			filter.GroupID = &groupID
+		}
 	}
 
-	habits, totalCount, err := h.habitService.ListHabits(ctx, userID, activeOnly)
+	result, err := h.habitService.ListHabits(ctx, userID, filter, page)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list habits: %v", err))
 	}
 
-	protoHabits := make([]*pb.Habit, len(habits))
-	for i, habit := range habits {
+	protoHabits := make([]*pb.Habit, len(result.Habits))
+	for i, habit := range result.Habits {
 		protoHabits[i] = mapHabitToProto(habit)
 	}
 
 	return &pb.ListHabitsResponse{
-		Habits:     protoHabits,
-		TotalCount: totalCount,
+		Habits:        protoHabits,
+		TotalCount:    result.TotalCount,
+		NextPageToken: encodePageToken(habitsPageToken, result.Next),
+	}, nil
+}
+
+func (h *HabitServiceHandler) GetTodayAgenda(ctx context.Context, req *pb.GetTodayAgendaRequest) (*pb.GetTodayAgendaResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	atRiskHours := int32(3)
+	if req.AtRiskHours != nil {
+		if *req.AtRiskHours < 0 || *req.AtRiskHours > 24 {
+			return nil, status.Error(codes.InvalidArgument, "at_risk_hours must be between 0 and 24")
+		}
+		atRiskHours = *req.AtRiskHours
+	}
+
+	agenda, err := h.habitService.GetTodayAgenda(ctx, userID, time.Duration(atRiskHours)*time.Hour)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get today agenda: %v", err))
+	}
+
+	return &pb.GetTodayAgendaResponse{
+		Due:      mapAgendaItemsToProto(agenda.Due),
+		AtRisk:   mapAgendaItemsToProto(agenda.AtRisk),
+		Done:     mapAgendaItemsToProto(agenda.Done),
+		Upcoming: mapAgendaItemsToProto(agenda.Upcoming),
 	}, nil
 }
 
@@ -238,14 +486,34 @@ func (h *HabitServiceHandler) UpdateHabit(ctx context.Context, req *pb.UpdateHab
 		scheduleType = &st
 	}
 
+	// An empty group_id removes the habit from its group
+	var groupID *uuid.UUID
+	if req.GroupId != nil {
+		groupID = &uuid.Nil
+		if *req.GroupId != "" {
+			parsed, err := uuid.Parse(*req.GroupId)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid group_id")
+			}
+			groupID = &parsed
+		}
+	}
+
+	var tags []string
+	if req.UpdateTags {
+		tags = make([]string, 0, len(req.Tags))
+		tags = append(tags, req.Tags...)
+	}
+
 	habit, err := h.habitService.UpdateHabit(
 		ctx, habitID, userID,
 		req.Name, req.Description, req.Color,
 		scheduleType, req.IntervalDays, req.WeeklyDays, req.Timezone,
+		groupID, req.Icon, tags, mapVisibilityFromProto(req.Visibility),
 	)
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update habit: %v", err))
+		return nil, mapOrganizeError("failed to update habit", err)
 	}
 
 	return &pb.UpdateHabitResponse{
@@ -282,6 +550,195 @@ func (h *HabitServiceHandler) DeleteHabit(ctx context.Context, req *pb.DeleteHab
 	}, nil
 }
 
+func (h *HabitServiceHandler) ArchiveHabit(ctx context.Context, req *pb.ArchiveHabitRequest) (*pb.ArchiveHabitResponse, error) {
+	if req.HabitId == "" {
+		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	habit, err := h.habitService.ArchiveHabit(ctx, habitID, userID)
+	if err != nil {
+		return nil, mapArchiveError("failed to archive habit", err)
+	}
+
+	return &pb.ArchiveHabitResponse{
+		Habit: mapHabitToProto(habit),
+	}, nil
+}
+
+func (h *HabitServiceHandler) UnarchiveHabit(ctx context.Context, req *pb.UnarchiveHabitRequest) (*pb.UnarchiveHabitResponse, error) {
+	if req.HabitId == "" {
+		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	habit, err := h.habitService.UnarchiveHabit(ctx, habitID, userID)
+	if err != nil {
+		return nil, mapArchiveError("failed to unarchive habit", err)
+	}
+
+	return &pb.UnarchiveHabitResponse{
+		Habit: mapHabitToProto(habit),
+	}, nil
+}
+
+func (h *HabitServiceHandler) PurgeHabit(ctx context.Context, req *pb.PurgeHabitRequest) (*pb.PurgeHabitResponse, error) {
+	if req.HabitId == "" {
+		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	deleted, err := h.habitService.PurgeHabit(ctx, habitID, userID)
+	if err != nil {
+		return nil, mapArchiveError("failed to purge habit", err)
+	}
+
+	return &pb.PurgeHabitResponse{
+		Success:              true,
+		DeletedConfirmations: int32(deleted),
+	}, nil
+}
+func mapArchiveError(msg string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrHabitNotFound):
+		return status.Error(codes.NotFound, "habit not found")
+	case errors.Is(err, service.ErrHabitArchived), errors.Is(err, service.ErrHabitNotArchived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
+func (h *HabitServiceHandler) PauseHabits(ctx context.Context, req *pb.PauseHabitsRequest) (*pb.PauseHabitsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.StartDate == "" || req.EndDate == "" {
+		return nil, status.Error(codes.InvalidArgument, "start_date and end_date are required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	habitID, err := parseOptionalHabitID(req.HabitId)
+	if err != nil {
+		return nil, err
+	}
+
+	pauses, err := h.habitService.PauseHabits(ctx, userID, habitID, req.StartDate, req.EndDate, req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrHabitNotFound):
+			return nil, status.Error(codes.NotFound, "habit not found")
+		case errors.Is(err, service.ErrInvalidArgument):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrHabitArchived), errors.Is(err, service.ErrNothingToPause),
+			errors.Is(err, service.ErrAlreadyPaused):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to pause habits: %v", err))
+	}
+
+	return &pb.PauseHabitsResponse{
+		Pauses: mapPausesToProto(pauses),
+	}, nil
+}
+
+func (h *HabitServiceHandler) ResumeHabits(ctx context.Context, req *pb.ResumeHabitsRequest) (*pb.ResumeHabitsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	habitID, err := parseOptionalHabitID(req.HabitId)
+	if err != nil {
+		return nil, err
+	}
+
+	resumed, err := h.habitService.ResumeHabits(ctx, userID, habitID)
+	if err != nil {
+		if errors.Is(err, service.ErrHabitNotFound) {
+			return nil, status.Error(codes.NotFound, "habit not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to resume habits: %v", err))
+	}
+
+	return &pb.ResumeHabitsResponse{
+		ResumedCount: resumed,
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListHabitPauses(ctx context.Context, req *pb.ListHabitPausesRequest) (*pb.ListHabitPausesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	habitID, err := parseOptionalHabitID(req.HabitId)
+	if err != nil {
+		return nil, err
+	}
+
+	pauses, err := h.habitService.ListPauses(ctx, userID, habitID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list habit pauses: %v", err))
+	}
+
+	return &pb.ListHabitPausesResponse{
+		Pauses: mapPausesToProto(pauses),
+	}, nil
+}
+
 func (h *HabitServiceHandler) ConfirmHabit(ctx context.Context, req *pb.ConfirmHabitRequest) (*pb.ConfirmHabitResponse, error) {
 	if req.HabitId == "" {
 		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
@@ -301,8 +758,23 @@ func (h *HabitServiceHandler) ConfirmHabit(ctx context.Context, req *pb.ConfirmH
 		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
 	}
 
-	habit, confirmation, err := h.habitService.ConfirmHabit(ctx, habitID, userID, req.Notes)
+	attachmentIDs := make([]uuid.UUID, len(req.AttachmentIds))
+	for i, id := range req.AttachmentIds {
+		attachmentIDs[i], err = uuid.Parse(id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid attachment_ids")
+		}
+	}
+
+	habit, confirmation, err := h.habitService.ConfirmHabit(ctx, habitID, userID, req.Notes, attachmentIDs)
 	if err != nil {
+		if errors.Is(err, service.ErrHabitArchived) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, service.ErrInvalidArgument) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to confirm habit: %v", err))
 	}
 
@@ -331,29 +803,90 @@ func (h *HabitServiceHandler) GetHabitHistory(ctx context.Context, req *pb.GetHa
 		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
 	}
 
-	limit := int32(30)
-	if req.Limit != nil {
-		limit = *req.Limit
+	after, err := decodePageToken(historyPageToken, req.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	offset := int32(0)
-	if req.Offset != nil {
-		offset = *req.Offset
+	page := entity.PageRequest{
+		Size:         pageSize(req.Limit, 30, 100),
+		After:        after,
+		IncludeTotal: req.IncludeTotalCount,
 	}
 
-	confirmations, totalCount, err := h.habitService.GetHabitHistory(ctx, habitID, userID, limit, offset)
+	history, err := h.habitService.GetHabitHistory(ctx, habitID, userID, page)
 	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrHabitNotFound):
+			return nil, status.Error(codes.NotFound, "habit not found")
+		case errors.Is(err, service.ErrInvalidPageToken):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get habit history: %v", err))
 	}
 
-	protoConfirmations := make([]*pb.HabitConfirmation, len(confirmations))
-	for i, confirmation := range confirmations {
+	protoConfirmations := make([]*pb.HabitConfirmation, len(history.Confirmations))
+	for i, confirmation := range history.Confirmations {
 		protoConfirmations[i] = mapConfirmationToProto(confirmation)
 	}
 
 	return &pb.GetHabitHistoryResponse{
 		Confirmations: protoConfirmations,
-		TotalCount:    totalCount,
+		TotalCount:    history.TotalCount,
+		Pauses:        mapPausesToProto(history.Pauses),
+		NextPageToken: encodePageToken(historyPageToken, history.Next),
+	}, nil
+}
+
+func (h *HabitServiceHandler) GetHabitCalendar(ctx context.Context, req *pb.GetHabitCalendarRequest) (*pb.GetHabitCalendarResponse, error) {
+	if req.HabitId == "" {
+		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.FromDate == "" || req.ToDate == "" {
+		return nil, status.Error(codes.InvalidArgument, "from_date and to_date are required")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	days, err := h.habitService.GetHabitCalendar(ctx, habitID, userID, req.FromDate, req.ToDate)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrHabitNotFound):
+			return nil, status.Error(codes.NotFound, "habit not found")
+		case errors.Is(err, service.ErrInvalidArgument):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get habit calendar: %v", err))
+	}
+
+	protoDays := make([]*pb.CalendarDay, len(days))
+	for i, day := range days {
+		protoDays[i] = &pb.CalendarDay{
+			Date:  day.Date,
+			State: mapCalendarDayStateToProto(day.State),
+			Due:   day.Due,
+			Notes: day.Notes,
+		}
+		for _, attachmentID := range day.AttachmentIDs {
+			protoDays[i].AttachmentIds = append(protoDays[i].AttachmentIds, attachmentID.String())
+		}
+	}
+
+	return &pb.GetHabitCalendarResponse{
+		Days: protoDays,
 	}, nil
 }
 
@@ -386,6 +919,11 @@ func (h *HabitServiceHandler) GetHabitStats(ctx context.Context, req *pb.GetHabi
 		LongestStreak:      stats.LongestStreak,
 		TotalConfirmations: stats.TotalConfirmations,
 		CompletionRate:     stats.CompletionRate,
+		PausedDays:         stats.PausedDays,
+		ExpectedPeriods:    stats.ExpectedPeriods,
+		CompletedPeriods:   stats.CompletedPeriods,
+		WeeklyRates:        mapPeriodRatesToProto(stats.WeeklyRates),
+		MonthlyRates:       mapPeriodRatesToProto(stats.MonthlyRates),
 	}
 
 	if stats.FirstConfirmation != nil {
@@ -398,3 +936,51 @@ func (h *HabitServiceHandler) GetHabitStats(ctx context.Context, req *pb.GetHabi
 
 	return resp, nil
 }
+
+func (h *HabitServiceHandler) ExportUserHabits(ctx context.Context, req *pb.ExportUserHabitsRequest) (*pb.ExportUserHabitsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	data, err := h.habitService.ExportUserHabits(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to export habits: %v", err))
+	}
+
+	resp := &pb.ExportUserHabitsResponse{
+		Pauses:       mapPausesToProto(data.Pauses),
+		Groups:       mapGroupsToProto(data.Groups),
+		Partners:     mapPartnersToProto(data.Partners),
+		Achievements: mapAchievementProgressToProto(data.Achievements),
+		Follows:      mapFollowsToProto(data.Follows),
+	}
+
+	for _, habit := range data.Habits {
+		resp.Habits = append(resp.Habits, mapHabitToProto(habit))
+	}
+	for _, confirmation := range data.Confirmations {
+		resp.Confirmations = append(resp.Confirmations, mapConfirmationToProto(confirmation))
+	}
+	for _, template := range data.Templates {
+		resp.Templates = append(resp.Templates, mapTemplateToProto(template))
+	}
+	for _, challenge := range data.Challenges {
+		resp.Challenges = append(resp.Challenges, mapChallengeToProto(challenge))
+	}
+	for _, item := range data.FeedItems {
+		resp.FeedItems = append(resp.FeedItems, mapFeedItemToProto(item))
+	}
+	for _, entry := range data.JournalEntries {
+		resp.JournalEntries = append(resp.JournalEntries, mapJournalEntryToProto(entry))
+	}
+	for _, attachment := range data.Attachments {
+		resp.Attachments = append(resp.Attachments, mapAttachmentToProto(attachment))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"bufio"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	pb "habits-service/proto/habits/v1"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// historyChunkSize is the size of history export chunks streamed to clients
+const historyChunkSize = 64 * 1024
+
+// historyChunkWriter sends everything written to it as history export chunks
+type historyChunkWriter struct {
+	stream   pb.HabitService_ExportHabitHistoryServer
+	fileName *string // Sent with the first chunk
+	sent     bool
+}
+
+func (w *historyChunkWriter) Write(p []byte) (int, error) {
+	chunk := &pb.HabitHistoryChunk{
+		Data:     p,
+		FileName: w.fileName,
+	}
+
+	if err := w.stream.Send(chunk); err != nil {
+		return 0, err
+	}
+
+	w.fileName = nil
+	w.sent = true
+	return len(p), nil
+}
+
+func (h *HabitServiceHandler) ExportHabitHistory(req *pb.ExportHabitHistoryRequest, stream pb.HabitService_ExportHabitHistoryServer) error {
+	if req.UserId == "" {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	var habitID *uuid.UUID
+	if req.HabitId != nil {
+		parsed, err := uuid.Parse(*req.HabitId)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid habit_id")
+		}
+		habitID = &parsed
+	}
+
+	var format entity.HistoryExportFormat
+	switch req.Format {
+	case pb.HistoryExportFormat_HISTORY_EXPORT_FORMAT_CSV:
+		format = entity.HistoryExportFormatCSV
+	case pb.HistoryExportFormat_HISTORY_EXPORT_FORMAT_JSON:
+		format = entity.HistoryExportFormatJSON
+	default:
+		return status.Error(codes.InvalidArgument, "format is required")
+	}
+
+	fileName := fmt.Sprintf("habit-history-%s.%s", time.Now().UTC().Format("2006-01-02"), format)
+	chunks := &historyChunkWriter{stream: stream, fileName: &fileName}
+	writer := bufio.NewWriterSize(chunks, historyChunkSize)
+
+	err = h.habitService.ExportHistory(stream.Context(), userID, habitID, req.GetFromDate(), req.GetToDate(), format, writer)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		if chunks.sent {
+			// The client already received part of the file, it sees a truncated download
+			return status.Error(codes.Internal, "history export interrupted")
+		}
+		return mapOrganizeError("failed to export habit history", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package grpc
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mapImportSourceFromProto(source pb.HabitImportSource) (entity.HabitImportSource, bool) {
+	switch source {
+	case pb.HabitImportSource_HABIT_IMPORT_SOURCE_LOOP:
+		return entity.HabitImportSourceLoop, true
+	case pb.HabitImportSource_HABIT_IMPORT_SOURCE_HABITICA:
+		return entity.HabitImportSourceHabitica, true
+	case pb.HabitImportSource_HABIT_IMPORT_SOURCE_STREAKS:
+		return entity.HabitImportSourceStreaks, true
+	default:
+		return "", false
+	}
+}
+
+func mapImportSourceToProto(source entity.HabitImportSource) pb.HabitImportSource {
+	switch source {
+	case entity.HabitImportSourceLoop:
+		return pb.HabitImportSource_HABIT_IMPORT_SOURCE_LOOP
+	case entity.HabitImportSourceHabitica:
+		return pb.HabitImportSource_HABIT_IMPORT_SOURCE_HABITICA
+	case entity.HabitImportSourceStreaks:
+		return pb.HabitImportSource_HABIT_IMPORT_SOURCE_STREAKS
+	default:
+		return pb.HabitImportSource_HABIT_IMPORT_SOURCE_UNSPECIFIED
+	}
+}
+
+func mapImportStatusToProto(importStatus entity.HabitImportStatus) pb.HabitImportStatus {
+	switch importStatus {
+	case entity.HabitImportStatusPending:
+		return pb.HabitImportStatus_HABIT_IMPORT_STATUS_PENDING
+	case entity.HabitImportStatusProcessing:
+		return pb.HabitImportStatus_HABIT_IMPORT_STATUS_PROCESSING
+	case entity.HabitImportStatusCompleted:
+		return pb.HabitImportStatus_HABIT_IMPORT_STATUS_COMPLETED
+	case entity.HabitImportStatusFailed:
+		return pb.HabitImportStatus_HABIT_IMPORT_STATUS_FAILED
+	default:
+		return pb.HabitImportStatus_HABIT_IMPORT_STATUS_UNSPECIFIED
+	}
+}
+
+func mapImportedHabitToProto(habit *entity.ImportedHabit) *pb.ImportedHabit {
+	pbHabit := &pb.ImportedHabit{
+		Name:          habit.Name,
+		Description:   habit.Description,
+		Color:         habit.Color,
+		ScheduleType:  mapScheduleTypeToProto(habit.ScheduleType),
+		IntervalDays:  habit.IntervalDays,
+		WeeklyDays:    habit.WeeklyDays,
+		Confirmations: int32(len(habit.Dates)),
+		Note:          habit.Note,
+	}
+
+	if len(habit.Dates) > 0 {
+		pbHabit.FirstDate = &habit.Dates[0]
+		pbHabit.LastDate = &habit.Dates[len(habit.Dates)-1]
+	}
+
+	return pbHabit
+}
+
+func mapHabitImportToProto(habitImport *entity.HabitImport) *pb.HabitImport {
+	pbImport := &pb.HabitImport{
+		Source:                mapImportSourceToProto(habitImport.Source),
+		Status:                mapImportStatusToProto(habitImport.Status),
+		DryRun:                habitImport.DryRun,
+		TotalHabits:           habitImport.TotalHabits,
+		TotalConfirmations:    habitImport.TotalConfirmations,
+		SkippedRows:           habitImport.SkippedRows,
+		HabitsImported:        habitImport.HabitsImported,
+		ConfirmationsImported: habitImport.ConfirmationsImported,
+		Error:                 habitImport.Error,
+		CreatedAt:             timestamppb.New(habitImport.CreatedAt),
+	}
+
+	if habitImport.ID != uuid.Nil {
+		pbImport.Id = habitImport.ID.String()
+	}
+
+	if habitImport.FinishedAt != nil {
+		pbImport.FinishedAt = timestamppb.New(*habitImport.FinishedAt)
+	}
+
+	for _, habit := range habitImport.Habits {
+		pbImport.Habits = append(pbImport.Habits, mapImportedHabitToProto(habit))
+	}
+
+	for _, issue := range habitImport.Issues {
+		pbImport.Issues = append(pbImport.Issues, &pb.HabitImportIssue{
+			Row:       issue.Row,
+			HabitName: issue.HabitName,
+			Reason:    issue.Reason,
+		})
+	}
+
+	return pbImport
+}
+
+func (h *HabitServiceHandler) ImportHabits(ctx context.Context, req *pb.ImportHabitsRequest) (*pb.ImportHabitsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	source, ok := mapImportSourceFromProto(req.Source)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "source is required")
+	}
+
+	if req.Timezone == "" {
+		return nil, status.Error(codes.InvalidArgument, "timezone is required")
+	}
+
+	habitImport, err := h.importService.ImportHabits(ctx, userID, source, req.Data, req.Timezone, req.DryRun)
+	if err != nil {
+		return nil, mapOrganizeError("failed to import habits", err)
+	}
+
+	return &pb.ImportHabitsResponse{
+		Import: mapHabitImportToProto(habitImport),
+	}, nil
+}
+
+func (h *HabitServiceHandler) GetHabitImport(ctx context.Context, req *pb.GetHabitImportRequest) (*pb.GetHabitImportResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	importID, err := uuid.Parse(req.ImportId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid import_id")
+	}
+
+	habitImport, err := h.importService.GetImport(ctx, userID, importID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to get habit import", err)
+	}
+
+	return &pb.GetHabitImportResponse{
+		Import: mapHabitImportToProto(habitImport),
+	}, nil
+}
@@ -0,0 +1,193 @@
+package grpc
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mapJournalEntryToProto(entry *entity.JournalEntry) *pb.JournalEntry {
+	confirmationIDs := make([]string, len(entry.ConfirmationIDs))
+	for i, id := range entry.ConfirmationIDs {
+		confirmationIDs[i] = id.String()
+	}
+
+	return &pb.JournalEntry{
+		Id:              entry.ID.String(),
+		Date:            entry.Date,
+		Mood:            entry.Mood,
+		Energy:          entry.Energy,
+		Content:         entry.Content,
+		Tags:            entry.Tags,
+		ConfirmationIds: confirmationIDs,
+		CreatedAt:       timestamppb.New(entry.CreatedAt),
+		UpdatedAt:       timestamppb.New(entry.UpdatedAt),
+	}
+}
+
+func mapNoteKindToProto(kind entity.NoteKind) pb.NoteKind {
+	switch kind {
+	case entity.NoteKindJournal:
+		return pb.NoteKind_NOTE_KIND_JOURNAL
+	case entity.NoteKindConfirmation:
+		return pb.NoteKind_NOTE_KIND_CONFIRMATION
+	default:
+		return pb.NoteKind_NOTE_KIND_UNSPECIFIED
+	}
+}
+
+func (h *HabitServiceHandler) SaveJournalEntry(ctx context.Context, req *pb.SaveJournalEntryRequest) (*pb.SaveJournalEntryResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if req.Date == "" {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	confirmationIDs := make([]uuid.UUID, len(req.ConfirmationIds))
+	for i, id := range req.ConfirmationIds {
+		confirmationIDs[i], err = uuid.Parse(id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid confirmation_ids")
+		}
+	}
+
+	entry, err := h.journalService.SaveEntry(ctx, &entity.JournalEntry{
+		UserID:          userID,
+		Date:            req.Date,
+		Mood:            req.Mood,
+		Energy:          req.Energy,
+		Content:         req.Content,
+		Tags:            req.Tags,
+		ConfirmationIDs: confirmationIDs,
+	})
+	if err != nil {
+		return nil, mapOrganizeError("failed to save journal entry", err)
+	}
+
+	return &pb.SaveJournalEntryResponse{
+		Entry: mapJournalEntryToProto(entry),
+	}, nil
+}
+
+func (h *HabitServiceHandler) GetJournalEntry(ctx context.Context, req *pb.GetJournalEntryRequest) (*pb.GetJournalEntryResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if req.Date == "" {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	entry, err := h.journalService.GetEntry(ctx, userID, req.Date)
+	if err != nil {
+		return nil, mapOrganizeError("failed to get journal entry", err)
+	}
+
+	return &pb.GetJournalEntryResponse{
+		Entry: mapJournalEntryToProto(entry),
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListJournalEntries(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if req.FromDate == "" || req.ToDate == "" {
+		return nil, status.Error(codes.InvalidArgument, "from_date and to_date are required")
+	}
+
+	entries, err := h.journalService.ListEntries(ctx, userID, req.FromDate, req.ToDate, req.GetTag())
+	if err != nil {
+		return nil, mapOrganizeError("failed to list journal entries", err)
+	}
+
+	pbEntries := make([]*pb.JournalEntry, len(entries))
+	for i, entry := range entries {
+		pbEntries[i] = mapJournalEntryToProto(entry)
+	}
+
+	return &pb.ListJournalEntriesResponse{
+		Entries: pbEntries,
+	}, nil
+}
+
+func (h *HabitServiceHandler) DeleteJournalEntry(ctx context.Context, req *pb.DeleteJournalEntryRequest) (*pb.DeleteJournalEntryResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if req.Date == "" {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	if err := h.journalService.DeleteEntry(ctx, userID, req.Date); err != nil {
+		return nil, mapOrganizeError("failed to delete journal entry", err)
+	}
+
+	return &pb.DeleteJournalEntryResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *HabitServiceHandler) SearchNotes(ctx context.Context, req *pb.SearchNotesRequest) (*pb.SearchNotesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	results, err := h.journalService.SearchNotes(ctx, userID, req.Query, req.GetLimit())
+	if err != nil {
+		return nil, mapOrganizeError("failed to search notes", err)
+	}
+
+	pbResults := make([]*pb.NoteSearchResult, len(results))
+	for i, result := range results {
+		pbResults[i] = &pb.NoteSearchResult{
+			Kind:    mapNoteKindToProto(result.Kind),
+			Id:      result.ID.String(),
+			Date:    result.Date,
+			Snippet: result.Snippet,
+			Rank:    result.Rank,
+		}
+		if result.HabitID != nil {
+			habitID := result.HabitID.String()
+			pbResults[i].HabitId = &habitID
+		}
+	}
+
+	return &pb.SearchNotesResponse{
+		Results: pbResults,
+	}, nil
+}
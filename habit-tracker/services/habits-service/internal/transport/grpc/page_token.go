@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Page token kinds bind a token to the listing and cursor format that issued it.
+// Bump the version whenever the sort key of a listing changes so that old tokens are rejected
+const (
+	habitsPageToken  = "habits/2" // Keyed by position, version 1 was keyed by created_at
+	historyPageToken = "history/1"
+	feedPageToken    = "feed/1"
+)
+
+// pageToken is the serialized form of a page cursor. Clients treat tokens as opaque
+type pageToken struct {
+	Kind string `json:"t"`
+	Key  string `json:"k"`
+	ID   string `json:"i"`
+}
+
+func encodePageToken(kind string, cursor *entity.PageCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	data, _ := json.Marshal(pageToken{Kind: kind, Key: cursor.Key, ID: cursor.ID.String()})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken returns nil for an empty token, which selects the first page. Tokens of
+// another listing or an older cursor format are rejected as invalid
+func decodePageToken(kind, token string) (*entity.PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	id, err := uuid.Parse(decoded.ID)
+	if err != nil || decoded.Key == "" || decoded.Kind != kind {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	return &entity.PageCursor{Key: decoded.Key, ID: id}, nil
+}
+
+// pageSize applies the default to an unset size and caps it at max
+func pageSize(size *int32, defaultSize, maxSize int32) int32 {
+	if size == nil || *size <= 0 {
+		return defaultSize
+	}
+	if *size > maxSize {
+		return maxSize
+	}
+	return *size
+}
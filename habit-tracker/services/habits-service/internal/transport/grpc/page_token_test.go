@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"habits-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDecodePageToken(t *testing.T) {
+	id := uuid.New()
+	raw := func(json string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(json))
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    *entity.PageCursor
+		invalid bool
+	}{
+		{
+			name:  "first page",
+			token: "",
+		},
+		{
+			name:  "issued by the listing",
+			token: encodePageToken(habitsPageToken, &entity.PageCursor{Key: "-3", ID: id}),
+			want:  &entity.PageCursor{Key: "-3", ID: id},
+		},
+		{
+			name:    "issued before tokens had a kind",
+			token:   raw(`{"k":"2025-01-02T03:04:05.123456Z","i":"` + id.String() + `"}`),
+			invalid: true,
+		},
+		{
+			name:    "older cursor format of the listing",
+			token:   raw(`{"t":"habits/1","k":"2025-01-02T03:04:05.123456Z","i":"` + id.String() + `"}`),
+			invalid: true,
+		},
+		{
+			name:    "issued by another listing",
+			token:   encodePageToken(historyPageToken, &entity.PageCursor{Key: "2025-01-02", ID: id}),
+			invalid: true,
+		},
+		{
+			name:    "not base64",
+			token:   "not a token!",
+			invalid: true,
+		},
+		{
+			name:    "not JSON",
+			token:   raw(`habits/2`),
+			invalid: true,
+		},
+		{
+			name:    "invalid ID",
+			token:   raw(`{"t":"habits/2","k":"1","i":"42"}`),
+			invalid: true,
+		},
+		{
+			name:    "empty key",
+			token:   raw(`{"t":"habits/2","k":"","i":"` + id.String() + `"}`),
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodePageToken(habitsPageToken, tt.token)
+			if tt.invalid {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("got %v, %v, want InvalidArgument", got, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
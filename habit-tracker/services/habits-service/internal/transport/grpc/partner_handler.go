@@ -0,0 +1,261 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"habits-service/internal/domain/entity"
+	"habits-service/internal/domain/service"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mapPartnerStatusToProto(partnerStatus entity.PartnerStatus) pb.PartnerStatus {
+	switch partnerStatus {
+	case entity.PartnerStatusPending:
+		return pb.PartnerStatus_PARTNER_STATUS_PENDING
+	case entity.PartnerStatusAccepted:
+		return pb.PartnerStatus_PARTNER_STATUS_ACCEPTED
+	case entity.PartnerStatusDeclined:
+		return pb.PartnerStatus_PARTNER_STATUS_DECLINED
+	default:
+		return pb.PartnerStatus_PARTNER_STATUS_UNSPECIFIED
+	}
+}
+
+func mapPartnerToProto(partner *entity.HabitPartner) *pb.HabitPartner {
+	p := &pb.HabitPartner{
+		Id:              partner.ID.String(),
+		HabitId:         partner.HabitID.String(),
+		HabitName:       partner.HabitName,
+		OwnerId:         partner.OwnerID.String(),
+		OwnerUsername:   partner.OwnerUsername,
+		PartnerId:       partner.PartnerID.String(),
+		PartnerUsername: partner.PartnerUsername,
+		Status:          mapPartnerStatusToProto(partner.Status),
+		CreatedAt:       timestamppb.New(partner.CreatedAt),
+	}
+
+	if partner.LastNudgedAt != nil {
+		p.LastNudgedAt = timestamppb.New(*partner.LastNudgedAt)
+	}
+
+	if partner.RespondedAt != nil {
+		p.RespondedAt = timestamppb.New(*partner.RespondedAt)
+	}
+
+	return p
+}
+
+func mapPartnersToProto(partners []*entity.HabitPartner) []*pb.HabitPartner {
+	protoPartners := make([]*pb.HabitPartner, len(partners))
+	for i, partner := range partners {
+		protoPartners[i] = mapPartnerToProto(partner)
+	}
+	return protoPartners
+}
+
+// mapPartnerError maps partner errors like mapOrganizeError, nudges and re-invitations over the limit
+// are rate limited
+func mapPartnerError(msg string, err error) error {
+	if errors.Is(err, service.ErrTooManyRequests) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return mapOrganizeError(msg, err)
+}
+
+func (h *HabitServiceHandler) InviteHabitPartner(ctx context.Context, req *pb.InviteHabitPartnerRequest) (*pb.InviteHabitPartnerResponse, error) {
+	if req.HabitId == "" {
+		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	partner, err := h.partnerService.InvitePartner(ctx, habitID, userID, req.Username)
+	if err != nil {
+		return nil, mapPartnerError("failed to invite habit partner", err)
+	}
+
+	return &pb.InviteHabitPartnerResponse{
+		Partner: mapPartnerToProto(partner),
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListHabitPartners(ctx context.Context, req *pb.ListHabitPartnersRequest) (*pb.ListHabitPartnersResponse, error) {
+	if req.HabitId == "" {
+		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	partners, err := h.partnerService.ListPartners(ctx, habitID, userID)
+	if err != nil {
+		return nil, mapPartnerError("failed to list habit partners", err)
+	}
+
+	return &pb.ListHabitPartnersResponse{
+		Partners: mapPartnersToProto(partners),
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListPartnerInvitations(ctx context.Context, req *pb.ListPartnerInvitationsRequest) (*pb.ListPartnerInvitationsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	invitations, err := h.partnerService.ListInvitations(ctx, userID)
+	if err != nil {
+		return nil, mapPartnerError("failed to list partner invitations", err)
+	}
+
+	return &pb.ListPartnerInvitationsResponse{
+		Invitations: mapPartnersToProto(invitations),
+	}, nil
+}
+
+func (h *HabitServiceHandler) RespondToPartnerInvitation(ctx context.Context, req *pb.RespondToPartnerInvitationRequest) (*pb.RespondToPartnerInvitationResponse, error) {
+	if req.InvitationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invitation_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	invitationID, err := uuid.Parse(req.InvitationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid invitation_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	partner, err := h.partnerService.RespondToInvitation(ctx, invitationID, userID, req.Accept)
+	if err != nil {
+		return nil, mapPartnerError("failed to respond to partner invitation", err)
+	}
+
+	return &pb.RespondToPartnerInvitationResponse{
+		Partner: mapPartnerToProto(partner),
+	}, nil
+}
+
+func (h *HabitServiceHandler) RemoveHabitPartner(ctx context.Context, req *pb.RemoveHabitPartnerRequest) (*pb.RemoveHabitPartnerResponse, error) {
+	if req.PartnershipId == "" {
+		return nil, status.Error(codes.InvalidArgument, "partnership_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	partnershipID, err := uuid.Parse(req.PartnershipId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid partnership_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if err := h.partnerService.RemovePartner(ctx, partnershipID, userID); err != nil {
+		return nil, mapPartnerError("failed to remove habit partner", err)
+	}
+
+	return &pb.RemoveHabitPartnerResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *HabitServiceHandler) ListSharedHabits(ctx context.Context, req *pb.ListSharedHabitsRequest) (*pb.ListSharedHabitsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	shared, err := h.habitService.ListSharedHabits(ctx, userID)
+	if err != nil {
+		return nil, mapPartnerError("failed to list shared habits", err)
+	}
+
+	protoShared := make([]*pb.SharedHabit, len(shared))
+	for i, item := range shared {
+		protoShared[i] = &pb.SharedHabit{
+			PartnershipId: item.PartnershipID.String(),
+			OwnerUsername: item.OwnerUsername,
+			Habit:         mapHabitToProto(item.Habit),
+		}
+	}
+
+	return &pb.ListSharedHabitsResponse{
+		Habits: protoShared,
+	}, nil
+}
+
+func (h *HabitServiceHandler) NudgeHabit(ctx context.Context, req *pb.NudgeHabitRequest) (*pb.NudgeHabitResponse, error) {
+	if req.HabitId == "" {
+		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	nudgedAt, err := h.partnerService.Nudge(ctx, habitID, userID)
+	if err != nil {
+		return nil, mapPartnerError("failed to nudge habit", err)
+	}
+
+	return &pb.NudgeHabitResponse{
+		NudgedAt: timestamppb.New(nudgedAt),
+	}, nil
+}
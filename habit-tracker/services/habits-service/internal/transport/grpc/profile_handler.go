@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"habits-service/internal/domain/entity"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mapPublicHabitToProto(habit *entity.Habit) *pb.PublicHabit {
+	return &pb.PublicHabit{
+		Id:                        habit.ID.String(),
+		Name:                      habit.Name,
+		Color:                     habit.Color,
+		Icon:                      habit.Icon,
+		ScheduleType:              mapScheduleTypeToProto(habit.ScheduleType),
+		IntervalDays:              habit.IntervalDays,
+		WeeklyDays:                habit.WeeklyDays,
+		Streak:                    habit.Streak,
+		ConfirmedForCurrentPeriod: habit.ConfirmedForCurrentPeriod,
+		CreatedAt:                 timestamppb.New(habit.CreatedAt),
+	}
+}
+
+func mapPublicProfileToProto(profile *entity.PublicProfile) *pb.PublicProfile {
+	habits := make([]*pb.PublicHabit, len(profile.Habits))
+	for i, habit := range profile.Habits {
+		habits[i] = mapPublicHabitToProto(habit)
+	}
+
+	return &pb.PublicProfile{
+		Username:    profile.Username,
+		FirstName:   profile.FirstName,
+		MemberSince: timestamppb.New(profile.MemberSince),
+		Habits:      habits,
+	}
+}
+
+func (h *HabitServiceHandler) GetPublicProfile(ctx context.Context, req *pb.GetPublicProfileRequest) (*pb.GetPublicProfileResponse, error) {
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+
+	profile, err := h.profileService.GetPublicProfile(ctx, req.Username)
+	if err != nil {
+		return nil, mapOrganizeError("failed to get public profile", err)
+	}
+
+	return &pb.GetPublicProfileResponse{
+		Profile: mapPublicProfileToProto(profile),
+	}, nil
+}
+
+func (h *HabitServiceHandler) GetPublicHabit(ctx context.Context, req *pb.GetPublicHabitRequest) (*pb.GetPublicHabitResponse, error) {
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	profile, err := h.profileService.GetPublicHabit(ctx, req.Username, habitID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to get public habit", err)
+	}
+
+	return &pb.GetPublicHabitResponse{
+		Username: profile.Username,
+		Habit:    mapPublicHabitToProto(profile.Habits[0]),
+	}, nil
+}
@@ -12,20 +12,26 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
+// maxRecvMsgSize allows habit imports and attachments to upload whole files
+const maxRecvMsgSize = 16 * 1024 * 1024
+
 // Server represents a gRPC server
 type Server struct {
-	grpcServer *grpc.Server
-	handler    *HabitServiceHandler
-	port       int
+	grpcServer       *grpc.Server
+	handler          *HabitServiceHandler
+	analyticsHandler *HabitAnalyticsHandler
+	port             int
 }
 
 // NewServer creates a new gRPC server
-func NewServer(handler *HabitServiceHandler, port int) *Server {
+func NewServer(handler *HabitServiceHandler, analyticsHandler *HabitAnalyticsHandler, port int) *Server {
 	grpcServer := grpc.NewServer(
-	// TODO: Add interceptors for logging, metrics, recovery
+		grpc.MaxRecvMsgSize(maxRecvMsgSize),
+		// TODO: Add interceptors for logging, metrics, recovery
 	)
 
 	pb.RegisterHabitServiceServer(grpcServer, handler)
+	pb.RegisterHabitAnalyticsServiceServer(grpcServer, analyticsHandler)
 
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
@@ -34,9 +40,10 @@ func NewServer(handler *HabitServiceHandler, port int) *Server {
 	reflection.Register(grpcServer)
 
 	return &Server{
-		grpcServer: grpcServer,
-		handler:    handler,
-		port:       port,
+		grpcServer:       grpcServer,
+		handler:          handler,
+		analyticsHandler: analyticsHandler,
+		port:             port,
 	}
 }
 
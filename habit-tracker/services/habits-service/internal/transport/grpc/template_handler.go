@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"habits-service/internal/domain/entity"
+	pb "habits-service/proto/habits/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mapTemplateToProto(template *entity.HabitTemplate) *pb.HabitTemplate {
+	return &pb.HabitTemplate{
+		Id:              template.ID.String(),
+		IsPrivate:       template.IsPrivate(),
+		Name:            template.Name,
+		Description:     template.Description,
+		Color:           template.Color,
+		Icon:            template.Icon,
+		Category:        template.Category,
+		Tags:            template.Tags,
+		ScheduleType:    mapScheduleTypeToProto(template.ScheduleType),
+		IntervalDays:    template.IntervalDays,
+		WeeklyDays:      template.WeeklyDays,
+		SuggestedTarget: template.SuggestedTarget,
+		CreatedAt:       timestamppb.New(template.CreatedAt),
+	}
+}
+
+func (h *HabitServiceHandler) ListHabitTemplates(ctx context.Context, req *pb.ListHabitTemplatesRequest) (*pb.ListHabitTemplatesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	templates, err := h.templateService.ListTemplates(ctx, userID, req.Category)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list habit templates: %v", err))
+	}
+
+	protoTemplates := make([]*pb.HabitTemplate, len(templates))
+	for i, template := range templates {
+		protoTemplates[i] = mapTemplateToProto(template)
+	}
+
+	return &pb.ListHabitTemplatesResponse{
+		Templates: protoTemplates,
+	}, nil
+}
+
+func (h *HabitServiceHandler) CreateHabitFromTemplate(ctx context.Context, req *pb.CreateHabitFromTemplateRequest) (*pb.CreateHabitFromTemplateResponse, error) {
+	if req.TemplateId == "" {
+		return nil, status.Error(codes.InvalidArgument, "template_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if req.Timezone == "" {
+		return nil, status.Error(codes.InvalidArgument, "timezone is required")
+	}
+
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid template_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	groupID, err := parseOptionalGroupID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	habit, err := h.templateService.CreateHabitFromTemplate(ctx, templateID, userID, req.Timezone, req.Name, groupID)
+	if err != nil {
+		return nil, mapOrganizeError("failed to create habit from template", err)
+	}
+
+	return &pb.CreateHabitFromTemplateResponse{
+		Habit: mapHabitToProto(habit),
+	}, nil
+}
+
+func (h *HabitServiceHandler) SaveHabitAsTemplate(ctx context.Context, req *pb.SaveHabitAsTemplateRequest) (*pb.SaveHabitAsTemplateResponse, error) {
+	if req.HabitId == "" {
+		return nil, status.Error(codes.InvalidArgument, "habit_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	habitID, err := uuid.Parse(req.HabitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid habit_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	template, err := h.templateService.SaveHabitAsTemplate(ctx, habitID, userID, req.Name, req.SuggestedTarget)
+	if err != nil {
+		return nil, mapOrganizeError("failed to save habit as template", err)
+	}
+
+	return &pb.SaveHabitAsTemplateResponse{
+		Template: mapTemplateToProto(template),
+	}, nil
+}
+
+func (h *HabitServiceHandler) DeleteHabitTemplate(ctx context.Context, req *pb.DeleteHabitTemplateRequest) (*pb.DeleteHabitTemplateResponse, error) {
+	if req.TemplateId == "" {
+		return nil, status.Error(codes.InvalidArgument, "template_id is required")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid template_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	if err := h.templateService.DeleteTemplate(ctx, templateID, userID); err != nil {
+		return nil, mapOrganizeError("failed to delete habit template", err)
+	}
+
+	return &pb.DeleteHabitTemplateResponse{
+		Success: true,
+	}, nil
+}
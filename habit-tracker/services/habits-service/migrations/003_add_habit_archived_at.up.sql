@@ -0,0 +1,4 @@
+ALTER TABLE habits ADD COLUMN archived_at TIMESTAMP;
+
+-- Habits soft deleted before archiving existed are treated as archived
+UPDATE habits SET archived_at = updated_at WHERE is_active = FALSE;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_pauses_pending;
+DROP INDEX IF EXISTS idx_pauses_user_id;
+DROP INDEX IF EXISTS idx_pauses_habit_id;
+
+DROP TABLE IF EXISTS habit_pauses;
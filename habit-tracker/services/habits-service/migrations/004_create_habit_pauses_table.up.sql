@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS habit_pauses (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    habit_id UUID NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+
+    start_date DATE NOT NULL, -- First paused day (in habit's timezone)
+    end_date DATE NOT NULL,   -- Last paused day, inclusive (in habit's timezone)
+    reason TEXT,
+
+    resumed_at TIMESTAMP, -- Set once the deadline was recalculated after the pause
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_pause_dates CHECK (end_date >= start_date)
+);
+
+CREATE INDEX idx_pauses_habit_id ON habit_pauses(habit_id, start_date DESC);
+CREATE INDEX idx_pauses_user_id ON habit_pauses(user_id);
+CREATE INDEX idx_pauses_pending ON habit_pauses(end_date) WHERE resumed_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_schedule_versions_habit;
+
+DROP TABLE IF EXISTS habit_schedule_versions;
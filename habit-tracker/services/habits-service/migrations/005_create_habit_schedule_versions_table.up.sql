@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS habit_schedule_versions (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    habit_id UUID NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+
+    schedule_type schedule_type NOT NULL,
+    interval_days INTEGER CHECK (interval_days > 0),
+    weekly_days INTEGER[],
+
+    effective_from DATE NOT NULL, -- First day the schedule applies (in habit's timezone)
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT unique_habit_schedule_version UNIQUE (habit_id, effective_from)
+);
+
+CREATE INDEX idx_schedule_versions_habit ON habit_schedule_versions(habit_id, effective_from);
+
+-- Existing habits start with their current schedule from the day they were created
+INSERT INTO habit_schedule_versions (habit_id, schedule_type, interval_days, weekly_days, effective_from, created_at)
+SELECT id, schedule_type, interval_days, weekly_days,
+       (created_at + make_interval(hours => timezone_offset_hours))::DATE, created_at
+FROM habits
+ON CONFLICT (habit_id, effective_from) DO NOTHING;
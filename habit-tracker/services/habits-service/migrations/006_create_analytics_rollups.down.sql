@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_habit_pair_rollups_user_id;
+DROP INDEX IF EXISTS idx_habit_rollups_user_id;
+
+DROP TABLE IF EXISTS analytics_habit_pair_rollups;
+DROP TABLE IF EXISTS analytics_habit_rollups;
+DROP TABLE IF EXISTS analytics_hourly_rollups;
+DROP TABLE IF EXISTS analytics_daily_rollups;
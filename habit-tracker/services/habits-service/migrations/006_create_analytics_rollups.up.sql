@@ -0,0 +1,71 @@
+-- Rollups behind the user analytics RPCs, updated on every confirmation and missed deadline
+-- so that analytics requests never scan habit_confirmations
+
+CREATE TABLE IF NOT EXISTS analytics_daily_rollups (
+    user_id UUID NOT NULL,
+    local_date DATE NOT NULL, -- In habit's timezone
+
+    completed INTEGER NOT NULL DEFAULT 0,
+    missed INTEGER NOT NULL DEFAULT 0,
+
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, local_date)
+);
+
+CREATE TABLE IF NOT EXISTS analytics_hourly_rollups (
+    user_id UUID NOT NULL,
+    local_hour SMALLINT NOT NULL CHECK (local_hour >= 0 AND local_hour <= 23), -- Hour of confirmed_at in habit's timezone
+
+    confirmations INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (user_id, local_hour)
+);
+
+CREATE TABLE IF NOT EXISTS analytics_habit_rollups (
+    habit_id UUID PRIMARY KEY REFERENCES habits(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+
+    confirmed_days INTEGER NOT NULL DEFAULT 0
+);
+
+-- Days on which both habits were confirmed, habit_a_id is always the smaller id
+CREATE TABLE IF NOT EXISTS analytics_habit_pair_rollups (
+    habit_a_id UUID NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+    habit_b_id UUID NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+
+    days_together INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (habit_a_id, habit_b_id),
+    CONSTRAINT ordered_habit_pair CHECK (habit_a_id < habit_b_id)
+);
+
+CREATE INDEX idx_habit_rollups_user_id ON analytics_habit_rollups(user_id);
+CREATE INDEX idx_habit_pair_rollups_user_id ON analytics_habit_pair_rollups(user_id);
+
+-- Backfill from existing confirmations. Missed deadlines were never recorded and start from zero
+INSERT INTO analytics_daily_rollups (user_id, local_date, completed)
+SELECT user_id, confirmed_for_date, COUNT(*)
+FROM habit_confirmations
+GROUP BY user_id, confirmed_for_date;
+
+INSERT INTO analytics_hourly_rollups (user_id, local_hour, confirmations)
+SELECT c.user_id, EXTRACT(HOUR FROM c.confirmed_at + make_interval(hours => h.timezone_offset_hours))::SMALLINT, COUNT(*)
+FROM habit_confirmations c
+JOIN habits h ON h.id = c.habit_id
+GROUP BY 1, 2;
+
+INSERT INTO analytics_habit_rollups (habit_id, user_id, confirmed_days)
+SELECT habit_id, user_id, COUNT(*)
+FROM habit_confirmations
+GROUP BY habit_id, user_id;
+
+INSERT INTO analytics_habit_pair_rollups (habit_a_id, habit_b_id, user_id, days_together)
+SELECT a.habit_id, b.habit_id, a.user_id, COUNT(*)
+FROM habit_confirmations a
+JOIN habit_confirmations b
+  ON b.user_id = a.user_id
+ AND b.confirmed_for_date = a.confirmed_for_date
+ AND b.habit_id > a.habit_id
+GROUP BY a.habit_id, b.habit_id, a.user_id;
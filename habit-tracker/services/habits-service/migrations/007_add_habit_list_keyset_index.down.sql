@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_habits_user_created;
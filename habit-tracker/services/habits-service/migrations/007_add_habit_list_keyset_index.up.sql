@@ -0,0 +1,2 @@
+-- Keyset pagination of habit lists, newest first
+CREATE INDEX IF NOT EXISTS idx_habits_user_created ON habits(user_id, created_at DESC, id DESC);
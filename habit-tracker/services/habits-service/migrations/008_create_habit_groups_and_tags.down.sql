@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS idx_habit_tags_user_tag;
+
+DROP TABLE IF EXISTS habit_tags;
+
+DROP INDEX IF EXISTS idx_habits_group_id;
+DROP INDEX IF EXISTS idx_habits_user_position;
+CREATE INDEX IF NOT EXISTS idx_habits_user_created ON habits(user_id, created_at DESC, id DESC);
+
+ALTER TABLE habits DROP COLUMN IF EXISTS position;
+ALTER TABLE habits DROP COLUMN IF EXISTS icon;
+ALTER TABLE habits DROP COLUMN IF EXISTS group_id;
+
+DROP INDEX IF EXISTS idx_habit_groups_user_position;
+
+DROP TABLE IF EXISTS habit_groups;
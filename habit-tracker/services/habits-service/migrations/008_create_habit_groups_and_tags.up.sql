@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS habit_groups (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id UUID NOT NULL,
+
+    name VARCHAR(100) NOT NULL,
+    color VARCHAR(7),  -- HEX color, e.g., "#FF5722"
+    icon VARCHAR(64),
+
+    position INTEGER NOT NULL DEFAULT 0,  -- Manual sort order, ascending
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT unique_habit_group_name UNIQUE (user_id, name)
+);
+
+CREATE INDEX idx_habit_groups_user_position ON habit_groups(user_id, position, id);
+
+ALTER TABLE habits ADD COLUMN group_id UUID REFERENCES habit_groups(id) ON DELETE SET NULL;
+ALTER TABLE habits ADD COLUMN icon VARCHAR(64);
+ALTER TABLE habits ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+
+-- Keep the previous newest first order until users rearrange their habits
+UPDATE habits h SET position = ordered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC, id DESC) - 1 AS position
+    FROM habits
+) ordered
+WHERE h.id = ordered.id;
+
+-- Habit lists are ordered by position instead of creation time
+DROP INDEX IF EXISTS idx_habits_user_created;
+CREATE INDEX idx_habits_user_position ON habits(user_id, position, id);
+CREATE INDEX idx_habits_group_id ON habits(group_id) WHERE group_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS habit_tags (
+    habit_id UUID NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    tag VARCHAR(50) NOT NULL,  -- Lowercase
+
+    PRIMARY KEY (habit_id, tag)
+);
+
+CREATE INDEX idx_habit_tags_user_tag ON habit_tags(user_id, tag);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_habit_templates_user_id;
+DROP INDEX IF EXISTS idx_habit_templates_catalog;
+
+DROP TABLE IF EXISTS habit_templates;
@@ -0,0 +1,45 @@
+CREATE TABLE IF NOT EXISTS habit_templates (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id UUID,  -- NULL for the shared catalog, set for private templates
+
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    color VARCHAR(7),  -- HEX color, e.g., "#FF5722"
+    icon VARCHAR(64),
+    category VARCHAR(50),  -- Catalog section, NULL for private templates
+    tags TEXT[] NOT NULL DEFAULT '{}',
+
+    schedule_type schedule_type NOT NULL,
+    interval_days INTEGER CHECK (interval_days > 0),
+    weekly_days INTEGER[] CHECK (array_length(weekly_days, 1) > 0),
+
+    suggested_target VARCHAR(100),  -- Free text, e.g., "8 glasses" or "20 minutes"
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT valid_template_schedule CHECK (
+        (schedule_type = 'interval' AND interval_days IS NOT NULL AND weekly_days IS NULL) OR
+        (schedule_type = 'weekly' AND weekly_days IS NOT NULL AND interval_days IS NULL)
+    )
+);
+
+CREATE INDEX idx_habit_templates_catalog ON habit_templates(category, name) WHERE user_id IS NULL;
+CREATE INDEX idx_habit_templates_user_id ON habit_templates(user_id, created_at) WHERE user_id IS NOT NULL;
+
+-- Starter catalog
+INSERT INTO habit_templates (name, description, color, icon, category, tags, schedule_type, interval_days, weekly_days, suggested_target) VALUES
+    ('Drink water', 'Stay hydrated through the day', '#2196F3', 'glass-water', 'health', '{health}', 'interval', 1, NULL, '8 glasses'),
+    ('Take vitamins', 'Take your daily supplements with breakfast', '#FFC107', 'pill', 'health', '{health}', 'interval', 1, NULL, NULL),
+    ('Sleep before midnight', 'Go to bed on time to get enough sleep', '#3F51B5', 'moon', 'health', '{health,sleep}', 'interval', 1, NULL, '8 hours of sleep'),
+    ('Walk', 'Get outside and keep moving', '#4CAF50', 'footprints', 'fitness', '{fitness}', 'interval', 1, NULL, '10000 steps'),
+    ('Workout', 'Strength or cardio training', '#F44336', 'dumbbell', 'fitness', '{fitness}', 'weekly', NULL, '{1,3,5}', '45 minutes'),
+    ('Stretch', 'Stretch to stay flexible and avoid injuries', '#FF9800', 'person-stretching', 'fitness', '{fitness}', 'interval', 1, NULL, '10 minutes'),
+    ('Meditate', 'Sit quietly and focus on your breath', '#9C27B0', 'lotus', 'mindfulness', '{mindfulness}', 'interval', 1, NULL, '10 minutes'),
+    ('Journal', 'Write down your thoughts of the day', '#795548', 'notebook', 'mindfulness', '{mindfulness}', 'interval', 1, NULL, 'One page'),
+    ('Gratitude', 'Write down three things you are grateful for', '#E91E63', 'heart', 'mindfulness', '{mindfulness}', 'interval', 1, NULL, '3 things'),
+    ('Read', 'Read a book, not a feed', '#607D8B', 'book-open', 'learning', '{learning}', 'interval', 1, NULL, '20 pages'),
+    ('Practice a language', 'Keep up with a language you are learning', '#00BCD4', 'languages', 'learning', '{learning}', 'interval', 1, NULL, '15 minutes'),
+    ('Plan the day', 'Pick the most important tasks before starting work', '#009688', 'list-checks', 'productivity', '{productivity}', 'weekly', NULL, '{1,2,3,4,5}', '3 priorities'),
+    ('Weekly review', 'Review the past week and plan the next one', '#8BC34A', 'calendar-check', 'productivity', '{productivity}', 'weekly', NULL, '{0}', '30 minutes'),
+    ('Tidy up', 'Keep your space clean and clear', '#CDDC39', 'broom', 'home', '{home}', 'interval', 2, NULL, '15 minutes'),
+    ('Call family', 'Stay in touch with the people you love', '#FF5722', 'phone', 'social', '{social}', 'weekly', NULL, '{0}', NULL);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_habit_partners_owner_id;
+DROP INDEX IF EXISTS idx_habit_partners_partner_id;
+
+DROP TABLE IF EXISTS habit_partners;
+
+DROP TYPE IF EXISTS partner_status;
@@ -0,0 +1,24 @@
+CREATE TYPE partner_status AS ENUM ('pending', 'accepted', 'declined');
+
+CREATE TABLE IF NOT EXISTS habit_partners (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    habit_id UUID NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+    owner_id UUID NOT NULL,
+    partner_id UUID NOT NULL,
+
+    -- Usernames as of the invitation, so lists don't need a round trip to user-service
+    owner_username VARCHAR(100) NOT NULL,
+    partner_username VARCHAR(100) NOT NULL,
+
+    status partner_status NOT NULL DEFAULT 'pending',
+    last_nudged_at TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    responded_at TIMESTAMP,
+
+    CONSTRAINT unique_habit_partner UNIQUE (habit_id, partner_id),
+    CONSTRAINT check_partner_not_owner CHECK (partner_id <> owner_id)
+);
+
+CREATE INDEX idx_habit_partners_partner_id ON habit_partners(partner_id, status);
+CREATE INDEX idx_habit_partners_owner_id ON habit_partners(owner_id);
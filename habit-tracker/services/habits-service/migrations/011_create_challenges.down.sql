@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_challenge_participants_habit_id;
+DROP INDEX IF EXISTS idx_challenge_participants_user_id;
+DROP INDEX IF EXISTS idx_challenges_creator_id;
+
+DROP TABLE IF EXISTS challenge_participants;
+DROP TABLE IF EXISTS challenges;
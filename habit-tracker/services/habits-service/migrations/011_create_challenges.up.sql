@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS challenges (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    creator_id UUID NOT NULL,
+
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+
+    -- Inclusive window, confirmations for dates inside it count toward the leaderboard
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+
+    invite_code VARCHAR(16) NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT unique_challenge_invite_code UNIQUE (invite_code),
+    CONSTRAINT valid_challenge_window CHECK (end_date >= start_date)
+);
+
+CREATE TABLE IF NOT EXISTS challenge_participants (
+    challenge_id UUID NOT NULL REFERENCES challenges(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+
+    -- Username as of joining, so leaderboards don't need a round trip to user-service
+    username VARCHAR(100) NOT NULL,
+
+    -- The participant's habit whose confirmations count, leaving the challenge when it is deleted
+    habit_id UUID NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+
+    joined_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (challenge_id, user_id)
+);
+
+CREATE INDEX idx_challenges_creator_id ON challenges(creator_id);
+CREATE INDEX idx_challenge_participants_user_id ON challenge_participants(user_id);
+CREATE INDEX idx_challenge_participants_habit_id ON challenge_participants(habit_id);
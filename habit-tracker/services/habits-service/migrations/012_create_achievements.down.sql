@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_habit_streak_breaks_user_id;
+
+DROP TABLE IF EXISTS habit_streak_breaks;
+DROP TABLE IF EXISTS user_achievements;
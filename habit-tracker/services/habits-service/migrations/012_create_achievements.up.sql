@@ -0,0 +1,24 @@
+-- Achievements unlocked by users. The catalog and its rules live in code,
+-- rows are only inserted once per user and code so evaluating a rule again is harmless
+CREATE TABLE IF NOT EXISTS user_achievements (
+    user_id UUID NOT NULL,
+    code VARCHAR(50) NOT NULL,
+
+    -- Habit whose event unlocked the achievement, kept after the habit is purged
+    habit_id UUID REFERENCES habits(id) ON DELETE SET NULL,
+
+    unlocked_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, code)
+);
+
+-- Latest streak a habit lost, for the comeback achievement
+CREATE TABLE IF NOT EXISTS habit_streak_breaks (
+    habit_id UUID PRIMARY KEY REFERENCES habits(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+
+    lost_streak INTEGER NOT NULL,
+    broken_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_habit_streak_breaks_user_id ON habit_streak_breaks(user_id);
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_feed_items_actor_id;
+DROP INDEX IF EXISTS idx_feed_items_user_occurred;
+
+DROP TABLE IF EXISTS feed_items;
+
+DROP INDEX IF EXISTS idx_user_follows_followee_id;
+
+DROP TABLE IF EXISTS user_follows;
+
+DROP TYPE IF EXISTS follow_status;
+
+ALTER TABLE habits DROP COLUMN IF EXISTS visibility;
+
+DROP TYPE IF EXISTS habit_visibility;
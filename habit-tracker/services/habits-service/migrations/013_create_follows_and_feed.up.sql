@@ -0,0 +1,48 @@
+CREATE TYPE habit_visibility AS ENUM ('private', 'friends', 'public');
+
+ALTER TABLE habits ADD COLUMN visibility habit_visibility NOT NULL DEFAULT 'private';
+
+CREATE TYPE follow_status AS ENUM ('pending', 'accepted');
+
+CREATE TABLE IF NOT EXISTS user_follows (
+    follower_id UUID NOT NULL,
+    followee_id UUID NOT NULL,
+
+    -- Usernames as of the request, so lists and feeds don't need a round trip to user-service
+    follower_username VARCHAR(100) NOT NULL,
+    followee_username VARCHAR(100) NOT NULL,
+
+    status follow_status NOT NULL DEFAULT 'pending',
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    responded_at TIMESTAMP,
+
+    PRIMARY KEY (follower_id, followee_id),
+    CONSTRAINT check_follow_not_self CHECK (follower_id <> followee_id)
+);
+
+CREATE INDEX idx_user_follows_followee_id ON user_follows(followee_id, status);
+
+-- Activity of followed users, written once per follower when a habit event is consumed.
+-- Visibility and the follow are checked again on read, so items don't outlive either
+CREATE TABLE IF NOT EXISTS feed_items (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id UUID NOT NULL,  -- Follower the item is shown to
+    actor_id UUID NOT NULL,
+    habit_id UUID NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+
+    -- Habit event the item was fanned out from, redelivered events insert nothing
+    event_id UUID NOT NULL,
+
+    kind VARCHAR(20) NOT NULL,  -- 'confirmation' or 'milestone'
+    confirmed_for_date DATE,
+    streak INTEGER,
+    achievement_code VARCHAR(50),
+
+    occurred_at TIMESTAMP NOT NULL,
+
+    CONSTRAINT unique_feed_item_event UNIQUE (user_id, event_id)
+);
+
+CREATE INDEX idx_feed_items_user_occurred ON feed_items(user_id, occurred_at DESC, id DESC);
+CREATE INDEX idx_feed_items_actor_id ON feed_items(actor_id);
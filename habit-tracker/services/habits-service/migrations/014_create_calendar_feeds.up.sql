@@ -0,0 +1,7 @@
+-- Secret tokens of ICS subscription URLs, one per user. Only a SHA-256 hash of the token is stored,
+-- rotating replaces it so that old URLs stop working
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    user_id UUID PRIMARY KEY,
+    token_hash BYTEA NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS habit_import_issues;
+DROP TABLE IF EXISTS habit_imports;
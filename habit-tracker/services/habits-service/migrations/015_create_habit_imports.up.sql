@@ -0,0 +1,39 @@
+-- Background jobs importing habits and their history from other trackers
+CREATE TABLE IF NOT EXISTS habit_imports (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id UUID NOT NULL,
+
+    source VARCHAR(20) NOT NULL, -- loop, habitica or streaks
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+
+    -- Progress, known once the upload is parsed
+    total_habits INTEGER NOT NULL DEFAULT 0,
+    total_confirmations INTEGER NOT NULL DEFAULT 0,
+    skipped_rows INTEGER NOT NULL DEFAULT 0,
+
+    habits_imported INTEGER NOT NULL DEFAULT 0,
+    confirmations_imported INTEGER NOT NULL DEFAULT 0,
+
+    error TEXT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP
+);
+
+-- Rows and habits that were skipped or could not be mapped
+CREATE TABLE IF NOT EXISTS habit_import_issues (
+    import_id UUID NOT NULL REFERENCES habit_imports(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL, -- Order in which the issues were found
+
+    row_number INTEGER, -- Line of the uploaded file, NULL when the issue concerns a whole habit
+    habit_name VARCHAR(255),
+    reason TEXT NOT NULL,
+
+    PRIMARY KEY (import_id, position)
+);
+
+CREATE INDEX idx_habit_imports_user_id ON habit_imports(user_id);
+
+-- At most one import in progress per user
+CREATE UNIQUE INDEX idx_habit_imports_user_in_progress ON habit_imports(user_id)
+    WHERE status IN ('pending', 'processing');
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_confirmations_notes_search;
+DROP TABLE IF EXISTS journal_entry_confirmations;
+DROP TABLE IF EXISTS journal_entries;
@@ -0,0 +1,37 @@
+-- Daily journal of a user: mood and energy ratings, tags and free text
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id UUID NOT NULL,
+    entry_date DATE NOT NULL, -- Local date the entry is about
+
+    mood SMALLINT CHECK (mood >= 1 AND mood <= 5),
+    energy SMALLINT CHECK (energy >= 1 AND energy <= 5),
+
+    content TEXT,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+
+    -- 'simple' does not stem, entries are written in any language
+    search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', COALESCE(content, ''))) STORED,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT unique_journal_entry UNIQUE (user_id, entry_date)
+);
+
+CREATE INDEX idx_journal_entries_search ON journal_entries USING GIN (search_vector);
+CREATE INDEX idx_journal_entries_tags ON journal_entries USING GIN (tags);
+
+-- Confirmations of the day an entry is linked to
+CREATE TABLE IF NOT EXISTS journal_entry_confirmations (
+    entry_id UUID NOT NULL REFERENCES journal_entries(id) ON DELETE CASCADE,
+    confirmation_id UUID NOT NULL REFERENCES habit_confirmations(id) ON DELETE CASCADE,
+
+    PRIMARY KEY (entry_id, confirmation_id)
+);
+
+CREATE INDEX idx_journal_entry_confirmations_confirmation_id ON journal_entry_confirmations(confirmation_id);
+
+-- Searching confirmation notes uses the same configuration as journal entries
+CREATE INDEX idx_confirmations_notes_search ON habit_confirmations
+    USING GIN (to_tsvector('simple', COALESCE(notes, '')));
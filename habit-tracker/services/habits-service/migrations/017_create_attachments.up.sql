@@ -0,0 +1,23 @@
+-- Photos attached to confirmations as proof, the files themselves live in the attachment storage
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id UUID NOT NULL,
+
+    -- NULL until used by a confirmation, and again once the confirmation or its habit is deleted
+    confirmation_id UUID REFERENCES habit_confirmations(id) ON DELETE SET NULL,
+
+    content_type VARCHAR(50) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    thumbnail_size_bytes BIGINT NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    attached_at TIMESTAMP -- Set once used, an attachment is never reused after its confirmation is gone
+);
+
+CREATE INDEX idx_attachments_user_id ON attachments(user_id);
+CREATE INDEX idx_attachments_confirmation_id ON attachments(confirmation_id);
+
+-- Uploads never used and attachments whose confirmation was deleted, removed by the cleanup job
+CREATE INDEX idx_attachments_orphaned ON attachments(created_at) WHERE confirmation_id IS NULL;
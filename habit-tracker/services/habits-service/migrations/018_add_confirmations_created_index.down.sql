@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_confirmations_created_at;
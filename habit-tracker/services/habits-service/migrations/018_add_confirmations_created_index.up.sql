@@ -0,0 +1,2 @@
+-- Finds users who confirmed habits since the last analytics rollup rebuild
+CREATE INDEX IF NOT EXISTS idx_confirmations_created_at ON habit_confirmations(created_at);
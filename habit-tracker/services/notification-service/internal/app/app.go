@@ -15,6 +15,7 @@ import (
 	"notification-service/internal/infrastructure/redis"
 	"notification-service/internal/infrastructure/smtp"
 	"notification-service/internal/service"
+	"notification-service/internal/transport/grpc"
 )
 
 type App struct {
@@ -69,6 +70,9 @@ func (a *App) Run() error {
 	consumer := kafka.NewConsumer(&a.cfg.Kafka, notificationService, emailService)
 	log.Println("Kafka consumer initialized")
 
+	grpcHandler := grpc.NewNotificationServiceHandler(notificationService)
+	grpcServer := grpc.NewServer(grpcHandler, a.cfg.GRPC.Port)
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -79,6 +83,13 @@ func (a *App) Run() error {
 		}
 	}()
 
+	grpcErrChan := make(chan error, 1)
+	go func() {
+		if err := grpcServer.Start(); err != nil {
+			grpcErrChan <- err
+		}
+	}()
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
@@ -88,11 +99,15 @@ func (a *App) Run() error {
 	case err := <-consumerErrChan:
 		log.Printf("Kafka consumer error: %v", err)
 		return err
+	case err := <-grpcErrChan:
+		log.Printf("gRPC server error: %v", err)
+		return err
 	case sig := <-sigChan:
 		log.Printf("Received signal: %v", sig)
 		cancel()
 
 		log.Println("Shutting down gracefully...")
+		grpcServer.Stop()
 		if err := consumer.Close(); err != nil {
 			log.Printf("Error closing Kafka consumer: %v", err)
 		}
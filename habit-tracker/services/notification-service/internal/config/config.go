@@ -11,6 +11,7 @@ import (
 
 type Config struct {
 	Service  ServiceConfig  `yaml:"service"`
+	GRPC     GRPCConfig     `yaml:"grpc"`
 	Database DatabaseConfig `yaml:"database"`
 	Redis    RedisConfig    `yaml:"redis"`
 	Kafka    KafkaConfig    `yaml:"kafka"`
@@ -26,6 +27,10 @@ type ServiceConfig struct {
 	Version     string `yaml:"version"`
 }
 
+type GRPCConfig struct {
+	Port int `yaml:"port"`
+}
+
 type DatabaseConfig struct {
 	Host            string        `yaml:"host"`
 	Port            int           `yaml:"port"`
@@ -51,12 +56,14 @@ type RedisConfig struct {
 }
 
 type KafkaConfig struct {
-	Brokers       []string      `yaml:"brokers"`
-	Topics        []string      `yaml:"topics"`
-	GroupID       string        `yaml:"group_id"`
-	ConsumerGroup string        `yaml:"consumer_group"`
-	MaxRetries    int           `yaml:"max_retries"`
-	RetryBackoff  time.Duration `yaml:"retry_backoff"`
+	Brokers       []string `yaml:"brokers"`
+	Topics        []string `yaml:"topics"`
+	GroupID       string   `yaml:"group_id"`
+	ConsumerGroup string   `yaml:"consumer_group"`
+	MaxRetries    int      `yaml:"max_retries"`
+
+	RetryBackoff    time.Duration `yaml:"retry_backoff"`     // Delay before a failed message is processed again
+	MaxRetryBackoff time.Duration `yaml:"max_retry_backoff"` // The delay doubles with every failure up to this
 }
 
 type SMTPConfig struct {
@@ -70,8 +77,12 @@ type SMTPConfig struct {
 }
 
 type EmailConfig struct {
-	VerificationURL string `yaml:"verification_url"`
-	TemplatesPath   string `yaml:"templates_path"`
+	VerificationURL       string `yaml:"verification_url"`
+	MagicLinkURL          string `yaml:"magic_link_url"`
+	EmailChangeConfirmURL string `yaml:"email_change_confirm_url"`
+	EmailChangeUndoURL    string `yaml:"email_change_undo_url"`
+	DataExportDownloadURL string `yaml:"data_export_download_url"`
+	TemplatesPath         string `yaml:"templates_path"`
 }
 
 type LoggingConfig struct {
@@ -103,10 +114,22 @@ func Load() (*Config, error) {
 	}
 
 	cfg.overrideFromEnv()
+	cfg.applyDefaults()
 
 	return &cfg, nil
 }
 
+// applyDefaults fills in values that must not stay zero when they are missing from the config file
+func (c *Config) applyDefaults() {
+	// A zero backoff would retry a failing message in a tight loop
+	if c.Kafka.RetryBackoff <= 0 {
+		c.Kafka.RetryBackoff = time.Second
+	}
+	if c.Kafka.MaxRetryBackoff < c.Kafka.RetryBackoff {
+		c.Kafka.MaxRetryBackoff = max(time.Minute, c.Kafka.RetryBackoff)
+	}
+}
+
 // overrideFromEnv overrides config values with environment variables if present
 func (c *Config) overrideFromEnv() {
 	if val := os.Getenv("SERVICE_NAME"); val != "" {
@@ -115,6 +138,9 @@ func (c *Config) overrideFromEnv() {
 	if val := os.Getenv("SERVICE_ENVIRONMENT"); val != "" {
 		c.Service.Environment = val
 	}
+	if val := os.Getenv("GRPC_PORT"); val != "" {
+		fmt.Sscanf(val, "%d", &c.GRPC.Port)
+	}
 	if val := os.Getenv("DATABASE_HOST"); val != "" {
 		c.Database.Host = val
 	}
@@ -168,6 +194,18 @@ func (c *Config) overrideFromEnv() {
 	if val := os.Getenv("EMAIL_VERIFICATION_URL"); val != "" {
 		c.Email.VerificationURL = val
 	}
+	if val := os.Getenv("EMAIL_MAGIC_LINK_URL"); val != "" {
+		c.Email.MagicLinkURL = val
+	}
+	if val := os.Getenv("EMAIL_CHANGE_CONFIRM_URL"); val != "" {
+		c.Email.EmailChangeConfirmURL = val
+	}
+	if val := os.Getenv("EMAIL_CHANGE_UNDO_URL"); val != "" {
+		c.Email.EmailChangeUndoURL = val
+	}
+	if val := os.Getenv("DATA_EXPORT_DOWNLOAD_URL"); val != "" {
+		c.Email.DataExportDownloadURL = val
+	}
 	if val := os.Getenv("KAFKA_BROKER"); val != "" {
 		c.Kafka.Brokers = []string{val}
 	}
@@ -0,0 +1,9 @@
+package entity
+
+import "time"
+
+// PageCursor is the position of the last notification of a page, the next page starts after it
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
@@ -13,12 +13,18 @@ type NotificationRepository interface {
 	// GetByID retrieves a notification by ID
 	GetByID(ctx context.Context, id string) (*entity.Notification, error)
 
-	// GetByUserID retrieves all notifications for a user
-	GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*entity.Notification, error)
+	// GetByUserID retrieves up to limit notifications of a user created before the cursor, newest first
+	GetByUserID(ctx context.Context, userID string, limit int, after *entity.PageCursor) ([]*entity.Notification, error)
+
+	// CountByUserID returns the number of notifications of a user
+	CountByUserID(ctx context.Context, userID string) (int, error)
 
 	// UpdateStatus updates the status of a notification
 	UpdateStatus(ctx context.Context, id string, status entity.NotificationStatus, sentAt *string, failedAt *string, errorMsg *string) error
 
 	// GetPendingNotifications retrieves all pending notifications
 	GetPendingNotifications(ctx context.Context, limit int) ([]*entity.Notification, error)
+
+	// DeleteByUserID deletes all notifications of a user and returns number of deleted rows
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"time"
+
 	"notification-service/internal/domain/entity"
 )
 
@@ -13,8 +15,15 @@ type NotificationService interface {
 	// SendPasswordReset sends a password reset notification
 	SendPasswordReset(ctx context.Context, data *entity.PasswordResetData) error
 
-	// GetNotificationHistory retrieves notification history for a user
-	GetNotificationHistory(ctx context.Context, userID string, limit, offset int) ([]*entity.Notification, error)
+	// GetNotificationHistory retrieves a page of notification history for a user, newest first.
+	// The returned cursor addresses the next page and is nil on the last page
+	GetNotificationHistory(ctx context.Context, userID string, limit int, after *entity.PageCursor) ([]*entity.Notification, *entity.PageCursor, error)
+
+	// CountNotifications returns the number of notifications of a user
+	CountNotifications(ctx context.Context, userID string) (int, error)
+
+	// PurgeUserData deletes all notifications of a deleted user, purging an already purged user is a no-op
+	PurgeUserData(ctx context.Context, userID string) (int64, error)
 }
 
 // EmailService defines the interface for email sending
@@ -27,4 +36,31 @@ type EmailService interface {
 
 	// SendPasswordChangedEmail sends a notification when password is changed
 	SendPasswordChangedEmail(ctx context.Context, to string, wasReset bool) error
+
+	// SendMagicLinkEmail sends a single-use sign-in link
+	SendMagicLinkEmail(ctx context.Context, to, username, firstName, token string, expiresInMinutes int) error
+
+	// SendEmailChangeConfirmEmail sends email change confirmation link to the new address
+	SendEmailChangeConfirmEmail(ctx context.Context, to, username, firstName, confirmToken string) error
+
+	// SendEmailChangeNoticeEmail notifies the old address about email change with undo link
+	SendEmailChangeNoticeEmail(ctx context.Context, to, username, firstName, newEmail, undoToken string) error
+
+	// SendDataExportReadyEmail sends a time-limited link to download user data export
+	SendDataExportReadyEmail(ctx context.Context, to, username, firstName, downloadToken string, expiresAt time.Time) error
+
+	// SendAccountDeletionScheduledEmail warns user that account will be permanently deleted
+	SendAccountDeletionScheduledEmail(ctx context.Context, to, username, firstName string, scheduledFor time.Time) error
+
+	// SendPartnerInvitationEmail invites a user to become an accountability partner on a habit
+	SendPartnerInvitationEmail(ctx context.Context, to, username, firstName, ownerUsername, habitName string) error
+
+	// SendHabitNudgeEmail reminds the owner of a habit on behalf of a partner
+	SendHabitNudgeEmail(ctx context.Context, to, username, firstName, partnerUsername, habitName string) error
+
+	// SendStreakBrokenEmail tells a partner that the owner of a habit lost a streak
+	SendStreakBrokenEmail(ctx context.Context, to, username, firstName, ownerUsername, habitName string, lostStreak int32) error
+
+	// SendAchievementUnlockedEmail congratulates a user on an unlocked achievement
+	SendAchievementUnlockedEmail(ctx context.Context, to, username, firstName, achievementName, achievementDescription string, xp, level int32) error
 }
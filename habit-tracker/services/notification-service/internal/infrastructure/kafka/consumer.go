@@ -15,7 +15,8 @@ import (
 )
 
 type Consumer struct {
-	reader              *kafka.Reader
+	reader              messageReader
+	backoff             retryBackoff
 	notificationService service.NotificationService
 	emailService        service.EmailService
 }
@@ -29,49 +30,32 @@ func NewConsumer(
 	reader := kafka.NewReader(kafka.ReaderConfig{
 		Brokers:        cfg.Brokers,
 		GroupID:        cfg.GroupID,
-		Topic:          cfg.Topics[0], // user-events
-		MinBytes:       10e3,          // 10KB
-		MaxBytes:       10e6,          // 10MB
+		GroupTopics:    cfg.Topics, // user-events, habit-events
+		MinBytes:       10e3,       // 10KB
+		MaxBytes:       10e6,       // 10MB
 		CommitInterval: time.Second,
 		StartOffset:    kafka.LastOffset,
 	})
 
 	return &Consumer{
 		reader:              reader,
+		backoff:             retryBackoff{initial: cfg.RetryBackoff, max: cfg.MaxRetryBackoff},
 		notificationService: notificationService,
 		emailService:        emailService,
 	}
 }
 
-// Start starts consuming messages from Kafka
+// Start consumes messages from Kafka until ctx is cancelled. Offsets are committed only after
+// a message was processed, failures are retried
 func (c *Consumer) Start(ctx context.Context) error {
-	log.Println("Starting Kafka consumer...")
-
-	for {
-		select {
-		case <-ctx.Done():
-			log.Println("Stopping Kafka consumer...")
-			return c.reader.Close()
-		default:
-			message, err := c.reader.ReadMessage(ctx)
-			if err != nil {
-				log.Printf("Error reading message: %v", err)
-				continue
-			}
-
-			if err := c.processMessage(ctx, message); err != nil {
-				log.Printf("Error processing message: %v", err)
-				// Continue processing other messages even if one fails
-			}
-		}
-	}
+	return consume(ctx, "Kafka consumer", c.reader, c.backoff, c.processMessage)
 }
 
 // processMessage processes a Kafka message
 func (c *Consumer) processMessage(ctx context.Context, message kafka.Message) error {
 	var event eventspb.Event
 	if err := proto.Unmarshal(message.Value, &event); err != nil {
-		return fmt.Errorf("failed to unmarshal event: %w", err)
+		return fmt.Errorf("%w: failed to unmarshal: %v", errMalformedEvent, err)
 	}
 
 	log.Printf("Received event: %s (ID: %s)", event.EventType.String(), event.EventId)
@@ -85,6 +69,30 @@ func (c *Consumer) processMessage(ctx context.Context, message kafka.Message) er
 		return c.handlePasswordResetRequested(ctx, event.GetPasswordResetRequested())
 	case eventspb.EventType_EVENT_TYPE_PASSWORD_CHANGED:
 		return c.handlePasswordChanged(ctx, event.GetPasswordChanged())
+	case eventspb.EventType_EVENT_TYPE_MAGIC_LINK_REQUESTED:
+		return c.handleMagicLinkRequested(ctx, event.GetMagicLinkRequested())
+	case eventspb.EventType_EVENT_TYPE_EMAIL_CHANGE_REQUESTED:
+		return c.handleEmailChangeRequested(ctx, event.GetEmailChangeRequested())
+	case eventspb.EventType_EVENT_TYPE_DATA_EXPORT_READY:
+		return c.handleDataExportReady(ctx, event.GetDataExportReady())
+	case eventspb.EventType_EVENT_TYPE_ACCOUNT_DELETION_SCHEDULED:
+		return c.handleAccountDeletionScheduled(ctx, event.GetAccountDeletionScheduled())
+	case eventspb.EventType_EVENT_TYPE_USER_DELETED:
+		return c.handleUserDeleted(ctx, event.GetUserDeleted())
+	case eventspb.EventType_EVENT_TYPE_HABIT_PARTNER_INVITED:
+		return c.handleHabitPartnerInvited(ctx, event.GetHabitPartnerInvited())
+	case eventspb.EventType_EVENT_TYPE_HABIT_NUDGED:
+		return c.handleHabitNudged(ctx, event.GetHabitNudged())
+	case eventspb.EventType_EVENT_TYPE_HABIT_STREAK_BROKEN:
+		return c.handleHabitStreakBroken(ctx, event.GetHabitStreakBroken())
+	case eventspb.EventType_EVENT_TYPE_ACHIEVEMENT_UNLOCKED:
+		return c.handleAchievementUnlocked(ctx, event.GetAchievementUnlocked())
+	case eventspb.EventType_EVENT_TYPE_SESSIONS_REVOKED:
+		// Consumed by api-gateway to evict cached tokens, nothing to notify about
+		return nil
+	case eventspb.EventType_EVENT_TYPE_HABIT_CONFIRMED:
+		// Consumed by habits-service to fan out activity feeds, nothing to notify about
+		return nil
 	default:
 		log.Printf("Unknown event type: %s", event.EventType.String())
 		return nil
@@ -94,7 +102,7 @@ func (c *Consumer) processMessage(ctx context.Context, message kafka.Message) er
 // handleUserRegistered handles user registration events
 func (c *Consumer) handleUserRegistered(ctx context.Context, event *eventspb.UserRegisteredEvent) error {
 	if event == nil {
-		return fmt.Errorf("user registered event is nil")
+		return fmt.Errorf("%w: user registered event is nil", errMalformedEvent)
 	}
 
 	log.Printf("Sending verification email to %s (user_id: %s)", event.Email, event.UserId)
@@ -117,7 +125,7 @@ func (c *Consumer) handleUserRegistered(ctx context.Context, event *eventspb.Use
 // handleEmailVerificationRequested handles email verification request events
 func (c *Consumer) handleEmailVerificationRequested(ctx context.Context, event *eventspb.EmailVerificationRequestedEvent) error {
 	if event == nil {
-		return fmt.Errorf("email verification requested event is nil")
+		return fmt.Errorf("%w: email verification requested event is nil", errMalformedEvent)
 	}
 
 	log.Printf("Resending verification email to %s (user_id: %s)", event.Email, event.UserId)
@@ -140,7 +148,7 @@ func (c *Consumer) handleEmailVerificationRequested(ctx context.Context, event *
 // handlePasswordResetRequested handles password reset request events
 func (c *Consumer) handlePasswordResetRequested(ctx context.Context, event *eventspb.PasswordResetRequestedEvent) error {
 	if event == nil {
-		return fmt.Errorf("password reset requested event is nil")
+		return fmt.Errorf("%w: password reset requested event is nil", errMalformedEvent)
 	}
 
 	log.Printf("Sending password reset email to %s (user_id: %s)", event.Email, event.UserId)
@@ -163,7 +171,7 @@ func (c *Consumer) handlePasswordResetRequested(ctx context.Context, event *even
 // handlePasswordChanged handles password changed events
 func (c *Consumer) handlePasswordChanged(ctx context.Context, event *eventspb.PasswordChangedEvent) error {
 	if event == nil {
-		return fmt.Errorf("password changed event is nil")
+		return fmt.Errorf("%w: password changed event is nil", errMalformedEvent)
 	}
 
 	log.Printf("Sending password changed notification to %s (user_id: %s, was_reset: %v)",
@@ -182,6 +190,230 @@ func (c *Consumer) handlePasswordChanged(ctx context.Context, event *eventspb.Pa
 	return nil
 }
 
+// handleMagicLinkRequested handles magic link sign-in request events
+func (c *Consumer) handleMagicLinkRequested(ctx context.Context, event *eventspb.MagicLinkRequestedEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: magic link requested event is nil", errMalformedEvent)
+	}
+
+	log.Printf("Sending magic link email to %s (user_id: %s)", event.Email, event.UserId)
+
+	expiresIn := event.ExpiresAt.AsTime().Sub(event.RequestedAt.AsTime())
+
+	err := c.emailService.SendMagicLinkEmail(
+		ctx,
+		event.Email,
+		event.Username,
+		event.FirstName,
+		event.Token,
+		int(expiresIn.Minutes()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send magic link email: %w", err)
+	}
+
+	log.Printf("Magic link email sent successfully to %s", event.Email)
+	return nil
+}
+
+// handleEmailChangeRequested handles email change request events
+func (c *Consumer) handleEmailChangeRequested(ctx context.Context, event *eventspb.EmailChangeRequestedEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: email change requested event is nil", errMalformedEvent)
+	}
+
+	log.Printf("Sending email change confirmation to %s (user_id: %s)", event.NewEmail, event.UserId)
+
+	err := c.emailService.SendEmailChangeConfirmEmail(
+		ctx,
+		event.NewEmail,
+		event.Username,
+		event.FirstName,
+		event.ConfirmToken,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send email change confirm email: %w", err)
+	}
+
+	log.Printf("Sending email change notice to %s (user_id: %s)", event.OldEmail, event.UserId)
+
+	err = c.emailService.SendEmailChangeNoticeEmail(
+		ctx,
+		event.OldEmail,
+		event.Username,
+		event.FirstName,
+		event.NewEmail,
+		event.UndoToken,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send email change notice email: %w", err)
+	}
+
+	log.Printf("Email change emails sent successfully for user_id: %s", event.UserId)
+	return nil
+}
+
+// handleDataExportReady handles data export ready events
+func (c *Consumer) handleDataExportReady(ctx context.Context, event *eventspb.DataExportReadyEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: data export ready event is nil", errMalformedEvent)
+	}
+
+	log.Printf("Sending data export link to %s (user_id: %s)", event.Email, event.UserId)
+
+	err := c.emailService.SendDataExportReadyEmail(
+		ctx,
+		event.Email,
+		event.Username,
+		event.FirstName,
+		event.DownloadToken,
+		event.ExpiresAt.AsTime(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send data export ready email: %w", err)
+	}
+
+	log.Printf("Data export link sent successfully to %s", event.Email)
+	return nil
+}
+
+// handleAccountDeletionScheduled handles account deletion request events
+func (c *Consumer) handleAccountDeletionScheduled(ctx context.Context, event *eventspb.AccountDeletionScheduledEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: account deletion scheduled event is nil", errMalformedEvent)
+	}
+
+	log.Printf("Sending account deletion notice to %s (user_id: %s)", event.Email, event.UserId)
+
+	err := c.emailService.SendAccountDeletionScheduledEmail(
+		ctx,
+		event.Email,
+		event.Username,
+		event.FirstName,
+		event.ScheduledFor.AsTime(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send account deletion scheduled email: %w", err)
+	}
+
+	log.Printf("Account deletion notice sent successfully to %s", event.Email)
+	return nil
+}
+
+// handleUserDeleted purges notifications of a deleted user, redelivered events delete nothing
+func (c *Consumer) handleUserDeleted(ctx context.Context, event *eventspb.UserDeletedEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: user deleted event is nil", errMalformedEvent)
+	}
+
+	deleted, err := c.notificationService.PurgeUserData(ctx, event.UserId)
+	if err != nil {
+		return fmt.Errorf("failed to purge notifications of user %s: %w", event.UserId, err)
+	}
+
+	log.Printf("Purged %d notifications of deleted user %s", deleted, event.UserId)
+	return nil
+}
+
+// handleHabitPartnerInvited handles habit partner invitation events
+func (c *Consumer) handleHabitPartnerInvited(ctx context.Context, event *eventspb.HabitPartnerInvitedEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: habit partner invited event is nil", errMalformedEvent)
+	}
+
+	log.Printf("Sending partner invitation to %s (user_id: %s)", event.PartnerEmail, event.PartnerUserId)
+
+	err := c.emailService.SendPartnerInvitationEmail(
+		ctx,
+		event.PartnerEmail,
+		event.PartnerUsername,
+		event.PartnerFirstName,
+		event.OwnerUsername,
+		event.HabitName,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send partner invitation email: %w", err)
+	}
+
+	log.Printf("Partner invitation sent successfully to %s", event.PartnerEmail)
+	return nil
+}
+
+// handleHabitNudged handles partner nudge events
+func (c *Consumer) handleHabitNudged(ctx context.Context, event *eventspb.HabitNudgedEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: habit nudged event is nil", errMalformedEvent)
+	}
+
+	log.Printf("Sending nudge to %s (user_id: %s)", event.OwnerEmail, event.OwnerUserId)
+
+	err := c.emailService.SendHabitNudgeEmail(
+		ctx,
+		event.OwnerEmail,
+		event.OwnerUsername,
+		event.OwnerFirstName,
+		event.PartnerUsername,
+		event.HabitName,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send habit nudge email: %w", err)
+	}
+
+	log.Printf("Nudge sent successfully to %s", event.OwnerEmail)
+	return nil
+}
+
+// handleHabitStreakBroken handles streak broken events addressed to a partner
+func (c *Consumer) handleHabitStreakBroken(ctx context.Context, event *eventspb.HabitStreakBrokenEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: habit streak broken event is nil", errMalformedEvent)
+	}
+
+	log.Printf("Sending streak broken notice to %s (user_id: %s)", event.PartnerEmail, event.PartnerUserId)
+
+	err := c.emailService.SendStreakBrokenEmail(
+		ctx,
+		event.PartnerEmail,
+		event.PartnerUsername,
+		event.PartnerFirstName,
+		event.OwnerUsername,
+		event.HabitName,
+		event.LostStreak,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send streak broken email: %w", err)
+	}
+
+	log.Printf("Streak broken notice sent successfully to %s", event.PartnerEmail)
+	return nil
+}
+
+// handleAchievementUnlocked handles achievement unlocked events
+func (c *Consumer) handleAchievementUnlocked(ctx context.Context, event *eventspb.AchievementUnlockedEvent) error {
+	if event == nil {
+		return fmt.Errorf("%w: achievement unlocked event is nil", errMalformedEvent)
+	}
+
+	log.Printf("Sending achievement %s notice to %s (user_id: %s)", event.AchievementCode, event.Email, event.UserId)
+
+	err := c.emailService.SendAchievementUnlockedEmail(
+		ctx,
+		event.Email,
+		event.Username,
+		event.FirstName,
+		event.AchievementName,
+		event.AchievementDescription,
+		event.Xp,
+		event.Level,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send achievement unlocked email: %w", err)
+	}
+
+	log.Printf("Achievement notice sent successfully to %s", event.Email)
+	return nil
+}
+
 // Close closes the Kafka consumer
 func (c *Consumer) Close() error {
 	if c.reader != nil {
@@ -0,0 +1,221 @@
+package kafka_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"notification-service/internal/domain/service"
+	"notification-service/internal/infrastructure/kafka"
+	eventspb "notification-service/proto/events/v1"
+
+	"github.com/google/uuid"
+	kafkago "github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// memoryBus is an in-memory stand-in for a Kafka topic with a single partition
+type memoryBus struct {
+	mu        sync.Mutex
+	offset    int64
+	messages  chan kafkago.Message
+	committed chan kafkago.Message
+}
+
+func newMemoryBus() *memoryBus {
+	return &memoryBus{
+		messages:  make(chan kafkago.Message, 16),
+		committed: make(chan kafkago.Message, 16),
+	}
+}
+
+func (b *memoryBus) publish(value []byte) {
+	b.mu.Lock()
+	b.offset++
+	message := kafkago.Message{Offset: b.offset, Value: value}
+	b.mu.Unlock()
+
+	b.messages <- message
+}
+
+func (b *memoryBus) publishEvent(t *testing.T, event *eventspb.Event) {
+	t.Helper()
+
+	value, err := proto.Marshal(event)
+	if err != nil {
+		t.Fatalf("failed to marshal event: %v", err)
+	}
+
+	b.publish(value)
+}
+
+func (b *memoryBus) FetchMessage(ctx context.Context) (kafkago.Message, error) {
+	select {
+	case message := <-b.messages:
+		return message, nil
+	case <-ctx.Done():
+		return kafkago.Message{}, ctx.Err()
+	}
+}
+
+func (b *memoryBus) CommitMessages(_ context.Context, messages ...kafkago.Message) error {
+	for _, message := range messages {
+		b.committed <- message
+	}
+	return nil
+}
+
+func (b *memoryBus) Close() error {
+	return nil
+}
+
+// waitCommitted waits until the consumer commits the next message
+func (b *memoryBus) waitCommitted(t *testing.T) kafkago.Message {
+	t.Helper()
+
+	select {
+	case message := <-b.committed:
+		return message
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the message to be committed")
+		return kafkago.Message{}
+	}
+}
+
+// startConsumer runs a consumer until the test ends
+func startConsumer(t *testing.T, bus *memoryBus, notificationService service.NotificationService) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- kafka.NewConsumerWithReader(bus, notificationService, nil).Start(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("consumer stopped with error: %v", err)
+		}
+	})
+}
+
+func userDeletedEvent(userID string) *eventspb.Event {
+	return &eventspb.Event{
+		EventId:   uuid.NewString(),
+		EventType: eventspb.EventType_EVENT_TYPE_USER_DELETED,
+		Payload: &eventspb.Event_UserDeleted{UserDeleted: &eventspb.UserDeletedEvent{
+			UserId:    userID,
+			DeletedAt: timestamppb.Now(),
+		}},
+	}
+}
+
+func TestUserDeletedEventIsCommittedAfterPurge(t *testing.T) {
+	notificationService := &fakeNotificationService{}
+	userID := uuid.NewString()
+
+	bus := newMemoryBus()
+	startConsumer(t, bus, notificationService)
+	bus.publishEvent(t, userDeletedEvent(userID))
+	bus.waitCommitted(t)
+
+	if purged := notificationService.purgedUsers(); len(purged) != 1 || purged[0] != userID {
+		t.Errorf("purged users = %v, want [%s]", purged, userID)
+	}
+}
+
+func TestUserDeletedEventIsRetriedUntilPurgeSucceeds(t *testing.T) {
+	notificationService := &fakeNotificationService{failures: 2}
+	userID := uuid.NewString()
+
+	bus := newMemoryBus()
+	startConsumer(t, bus, notificationService)
+	bus.publishEvent(t, userDeletedEvent(userID))
+
+	bus.waitCommitted(t)
+
+	if calls := notificationService.callCount(); calls != 3 {
+		t.Errorf("purge ran %d times before the event was committed, want 3", calls)
+	}
+	if purged := notificationService.purgedUsers(); len(purged) != 1 || purged[0] != userID {
+		t.Errorf("purged users = %v, want [%s]", purged, userID)
+	}
+}
+
+func TestUnretryableEventsAreSkipped(t *testing.T) {
+	tests := []struct {
+		name  string
+		value func(t *testing.T) []byte
+	}{
+		{
+			name:  "not a protobuf message",
+			value: func(*testing.T) []byte { return []byte("not an event") },
+		},
+		{
+			name: "user deleted without payload",
+			value: func(t *testing.T) []byte {
+				value, err := proto.Marshal(&eventspb.Event{
+					EventId:   uuid.NewString(),
+					EventType: eventspb.EventType_EVENT_TYPE_USER_DELETED,
+				})
+				if err != nil {
+					t.Fatalf("failed to marshal event: %v", err)
+				}
+				return value
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notificationService := &fakeNotificationService{}
+
+			bus := newMemoryBus()
+			startConsumer(t, bus, notificationService)
+			bus.publish(tt.value(t))
+			bus.waitCommitted(t)
+
+			if calls := notificationService.callCount(); calls != 0 {
+				t.Errorf("purge ran %d times for an unretryable event", calls)
+			}
+		})
+	}
+}
+
+// fakeNotificationService records purges and fails the first ones
+type fakeNotificationService struct {
+	service.NotificationService
+
+	mu       sync.Mutex
+	failures int
+	calls    int
+	purged   []string
+}
+
+func (s *fakeNotificationService) PurgeUserData(_ context.Context, userID string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+	if s.calls <= s.failures {
+		return 0, errors.New("database unavailable")
+	}
+	s.purged = append(s.purged, userID)
+	return 1, nil
+}
+
+func (s *fakeNotificationService) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func (s *fakeNotificationService) purgedUsers() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.purged...)
+}
@@ -0,0 +1,17 @@
+package kafka
+
+import (
+	"time"
+
+	"notification-service/internal/domain/service"
+)
+
+// NewConsumerWithReader creates a consumer that reads from reader instead of Kafka
+func NewConsumerWithReader(reader messageReader, notificationService service.NotificationService, emailService service.EmailService) *Consumer {
+	return &Consumer{
+		reader:              reader,
+		backoff:             retryBackoff{initial: time.Millisecond, max: 10 * time.Millisecond},
+		notificationService: notificationService,
+		emailService:        emailService,
+	}
+}
@@ -85,17 +85,22 @@ func (r *notificationRepository) GetByID(ctx context.Context, id string) (*entit
 	return &notification, nil
 }
 
-func (r *notificationRepository) GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*entity.Notification, error) {
+func (r *notificationRepository) GetByUserID(ctx context.Context, userID string, limit int, after *entity.PageCursor) ([]*entity.Notification, error) {
 	query := `
 		SELECT id, user_id, type, status, subject, content, recipient, metadata,
 		       sent_at, failed_at, error, created_at, updated_at
 		FROM notifications
 		WHERE user_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
 	`
+	args := []interface{}{userID, limit}
 
-	rows, err := r.db.Query(ctx, query, userID, limit, offset)
+	if after != nil {
+		query += " AND (created_at, id) < ($3, $4)"
+		args = append(args, after.CreatedAt, after.ID)
+	}
+	query += " ORDER BY created_at DESC, id DESC LIMIT $2"
+
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get notifications: %w", err)
 	}
@@ -127,6 +132,17 @@ func (r *notificationRepository) GetByUserID(ctx context.Context, userID string,
 	return notifications, nil
 }
 
+func (r *notificationRepository) CountByUserID(ctx context.Context, userID string) (int, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1`
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	return count, nil
+}
+
 func (r *notificationRepository) UpdateStatus(ctx context.Context, id string, status entity.NotificationStatus, sentAt *string, failedAt *string, errorMsg *string) error {
 	query := `
 		UPDATE notifications
@@ -184,3 +200,14 @@ func (r *notificationRepository) GetPendingNotifications(ctx context.Context, li
 
 	return notifications, nil
 }
+
+func (r *notificationRepository) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	query := `DELETE FROM notifications WHERE user_id = $1`
+
+	result, err := r.db.Exec(ctx, query, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete notifications: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
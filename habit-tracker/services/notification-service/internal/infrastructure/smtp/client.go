@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"html/template"
 	"path/filepath"
+	"time"
 
 	"notification-service/internal/config"
 
@@ -69,6 +70,105 @@ func (c *Client) loadTemplates() error {
 	}
 	c.templates["password_changed"] = changedTemplate
 
+	magicLinkTemplate, err := template.ParseFiles(
+		filepath.Join(c.emailCfg.TemplatesPath, "magic_link.html"),
+	)
+	if err != nil {
+		magicLinkTemplate, err = template.New("magic_link").Parse(defaultMagicLinkTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse default magic link template: %w", err)
+		}
+	}
+	c.templates["magic_link"] = magicLinkTemplate
+
+	emailChangeConfirmTemplate, err := template.ParseFiles(
+		filepath.Join(c.emailCfg.TemplatesPath, "email_change_confirm.html"),
+	)
+	if err != nil {
+		emailChangeConfirmTemplate, err = template.New("email_change_confirm").Parse(defaultEmailChangeConfirmTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse default email change confirm template: %w", err)
+		}
+	}
+	c.templates["email_change_confirm"] = emailChangeConfirmTemplate
+
+	emailChangeNoticeTemplate, err := template.ParseFiles(
+		filepath.Join(c.emailCfg.TemplatesPath, "email_change_notice.html"),
+	)
+	if err != nil {
+		emailChangeNoticeTemplate, err = template.New("email_change_notice").Parse(defaultEmailChangeNoticeTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse default email change notice template: %w", err)
+		}
+	}
+	c.templates["email_change_notice"] = emailChangeNoticeTemplate
+
+	dataExportReadyTemplate, err := template.ParseFiles(
+		filepath.Join(c.emailCfg.TemplatesPath, "data_export_ready.html"),
+	)
+	if err != nil {
+		dataExportReadyTemplate, err = template.New("data_export_ready").Parse(defaultDataExportReadyTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse default data export ready template: %w", err)
+		}
+	}
+	c.templates["data_export_ready"] = dataExportReadyTemplate
+
+	accountDeletionTemplate, err := template.ParseFiles(
+		filepath.Join(c.emailCfg.TemplatesPath, "account_deletion_scheduled.html"),
+	)
+	if err != nil {
+		accountDeletionTemplate, err = template.New("account_deletion_scheduled").Parse(defaultAccountDeletionScheduledTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse default account deletion scheduled template: %w", err)
+		}
+	}
+	c.templates["account_deletion_scheduled"] = accountDeletionTemplate
+
+	partnerInvitationTemplate, err := template.ParseFiles(
+		filepath.Join(c.emailCfg.TemplatesPath, "partner_invitation.html"),
+	)
+	if err != nil {
+		partnerInvitationTemplate, err = template.New("partner_invitation").Parse(defaultPartnerInvitationTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse default partner invitation template: %w", err)
+		}
+	}
+	c.templates["partner_invitation"] = partnerInvitationTemplate
+
+	habitNudgeTemplate, err := template.ParseFiles(
+		filepath.Join(c.emailCfg.TemplatesPath, "habit_nudge.html"),
+	)
+	if err != nil {
+		habitNudgeTemplate, err = template.New("habit_nudge").Parse(defaultHabitNudgeTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse default habit nudge template: %w", err)
+		}
+	}
+	c.templates["habit_nudge"] = habitNudgeTemplate
+
+	streakBrokenTemplate, err := template.ParseFiles(
+		filepath.Join(c.emailCfg.TemplatesPath, "streak_broken.html"),
+	)
+	if err != nil {
+		streakBrokenTemplate, err = template.New("streak_broken").Parse(defaultStreakBrokenTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse default streak broken template: %w", err)
+		}
+	}
+	c.templates["streak_broken"] = streakBrokenTemplate
+
+	achievementUnlockedTemplate, err := template.ParseFiles(
+		filepath.Join(c.emailCfg.TemplatesPath, "achievement_unlocked.html"),
+	)
+	if err != nil {
+		achievementUnlockedTemplate, err = template.New("achievement_unlocked").Parse(defaultAchievementUnlockedTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse default achievement unlocked template: %w", err)
+		}
+	}
+	c.templates["achievement_unlocked"] = achievementUnlockedTemplate
+
 	return nil
 }
 
@@ -125,6 +225,178 @@ func (c *Client) SendPasswordChangedEmail(ctx context.Context, to string, wasRes
 	return c.send(to, subject, body)
 }
 
+// SendMagicLinkEmail sends a single-use sign-in link
+func (c *Client) SendMagicLinkEmail(ctx context.Context, to, username, firstName, token string, expiresInMinutes int) error {
+	magicLinkURL := fmt.Sprintf("%s?token=%s", c.emailCfg.MagicLinkURL, token)
+
+	data := map[string]interface{}{
+		"Username":         username,
+		"FirstName":        firstName,
+		"MagicLinkURL":     magicLinkURL,
+		"ExpiresInMinutes": expiresInMinutes,
+	}
+
+	body, err := c.renderTemplate("magic_link", data)
+	if err != nil {
+		return fmt.Errorf("failed to render magic link email: %w", err)
+	}
+
+	subject := "Your Sign-In Link - Habit Tracker"
+	return c.send(to, subject, body)
+}
+
+// SendEmailChangeConfirmEmail sends email change confirmation link to the new address
+func (c *Client) SendEmailChangeConfirmEmail(ctx context.Context, to, username, firstName, confirmToken string) error {
+	confirmURL := fmt.Sprintf("%s?token=%s", c.emailCfg.EmailChangeConfirmURL, confirmToken)
+
+	data := map[string]interface{}{
+		"Username":   username,
+		"FirstName":  firstName,
+		"NewEmail":   to,
+		"ConfirmURL": confirmURL,
+	}
+
+	body, err := c.renderTemplate("email_change_confirm", data)
+	if err != nil {
+		return fmt.Errorf("failed to render email change confirm email: %w", err)
+	}
+
+	subject := "Confirm Your New Email - Habit Tracker"
+	return c.send(to, subject, body)
+}
+
+// SendEmailChangeNoticeEmail notifies the old address about email change with undo link
+func (c *Client) SendEmailChangeNoticeEmail(ctx context.Context, to, username, firstName, newEmail, undoToken string) error {
+	undoURL := fmt.Sprintf("%s?token=%s", c.emailCfg.EmailChangeUndoURL, undoToken)
+
+	data := map[string]interface{}{
+		"Username":  username,
+		"FirstName": firstName,
+		"NewEmail":  newEmail,
+		"UndoURL":   undoURL,
+	}
+
+	body, err := c.renderTemplate("email_change_notice", data)
+	if err != nil {
+		return fmt.Errorf("failed to render email change notice email: %w", err)
+	}
+
+	subject := "Email Change Requested - Habit Tracker"
+	return c.send(to, subject, body)
+}
+
+// SendDataExportReadyEmail sends a time-limited link to download user data export
+func (c *Client) SendDataExportReadyEmail(ctx context.Context, to, username, firstName, downloadToken string, expiresAt time.Time) error {
+	downloadURL := fmt.Sprintf("%s?token=%s", c.emailCfg.DataExportDownloadURL, downloadToken)
+
+	data := map[string]interface{}{
+		"Username":    username,
+		"FirstName":   firstName,
+		"DownloadURL": downloadURL,
+		"ExpiresAt":   expiresAt.UTC().Format("January 2, 2006 15:04 MST"),
+	}
+
+	body, err := c.renderTemplate("data_export_ready", data)
+	if err != nil {
+		return fmt.Errorf("failed to render data export ready email: %w", err)
+	}
+
+	subject := "Your Data Export Is Ready - Habit Tracker"
+	return c.send(to, subject, body)
+}
+
+// SendAccountDeletionScheduledEmail warns user that account will be permanently deleted
+func (c *Client) SendAccountDeletionScheduledEmail(ctx context.Context, to, username, firstName string, scheduledFor time.Time) error {
+	data := map[string]interface{}{
+		"Username":     username,
+		"FirstName":    firstName,
+		"ScheduledFor": scheduledFor.UTC().Format("January 2, 2006 15:04 MST"),
+	}
+
+	body, err := c.renderTemplate("account_deletion_scheduled", data)
+	if err != nil {
+		return fmt.Errorf("failed to render account deletion scheduled email: %w", err)
+	}
+
+	subject := "Your Account Is Scheduled for Deletion - Habit Tracker"
+	return c.send(to, subject, body)
+}
+
+// SendPartnerInvitationEmail invites a user to become an accountability partner on a habit
+func (c *Client) SendPartnerInvitationEmail(ctx context.Context, to, username, firstName, ownerUsername, habitName string) error {
+	data := map[string]interface{}{
+		"Username":      username,
+		"FirstName":     firstName,
+		"OwnerUsername": ownerUsername,
+		"HabitName":     habitName,
+	}
+
+	body, err := c.renderTemplate("partner_invitation", data)
+	if err != nil {
+		return fmt.Errorf("failed to render partner invitation email: %w", err)
+	}
+
+	subject := fmt.Sprintf("%s Invited You as an Accountability Partner - Habit Tracker", ownerUsername)
+	return c.send(to, subject, body)
+}
+
+// SendHabitNudgeEmail reminds the owner of a habit on behalf of a partner
+func (c *Client) SendHabitNudgeEmail(ctx context.Context, to, username, firstName, partnerUsername, habitName string) error {
+	data := map[string]interface{}{
+		"Username":        username,
+		"FirstName":       firstName,
+		"PartnerUsername": partnerUsername,
+		"HabitName":       habitName,
+	}
+
+	body, err := c.renderTemplate("habit_nudge", data)
+	if err != nil {
+		return fmt.Errorf("failed to render habit nudge email: %w", err)
+	}
+
+	subject := fmt.Sprintf("%s Nudged You About %s - Habit Tracker", partnerUsername, habitName)
+	return c.send(to, subject, body)
+}
+
+// SendStreakBrokenEmail tells a partner that the owner of a habit lost a streak
+func (c *Client) SendStreakBrokenEmail(ctx context.Context, to, username, firstName, ownerUsername, habitName string, lostStreak int32) error {
+	data := map[string]interface{}{
+		"Username":      username,
+		"FirstName":     firstName,
+		"OwnerUsername": ownerUsername,
+		"HabitName":     habitName,
+		"LostStreak":    lostStreak,
+	}
+
+	body, err := c.renderTemplate("streak_broken", data)
+	if err != nil {
+		return fmt.Errorf("failed to render streak broken email: %w", err)
+	}
+
+	subject := fmt.Sprintf("%s Broke Their Streak - Habit Tracker", ownerUsername)
+	return c.send(to, subject, body)
+}
+
+// SendAchievementUnlockedEmail congratulates a user on an unlocked achievement
+func (c *Client) SendAchievementUnlockedEmail(ctx context.Context, to, username, firstName, achievementName, achievementDescription string, xp, level int32) error {
+	data := map[string]interface{}{
+		"Username":               username,
+		"FirstName":              firstName,
+		"AchievementName":        achievementName,
+		"AchievementDescription": achievementDescription,
+		"XP":                     xp,
+		"Level":                  level,
+	}
+
+	body, err := c.renderTemplate("achievement_unlocked", data)
+	if err != nil {
+		return fmt.Errorf("failed to render achievement unlocked email: %w", err)
+	}
+
+	subject := fmt.Sprintf("Achievement Unlocked: %s - Habit Tracker", achievementName)
+	return c.send(to, subject, body)
+}
+
 // send sends an email using gomail
 func (c *Client) send(to, subject, body string) error {
 	m := gomail.NewMessage()
@@ -246,3 +518,210 @@ const defaultPasswordChangedTemplate = `
 </body>
 </html>
 `
+
+const defaultMagicLinkTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Sign In to Habit Tracker</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #4CAF50;">Sign In to Habit Tracker</h2>
+        <p>Hi {{if .FirstName}}{{.FirstName}}{{else}}{{.Username}}{{end}},</p>
+        <p>Click the button below to sign in. The link can be used only once and expires in {{.ExpiresInMinutes}} minutes.</p>
+        <div style="text-align: center; margin: 30px 0;">
+            <a href="{{.MagicLinkURL}}" style="background-color: #4CAF50; color: white; padding: 12px 30px; text-decoration: none; border-radius: 5px; display: inline-block;">Sign In</a>
+        </div>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all; color: #666;">{{.MagicLinkURL}}</p>
+        <p>If you didn't request this link, please ignore this email. Your account is safe.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
+        <p style="color: #999; font-size: 12px;">This is an automated email, please do not reply.</p>
+    </div>
+</body>
+</html>
+`
+
+const defaultEmailChangeConfirmTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Confirm Your New Email</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #4CAF50;">Confirm Your New Email</h2>
+        <p>Hi {{if .FirstName}}{{.FirstName}}{{else}}{{.Username}}{{end}},</p>
+        <p>You asked to use <strong>{{.NewEmail}}</strong> for your Habit Tracker account. Click the button below to confirm:</p>
+        <div style="text-align: center; margin: 30px 0;">
+            <a href="{{.ConfirmURL}}" style="background-color: #4CAF50; color: white; padding: 12px 30px; text-decoration: none; border-radius: 5px; display: inline-block;">Confirm Email</a>
+        </div>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all; color: #666;">{{.ConfirmURL}}</p>
+        <p>After confirmation you will be signed out on all other devices.</p>
+        <p>If you didn't request this change, please ignore this email.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
+        <p style="color: #999; font-size: 12px;">This is an automated email, please do not reply.</p>
+    </div>
+</body>
+</html>
+`
+
+const defaultEmailChangeNoticeTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Email Change Requested</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #FF5722;">Email Change Requested</h2>
+        <p>Hi {{if .FirstName}}{{.FirstName}}{{else}}{{.Username}}{{end}},</p>
+        <p>We received a request to change the email address of your Habit Tracker account to <strong>{{.NewEmail}}</strong>.</p>
+        <p>If this wasn't you, click the button below. A pending change will be cancelled, an already applied change will be reverted and all sessions will be signed out:</p>
+        <div style="text-align: center; margin: 30px 0;">
+            <a href="{{.UndoURL}}" style="background-color: #FF5722; color: white; padding: 12px 30px; text-decoration: none; border-radius: 5px; display: inline-block;">This Wasn't Me</a>
+        </div>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all; color: #666;">{{.UndoURL}}</p>
+        <div style="background-color: #f5f5f5; padding: 15px; border-radius: 5px; margin: 20px 0;">
+            <p style="margin: 0;"><strong>Security Tip:</strong> After undoing the change, reset your password to keep your account safe.</p>
+        </div>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
+        <p style="color: #999; font-size: 12px;">This is an automated email, please do not reply.</p>
+    </div>
+</body>
+</html>
+`
+
+const defaultDataExportReadyTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Your Data Export Is Ready</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #4CAF50;">Your Data Export Is Ready</h2>
+        <p>Hi {{if .FirstName}}{{.FirstName}}{{else}}{{.Username}}{{end}},</p>
+        <p>The archive with all data we hold about your Habit Tracker account is ready. Click the button below to download it:</p>
+        <div style="text-align: center; margin: 30px 0;">
+            <a href="{{.DownloadURL}}" style="background-color: #4CAF50; color: white; padding: 12px 30px; text-decoration: none; border-radius: 5px; display: inline-block;">Download Archive</a>
+        </div>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all; color: #666;">{{.DownloadURL}}</p>
+        <p>This link will expire on <strong>{{.ExpiresAt}}</strong>. After that you can request a new export from your account settings.</p>
+        <p>If you didn't request this export, please change your password.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
+        <p style="color: #999; font-size: 12px;">This is an automated email, please do not reply.</p>
+    </div>
+</body>
+</html>
+`
+
+const defaultAccountDeletionScheduledTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Account Deletion Scheduled</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #f44336;">Account Deletion Scheduled</h2>
+        <p>Hi {{if .FirstName}}{{.FirstName}}{{else}}{{.Username}}{{end}},</p>
+        <p>We received a request to delete your Habit Tracker account. Your account and all your habits, history and notifications will be permanently deleted on <strong>{{.ScheduledFor}}</strong>.</p>
+        <p>Changed your mind? Sign in before that date and cancel the deletion from your account settings.</p>
+        <p>If you didn't request this, sign in, cancel the deletion and change your password immediately.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
+        <p style="color: #999; font-size: 12px;">This is an automated email, please do not reply.</p>
+    </div>
+</body>
+</html>
+`
+
+const defaultPartnerInvitationTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Accountability Partner Invitation</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #4CAF50;">You've Been Invited as an Accountability Partner</h2>
+        <p>Hi {{if .FirstName}}{{.FirstName}}{{else}}{{.Username}}{{end}},</p>
+        <p><strong>{{.OwnerUsername}}</strong> invited you to keep them accountable for <strong>{{.HabitName}}</strong>.</p>
+        <p>As a partner you can follow their streak and calendar, nudge them when the habit is due and you'll hear from us if the streak breaks.</p>
+        <p>Open Habit Tracker to accept or decline the invitation.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
+        <p style="color: #999; font-size: 12px;">This is an automated email, please do not reply.</p>
+    </div>
+</body>
+</html>
+`
+
+const defaultHabitNudgeTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>You've Been Nudged</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #FF9800;">A Friendly Nudge</h2>
+        <p>Hi {{if .FirstName}}{{.FirstName}}{{else}}{{.Username}}{{end}},</p>
+        <p>Your accountability partner <strong>{{.PartnerUsername}}</strong> is cheering you on: <strong>{{.HabitName}}</strong> isn't done yet.</p>
+        <p>Open Habit Tracker and confirm it before the deadline to keep your streak going.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
+        <p style="color: #999; font-size: 12px;">This is an automated email, please do not reply.</p>
+    </div>
+</body>
+</html>
+`
+
+const defaultStreakBrokenTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Streak Broken</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #f44336;">A Streak Was Broken</h2>
+        <p>Hi {{if .FirstName}}{{.FirstName}}{{else}}{{.Username}}{{end}},</p>
+        <p><strong>{{.OwnerUsername}}</strong> missed <strong>{{.HabitName}}</strong> and lost a streak of <strong>{{.LostStreak}}</strong>.</p>
+        <p>A few words of encouragement from you can help them get back on track.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
+        <p style="color: #999; font-size: 12px;">This is an automated email, please do not reply.</p>
+    </div>
+</body>
+</html>
+`
+
+const defaultAchievementUnlockedTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Achievement Unlocked</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2 style="color: #4CAF50;">Achievement Unlocked: {{.AchievementName}}</h2>
+        <p>Hi {{if .FirstName}}{{.FirstName}}{{else}}{{.Username}}{{end}},</p>
+        <p>Congratulations! You unlocked <strong>{{.AchievementName}}</strong>: {{.AchievementDescription}}.</p>
+        <p>It earned you <strong>{{.XP}} XP</strong>, you are now at <strong>level {{.Level}}</strong>.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
+        <p style="color: #999; font-size: 12px;">This is an automated email, please do not reply.</p>
+    </div>
+</body>
+</html>
+`
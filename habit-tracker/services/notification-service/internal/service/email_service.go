@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"notification-service/internal/domain/service"
 	"notification-service/internal/infrastructure/smtp"
@@ -29,3 +30,39 @@ func (s *emailService) SendPasswordResetEmail(ctx context.Context, to, username,
 func (s *emailService) SendPasswordChangedEmail(ctx context.Context, to string, wasReset bool) error {
 	return s.smtpClient.SendPasswordChangedEmail(ctx, to, wasReset)
 }
+
+func (s *emailService) SendMagicLinkEmail(ctx context.Context, to, username, firstName, token string, expiresInMinutes int) error {
+	return s.smtpClient.SendMagicLinkEmail(ctx, to, username, firstName, token, expiresInMinutes)
+}
+
+func (s *emailService) SendEmailChangeConfirmEmail(ctx context.Context, to, username, firstName, confirmToken string) error {
+	return s.smtpClient.SendEmailChangeConfirmEmail(ctx, to, username, firstName, confirmToken)
+}
+
+func (s *emailService) SendEmailChangeNoticeEmail(ctx context.Context, to, username, firstName, newEmail, undoToken string) error {
+	return s.smtpClient.SendEmailChangeNoticeEmail(ctx, to, username, firstName, newEmail, undoToken)
+}
+
+func (s *emailService) SendDataExportReadyEmail(ctx context.Context, to, username, firstName, downloadToken string, expiresAt time.Time) error {
+	return s.smtpClient.SendDataExportReadyEmail(ctx, to, username, firstName, downloadToken, expiresAt)
+}
+
+func (s *emailService) SendAccountDeletionScheduledEmail(ctx context.Context, to, username, firstName string, scheduledFor time.Time) error {
+	return s.smtpClient.SendAccountDeletionScheduledEmail(ctx, to, username, firstName, scheduledFor)
+}
+
+func (s *emailService) SendPartnerInvitationEmail(ctx context.Context, to, username, firstName, ownerUsername, habitName string) error {
+	return s.smtpClient.SendPartnerInvitationEmail(ctx, to, username, firstName, ownerUsername, habitName)
+}
+
+func (s *emailService) SendHabitNudgeEmail(ctx context.Context, to, username, firstName, partnerUsername, habitName string) error {
+	return s.smtpClient.SendHabitNudgeEmail(ctx, to, username, firstName, partnerUsername, habitName)
+}
+
+func (s *emailService) SendStreakBrokenEmail(ctx context.Context, to, username, firstName, ownerUsername, habitName string, lostStreak int32) error {
+	return s.smtpClient.SendStreakBrokenEmail(ctx, to, username, firstName, ownerUsername, habitName, lostStreak)
+}
+
+func (s *emailService) SendAchievementUnlockedEmail(ctx context.Context, to, username, firstName, achievementName, achievementDescription string, xp, level int32) error {
+	return s.smtpClient.SendAchievementUnlockedEmail(ctx, to, username, firstName, achievementName, achievementDescription, xp, level)
+}
@@ -112,6 +112,27 @@ func (s *notificationService) SendPasswordReset(ctx context.Context, data *entit
 	return nil
 }
 
-func (s *notificationService) GetNotificationHistory(ctx context.Context, userID string, limit, offset int) ([]*entity.Notification, error) {
-	return s.repo.GetByUserID(ctx, userID, limit, offset)
+func (s *notificationService) GetNotificationHistory(ctx context.Context, userID string, limit int, after *entity.PageCursor) ([]*entity.Notification, *entity.PageCursor, error) {
+	// One extra row tells whether there is a next page
+	notifications, err := s.repo.GetByUserID(ctx, userID, limit+1, after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(notifications) <= limit {
+		return notifications, nil, nil
+	}
+
+	notifications = notifications[:limit]
+	last := notifications[len(notifications)-1]
+
+	return notifications, &entity.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID}, nil
+}
+
+func (s *notificationService) CountNotifications(ctx context.Context, userID string) (int, error) {
+	return s.repo.CountByUserID(ctx, userID)
+}
+
+func (s *notificationService) PurgeUserData(ctx context.Context, userID string) (int64, error) {
+	return s.repo.DeleteByUserID(ctx, userID)
 }
@@ -0,0 +1,119 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"notification-service/internal/domain/entity"
+	"notification-service/internal/domain/service"
+	pb "notification-service/proto/notification/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+// NotificationServiceHandler implements the gRPC NotificationService
+type NotificationServiceHandler struct {
+	pb.UnimplementedNotificationServiceServer
+	notificationService service.NotificationService
+}
+
+// NewNotificationServiceHandler creates a new notification service handler
+func NewNotificationServiceHandler(notificationService service.NotificationService) *NotificationServiceHandler {
+	return &NotificationServiceHandler{
+		notificationService: notificationService,
+	}
+}
+
+// mapNotificationToProto converts notification entity to proto.
+// Metadata entries holding tokens are dropped, they must not leave the service.
+func mapNotificationToProto(notification *entity.Notification) *pb.Notification {
+	metadata := make(map[string]string, len(notification.Metadata))
+	for key, value := range notification.Metadata {
+		if strings.HasSuffix(key, "_token") {
+			continue
+		}
+		metadata[key] = value
+	}
+
+	protoNotification := &pb.Notification{
+		Id:        notification.ID,
+		UserId:    notification.UserID,
+		Type:      string(notification.Type),
+		Status:    string(notification.Status),
+		Subject:   notification.Subject,
+		Content:   notification.Content,
+		Recipient: notification.To,
+		Metadata:  metadata,
+		Error:     notification.Error,
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+		UpdatedAt: timestamppb.New(notification.UpdatedAt),
+	}
+
+	if notification.SentAt != nil {
+		protoNotification.SentAt = timestamppb.New(*notification.SentAt)
+	}
+
+	if notification.FailedAt != nil {
+		protoNotification.FailedAt = timestamppb.New(*notification.FailedAt)
+	}
+
+	return protoNotification
+}
+
+func (h *NotificationServiceHandler) ListUserNotifications(ctx context.Context, req *pb.ListUserNotificationsRequest) (*pb.ListUserNotificationsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	limit := defaultListLimit
+	if req.Limit != nil && *req.Limit > 0 {
+		limit = int(*req.Limit)
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	after, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, next, err := h.notificationService.GetNotificationHistory(ctx, req.UserId, limit, after)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list notifications: %v", err))
+	}
+
+	protoNotifications := make([]*pb.Notification, len(notifications))
+	for i, notification := range notifications {
+		protoNotifications[i] = mapNotificationToProto(notification)
+	}
+
+	resp := &pb.ListUserNotificationsResponse{
+		Notifications: protoNotifications,
+		NextPageToken: encodePageToken(next),
+	}
+
+	if req.IncludeTotalCount {
+		count, err := h.notificationService.CountNotifications(ctx, req.UserId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to count notifications: %v", err))
+		}
+		totalCount := int32(count)
+		resp.TotalCount = &totalCount
+	}
+
+	return resp, nil
+}
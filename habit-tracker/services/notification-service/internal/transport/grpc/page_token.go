@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"notification-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pageToken is the serialized form of a page cursor. Clients treat tokens as opaque
+type pageToken struct {
+	CreatedAt string `json:"t"`
+	ID        string `json:"i"`
+}
+
+func encodePageToken(cursor *entity.PageCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	data, _ := json.Marshal(pageToken{
+		CreatedAt: cursor.CreatedAt.UTC().Format(time.RFC3339Nano),
+		ID:        cursor.ID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken returns nil for an empty token, which selects the first page
+func decodePageToken(token string) (*entity.PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, decoded.CreatedAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	if _, err := uuid.Parse(decoded.ID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	return &entity.PageCursor{CreatedAt: createdAt, ID: decoded.ID}, nil
+}
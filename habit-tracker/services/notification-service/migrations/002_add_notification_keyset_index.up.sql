@@ -0,0 +1,2 @@
+-- Keyset pagination of notification history, newest first
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created ON notifications(user_id, created_at DESC, id DESC);
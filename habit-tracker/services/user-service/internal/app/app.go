@@ -6,22 +6,33 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"user-service/internal/config"
+	domainservice "user-service/internal/domain/service"
 	infradb "user-service/internal/infrastructure/db"
+	"user-service/internal/infrastructure/export"
 	"user-service/internal/infrastructure/kafka"
 	"user-service/internal/infrastructure/postgres"
 	infraredis "user-service/internal/infrastructure/redis"
 	"user-service/internal/service"
 	"user-service/internal/transport/grpc"
 	"user-service/pkg/jwt"
+	habitspb "user-service/proto/habits/v1"
+	notificationpb "user-service/proto/notification/v1"
+
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 // App represents the application
 type App struct {
-	config        *config.Config
-	grpcServer    *grpc.Server
-	kafkaProducer *kafka.Producer
+	config         *config.Config
+	grpcServer     *grpc.Server
+	kafkaProducer  *kafka.Producer
+	exportService  domainservice.ExportService
+	accountService domainservice.AccountService
+	grpcConns      []*grpclib.ClientConn
 }
 
 // New creates a new application
@@ -48,6 +59,7 @@ func New() (*App, error) {
 
 	userRepo := postgres.NewUserRepository(pgPool)
 	sessionRepo := postgres.NewSessionRepository(pgPool)
+	dataExportRepo := postgres.NewDataExportRepository(pgPool)
 
 	sessionStorage := infraredis.NewSessionStorage(redisClient, cfg.Redis.SessionTTL)
 
@@ -55,11 +67,44 @@ func New() (*App, error) {
 
 	passwordResetTokenStorage := infraredis.NewPasswordResetTokenStorage(redisClient)
 
+	magicLinkTokenStorage := infraredis.NewMagicLinkTokenStorage(redisClient)
+
+	emailChangeTokenStorage := infraredis.NewEmailChangeTokenStorage(redisClient)
+
+	dataExportTokenStorage := infraredis.NewDataExportTokenStorage(redisClient, cfg.Export.DownloadTTL)
+
+	exportStorage, err := export.NewFileStorage(cfg.Export.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize export storage: %w", err)
+	}
+
+	habitsConn, err := grpclib.NewClient(
+		cfg.Services.HabitsServiceAddr,
+		grpclib.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to habits-service: %w", err)
+	}
+
+	notificationConn, err := grpclib.NewClient(
+		cfg.Services.NotificationServiceAddr,
+		grpclib.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to notification-service: %w", err)
+	}
+
 	kafkaProducer := kafka.NewProducer(&cfg.Kafka)
 	fmt.Println("Kafka producer initialized")
 
+	keySet, err := loadKeySet(&cfg.JWT, cfg.Service.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JWT signing keys: %w", err)
+	}
+	fmt.Printf("JWT signing keys loaded, active key: %s\n", keySet.Active().ID)
+
 	tokenManager := jwt.NewTokenManager(
-		cfg.JWT.Secret,
+		keySet,
 		cfg.JWT.AccessTokenTTL,
 		cfg.JWT.RefreshTokenTTL,
 		cfg.JWT.Issuer,
@@ -73,26 +118,76 @@ func New() (*App, error) {
 		sessionStorage,
 		verificationTokenStorage,
 		passwordResetTokenStorage,
+		magicLinkTokenStorage,
+		emailChangeTokenStorage,
 		tokenManager,
 		kafkaProducer,
 	)
+	exportService := service.NewExportService(
+		dataExportRepo,
+		userService,
+		sessionRepo,
+		habitspb.NewHabitServiceClient(habitsConn),
+		notificationpb.NewNotificationServiceClient(notificationConn),
+		exportStorage,
+		dataExportTokenStorage,
+		kafkaProducer,
+	)
+
+	if err := exportService.FailInterrupted(ctx); err != nil {
+		fmt.Printf("Warning: failed to fail interrupted data exports: %v\n", err)
+	}
+
+	accountService := service.NewAccountService(
+		userRepo,
+		userService,
+		authService,
+		exportService,
+		kafkaProducer,
+		cfg.Account.DeletionGracePeriod,
+	)
 
-	grpcHandler := grpc.NewUserServiceHandler(userService, authService)
+	grpcHandler := grpc.NewUserServiceHandler(userService, authService, exportService, accountService)
 
 	grpcServer := grpc.NewServer(grpcHandler, cfg.GRPC.Port)
 
 	return &App{
-		config:        cfg,
-		grpcServer:    grpcServer,
-		kafkaProducer: kafkaProducer,
+		config:         cfg,
+		grpcServer:     grpcServer,
+		kafkaProducer:  kafkaProducer,
+		exportService:  exportService,
+		accountService: accountService,
+		grpcConns:      []*grpclib.ClientConn{habitsConn, notificationConn},
 	}, nil
 }
 
+// loadKeySet loads JWT signing keys from the configured directory.
+// Without a directory an ephemeral key is generated in development, any other environment fails to start
+func loadKeySet(cfg *config.JWTConfig, environment string) (*jwt.KeySet, error) {
+	if cfg.KeysDir != "" {
+		return jwt.LoadKeySet(cfg.KeysDir, cfg.ActiveKeyID)
+	}
+
+	if environment != "development" {
+		return nil, fmt.Errorf("jwt.keys_dir (JWT_KEYS_DIR) must be set in the %q environment", environment)
+	}
+
+	fmt.Println("WARNING: jwt.keys_dir (JWT_KEYS_DIR) is not set, generating an ephemeral signing key.")
+	fmt.Println("WARNING: all tokens become invalid on restart and other instances cannot verify them, never run like this outside development.")
+	return jwt.GenerateKeySet()
+}
+
 // Run starts the application
 func (a *App) Run() error {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go a.runExportCleanup(ctx)
+	go a.runAccountDeletion(ctx)
+
 	go func() {
 		if err := a.grpcServer.Start(); err != nil {
 			fmt.Printf("gRPC server error: %v\n", err)
@@ -108,6 +203,10 @@ func (a *App) Run() error {
 
 	a.grpcServer.Stop()
 
+	for _, conn := range a.grpcConns {
+		conn.Close()
+	}
+
 	if err := a.kafkaProducer.Close(); err != nil {
 		fmt.Printf("Error closing Kafka producer: %v\n", err)
 	}
@@ -115,3 +214,42 @@ func (a *App) Run() error {
 	fmt.Println("Server shutdown complete")
 	return nil
 }
+
+// runExportCleanup periodically removes data export archives with expired download links
+func (a *App) runExportCleanup(ctx context.Context) {
+	ticker := time.NewTicker(a.config.Export.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.exportService.CleanupExpired(ctx); err != nil {
+				fmt.Printf("Warning: failed to clean up expired data exports: %v\n", err)
+			}
+		}
+	}
+}
+
+// runAccountDeletion periodically deletes accounts whose deletion grace period has passed
+func (a *App) runAccountDeletion(ctx context.Context) {
+	ticker := time.NewTicker(a.config.Account.DeletionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := a.accountService.DeleteDueAccounts(ctx)
+			if err != nil {
+				fmt.Printf("Warning: failed to delete accounts due for deletion: %v\n", err)
+				continue
+			}
+			if deleted > 0 {
+				fmt.Printf("Deleted %d accounts after grace period\n", deleted)
+			}
+		}
+	}
+}
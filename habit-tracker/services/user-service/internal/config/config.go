@@ -15,6 +15,9 @@ type Config struct {
 	Redis    RedisConfig    `yaml:"redis"`
 	Kafka    KafkaConfig    `yaml:"kafka"`
 	JWT      JWTConfig      `yaml:"jwt"`
+	Export   ExportConfig   `yaml:"export"`
+	Account  AccountConfig  `yaml:"account"`
+	Services ServicesConfig `yaml:"services"`
 	Logging  LoggingConfig  `yaml:"logging"`
 	Metrics  MetricsConfig  `yaml:"metrics"`
 }
@@ -65,12 +68,29 @@ type KafkaConfig struct {
 }
 
 type JWTConfig struct {
-	Secret          string        `yaml:"secret"`
+	KeysDir         string        `yaml:"keys_dir"`
+	ActiveKeyID     string        `yaml:"active_key_id"`
 	AccessTokenTTL  time.Duration `yaml:"access_token_ttl"`
 	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl"`
 	Issuer          string        `yaml:"issuer"`
 }
 
+type ExportConfig struct {
+	Dir             string        `yaml:"dir"`
+	DownloadTTL     time.Duration `yaml:"download_ttl"`
+	CleanupInterval time.Duration `yaml:"cleanup_interval"`
+}
+
+type AccountConfig struct {
+	DeletionGracePeriod   time.Duration `yaml:"deletion_grace_period"`
+	DeletionCheckInterval time.Duration `yaml:"deletion_check_interval"`
+}
+
+type ServicesConfig struct {
+	HabitsServiceAddr       string `yaml:"habits_service_addr"`
+	NotificationServiceAddr string `yaml:"notification_service_addr"`
+}
+
 type LoggingConfig struct {
 	Level      string `yaml:"level"`
 	Format     string `yaml:"format"`
@@ -142,8 +162,25 @@ func (c *Config) overrideFromEnv() {
 	if val := os.Getenv("REDIS_DB"); val != "" {
 		fmt.Sscanf(val, "%d", &c.Redis.DB)
 	}
-	if val := os.Getenv("JWT_SECRET"); val != "" {
-		c.JWT.Secret = val
+	if val := os.Getenv("JWT_KEYS_DIR"); val != "" {
+		c.JWT.KeysDir = val
+	}
+	if val := os.Getenv("JWT_ACTIVE_KEY_ID"); val != "" {
+		c.JWT.ActiveKeyID = val
+	}
+	if val := os.Getenv("EXPORT_DIR"); val != "" {
+		c.Export.Dir = val
+	}
+	if val := os.Getenv("ACCOUNT_DELETION_GRACE_PERIOD"); val != "" {
+		if d, err := time.ParseDuration(val); err == nil {
+			c.Account.DeletionGracePeriod = d
+		}
+	}
+	if val := os.Getenv("HABITS_SERVICE_ADDR"); val != "" {
+		c.Services.HabitsServiceAddr = val
+	}
+	if val := os.Getenv("NOTIFICATION_SERVICE_ADDR"); val != "" {
+		c.Services.NotificationServiceAddr = val
 	}
 	if val := os.Getenv("LOG_LEVEL"); val != "" {
 		c.Logging.Level = val
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataExportStatus represents state of a data export job
+type DataExportStatus string
+
+const (
+	DataExportStatusPending    DataExportStatus = "pending"
+	DataExportStatusProcessing DataExportStatus = "processing"
+	DataExportStatusCompleted  DataExportStatus = "completed"
+	DataExportStatusFailed     DataExportStatus = "failed"
+	DataExportStatusExpired    DataExportStatus = "expired"
+)
+
+// DataExport represents an asynchronous export of all user data
+type DataExport struct {
+	ID          uuid.UUID        `json:"id" db:"id"`
+	UserID      uuid.UUID        `json:"user_id" db:"user_id"`
+	Status      DataExportStatus `json:"status" db:"status"`
+	FilePath    *string          `json:"-" db:"file_path"` // Never expose in JSON
+	FileSize    *int64           `json:"file_size,omitempty" db:"file_size"`
+	Error       *string          `json:"error,omitempty" db:"error"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+	CompletedAt *time.Time       `json:"completed_at,omitempty" db:"completed_at"`
+	ExpiresAt   *time.Time       `json:"expires_at,omitempty" db:"expires_at"`
+}
+
+// IsInProgress checks if the export is still being built
+func (e *DataExport) IsInProgress() bool {
+	return e.Status == DataExportStatusPending || e.Status == DataExportStatusProcessing
+}
+
+// IsDownloadable checks if the export archive can be downloaded
+func (e *DataExport) IsDownloadable() bool {
+	return e.Status == DataExportStatusCompleted && e.ExpiresAt != nil && time.Now().Before(*e.ExpiresAt)
+}
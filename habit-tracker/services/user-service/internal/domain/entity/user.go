@@ -18,6 +18,16 @@ type User struct {
 	Timezone      string    `json:"timezone" db:"timezone"`
 	CreatedAt     time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty" db:"deletion_scheduled_at"`
+
+	// PublicProfile opts the user in to a profile readable without auth
+	PublicProfile bool `json:"public_profile" db:"public_profile"`
+}
+
+// IsDeletionScheduled reports whether account is waiting for deletion grace period to pass
+func (u *User) IsDeletionScheduled() bool {
+	return u.DeletionScheduledAt != nil
 }
 
 // UserCreate represents data needed to create a new user
@@ -31,9 +41,10 @@ type UserCreate struct {
 
 // UserUpdate represents data that can be updated
 type UserUpdate struct {
-	FirstName     *string `json:"first_name,omitempty"`
-	Timezone      *string `json:"timezone,omitempty"`
-	EmailVerified *bool   `json:"email_verified,omitempty"`
+	FirstName *string `json:"first_name,omitempty"`
+	Timezone  *string `json:"timezone,omitempty"`
+
+	PublicProfile *bool `json:"public_profile,omitempty"`
 }
 
 // UserResponse represents user data for API responses (without sensitive data)
@@ -47,6 +58,9 @@ type UserResponse struct {
 	Timezone      string    `json:"timezone"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
+
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	PublicProfile       bool       `json:"public_profile"`
 }
 
 // ToResponse converts User to UserResponse
@@ -61,5 +75,8 @@ func (u *User) ToResponse() *UserResponse {
 		Timezone:      u.Timezone,
 		CreatedAt:     u.CreatedAt,
 		UpdatedAt:     u.UpdatedAt,
+
+		DeletionScheduledAt: u.DeletionScheduledAt,
+		PublicProfile:       u.PublicProfile,
 	}
 }
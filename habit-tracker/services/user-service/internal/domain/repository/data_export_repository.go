@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"user-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// DataExportRepository defines methods for data export job access
+type DataExportRepository interface {
+	// Create creates a new export job
+	Create(ctx context.Context, export *entity.DataExport) error
+
+	// GetByID retrieves an export job by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.DataExport, error)
+
+	// GetInProgressByUserID retrieves pending or processing export of a user, nil if none
+	GetInProgressByUserID(ctx context.Context, userID uuid.UUID) (*entity.DataExport, error)
+
+	// MarkProcessing marks export job as being built
+	MarkProcessing(ctx context.Context, id uuid.UUID) error
+
+	// MarkCompleted stores archive location and download expiration
+	MarkCompleted(ctx context.Context, id uuid.UUID, filePath string, fileSize int64, expiresAt time.Time) error
+
+	// MarkFailed stores failure reason
+	MarkFailed(ctx context.Context, id uuid.UUID, errMsg string) error
+
+	// FailInProgress fails all pending and processing jobs, used on startup after unclean shutdown
+	FailInProgress(ctx context.Context, errMsg string) (int64, error)
+
+	// GetExpired retrieves completed exports whose download link has expired
+	GetExpired(ctx context.Context, now time.Time) ([]*entity.DataExport, error)
+
+	// MarkExpired marks export as expired after its archive is removed
+	MarkExpired(ctx context.Context, id uuid.UUID) error
+
+	// GetByUserID retrieves all export jobs of a user
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.DataExport, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"user-service/internal/domain/entity"
 
@@ -34,9 +35,24 @@ type UserRepository interface {
 	// UpdateEmailVerified updates email verification status
 	UpdateEmailVerified(ctx context.Context, userID uuid.UUID, verified bool) error
 
+	// UpdateEmail replaces user email if it still equals oldEmail and marks it verified
+	UpdateEmail(ctx context.Context, userID uuid.UUID, oldEmail, newEmail string) error
+
 	// Delete deletes a user (soft delete by setting is_active to false)
 	Delete(ctx context.Context, id uuid.UUID) error
 
+	// ScheduleDeletion marks account for deletion at the given time
+	ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) error
+
+	// CancelDeletion clears scheduled account deletion
+	CancelDeletion(ctx context.Context, userID uuid.UUID) error
+
+	// GetDueForDeletion retrieves users whose deletion grace period has passed
+	GetDueForDeletion(ctx context.Context, now time.Time, limit int) ([]*entity.User, error)
+
+	// HardDelete permanently removes a user, sessions and data exports are removed by cascade
+	HardDelete(ctx context.Context, id uuid.UUID) error
+
 	// Exists checks if a user exists by ID
 	Exists(ctx context.Context, id uuid.UUID) (bool, error)
 
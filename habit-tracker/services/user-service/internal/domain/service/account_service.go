@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+
+	"user-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// AccountService defines business logic for account lifecycle
+type AccountService interface {
+	// ScheduleDeletion verifies password and schedules permanent account deletion after grace period
+	ScheduleDeletion(ctx context.Context, userID uuid.UUID, password string) (*entity.User, error)
+
+	// CancelDeletion cancels scheduled account deletion during grace period
+	CancelDeletion(ctx context.Context, userID uuid.UUID) (*entity.User, error)
+
+	// DeleteDueAccounts permanently deletes accounts whose grace period has passed, returns number of deleted accounts
+	DeleteDueAccounts(ctx context.Context) (int, error)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"user-service/internal/domain/entity"
+	"user-service/pkg/jwt"
 
 	"github.com/google/uuid"
 )
@@ -35,6 +36,12 @@ type AuthService interface {
 	// ValidateAccessToken validates access token and returns user ID and session ID
 	ValidateAccessToken(ctx context.Context, accessToken string) (uuid.UUID, uuid.UUID, error)
 
+	// GetJWKS returns public keys used to verify issued tokens
+	GetJWKS(ctx context.Context) jwt.JWKS
+
+	// CheckSession reports whether session is still active and belongs to user
+	CheckSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) (bool, error)
+
 	// GetUserSessions retrieves all active sessions for a user
 	GetUserSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
 
@@ -55,4 +62,20 @@ type AuthService interface {
 
 	// ResetPassword completes password reset with token
 	ResetPassword(ctx context.Context, token, newPassword string) error
+
+	// RequestMagicLink emails a single-use sign-in link
+	RequestMagicLink(ctx context.Context, email string) error
+
+	// ConsumeMagicLink signs user in with a magic link token and creates session
+	ConsumeMagicLink(ctx context.Context, token string, ipAddress *net.IP, userAgent *string) (*entity.User, *TokenPair, error)
+
+	// RequestEmailChange verifies password and sends confirmation link to the new address
+	RequestEmailChange(ctx context.Context, userID, sessionID uuid.UUID, password, newEmail string) error
+
+	// ConfirmEmailChange applies email change, revokes all sessions except the requesting one and invalidates magic links
+	ConfirmEmailChange(ctx context.Context, token string) (*entity.User, int, error)
+
+	// UndoEmailChange cancels pending email change or reverts applied one, returns true if reverted.
+	// Reverting revokes all sessions and invalidates magic links
+	UndoEmailChange(ctx context.Context, token string) (bool, error)
 }
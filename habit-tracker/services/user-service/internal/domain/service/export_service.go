@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"user-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// ExportService defines business logic for user data export
+type ExportService interface {
+	// RequestExport starts an asynchronous export of all user data, returns job in progress if there is one
+	RequestExport(ctx context.Context, userID uuid.UUID) (*entity.DataExport, error)
+
+	// GetExport retrieves export job of the user
+	GetExport(ctx context.Context, userID, exportID uuid.UUID) (*entity.DataExport, error)
+
+	// OpenDownload opens export archive by download token, caller must close the reader
+	OpenDownload(ctx context.Context, token string) (*entity.DataExport, io.ReadCloser, error)
+
+	// FailInterrupted fails jobs left in progress by a previous run
+	FailInterrupted(ctx context.Context) error
+
+	// CleanupExpired removes archives whose download link has expired
+	CleanupExpired(ctx context.Context) error
+
+	// RemoveUserArchives removes archive files of all user exports, used before account is deleted
+	RemoveUserArchives(ctx context.Context, userID uuid.UUID) error
+}
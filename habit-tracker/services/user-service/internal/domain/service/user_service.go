@@ -34,6 +34,9 @@ type UserService interface {
 	// VerifyEmail marks user email as verified
 	VerifyEmail(ctx context.Context, userID uuid.UUID) error
 
+	// ChangeEmail atomically swaps user email from oldEmail to newEmail
+	ChangeEmail(ctx context.Context, userID uuid.UUID, oldEmail, newEmail string) error
+
 	// DeactivateUser deactivates a user account
 	DeactivateUser(ctx context.Context, userID uuid.UUID) error
 
@@ -0,0 +1,106 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ArchiveWriter writes JSON, CSV and binary files into a ZIP archive
+type ArchiveWriter struct {
+	zw        *zip.Writer
+	createdAt time.Time
+}
+
+// NewArchiveWriter creates a new archive writer on top of w
+func NewArchiveWriter(w io.Writer) *ArchiveWriter {
+	return &ArchiveWriter{
+		zw:        zip.NewWriter(w),
+		createdAt: time.Now(),
+	}
+}
+
+// AddJSON adds an indented JSON file to the archive
+func (a *ArchiveWriter) AddJSON(name string, v interface{}) error {
+	w, err := a.create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// AddCSV adds a CSV file with header row to the archive
+func (a *ArchiveWriter) AddCSV(name string, header []string, rows [][]string) error {
+	w, err := a.create(name)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// AddText adds a plain text file to the archive
+func (a *ArchiveWriter) AddText(name, content string) error {
+	w, err := a.create(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// AddFile adds a file with the contents of r to the archive
+func (a *ArchiveWriter) AddFile(name string, r io.Reader) error {
+	w, err := a.create(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// Close finishes the archive, it does not close the underlying writer
+func (a *ArchiveWriter) Close() error {
+	if err := a.zw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return nil
+}
+
+func (a *ArchiveWriter) create(name string) (io.Writer, error) {
+	w, err := a.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: a.createdAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add %s to archive: %w", name, err)
+	}
+	return w, nil
+}
@@ -0,0 +1,53 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileStorage keeps export archives on local disk
+type FileStorage struct {
+	dir string
+}
+
+// NewFileStorage creates a new file storage, creating the directory if needed
+func NewFileStorage(dir string) (*FileStorage, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	return &FileStorage{
+		dir: dir,
+	}, nil
+}
+
+// Create creates a new archive file and returns it with its path
+func (s *FileStorage) Create(name string) (*os.File, string, error) {
+	path := filepath.Join(s.dir, filepath.Base(name))
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create export file: %w", err)
+	}
+
+	return file, path, nil
+}
+
+// Open opens an archive file for reading
+func (s *FileStorage) Open(path string) (*os.File, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open export file: %w", err)
+	}
+	return file, nil
+}
+
+// Remove removes an archive file, missing files are ignored
+func (s *FileStorage) Remove(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove export file: %w", err)
+	}
+	return nil
+}
@@ -15,9 +15,17 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// MessageWriter is the part of kafka.Writer used by the producer
+type MessageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
 // Producer handles publishing events to Kafka
 type Producer struct {
-	writer *kafka.Writer
+	writer MessageWriter
+	// syncWriter waits for broker acknowledgement, used for events that must not be lost
+	syncWriter MessageWriter
 }
 
 // NewProducer creates a new Kafka producer
@@ -31,8 +39,21 @@ func NewProducer(cfg *config.KafkaConfig) *Producer {
 		Async:        true,
 	}
 
+	syncWriter := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Brokers...),
+		Topic:        cfg.Topic,
+		Balancer:     &kafka.LeastBytes{},
+		RequiredAcks: kafka.RequireAll,
+	}
+
+	return NewProducerWithWriters(writer, syncWriter)
+}
+
+// NewProducerWithWriters creates a producer that publishes to the given writers instead of Kafka brokers
+func NewProducerWithWriters(writer, syncWriter MessageWriter) *Producer {
 	return &Producer{
-		writer: writer,
+		writer:     writer,
+		syncWriter: syncWriter,
 	}
 }
 
@@ -76,6 +97,11 @@ func (p *Producer) PublishUserRegisteredEvent(ctx context.Context, event *UserRe
 
 // Close closes the Kafka producer
 func (p *Producer) Close() error {
+	if p.syncWriter != nil {
+		if err := p.syncWriter.Close(); err != nil {
+			return err
+		}
+	}
 	if p.writer != nil {
 		return p.writer.Close()
 	}
@@ -152,6 +178,226 @@ func (p *Producer) PublishPasswordChangedEvent(ctx context.Context, event *Passw
 	return nil
 }
 
+// PublishSessionsRevokedEvent publishes a sessions revoked event
+func (p *Producer) PublishSessionsRevokedEvent(ctx context.Context, event *SessionsRevokedEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_SESSIONS_REVOKED,
+		Timestamp: timestamppb.New(event.RevokedAt),
+		Payload: &eventspb.Event_SessionsRevoked{
+			SessionsRevoked: &eventspb.SessionsRevokedEvent{
+				UserId:     event.UserID,
+				SessionIds: event.SessionIDs,
+				RevokedAt:  timestamppb.New(event.RevokedAt),
+			},
+		},
+	}
+
+	data, err := proto.Marshal(protoEvent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(event.UserID),
+		Value: data,
+		Time:  time.Now(),
+	}
+
+	if err := p.writer.WriteMessages(ctx, message); err != nil {
+		return fmt.Errorf("failed to publish sessions revoked event: %w", err)
+	}
+
+	log.Printf("Published sessions revoked event for user_id: %s (sessions: %d)", event.UserID, len(event.SessionIDs))
+	return nil
+}
+
+// PublishMagicLinkRequestedEvent publishes a magic link requested event
+func (p *Producer) PublishMagicLinkRequestedEvent(ctx context.Context, event *MagicLinkRequestedEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_MAGIC_LINK_REQUESTED,
+		Timestamp: timestamppb.New(event.RequestedAt),
+		Payload: &eventspb.Event_MagicLinkRequested{
+			MagicLinkRequested: &eventspb.MagicLinkRequestedEvent{
+				UserId:      event.UserID,
+				Email:       event.Email,
+				Username:    event.Username,
+				FirstName:   event.FirstName,
+				Token:       event.Token,
+				RequestedAt: timestamppb.New(event.RequestedAt),
+				ExpiresAt:   timestamppb.New(event.ExpiresAt),
+			},
+		},
+	}
+
+	data, err := proto.Marshal(protoEvent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(event.UserID),
+		Value: data,
+		Time:  time.Now(),
+	}
+
+	if err := p.writer.WriteMessages(ctx, message); err != nil {
+		return fmt.Errorf("failed to publish magic link requested event: %w", err)
+	}
+
+	log.Printf("Published magic link requested event for user_id: %s", event.UserID)
+	return nil
+}
+
+// PublishEmailChangeRequestedEvent publishes an email change requested event
+func (p *Producer) PublishEmailChangeRequestedEvent(ctx context.Context, event *EmailChangeRequestedEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_EMAIL_CHANGE_REQUESTED,
+		Timestamp: timestamppb.New(event.RequestedAt),
+		Payload: &eventspb.Event_EmailChangeRequested{
+			EmailChangeRequested: &eventspb.EmailChangeRequestedEvent{
+				UserId:       event.UserID,
+				Username:     event.Username,
+				FirstName:    event.FirstName,
+				OldEmail:     event.OldEmail,
+				NewEmail:     event.NewEmail,
+				ConfirmToken: event.ConfirmToken,
+				UndoToken:    event.UndoToken,
+				RequestedAt:  timestamppb.New(event.RequestedAt),
+			},
+		},
+	}
+
+	data, err := proto.Marshal(protoEvent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(event.UserID),
+		Value: data,
+		Time:  time.Now(),
+	}
+
+	if err := p.writer.WriteMessages(ctx, message); err != nil {
+		return fmt.Errorf("failed to publish email change requested event: %w", err)
+	}
+
+	log.Printf("Published email change requested event for user_id: %s", event.UserID)
+	return nil
+}
+
+// PublishDataExportReadyEvent publishes a data export ready event
+func (p *Producer) PublishDataExportReadyEvent(ctx context.Context, event *DataExportReadyEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_DATA_EXPORT_READY,
+		Timestamp: timestamppb.New(event.CompletedAt),
+		Payload: &eventspb.Event_DataExportReady{
+			DataExportReady: &eventspb.DataExportReadyEvent{
+				UserId:        event.UserID,
+				Email:         event.Email,
+				Username:      event.Username,
+				FirstName:     event.FirstName,
+				ExportId:      event.ExportID,
+				DownloadToken: event.DownloadToken,
+				ExpiresAt:     timestamppb.New(event.ExpiresAt),
+			},
+		},
+	}
+
+	data, err := proto.Marshal(protoEvent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(event.UserID),
+		Value: data,
+		Time:  time.Now(),
+	}
+
+	if err := p.writer.WriteMessages(ctx, message); err != nil {
+		return fmt.Errorf("failed to publish data export ready event: %w", err)
+	}
+
+	log.Printf("Published data export ready event for user_id: %s", event.UserID)
+	return nil
+}
+
+// PublishAccountDeletionScheduledEvent publishes an account deletion scheduled event
+func (p *Producer) PublishAccountDeletionScheduledEvent(ctx context.Context, event *AccountDeletionScheduledEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_ACCOUNT_DELETION_SCHEDULED,
+		Timestamp: timestamppb.New(event.RequestedAt),
+		Payload: &eventspb.Event_AccountDeletionScheduled{
+			AccountDeletionScheduled: &eventspb.AccountDeletionScheduledEvent{
+				UserId:       event.UserID,
+				Email:        event.Email,
+				Username:     event.Username,
+				FirstName:    event.FirstName,
+				RequestedAt:  timestamppb.New(event.RequestedAt),
+				ScheduledFor: timestamppb.New(event.ScheduledFor),
+			},
+		},
+	}
+
+	data, err := proto.Marshal(protoEvent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(event.UserID),
+		Value: data,
+		Time:  time.Now(),
+	}
+
+	if err := p.writer.WriteMessages(ctx, message); err != nil {
+		return fmt.Errorf("failed to publish account deletion scheduled event: %w", err)
+	}
+
+	log.Printf("Published account deletion scheduled event for user_id: %s", event.UserID)
+	return nil
+}
+
+// PublishUserDeletedEvent publishes a user deleted event and waits for broker acknowledgement,
+// so user row is never removed before other services are told to purge their data
+func (p *Producer) PublishUserDeletedEvent(ctx context.Context, event *UserDeletedEvent) error {
+	protoEvent := &eventspb.Event{
+		EventId:   event.EventID,
+		EventType: eventspb.EventType_EVENT_TYPE_USER_DELETED,
+		Timestamp: timestamppb.New(event.DeletedAt),
+		Payload: &eventspb.Event_UserDeleted{
+			UserDeleted: &eventspb.UserDeletedEvent{
+				UserId:    event.UserID,
+				DeletedAt: timestamppb.New(event.DeletedAt),
+			},
+		},
+	}
+
+	data, err := proto.Marshal(protoEvent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	message := kafka.Message{
+		Key:   []byte(event.UserID),
+		Value: data,
+		Time:  time.Now(),
+	}
+
+	if err := p.syncWriter.WriteMessages(ctx, message); err != nil {
+		return fmt.Errorf("failed to publish user deleted event: %w", err)
+	}
+
+	log.Printf("Published user deleted event for user_id: %s", event.UserID)
+	return nil
+}
+
 // UserRegisteredEvent represents a user registration event
 type UserRegisteredEvent struct {
 	EventID           string
@@ -182,6 +428,70 @@ type PasswordChangedEvent struct {
 	WasReset  bool
 }
 
+// SessionsRevokedEvent represents a sessions revocation event
+type SessionsRevokedEvent struct {
+	EventID    string
+	UserID     string
+	SessionIDs []string
+	RevokedAt  time.Time
+}
+
+// MagicLinkRequestedEvent represents a magic link sign-in request event
+type MagicLinkRequestedEvent struct {
+	EventID     string
+	UserID      string
+	Email       string
+	Username    string
+	FirstName   string
+	Token       string
+	RequestedAt time.Time
+	ExpiresAt   time.Time
+}
+
+// EmailChangeRequestedEvent represents an email change request event
+type EmailChangeRequestedEvent struct {
+	EventID      string
+	UserID       string
+	Username     string
+	FirstName    string
+	OldEmail     string
+	NewEmail     string
+	ConfirmToken string
+	UndoToken    string
+	RequestedAt  time.Time
+}
+
+// DataExportReadyEvent represents a completed user data export event
+type DataExportReadyEvent struct {
+	EventID       string
+	UserID        string
+	Email         string
+	Username      string
+	FirstName     string
+	ExportID      string
+	DownloadToken string
+	ExpiresAt     time.Time
+	CompletedAt   time.Time
+}
+
+// AccountDeletionScheduledEvent represents an account deletion request event
+type AccountDeletionScheduledEvent struct {
+	EventID      string
+	UserID       string
+	Email        string
+	Username     string
+	FirstName    string
+	RequestedAt  time.Time
+	ScheduledFor time.Time
+}
+
+// UserDeletedEvent represents a permanent account deletion event
+type UserDeletedEvent struct {
+	EventID   string
+	UserID    string
+	DeletedAt time.Time
+}
+
 func NewEventID() string {
 	return uuid.New().String()
 }
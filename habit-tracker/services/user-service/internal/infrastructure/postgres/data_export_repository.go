@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"user-service/internal/domain/entity"
+	"user-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// dataExportRepository implements repository.DataExportRepository
+type dataExportRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDataExportRepository creates a new data export repository
+func NewDataExportRepository(pool *pgxpool.Pool) repository.DataExportRepository {
+	return &dataExportRepository{
+		pool: pool,
+	}
+}
+
+// Create creates a new export job
+func (r *dataExportRepository) Create(ctx context.Context, export *entity.DataExport) error {
+	query := `
+		INSERT INTO data_exports (id, user_id, status, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		export.ID,
+		export.UserID,
+		export.Status,
+		export.CreatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return fmt.Errorf("data export already in progress")
+		}
+		return fmt.Errorf("failed to create data export: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an export job by ID
+func (r *dataExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.DataExport, error) {
+	query := `
+		SELECT id, user_id, status, file_path, file_size, error, created_at, completed_at, expires_at
+		FROM data_exports
+		WHERE id = $1
+	`
+
+	export, err := scanDataExport(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("data export not found")
+		}
+		return nil, fmt.Errorf("failed to get data export: %w", err)
+	}
+
+	return export, nil
+}
+
+// GetInProgressByUserID retrieves pending or processing export of a user
+func (r *dataExportRepository) GetInProgressByUserID(ctx context.Context, userID uuid.UUID) (*entity.DataExport, error) {
+	query := `
+		SELECT id, user_id, status, file_path, file_size, error, created_at, completed_at, expires_at
+		FROM data_exports
+		WHERE user_id = $1 AND status IN ('pending', 'processing')
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	export, err := scanDataExport(r.pool.QueryRow(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get data export in progress: %w", err)
+	}
+
+	return export, nil
+}
+
+// MarkProcessing marks export job as being built
+func (r *dataExportRepository) MarkProcessing(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE data_exports
+		SET status = 'processing'
+		WHERE id = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark data export as processing: %w", err)
+	}
+
+	return nil
+}
+
+// MarkCompleted stores archive location and download expiration
+func (r *dataExportRepository) MarkCompleted(ctx context.Context, id uuid.UUID, filePath string, fileSize int64, expiresAt time.Time) error {
+	query := `
+		UPDATE data_exports
+		SET status = 'completed', file_path = $2, file_size = $3, completed_at = NOW(), expires_at = $4
+		WHERE id = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, query, id, filePath, fileSize, expiresAt); err != nil {
+		return fmt.Errorf("failed to mark data export as completed: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed stores failure reason
+func (r *dataExportRepository) MarkFailed(ctx context.Context, id uuid.UUID, errMsg string) error {
+	query := `
+		UPDATE data_exports
+		SET status = 'failed', error = $2, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, query, id, errMsg); err != nil {
+		return fmt.Errorf("failed to mark data export as failed: %w", err)
+	}
+
+	return nil
+}
+
+// FailInProgress fails all pending and processing jobs
+func (r *dataExportRepository) FailInProgress(ctx context.Context, errMsg string) (int64, error) {
+	query := `
+		UPDATE data_exports
+		SET status = 'failed', error = $1, completed_at = NOW()
+		WHERE status IN ('pending', 'processing')
+	`
+
+	result, err := r.pool.Exec(ctx, query, errMsg)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail data exports in progress: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// GetExpired retrieves completed exports whose download link has expired
+func (r *dataExportRepository) GetExpired(ctx context.Context, now time.Time) ([]*entity.DataExport, error) {
+	query := `
+		SELECT id, user_id, status, file_path, file_size, error, created_at, completed_at, expires_at
+		FROM data_exports
+		WHERE status = 'completed' AND expires_at <= $1
+	`
+
+	rows, err := r.pool.Query(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired data exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []*entity.DataExport
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan data export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating data exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+// MarkExpired marks export as expired after its archive is removed
+func (r *dataExportRepository) MarkExpired(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE data_exports
+		SET status = 'expired', file_path = NULL
+		WHERE id = $1
+	`
+
+	if _, err := r.pool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark data export as expired: %w", err)
+	}
+
+	return nil
+}
+
+// GetByUserID retrieves all export jobs of a user
+func (r *dataExportRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.DataExport, error) {
+	query := `
+		SELECT id, user_id, status, file_path, file_size, error, created_at, completed_at, expires_at
+		FROM data_exports
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data exports by user ID: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []*entity.DataExport
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan data export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating data exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+// scanDataExport scans a single data export row
+func scanDataExport(row pgx.Row) (*entity.DataExport, error) {
+	var export entity.DataExport
+	err := row.Scan(
+		&export.ID,
+		&export.UserID,
+		&export.Status,
+		&export.FilePath,
+		&export.FileSize,
+		&export.Error,
+		&export.CreatedAt,
+		&export.CompletedAt,
+		&export.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"user-service/internal/domain/entity"
 	"user-service/internal/domain/repository"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -55,7 +57,7 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
 // GetByID retrieves a user by ID
 func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	query := `
-		SELECT id, email, username, password_hash, first_name, is_active, email_verified, timezone, created_at, updated_at
+		SELECT id, email, username, password_hash, first_name, is_active, email_verified, timezone, created_at, updated_at, deletion_scheduled_at, public_profile
 		FROM users
 		WHERE id = $1 AND is_active = true
 	`
@@ -72,6 +74,8 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Use
 		&user.Timezone,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.DeletionScheduledAt,
+		&user.PublicProfile,
 	)
 
 	if err != nil {
@@ -87,7 +91,7 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Use
 // GetByEmail retrieves a user by email
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
 	query := `
-		SELECT id, email, username, password_hash, first_name, is_active, email_verified, timezone, created_at, updated_at
+		SELECT id, email, username, password_hash, first_name, is_active, email_verified, timezone, created_at, updated_at, deletion_scheduled_at, public_profile
 		FROM users
 		WHERE email = $1 AND is_active = true
 	`
@@ -104,6 +108,8 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.
 		&user.Timezone,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.DeletionScheduledAt,
+		&user.PublicProfile,
 	)
 
 	if err != nil {
@@ -119,7 +125,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.
 // GetByUsername retrieves a user by username
 func (r *userRepository) GetByUsername(ctx context.Context, username string) (*entity.User, error) {
 	query := `
-		SELECT id, email, username, password_hash, first_name, is_active, email_verified, timezone, created_at, updated_at
+		SELECT id, email, username, password_hash, first_name, is_active, email_verified, timezone, created_at, updated_at, deletion_scheduled_at, public_profile
 		FROM users
 		WHERE username = $1 AND is_active = true
 	`
@@ -136,6 +142,8 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*e
 		&user.Timezone,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.DeletionScheduledAt,
+		&user.PublicProfile,
 	)
 
 	if err != nil {
@@ -151,7 +159,7 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*e
 // GetByEmailOrUsername retrieves a user by email or username
 func (r *userRepository) GetByEmailOrUsername(ctx context.Context, emailOrUsername string) (*entity.User, error) {
 	query := `
-		SELECT id, email, username, password_hash, first_name, is_active, email_verified, timezone, created_at, updated_at
+		SELECT id, email, username, password_hash, first_name, is_active, email_verified, timezone, created_at, updated_at, deletion_scheduled_at, public_profile
 		FROM users
 		WHERE (email = $1 OR username = $1) AND is_active = true
 	`
@@ -168,6 +176,8 @@ func (r *userRepository) GetByEmailOrUsername(ctx context.Context, emailOrUserna
 		&user.Timezone,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.DeletionScheduledAt,
+		&user.PublicProfile,
 	)
 
 	if err != nil {
@@ -184,7 +194,7 @@ func (r *userRepository) GetByEmailOrUsername(ctx context.Context, emailOrUserna
 func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
 	query := `
 		UPDATE users
-		SET first_name = $2, timezone = $3, email_verified = $4, updated_at = CURRENT_TIMESTAMP
+		SET first_name = $2, timezone = $3, public_profile = $4, updated_at = CURRENT_TIMESTAMP
 		WHERE id = $1 AND is_active = true
 	`
 
@@ -192,7 +202,7 @@ func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
 		user.ID,
 		user.FirstName,
 		user.Timezone,
-		user.EmailVerified,
+		user.PublicProfile,
 	)
 
 	if err != nil {
@@ -246,6 +256,30 @@ func (r *userRepository) UpdateEmailVerified(ctx context.Context, userID uuid.UU
 	return nil
 }
 
+// UpdateEmail replaces user email if it still equals oldEmail and marks it verified
+func (r *userRepository) UpdateEmail(ctx context.Context, userID uuid.UUID, oldEmail, newEmail string) error {
+	query := `
+		UPDATE users
+		SET email = $3, email_verified = true, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND email = $2 AND is_active = true
+	`
+
+	result, err := r.pool.Exec(ctx, query, userID, oldEmail, newEmail)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return fmt.Errorf("email already registered")
+		}
+		return fmt.Errorf("failed to update email: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("user not found or email already changed")
+	}
+
+	return nil
+}
+
 // Delete soft deletes a user by setting is_active to false
 func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `
@@ -266,6 +300,103 @@ func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// ScheduleDeletion marks account for deletion at the given time
+func (r *userRepository) ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	query := `
+		UPDATE users
+		SET deletion_scheduled_at = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND is_active = true AND deletion_scheduled_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, userID, at)
+	if err != nil {
+		return fmt.Errorf("failed to schedule account deletion: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("user not found or deletion already scheduled")
+	}
+
+	return nil
+}
+
+// CancelDeletion clears scheduled account deletion
+func (r *userRepository) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	query := `
+		UPDATE users
+		SET deletion_scheduled_at = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND is_active = true AND deletion_scheduled_at IS NOT NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel account deletion: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("user not found or deletion not scheduled")
+	}
+
+	return nil
+}
+
+// GetDueForDeletion retrieves users whose deletion grace period has passed
+func (r *userRepository) GetDueForDeletion(ctx context.Context, now time.Time, limit int) ([]*entity.User, error) {
+	query := `
+		SELECT id, email, username, password_hash, first_name, is_active, email_verified, timezone, created_at, updated_at, deletion_scheduled_at, public_profile
+		FROM users
+		WHERE deletion_scheduled_at <= $1
+		ORDER BY deletion_scheduled_at
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users due for deletion: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*entity.User
+	for rows.Next() {
+		var user entity.User
+		err := rows.Scan(
+			&user.ID,
+			&user.Email,
+			&user.Username,
+			&user.PasswordHash,
+			&user.FirstName,
+			&user.IsActive,
+			&user.EmailVerified,
+			&user.Timezone,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+			&user.DeletionScheduledAt,
+			&user.PublicProfile,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, &user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating users: %w", err)
+	}
+
+	return users, nil
+}
+
+// HardDelete permanently removes a user, deleting an already removed user is not an error
+func (r *userRepository) HardDelete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM users WHERE id = $1`
+
+	if _, err := r.pool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to hard delete user: %w", err)
+	}
+
+	return nil
+}
+
 // Exists checks if a user exists by ID
 func (r *userRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	query := `SELECT EXISTS(SELECT 1 FROM users WHERE id = $1 AND is_active = true)`
@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	dataExportTokenPrefix = "data_export:download:"
+)
+
+// DataExportTokenStorage handles data export download tokens in Redis.
+// Tokens are not consumed on download so an interrupted download can be retried until the link expires.
+type DataExportTokenStorage struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewDataExportTokenStorage creates a new data export token storage
+func NewDataExportTokenStorage(client *redis.Client, ttl time.Duration) *DataExportTokenStorage {
+	return &DataExportTokenStorage{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// GenerateToken generates a new download token
+func (s *DataExportTokenStorage) GenerateToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// StoreToken stores a download token with export ID
+func (s *DataExportTokenStorage) StoreToken(ctx context.Context, token, exportID string) error {
+	key := dataExportTokenPrefix + token
+	err := s.client.Set(ctx, key, exportID, s.ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to store data export token: %w", err)
+	}
+	return nil
+}
+
+// GetExportID retrieves export ID by download token
+func (s *DataExportTokenStorage) GetExportID(ctx context.Context, token string) (string, error) {
+	key := dataExportTokenPrefix + token
+	exportID, err := s.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", fmt.Errorf("data export token not found or expired")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get data export token: %w", err)
+	}
+	return exportID, nil
+}
+
+// TTL returns how long download links stay valid
+func (s *DataExportTokenStorage) TTL() time.Duration {
+	return s.ttl
+}
@@ -0,0 +1,104 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	emailChangeConfirmPrefix = "email_change:confirm:"
+	emailChangeUndoPrefix    = "email_change:undo:"
+	emailChangeConfirmTTL    = 24 * time.Hour
+	emailChangeUndoTTL       = 7 * 24 * time.Hour
+)
+
+// EmailChangeRequest represents a pending or applied email change
+type EmailChangeRequest struct {
+	UserID       string `json:"user_id"`
+	SessionID    string `json:"session_id"`
+	OldEmail     string `json:"old_email"`
+	NewEmail     string `json:"new_email"`
+	ConfirmToken string `json:"confirm_token"`
+	UndoToken    string `json:"undo_token"`
+}
+
+// EmailChangeTokenStorage handles email change tokens in Redis.
+// Confirm token is sent to the new address, undo token to the old one and outlives the confirm token
+// so the change can be reverted after it has been applied.
+type EmailChangeTokenStorage struct {
+	client *redis.Client
+}
+
+// NewEmailChangeTokenStorage creates a new email change token storage
+func NewEmailChangeTokenStorage(client *redis.Client) *EmailChangeTokenStorage {
+	return &EmailChangeTokenStorage{
+		client: client,
+	}
+}
+
+// GenerateToken generates a new email change token
+func (s *EmailChangeTokenStorage) GenerateToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// StoreRequest stores email change request under both confirm and undo tokens
+func (s *EmailChangeTokenStorage) StoreRequest(ctx context.Context, request *EmailChangeRequest) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal email change request: %w", err)
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.Set(ctx, emailChangeConfirmPrefix+request.ConfirmToken, data, emailChangeConfirmTTL)
+	pipe.Set(ctx, emailChangeUndoPrefix+request.UndoToken, data, emailChangeUndoTTL)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store email change request: %w", err)
+	}
+	return nil
+}
+
+// ConsumeConfirmToken atomically retrieves and deletes email change request by confirm token
+func (s *EmailChangeTokenStorage) ConsumeConfirmToken(ctx context.Context, token string) (*EmailChangeRequest, error) {
+	return s.consume(ctx, emailChangeConfirmPrefix+token)
+}
+
+// ConsumeUndoToken atomically retrieves and deletes email change request by undo token
+func (s *EmailChangeTokenStorage) ConsumeUndoToken(ctx context.Context, token string) (*EmailChangeRequest, error) {
+	return s.consume(ctx, emailChangeUndoPrefix+token)
+}
+
+// DeleteConfirmToken deletes a confirm token, cancelling a pending change
+func (s *EmailChangeTokenStorage) DeleteConfirmToken(ctx context.Context, token string) (bool, error) {
+	deleted, err := s.client.Del(ctx, emailChangeConfirmPrefix+token).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete email change token: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+func (s *EmailChangeTokenStorage) consume(ctx context.Context, key string) (*EmailChangeRequest, error) {
+	data, err := s.client.GetDel(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, fmt.Errorf("email change token not found or expired")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email change token: %w", err)
+	}
+
+	var request EmailChangeRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal email change request: %w", err)
+	}
+	return &request, nil
+}
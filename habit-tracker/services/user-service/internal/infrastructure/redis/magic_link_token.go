@@ -0,0 +1,95 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	magicLinkTokenPrefix = "magic_link:token:"
+	magicLinkUserPrefix  = "magic_link:user:"
+	magicLinkTokenTTL    = 15 * time.Minute
+)
+
+// MagicLinkTokenStorage handles magic link sign-in token storage in Redis
+type MagicLinkTokenStorage struct {
+	client *redis.Client
+}
+
+// NewMagicLinkTokenStorage creates a new magic link token storage
+func NewMagicLinkTokenStorage(client *redis.Client) *MagicLinkTokenStorage {
+	return &MagicLinkTokenStorage{
+		client: client,
+	}
+}
+
+// GenerateToken generates a new magic link token
+func (s *MagicLinkTokenStorage) GenerateToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// StoreToken stores a magic link token with user ID and remembers it among the user's tokens
+func (s *MagicLinkTokenStorage) StoreToken(ctx context.Context, token, userID string) error {
+	userKey := magicLinkUserPrefix + userID
+
+	pipe := s.client.TxPipeline()
+	pipe.Set(ctx, magicLinkTokenPrefix+token, userID, magicLinkTokenTTL)
+	pipe.SAdd(ctx, userKey, token)
+	pipe.Expire(ctx, userKey, magicLinkTokenTTL)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store magic link token: %w", err)
+	}
+	return nil
+}
+
+// ConsumeToken atomically retrieves and deletes a magic link token so it can be used only once
+func (s *MagicLinkTokenStorage) ConsumeToken(ctx context.Context, token string) (string, error) {
+	key := magicLinkTokenPrefix + token
+	userID, err := s.client.GetDel(ctx, key).Result()
+	if err == redis.Nil {
+		return "", fmt.Errorf("magic link token not found or expired")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to consume magic link token: %w", err)
+	}
+
+	s.client.SRem(ctx, magicLinkUserPrefix+userID, token)
+
+	return userID, nil
+}
+
+// DeleteUserTokens invalidates all outstanding magic link tokens of the user
+func (s *MagicLinkTokenStorage) DeleteUserTokens(ctx context.Context, userID string) error {
+	userKey := magicLinkUserPrefix + userID
+
+	tokens, err := s.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get magic link tokens: %w", err)
+	}
+
+	keys := make([]string, 0, len(tokens)+1)
+	for _, token := range tokens {
+		keys = append(keys, magicLinkTokenPrefix+token)
+	}
+	keys = append(keys, userKey)
+
+	if err := s.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("failed to delete magic link tokens: %w", err)
+	}
+	return nil
+}
+
+// TTL returns how long issued tokens stay valid
+func (s *MagicLinkTokenStorage) TTL() time.Duration {
+	return magicLinkTokenTTL
+}
@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// ErrSessionNotFound is returned when session doesn't exist, e.g. because it was revoked or has expired
+var ErrSessionNotFound = errors.New("session not found")
+
 // SessionStorage handles session storage in Redis
 type SessionStorage struct {
 	client     *redis.Client
@@ -81,7 +85,7 @@ func (s *SessionStorage) Get(ctx context.Context, sessionID uuid.UUID) (*entity.
 	data, err := s.client.Get(ctx, sessionKey).Result()
 	if err != nil {
 		if err == redis.Nil {
-			return nil, fmt.Errorf("session not found")
+			return nil, ErrSessionNotFound
 		}
 		return nil, fmt.Errorf("failed to get session: %w", err)
 	}
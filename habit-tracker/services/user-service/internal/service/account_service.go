@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"user-service/internal/domain/entity"
+	"user-service/internal/domain/repository"
+	"user-service/internal/domain/service"
+	"user-service/internal/infrastructure/kafka"
+
+	"github.com/google/uuid"
+)
+
+// deletionBatchSize limits number of accounts deleted in one run
+const deletionBatchSize = 100
+
+// accountService implements service.AccountService
+type accountService struct {
+	userRepo      repository.UserRepository
+	userService   service.UserService
+	authService   service.AuthService
+	exportService service.ExportService
+	kafkaProducer *kafka.Producer
+	gracePeriod   time.Duration
+}
+
+// NewAccountService creates a new account service
+func NewAccountService(
+	userRepo repository.UserRepository,
+	userService service.UserService,
+	authService service.AuthService,
+	exportService service.ExportService,
+	kafkaProducer *kafka.Producer,
+	gracePeriod time.Duration,
+) service.AccountService {
+	return &accountService{
+		userRepo:      userRepo,
+		userService:   userService,
+		authService:   authService,
+		exportService: exportService,
+		kafkaProducer: kafkaProducer,
+		gracePeriod:   gracePeriod,
+	}
+}
+
+// ScheduleDeletion verifies password and schedules permanent account deletion after grace period
+func (s *accountService) ScheduleDeletion(ctx context.Context, userID uuid.UUID, password string) (*entity.User, error) {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	if err := s.userService.ValidatePassword(ctx, user, password); err != nil {
+		return nil, fmt.Errorf("invalid password")
+	}
+
+	if user.IsDeletionScheduled() {
+		return nil, fmt.Errorf("account deletion already scheduled")
+	}
+
+	now := time.Now()
+	scheduledFor := now.Add(s.gracePeriod)
+
+	if err := s.userRepo.ScheduleDeletion(ctx, userID, scheduledFor); err != nil {
+		return nil, err
+	}
+	user.DeletionScheduledAt = &scheduledFor
+
+	firstName := ""
+	if user.FirstName != nil {
+		firstName = *user.FirstName
+	}
+
+	event := &kafka.AccountDeletionScheduledEvent{
+		EventID:      kafka.NewEventID(),
+		UserID:       user.ID.String(),
+		Email:        user.Email,
+		Username:     user.Username,
+		FirstName:    firstName,
+		RequestedAt:  now,
+		ScheduledFor: scheduledFor,
+	}
+
+	if err := s.kafkaProducer.PublishAccountDeletionScheduledEvent(ctx, event); err != nil {
+		fmt.Printf("Warning: failed to publish account deletion scheduled event: %v\n", err)
+	}
+
+	return user, nil
+}
+
+// CancelDeletion cancels scheduled account deletion during grace period
+func (s *accountService) CancelDeletion(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	if err := s.userRepo.CancelDeletion(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	return user, nil
+}
+
+// DeleteDueAccounts permanently deletes accounts whose grace period has passed.
+// A failed account is left scheduled and retried on the next run.
+func (s *accountService) DeleteDueAccounts(ctx context.Context) (int, error) {
+	users, err := s.userRepo.GetDueForDeletion(ctx, time.Now(), deletionBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, user := range users {
+		if err := s.deleteAccount(ctx, user.ID); err != nil {
+			fmt.Printf("Warning: failed to delete account %s: %v\n", user.ID, err)
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// deleteAccount tells other services to purge user data and then removes the user.
+// Every step is idempotent, so the whole sequence can be repeated after a partial failure.
+func (s *accountService) deleteAccount(ctx context.Context, userID uuid.UUID) error {
+	event := &kafka.UserDeletedEvent{
+		EventID:   kafka.NewEventID(),
+		UserID:    userID.String(),
+		DeletedAt: time.Now(),
+	}
+
+	if err := s.kafkaProducer.PublishUserDeletedEvent(ctx, event); err != nil {
+		return err
+	}
+
+	if _, err := s.authService.RevokeAllSessions(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	if err := s.exportService.RemoveUserArchives(ctx, userID); err != nil {
+		return fmt.Errorf("failed to remove data exports: %w", err)
+	}
+
+	if err := s.userRepo.HardDelete(ctx, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
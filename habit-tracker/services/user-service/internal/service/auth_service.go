@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"user-service/internal/domain/entity"
@@ -23,6 +25,8 @@ type authService struct {
 	sessionStorage          *redis.SessionStorage
 	verificationTokenStore  *redis.VerificationTokenStorage
 	passwordResetTokenStore *redis.PasswordResetTokenStorage
+	magicLinkTokenStore     *redis.MagicLinkTokenStorage
+	emailChangeTokenStore   *redis.EmailChangeTokenStorage
 	tokenManager            *pkgjwt.TokenManager
 	kafkaProducer           *kafka.Producer
 }
@@ -34,6 +38,8 @@ func NewAuthService(
 	sessionStorage *redis.SessionStorage,
 	verificationTokenStore *redis.VerificationTokenStorage,
 	passwordResetTokenStore *redis.PasswordResetTokenStorage,
+	magicLinkTokenStore *redis.MagicLinkTokenStorage,
+	emailChangeTokenStore *redis.EmailChangeTokenStorage,
 	tokenManager *pkgjwt.TokenManager,
 	kafkaProducer *kafka.Producer,
 ) service.AuthService {
@@ -43,6 +49,8 @@ func NewAuthService(
 		sessionStorage:          sessionStorage,
 		verificationTokenStore:  verificationTokenStore,
 		passwordResetTokenStore: passwordResetTokenStore,
+		magicLinkTokenStore:     magicLinkTokenStore,
+		emailChangeTokenStore:   emailChangeTokenStore,
 		tokenManager:            tokenManager,
 		kafkaProducer:           kafkaProducer,
 	}
@@ -146,6 +154,8 @@ func (s *authService) Logout(ctx context.Context, userID uuid.UUID, sessionID uu
 		return fmt.Errorf("failed to delete session: %w", err)
 	}
 
+	s.publishSessionsRevoked(ctx, userID, []uuid.UUID{sessionID})
+
 	return nil
 }
 
@@ -205,6 +215,33 @@ func (s *authService) ValidateAccessToken(ctx context.Context, accessToken strin
 	return claims.UserID, claims.SessionID, nil
 }
 
+// GetJWKS returns public keys used to verify issued tokens
+func (s *authService) GetJWKS(ctx context.Context) pkgjwt.JWKS {
+	return s.tokenManager.JWKS()
+}
+
+// CheckSession reports whether session is still active and belongs to user
+func (s *authService) CheckSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) (bool, error) {
+	session, err := s.sessionStorage.Get(ctx, sessionID)
+	if errors.Is(err, redis.ErrSessionNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		// Not knowing must not look like a revoked session, that would sign every user out during an outage
+		return false, fmt.Errorf("failed to check session: %w", err)
+	}
+
+	if session.UserID != userID {
+		return false, nil
+	}
+
+	if err := s.sessionStorage.UpdateLastActivity(ctx, sessionID); err != nil {
+		// TODO: Log error but don't fail
+	}
+
+	return true, nil
+}
+
 // GetUserSessions retrieves all active sessions for a user
 func (s *authService) GetUserSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
 	// Get from Redis first (active sessions)
@@ -239,6 +276,8 @@ func (s *authService) RevokeSession(ctx context.Context, userID uuid.UUID, sessi
 		return fmt.Errorf("failed to delete session: %w", err)
 	}
 
+	s.publishSessionsRevoked(ctx, userID, []uuid.UUID{sessionID})
+
 	return nil
 }
 
@@ -259,9 +298,31 @@ func (s *authService) RevokeAllSessions(ctx context.Context, userID uuid.UUID) (
 		return 0, fmt.Errorf("failed to delete sessions: %w", err)
 	}
 
+	s.publishSessionsRevoked(ctx, userID, nil)
+
 	return count, nil
 }
 
+// publishSessionsRevoked notifies other services (e.g. gateway token caches) about revoked sessions.
+// An empty session list means all sessions of the user were revoked.
+func (s *authService) publishSessionsRevoked(ctx context.Context, userID uuid.UUID, sessionIDs []uuid.UUID) {
+	ids := make([]string, 0, len(sessionIDs))
+	for _, id := range sessionIDs {
+		ids = append(ids, id.String())
+	}
+
+	event := &kafka.SessionsRevokedEvent{
+		EventID:    kafka.NewEventID(),
+		UserID:     userID.String(),
+		SessionIDs: ids,
+		RevokedAt:  time.Now(),
+	}
+
+	if err := s.kafkaProducer.PublishSessionsRevokedEvent(ctx, event); err != nil {
+		fmt.Printf("Warning: failed to publish sessions revoked event: %v\n", err)
+	}
+}
+
 // createSession creates a new session and generates tokens
 func (s *authService) createSession(
 	ctx context.Context,
@@ -332,21 +393,16 @@ func (s *authService) VerifyEmail(ctx context.Context, token string) (*entity.Us
 		return user, nil
 	}
 
-	emailVerified := true
-	updateData := &entity.UserUpdate{
-		EmailVerified: &emailVerified,
-	}
-
-	updatedUser, err := s.userService.UpdateUser(ctx, userID, updateData)
-	if err != nil {
+	if err := s.userService.VerifyEmail(ctx, userID); err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
+	user.EmailVerified = true
 
 	if err := s.verificationTokenStore.DeleteToken(ctx, token); err != nil {
 		fmt.Printf("Warning: failed to delete verification token: %v\n", err)
 	}
 
-	return updatedUser, nil
+	return user, nil
 }
 
 // ResendVerificationEmail resends verification email
@@ -467,3 +523,255 @@ func (s *authService) ResetPassword(ctx context.Context, token, newPassword stri
 
 	return nil
 }
+
+// RequestMagicLink emails a single-use sign-in link
+func (s *authService) RequestMagicLink(ctx context.Context, email string) error {
+	user, err := s.userService.GetUserByEmail(ctx, email)
+	if err != nil {
+		// Don't reveal if email exists - always return success
+		return nil
+	}
+
+	if !user.IsActive || !user.EmailVerified {
+		return nil
+	}
+
+	token, err := s.magicLinkTokenStore.GenerateToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate magic link token: %w", err)
+	}
+
+	if err := s.magicLinkTokenStore.StoreToken(ctx, token, user.ID.String()); err != nil {
+		return fmt.Errorf("failed to store magic link token: %w", err)
+	}
+
+	firstName := ""
+	if user.FirstName != nil {
+		firstName = *user.FirstName
+	}
+
+	now := time.Now()
+	event := &kafka.MagicLinkRequestedEvent{
+		EventID:     kafka.NewEventID(),
+		UserID:      user.ID.String(),
+		Email:       user.Email,
+		Username:    user.Username,
+		FirstName:   firstName,
+		Token:       token,
+		RequestedAt: now,
+		ExpiresAt:   now.Add(s.magicLinkTokenStore.TTL()),
+	}
+
+	if err := s.kafkaProducer.PublishMagicLinkRequestedEvent(ctx, event); err != nil {
+		fmt.Printf("Warning: failed to publish magic link requested event: %v\n", err)
+	}
+
+	return nil
+}
+
+// ConsumeMagicLink signs user in with a magic link token and creates session
+func (s *authService) ConsumeMagicLink(
+	ctx context.Context,
+	token string,
+	ipAddress *net.IP,
+	userAgent *string,
+) (*entity.User, *service.TokenPair, error) {
+	userIDStr, err := s.magicLinkTokenStore.ConsumeToken(ctx, token)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid or expired magic link: %w", err)
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	if !user.IsActive {
+		return nil, nil, fmt.Errorf("account is deactivated")
+	}
+
+	tokenPair, err := s.createSession(ctx, user.ID, ipAddress, userAgent)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return user, tokenPair, nil
+}
+
+// RequestEmailChange verifies password and sends confirmation link to the new address
+func (s *authService) RequestEmailChange(ctx context.Context, userID, sessionID uuid.UUID, password, newEmail string) error {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	if err := s.userService.ValidatePassword(ctx, user, password); err != nil {
+		return fmt.Errorf("invalid password")
+	}
+
+	if strings.EqualFold(user.Email, newEmail) {
+		return fmt.Errorf("new email must differ from current email")
+	}
+
+	if _, err := s.userService.GetUserByEmail(ctx, newEmail); err == nil {
+		return fmt.Errorf("email already registered")
+	}
+
+	confirmToken, err := s.emailChangeTokenStore.GenerateToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate confirm token: %w", err)
+	}
+
+	undoToken, err := s.emailChangeTokenStore.GenerateToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate undo token: %w", err)
+	}
+
+	request := &redis.EmailChangeRequest{
+		UserID:       user.ID.String(),
+		SessionID:    sessionID.String(),
+		OldEmail:     user.Email,
+		NewEmail:     newEmail,
+		ConfirmToken: confirmToken,
+		UndoToken:    undoToken,
+	}
+
+	if err := s.emailChangeTokenStore.StoreRequest(ctx, request); err != nil {
+		return fmt.Errorf("failed to store email change request: %w", err)
+	}
+
+	firstName := ""
+	if user.FirstName != nil {
+		firstName = *user.FirstName
+	}
+
+	event := &kafka.EmailChangeRequestedEvent{
+		EventID:      kafka.NewEventID(),
+		UserID:       user.ID.String(),
+		Username:     user.Username,
+		FirstName:    firstName,
+		OldEmail:     user.Email,
+		NewEmail:     newEmail,
+		ConfirmToken: confirmToken,
+		UndoToken:    undoToken,
+		RequestedAt:  time.Now(),
+	}
+
+	if err := s.kafkaProducer.PublishEmailChangeRequestedEvent(ctx, event); err != nil {
+		return fmt.Errorf("failed to publish email change requested event: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange applies email change, revokes all sessions except the requesting one and invalidates magic links
+func (s *authService) ConfirmEmailChange(ctx context.Context, token string) (*entity.User, int, error) {
+	request, err := s.emailChangeTokenStore.ConsumeConfirmToken(ctx, token)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid or expired email change token: %w", err)
+	}
+
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if err := s.userService.ChangeEmail(ctx, userID, request.OldEmail, request.NewEmail); err != nil {
+		return nil, 0, err
+	}
+
+	keepSessionID, err := uuid.Parse(request.SessionID)
+	if err != nil {
+		keepSessionID = uuid.Nil
+	}
+
+	revoked, err := s.revokeOtherSessions(ctx, userID, keepSessionID)
+	if err != nil {
+		fmt.Printf("Warning: failed to revoke sessions after email change: %v\n", err)
+	}
+
+	// Links sent to the old address must not sign in any more
+	if err := s.magicLinkTokenStore.DeleteUserTokens(ctx, request.UserID); err != nil {
+		fmt.Printf("Warning: failed to invalidate magic links after email change: %v\n", err)
+	}
+
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("user not found: %w", err)
+	}
+
+	return user, revoked, nil
+}
+
+// UndoEmailChange cancels pending email change or reverts applied one, returns true if reverted
+func (s *authService) UndoEmailChange(ctx context.Context, token string) (bool, error) {
+	request, err := s.emailChangeTokenStore.ConsumeUndoToken(ctx, token)
+	if err != nil {
+		return false, fmt.Errorf("invalid or expired undo token: %w", err)
+	}
+
+	cancelled, err := s.emailChangeTokenStore.DeleteConfirmToken(ctx, request.ConfirmToken)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel email change: %w", err)
+	}
+	if cancelled {
+		return false, nil
+	}
+
+	userID, err := uuid.Parse(request.UserID)
+	if err != nil {
+		return false, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	if err := s.userService.ChangeEmail(ctx, userID, request.NewEmail, request.OldEmail); err != nil {
+		return false, err
+	}
+
+	// The change was not made by the account owner, so nobody keeps their session
+	if _, err := s.RevokeAllSessions(ctx, userID); err != nil {
+		fmt.Printf("Warning: failed to revoke sessions after email change undo: %v\n", err)
+	}
+
+	// Links sent to the reverted address must not sign in any more
+	if err := s.magicLinkTokenStore.DeleteUserTokens(ctx, request.UserID); err != nil {
+		fmt.Printf("Warning: failed to invalidate magic links after email change undo: %v\n", err)
+	}
+
+	return true, nil
+}
+
+// revokeOtherSessions revokes all user sessions except keepSessionID
+func (s *authService) revokeOtherSessions(ctx context.Context, userID, keepSessionID uuid.UUID) (int, error) {
+	sessions, err := s.GetUserSessions(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := make([]uuid.UUID, 0, len(sessions))
+	for _, session := range sessions {
+		if session.ID == keepSessionID {
+			continue
+		}
+
+		if err := s.sessionStorage.Delete(ctx, session.ID); err != nil {
+			return len(revoked), fmt.Errorf("failed to delete session from cache: %w", err)
+		}
+
+		if err := s.sessionRepo.Delete(ctx, session.ID); err != nil {
+			return len(revoked), fmt.Errorf("failed to delete session: %w", err)
+		}
+
+		revoked = append(revoked, session.ID)
+	}
+
+	if len(revoked) > 0 {
+		s.publishSessionsRevoked(ctx, userID, revoked)
+	}
+
+	return len(revoked), nil
+}
@@ -0,0 +1,281 @@
+package service_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"user-service/internal/domain/entity"
+	"user-service/internal/domain/repository"
+	domainservice "user-service/internal/domain/service"
+	"user-service/internal/infrastructure/kafka"
+	"user-service/internal/infrastructure/redis"
+	"user-service/internal/service"
+	pkgjwt "user-service/pkg/jwt"
+	eventspb "user-service/proto/events/v1"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	kafkago "github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeUserService keeps users in memory, passwords are stored as is
+type fakeUserService struct {
+	domainservice.UserService
+
+	mu    sync.Mutex
+	users map[uuid.UUID]*entity.User
+}
+
+func (s *fakeUserService) add(user *entity.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copied := *user
+	s.users[user.ID] = &copied
+}
+
+func (s *fakeUserService) GetUserByID(_ context.Context, id uuid.UUID) (*entity.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return nil, fmt.Errorf("user not found")
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (s *fakeUserService) GetUserByEmail(_ context.Context, email string) (*entity.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, user := range s.users {
+		if strings.EqualFold(user.Email, email) {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, fmt.Errorf("user not found")
+}
+
+func (s *fakeUserService) ValidatePassword(_ context.Context, user *entity.User, password string) error {
+	if user.PasswordHash != password {
+		return fmt.Errorf("invalid password")
+	}
+	return nil
+}
+
+func (s *fakeUserService) ChangeEmail(_ context.Context, userID uuid.UUID, oldEmail, newEmail string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[userID]
+	if !ok || user.Email != oldEmail {
+		return fmt.Errorf("failed to change email: email was changed concurrently")
+	}
+	for _, other := range s.users {
+		if other.ID != userID && strings.EqualFold(other.Email, newEmail) {
+			return fmt.Errorf("failed to change email: email already registered")
+		}
+	}
+
+	user.Email = newEmail
+	return nil
+}
+
+// fakeSessionRepository stands in for PostgreSQL, Redis is the primary session storage
+type fakeSessionRepository struct {
+	repository.SessionRepository
+}
+
+func (fakeSessionRepository) Create(context.Context, *entity.Session) error {
+	return nil
+}
+
+func (fakeSessionRepository) Delete(context.Context, uuid.UUID) error {
+	return nil
+}
+
+func (fakeSessionRepository) DeleteByUserID(context.Context, uuid.UUID) error {
+	return nil
+}
+
+// memoryWriter keeps published messages in memory instead of sending them to Kafka
+type memoryWriter struct {
+	mu       sync.Mutex
+	messages []kafkago.Message
+}
+
+func (w *memoryWriter) WriteMessages(_ context.Context, msgs ...kafkago.Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.messages = append(w.messages, msgs...)
+	return nil
+}
+
+func (w *memoryWriter) Close() error {
+	return nil
+}
+
+// authFixture wires the auth service to in-memory Redis and fake user and session stores
+type authFixture struct {
+	auth     domainservice.AuthService
+	redis    *miniredis.Miniredis
+	writer   *memoryWriter
+	users    *fakeUserService
+	sessions *redis.SessionStorage
+	tokens   *pkgjwt.TokenManager
+
+	magicLinks *redis.MagicLinkTokenStorage
+}
+
+func newAuthFixture(t *testing.T) *authFixture {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	keySet, err := pkgjwt.NewKeySet([]*pkgjwt.SigningKey{
+		{ID: "test", Method: jwt.SigningMethodEdDSA, PrivateKey: privateKey},
+	}, "test")
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+	tokens := pkgjwt.NewTokenManager(keySet, 15*time.Minute, 24*time.Hour, "user-service")
+
+	writer := &memoryWriter{}
+	producer := kafka.NewProducerWithWriters(writer, writer)
+
+	users := &fakeUserService{users: make(map[uuid.UUID]*entity.User)}
+	sessions := redis.NewSessionStorage(client, 24*time.Hour)
+	magicLinks := redis.NewMagicLinkTokenStorage(client)
+
+	auth := service.NewAuthService(
+		users,
+		fakeSessionRepository{},
+		sessions,
+		redis.NewVerificationTokenStorage(client),
+		redis.NewPasswordResetTokenStorage(client),
+		magicLinks,
+		redis.NewEmailChangeTokenStorage(client),
+		tokens,
+		producer,
+	)
+
+	return &authFixture{auth: auth, redis: mr, writer: writer, users: users, sessions: sessions, tokens: tokens, magicLinks: magicLinks}
+}
+
+// addUser registers an active user with a verified email
+func (f *authFixture) addUser(t *testing.T, email, password string) *entity.User {
+	t.Helper()
+
+	user := &entity.User{
+		ID:            uuid.New(),
+		Email:         email,
+		Username:      strings.Split(email, "@")[0],
+		PasswordHash:  password,
+		IsActive:      true,
+		EmailVerified: true,
+		Timezone:      "UTC",
+	}
+	f.users.add(user)
+	return user
+}
+
+// addSession stores a session of the user as if they had signed in
+func (f *authFixture) addSession(t *testing.T, userID uuid.UUID) uuid.UUID {
+	t.Helper()
+
+	now := time.Now()
+	session := &entity.Session{
+		ID:             uuid.New(),
+		UserID:         userID,
+		TokenHash:      uuid.NewString(),
+		ExpiresAt:      now.Add(24 * time.Hour),
+		CreatedAt:      now,
+		LastActivityAt: now,
+	}
+	if err := f.sessions.Set(context.Background(), session); err != nil {
+		t.Fatalf("failed to store session: %v", err)
+	}
+	return session.ID
+}
+
+func (f *authFixture) sessionActive(t *testing.T, userID, sessionID uuid.UUID) bool {
+	t.Helper()
+
+	active, err := f.auth.CheckSession(context.Background(), userID, sessionID)
+	if err != nil {
+		t.Fatalf("CheckSession() error = %v", err)
+	}
+	return active
+}
+
+// storedTokens returns tokens that were stored in Redis under the key prefix
+func (f *authFixture) storedTokens(prefix string) []string {
+	var tokens []string
+	for _, key := range f.redis.Keys() {
+		if strings.HasPrefix(key, prefix) {
+			tokens = append(tokens, strings.TrimPrefix(key, prefix))
+		}
+	}
+	return tokens
+}
+
+// events decodes the published events of the given type
+func (f *authFixture) events(t *testing.T, eventType eventspb.EventType) []*eventspb.Event {
+	t.Helper()
+
+	f.writer.mu.Lock()
+	defer f.writer.mu.Unlock()
+
+	var events []*eventspb.Event
+	for _, message := range f.writer.messages {
+		var event eventspb.Event
+		if err := proto.Unmarshal(message.Value, &event); err != nil {
+			t.Fatalf("failed to unmarshal event: %v", err)
+		}
+		if event.EventType == eventType {
+			events = append(events, &event)
+		}
+	}
+	return events
+}
+
+func TestCheckSession(t *testing.T) {
+	f := newAuthFixture(t)
+	user := f.addUser(t, "ada@example.com", "password")
+	sessionID := f.addSession(t, user.ID)
+
+	if !f.sessionActive(t, user.ID, sessionID) {
+		t.Error("CheckSession() reported an active session as revoked")
+	}
+	if f.sessionActive(t, user.ID, uuid.New()) {
+		t.Error("CheckSession() reported an unknown session as active")
+	}
+	if f.sessionActive(t, uuid.New(), sessionID) {
+		t.Error("CheckSession() reported a session of another user as active")
+	}
+
+	// An outage is an error, not a revoked session
+	f.redis.SetError("LOADING Redis is loading the dataset in memory")
+	if active, err := f.auth.CheckSession(context.Background(), user.ID, sessionID); err == nil {
+		t.Errorf("CheckSession() = %v, nil during a Redis outage, want an error", active)
+	}
+}
@@ -0,0 +1,275 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"user-service/internal/infrastructure/redis"
+	eventspb "user-service/proto/events/v1"
+
+	"github.com/google/uuid"
+)
+
+const (
+	emailChangeConfirmPrefix = "email_change:confirm:"
+	emailChangeUndoPrefix    = "email_change:undo:"
+)
+
+// requestEmailChange requests the change from sessionID and returns the confirm and undo tokens
+func (f *authFixture) requestEmailChange(t *testing.T, userID, sessionID uuid.UUID, newEmail string) (string, string) {
+	t.Helper()
+
+	if err := f.auth.RequestEmailChange(context.Background(), userID, sessionID, "password", newEmail); err != nil {
+		t.Fatalf("RequestEmailChange() error = %v", err)
+	}
+
+	confirmTokens := f.storedTokens(emailChangeConfirmPrefix)
+	if len(confirmTokens) != 1 {
+		t.Fatalf("RequestEmailChange() stored %d confirm tokens, want 1", len(confirmTokens))
+	}
+
+	data, err := f.redis.Get(emailChangeConfirmPrefix + confirmTokens[0])
+	if err != nil {
+		t.Fatalf("failed to read email change request: %v", err)
+	}
+	var request redis.EmailChangeRequest
+	if err := json.Unmarshal([]byte(data), &request); err != nil {
+		t.Fatalf("failed to unmarshal email change request: %v", err)
+	}
+
+	// The confirm link goes to the new address and the undo link to the old one
+	events := f.events(t, eventspb.EventType_EVENT_TYPE_EMAIL_CHANGE_REQUESTED)
+	if len(events) != 1 {
+		t.Fatalf("RequestEmailChange() published %d events, want 1", len(events))
+	}
+	event := events[0].GetEmailChangeRequested()
+	if event.GetNewEmail() != newEmail || event.GetConfirmToken() != request.ConfirmToken || event.GetUndoToken() != request.UndoToken {
+		t.Fatalf("published event %+v does not match the stored request", event)
+	}
+	return request.ConfirmToken, request.UndoToken
+}
+
+func (f *authFixture) email(t *testing.T, userID uuid.UUID) string {
+	t.Helper()
+
+	user, err := f.users.GetUserByID(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	return user.Email
+}
+
+func TestRequestEmailChangeRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		newEmail string
+	}{
+		{name: "wrong password", password: "wrong", newEmail: "new@example.com"},
+		{name: "same email", password: "password", newEmail: "ADA@example.com"},
+		{name: "email of another account", password: "password", newEmail: "grace@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			user := f.addUser(t, "ada@example.com", "password")
+			f.addUser(t, "grace@example.com", "password")
+			sessionID := f.addSession(t, user.ID)
+
+			if err := f.auth.RequestEmailChange(context.Background(), user.ID, sessionID, tt.password, tt.newEmail); err == nil {
+				t.Fatal("RequestEmailChange() error = nil")
+			}
+			if tokens := f.storedTokens("email_change:"); len(tokens) != 0 {
+				t.Errorf("RequestEmailChange() stored %d tokens, want 0", len(tokens))
+			}
+			if events := f.events(t, eventspb.EventType_EVENT_TYPE_EMAIL_CHANGE_REQUESTED); len(events) != 0 {
+				t.Errorf("RequestEmailChange() published %d events, want 0", len(events))
+			}
+		})
+	}
+}
+
+func TestConfirmEmailChange(t *testing.T) {
+	f := newAuthFixture(t)
+	user := f.addUser(t, "ada@example.com", "password")
+	requestingSession := f.addSession(t, user.ID)
+	otherSessions := []uuid.UUID{f.addSession(t, user.ID), f.addSession(t, user.ID)}
+
+	// The change is pending until confirmed from the new address
+	confirmToken, _ := f.requestEmailChange(t, user.ID, requestingSession, "ada@new.example.com")
+	if email := f.email(t, user.ID); email != "ada@example.com" {
+		t.Fatalf("email before confirmation = %s, want ada@example.com", email)
+	}
+
+	changed, revoked, err := f.auth.ConfirmEmailChange(context.Background(), confirmToken)
+	if err != nil {
+		t.Fatalf("ConfirmEmailChange() error = %v", err)
+	}
+	if changed.Email != "ada@new.example.com" || f.email(t, user.ID) != "ada@new.example.com" {
+		t.Errorf("email after confirmation = %s, want ada@new.example.com", changed.Email)
+	}
+
+	if revoked != len(otherSessions) {
+		t.Errorf("ConfirmEmailChange() revoked %d sessions, want %d", revoked, len(otherSessions))
+	}
+	if !f.sessionActive(t, user.ID, requestingSession) {
+		t.Error("requesting session was revoked")
+	}
+	for _, sessionID := range otherSessions {
+		if f.sessionActive(t, user.ID, sessionID) {
+			t.Errorf("session %s is still active", sessionID)
+		}
+	}
+
+	// Gateway token caches drop the revoked sessions from the event
+	revokedEvents := f.events(t, eventspb.EventType_EVENT_TYPE_SESSIONS_REVOKED)
+	if len(revokedEvents) != 1 || len(revokedEvents[0].GetSessionsRevoked().GetSessionIds()) != len(otherSessions) {
+		t.Errorf("published sessions revoked events = %v, want one with %d sessions", revokedEvents, len(otherSessions))
+	}
+
+	if _, _, err := f.auth.ConfirmEmailChange(context.Background(), confirmToken); err == nil {
+		t.Error("second ConfirmEmailChange() accepted a used token")
+	}
+}
+
+func TestConfirmEmailChangeFailsWhenEmailTakenMeanwhile(t *testing.T) {
+	f := newAuthFixture(t)
+	user := f.addUser(t, "ada@example.com", "password")
+	sessionID := f.addSession(t, user.ID)
+
+	confirmToken, _ := f.requestEmailChange(t, user.ID, sessionID, "ada@new.example.com")
+	f.addUser(t, "ada@new.example.com", "password")
+
+	if _, _, err := f.auth.ConfirmEmailChange(context.Background(), confirmToken); err == nil {
+		t.Fatal("ConfirmEmailChange() took an email registered in the meantime")
+	}
+	if email := f.email(t, user.ID); email != "ada@example.com" {
+		t.Errorf("email = %s, want ada@example.com", email)
+	}
+	if !f.sessionActive(t, user.ID, sessionID) {
+		t.Error("session was revoked by a failed change")
+	}
+}
+
+func TestUndoPendingEmailChange(t *testing.T) {
+	f := newAuthFixture(t)
+	user := f.addUser(t, "ada@example.com", "password")
+	sessionID := f.addSession(t, user.ID)
+
+	confirmToken, undoToken := f.requestEmailChange(t, user.ID, sessionID, "ada@new.example.com")
+
+	reverted, err := f.auth.UndoEmailChange(context.Background(), undoToken)
+	if err != nil {
+		t.Fatalf("UndoEmailChange() error = %v", err)
+	}
+	if reverted {
+		t.Error("UndoEmailChange() reported a revert of a change that was never applied")
+	}
+
+	// A cancelled change can't be confirmed any more
+	if _, _, err := f.auth.ConfirmEmailChange(context.Background(), confirmToken); err == nil {
+		t.Error("ConfirmEmailChange() applied a cancelled change")
+	}
+	if email := f.email(t, user.ID); email != "ada@example.com" {
+		t.Errorf("email = %s, want ada@example.com", email)
+	}
+	if !f.sessionActive(t, user.ID, sessionID) {
+		t.Error("cancelling a pending change revoked the session")
+	}
+}
+
+func TestUndoAppliedEmailChange(t *testing.T) {
+	f := newAuthFixture(t)
+	user := f.addUser(t, "ada@example.com", "password")
+	requestingSession := f.addSession(t, user.ID)
+	otherSession := f.addSession(t, user.ID)
+
+	confirmToken, undoToken := f.requestEmailChange(t, user.ID, requestingSession, "ada@new.example.com")
+	if _, _, err := f.auth.ConfirmEmailChange(context.Background(), confirmToken); err != nil {
+		t.Fatalf("ConfirmEmailChange() error = %v", err)
+	}
+
+	reverted, err := f.auth.UndoEmailChange(context.Background(), undoToken)
+	if err != nil {
+		t.Fatalf("UndoEmailChange() error = %v", err)
+	}
+	if !reverted {
+		t.Error("UndoEmailChange() did not report the revert")
+	}
+	if email := f.email(t, user.ID); email != "ada@example.com" {
+		t.Errorf("email after undo = %s, want ada@example.com", email)
+	}
+
+	// Whoever requested the change loses access too
+	for _, sessionID := range []uuid.UUID{requestingSession, otherSession} {
+		if f.sessionActive(t, user.ID, sessionID) {
+			t.Errorf("session %s is still active after undo", sessionID)
+		}
+	}
+
+	if _, err := f.auth.UndoEmailChange(context.Background(), undoToken); err == nil {
+		t.Error("second UndoEmailChange() accepted a used token")
+	}
+}
+
+func TestUndoEmailChangeOutlivesConfirmToken(t *testing.T) {
+	f := newAuthFixture(t)
+	user := f.addUser(t, "ada@example.com", "password")
+	sessionID := f.addSession(t, user.ID)
+
+	confirmToken, undoToken := f.requestEmailChange(t, user.ID, sessionID, "ada@new.example.com")
+	if _, _, err := f.auth.ConfirmEmailChange(context.Background(), confirmToken); err != nil {
+		t.Fatalf("ConfirmEmailChange() error = %v", err)
+	}
+
+	// Past the confirm token lifetime the owner can still revert the change from the old address
+	f.redis.FastForward(3 * 24 * time.Hour)
+
+	reverted, err := f.auth.UndoEmailChange(context.Background(), undoToken)
+	if err != nil || !reverted {
+		t.Errorf("UndoEmailChange() = %v, %v, want true, nil", reverted, err)
+	}
+}
+
+func TestUndoEmailChangeRejectsUnknownToken(t *testing.T) {
+	f := newAuthFixture(t)
+	f.addUser(t, "ada@example.com", "password")
+
+	if _, err := f.auth.UndoEmailChange(context.Background(), "forged"); err == nil {
+		t.Error("UndoEmailChange() accepted a forged token")
+	}
+}
+
+func TestEmailChangeInvalidatesMagicLinks(t *testing.T) {
+	f := newAuthFixture(t)
+	user := f.addUser(t, "ada@example.com", "password")
+	sessionID := f.addSession(t, user.ID)
+
+	oldAddressLink := f.requestMagicLink(t, "ada@example.com")
+
+	confirmToken, undoToken := f.requestEmailChange(t, user.ID, sessionID, "ada@new.example.com")
+	if _, _, err := f.auth.ConfirmEmailChange(context.Background(), confirmToken); err != nil {
+		t.Fatalf("ConfirmEmailChange() error = %v", err)
+	}
+	if _, _, err := f.auth.ConsumeMagicLink(context.Background(), oldAddressLink, nil, nil); err == nil {
+		t.Error("a link sent to the old address signed in after the email change")
+	}
+
+	if err := f.auth.RequestMagicLink(context.Background(), "ada@new.example.com"); err != nil {
+		t.Fatalf("RequestMagicLink() error = %v", err)
+	}
+	newAddressLinks := f.storedTokens(magicLinkPrefix)
+	if len(newAddressLinks) != 1 {
+		t.Fatalf("RequestMagicLink() stored %d tokens, want 1", len(newAddressLinks))
+	}
+
+	if _, err := f.auth.UndoEmailChange(context.Background(), undoToken); err != nil {
+		t.Fatalf("UndoEmailChange() error = %v", err)
+	}
+	if _, _, err := f.auth.ConsumeMagicLink(context.Background(), newAddressLinks[0], nil, nil); err == nil {
+		t.Error("a link sent to the reverted address signed in after the undo")
+	}
+}
@@ -0,0 +1,728 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"user-service/internal/domain/entity"
+	habitspb "user-service/proto/habits/v1"
+	notificationpb "user-service/proto/notification/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exportManifest describes contents of a data export archive
+type exportManifest struct {
+	ExportID    string         `json:"export_id"`
+	UserID      string         `json:"user_id"`
+	GeneratedAt time.Time      `json:"generated_at"`
+	Counts      map[string]int `json:"counts"`
+	Notes       []string       `json:"notes"`
+}
+
+// habitRecord is a habit as written to the export archive
+type habitRecord struct {
+	ID                        string     `json:"id"`
+	Name                      string     `json:"name"`
+	Description               *string    `json:"description,omitempty"`
+	Color                     *string    `json:"color,omitempty"`
+	ScheduleType              string     `json:"schedule_type"`
+	IntervalDays              *int32     `json:"interval_days,omitempty"`
+	WeeklyDays                []int32    `json:"weekly_days,omitempty"`
+	TimezoneOffsetHours       int32      `json:"timezone_offset_hours"`
+	Streak                    int32      `json:"streak"`
+	NextDeadlineUTC           *time.Time `json:"next_deadline_utc,omitempty"`
+	ConfirmedForCurrentPeriod bool       `json:"confirmed_for_current_period"`
+	LastConfirmedAt           *time.Time `json:"last_confirmed_at,omitempty"`
+	IsActive                  bool       `json:"is_active"`
+	GroupID                   *string    `json:"group_id,omitempty"`
+	Icon                      *string    `json:"icon,omitempty"`
+	Position                  int32      `json:"position"`
+	Tags                      []string   `json:"tags,omitempty"`
+	Visibility                string     `json:"visibility"`
+	CreatedAt                 *time.Time `json:"created_at,omitempty"`
+	UpdatedAt                 *time.Time `json:"updated_at,omitempty"`
+	ArchivedAt                *time.Time `json:"archived_at,omitempty"`
+}
+
+// confirmationRecord is a habit confirmation as written to the export archive
+type confirmationRecord struct {
+	ID               string     `json:"id"`
+	HabitID          string     `json:"habit_id"`
+	ConfirmedAt      *time.Time `json:"confirmed_at,omitempty"`
+	ConfirmedForDate string     `json:"confirmed_for_date"`
+	Notes            *string    `json:"notes,omitempty"`
+	AttachmentIDs    []string   `json:"attachment_ids,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+}
+
+// pauseRecord is a habit pause as written to the export archive
+type pauseRecord struct {
+	ID        string     `json:"id"`
+	HabitID   string     `json:"habit_id"`
+	StartDate string     `json:"start_date"`
+	EndDate   string     `json:"end_date"`
+	Reason    *string    `json:"reason,omitempty"`
+	ResumedAt *time.Time `json:"resumed_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// groupRecord is a habit group as written to the export archive
+type groupRecord struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Color     *string    `json:"color,omitempty"`
+	Icon      *string    `json:"icon,omitempty"`
+	Position  int32      `json:"position"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// templateRecord is a private habit template as written to the export archive
+type templateRecord struct {
+	ID              string     `json:"id"`
+	Name            string     `json:"name"`
+	Description     *string    `json:"description,omitempty"`
+	Color           *string    `json:"color,omitempty"`
+	Icon            *string    `json:"icon,omitempty"`
+	Tags            []string   `json:"tags,omitempty"`
+	ScheduleType    string     `json:"schedule_type"`
+	IntervalDays    *int32     `json:"interval_days,omitempty"`
+	WeeklyDays      []int32    `json:"weekly_days,omitempty"`
+	SuggestedTarget *string    `json:"suggested_target,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+}
+
+// partnerRecord is a habit partnership or invitation as written to the export archive
+type partnerRecord struct {
+	ID              string     `json:"id"`
+	HabitID         string     `json:"habit_id"`
+	HabitName       string     `json:"habit_name"`
+	OwnerID         string     `json:"owner_id"`
+	OwnerUsername   string     `json:"owner_username"`
+	PartnerID       string     `json:"partner_id"`
+	PartnerUsername string     `json:"partner_username"`
+	Status          string     `json:"status"`
+	LastNudgedAt    *time.Time `json:"last_nudged_at,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	RespondedAt     *time.Time `json:"responded_at,omitempty"`
+}
+
+// challengeRecord is a challenge the user created or joined as written to the export archive
+type challengeRecord struct {
+	ID               string     `json:"id"`
+	CreatorID        string     `json:"creator_id"`
+	Name             string     `json:"name"`
+	Description      *string    `json:"description,omitempty"`
+	StartDate        string     `json:"start_date"`
+	EndDate          string     `json:"end_date"`
+	InviteCode       string     `json:"invite_code"`
+	ParticipantCount int32      `json:"participant_count"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
+
+// achievementsRecord is the XP, level and unlocked achievements as written to the export archive
+type achievementsRecord struct {
+	XP           int32                `json:"xp"`
+	Level        int32                `json:"level"`
+	LevelXP      int32                `json:"level_xp"`
+	NextLevelXP  int32                `json:"next_level_xp"`
+	Achievements []*achievementRecord `json:"achievements"`
+}
+
+// achievementRecord is an unlocked achievement as written to the export archive
+type achievementRecord struct {
+	Code        string     `json:"code"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	XP          int32      `json:"xp"`
+	UnlockedAt  *time.Time `json:"unlocked_at,omitempty"`
+	HabitID     *string    `json:"habit_id,omitempty"`
+}
+
+// followRecord is a follow or follow request as written to the export archive
+type followRecord struct {
+	FollowerID       string     `json:"follower_id"`
+	FollowerUsername string     `json:"follower_username"`
+	FolloweeID       string     `json:"followee_id"`
+	FolloweeUsername string     `json:"followee_username"`
+	Status           string     `json:"status"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	RespondedAt      *time.Time `json:"responded_at,omitempty"`
+}
+
+// feedItemRecord is an activity feed item as written to the export archive
+type feedItemRecord struct {
+	ID               string     `json:"id"`
+	Kind             string     `json:"kind"`
+	ActorID          string     `json:"actor_id"`
+	ActorUsername    string     `json:"actor_username"`
+	HabitID          string     `json:"habit_id"`
+	HabitName        string     `json:"habit_name"`
+	ConfirmedForDate *string    `json:"confirmed_for_date,omitempty"`
+	Streak           *int32     `json:"streak,omitempty"`
+	AchievementCode  *string    `json:"achievement_code,omitempty"`
+	AchievementName  *string    `json:"achievement_name,omitempty"`
+	OccurredAt       *time.Time `json:"occurred_at,omitempty"`
+}
+
+// journalEntryRecord is a journal entry as written to the export archive
+type journalEntryRecord struct {
+	ID              string     `json:"id"`
+	Date            string     `json:"date"`
+	Mood            *int32     `json:"mood,omitempty"`
+	Energy          *int32     `json:"energy,omitempty"`
+	Content         *string    `json:"content,omitempty"`
+	Tags            []string   `json:"tags,omitempty"`
+	ConfirmationIDs []string   `json:"confirmation_ids,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+// attachmentRecord is a photo as written to the export archive, File is its path in the archive
+type attachmentRecord struct {
+	ID             string     `json:"id"`
+	File           string     `json:"file"`
+	ContentType    string     `json:"content_type"`
+	SizeBytes      int64      `json:"size_bytes"`
+	Width          int32      `json:"width"`
+	Height         int32      `json:"height"`
+	ConfirmationID *string    `json:"confirmation_id,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+}
+
+// notificationRecord is a notification as written to the export archive
+type notificationRecord struct {
+	ID        string            `json:"id"`
+	Type      string            `json:"type"`
+	Status    string            `json:"status"`
+	Subject   string            `json:"subject"`
+	Content   string            `json:"content"`
+	Recipient string            `json:"recipient"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	SentAt    *time.Time        `json:"sent_at,omitempty"`
+	FailedAt  *time.Time        `json:"failed_at,omitempty"`
+	Error     *string           `json:"error,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt *time.Time        `json:"updated_at,omitempty"`
+}
+
+var (
+	sessionCSVHeader = []string{
+		"id", "ip_address", "user_agent", "created_at", "last_activity_at", "expires_at",
+	}
+	habitCSVHeader = []string{
+		"id", "name", "description", "color", "schedule_type", "interval_days", "weekly_days",
+		"timezone_offset_hours", "streak", "next_deadline_utc", "confirmed_for_current_period",
+		"last_confirmed_at", "is_active", "group_id", "icon", "position", "tags", "visibility",
+		"created_at", "updated_at", "archived_at",
+	}
+	confirmationCSVHeader = []string{
+		"id", "habit_id", "confirmed_at", "confirmed_for_date", "notes", "attachment_ids", "created_at",
+	}
+	pauseCSVHeader = []string{
+		"id", "habit_id", "start_date", "end_date", "reason", "resumed_at", "created_at",
+	}
+	groupCSVHeader = []string{
+		"id", "name", "color", "icon", "position", "created_at", "updated_at",
+	}
+	templateCSVHeader = []string{
+		"id", "name", "description", "color", "icon", "tags", "schedule_type", "interval_days", "weekly_days",
+		"suggested_target", "created_at",
+	}
+	partnerCSVHeader = []string{
+		"id", "habit_id", "habit_name", "owner_id", "owner_username", "partner_id", "partner_username", "status",
+		"last_nudged_at", "created_at", "responded_at",
+	}
+	challengeCSVHeader = []string{
+		"id", "creator_id", "name", "description", "start_date", "end_date", "invite_code", "participant_count",
+		"created_at", "updated_at",
+	}
+	achievementCSVHeader = []string{
+		"code", "name", "description", "xp", "unlocked_at", "habit_id",
+	}
+	followCSVHeader = []string{
+		"follower_id", "follower_username", "followee_id", "followee_username", "status", "created_at", "responded_at",
+	}
+	feedItemCSVHeader = []string{
+		"id", "kind", "actor_id", "actor_username", "habit_id", "habit_name", "confirmed_for_date", "streak",
+		"achievement_code", "achievement_name", "occurred_at",
+	}
+	journalEntryCSVHeader = []string{
+		"id", "date", "mood", "energy", "content", "tags", "confirmation_ids", "created_at", "updated_at",
+	}
+	attachmentCSVHeader = []string{
+		"id", "file", "content_type", "size_bytes", "width", "height", "confirmation_id", "created_at",
+	}
+	notificationCSVHeader = []string{
+		"id", "type", "status", "subject", "recipient", "sent_at", "failed_at", "error", "created_at",
+	}
+)
+
+func toHabitRecord(habit *habitspb.Habit) *habitRecord {
+	return &habitRecord{
+		ID:                        habit.Id,
+		Name:                      habit.Name,
+		Description:               habit.Description,
+		Color:                     habit.Color,
+		ScheduleType:              scheduleTypeName(habit.ScheduleType),
+		IntervalDays:              habit.IntervalDays,
+		WeeklyDays:                habit.WeeklyDays,
+		TimezoneOffsetHours:       habit.TimezoneOffsetHours,
+		Streak:                    habit.Streak,
+		NextDeadlineUTC:           fromProtoTime(habit.NextDeadlineUtc),
+		ConfirmedForCurrentPeriod: habit.ConfirmedForCurrentPeriod,
+		LastConfirmedAt:           fromProtoTime(habit.LastConfirmedAt),
+		IsActive:                  habit.IsActive,
+		GroupID:                   habit.GroupId,
+		Icon:                      habit.Icon,
+		Position:                  habit.Position,
+		Tags:                      habit.Tags,
+		Visibility:                enumName(habit.Visibility.String(), "HABIT_VISIBILITY_"),
+		CreatedAt:                 fromProtoTime(habit.CreatedAt),
+		UpdatedAt:                 fromProtoTime(habit.UpdatedAt),
+		ArchivedAt:                fromProtoTime(habit.ArchivedAt),
+	}
+}
+
+func toConfirmationRecord(confirmation *habitspb.HabitConfirmation) *confirmationRecord {
+	return &confirmationRecord{
+		ID:               confirmation.Id,
+		HabitID:          confirmation.HabitId,
+		ConfirmedAt:      fromProtoTime(confirmation.ConfirmedAt),
+		ConfirmedForDate: confirmation.ConfirmedForDate,
+		Notes:            confirmation.Notes,
+		AttachmentIDs:    confirmation.AttachmentIds,
+		CreatedAt:        fromProtoTime(confirmation.CreatedAt),
+	}
+}
+
+func toPauseRecord(pause *habitspb.HabitPause) *pauseRecord {
+	return &pauseRecord{
+		ID:        pause.Id,
+		HabitID:   pause.HabitId,
+		StartDate: pause.StartDate,
+		EndDate:   pause.EndDate,
+		Reason:    pause.Reason,
+		ResumedAt: fromProtoTime(pause.ResumedAt),
+		CreatedAt: fromProtoTime(pause.CreatedAt),
+	}
+}
+
+func toGroupRecord(group *habitspb.HabitGroup) *groupRecord {
+	return &groupRecord{
+		ID:        group.Id,
+		Name:      group.Name,
+		Color:     group.Color,
+		Icon:      group.Icon,
+		Position:  group.Position,
+		CreatedAt: fromProtoTime(group.CreatedAt),
+		UpdatedAt: fromProtoTime(group.UpdatedAt),
+	}
+}
+
+func toTemplateRecord(template *habitspb.HabitTemplate) *templateRecord {
+	return &templateRecord{
+		ID:              template.Id,
+		Name:            template.Name,
+		Description:     template.Description,
+		Color:           template.Color,
+		Icon:            template.Icon,
+		Tags:            template.Tags,
+		ScheduleType:    scheduleTypeName(template.ScheduleType),
+		IntervalDays:    template.IntervalDays,
+		WeeklyDays:      template.WeeklyDays,
+		SuggestedTarget: template.SuggestedTarget,
+		CreatedAt:       fromProtoTime(template.CreatedAt),
+	}
+}
+
+func toPartnerRecord(partner *habitspb.HabitPartner) *partnerRecord {
+	return &partnerRecord{
+		ID:              partner.Id,
+		HabitID:         partner.HabitId,
+		HabitName:       partner.HabitName,
+		OwnerID:         partner.OwnerId,
+		OwnerUsername:   partner.OwnerUsername,
+		PartnerID:       partner.PartnerId,
+		PartnerUsername: partner.PartnerUsername,
+		Status:          enumName(partner.Status.String(), "PARTNER_STATUS_"),
+		LastNudgedAt:    fromProtoTime(partner.LastNudgedAt),
+		CreatedAt:       fromProtoTime(partner.CreatedAt),
+		RespondedAt:     fromProtoTime(partner.RespondedAt),
+	}
+}
+
+func toChallengeRecord(challenge *habitspb.Challenge) *challengeRecord {
+	return &challengeRecord{
+		ID:               challenge.Id,
+		CreatorID:        challenge.CreatorId,
+		Name:             challenge.Name,
+		Description:      challenge.Description,
+		StartDate:        challenge.StartDate,
+		EndDate:          challenge.EndDate,
+		InviteCode:       challenge.InviteCode,
+		ParticipantCount: challenge.ParticipantCount,
+		CreatedAt:        fromProtoTime(challenge.CreatedAt),
+		UpdatedAt:        fromProtoTime(challenge.UpdatedAt),
+	}
+}
+
+// toAchievementsRecord keeps unlocked achievements only, the catalog is the same for every user
+func toAchievementsRecord(progress *habitspb.ListAchievementsResponse) *achievementsRecord {
+	record := &achievementsRecord{
+		XP:           progress.GetXp(),
+		Level:        progress.GetLevel(),
+		LevelXP:      progress.GetLevelXp(),
+		NextLevelXP:  progress.GetNextLevelXp(),
+		Achievements: []*achievementRecord{},
+	}
+
+	for _, achievement := range progress.GetAchievements() {
+		if !achievement.Unlocked {
+			continue
+		}
+		record.Achievements = append(record.Achievements, &achievementRecord{
+			Code:        achievement.Code,
+			Name:        achievement.Name,
+			Description: achievement.Description,
+			XP:          achievement.Xp,
+			UnlockedAt:  fromProtoTime(achievement.UnlockedAt),
+			HabitID:     achievement.HabitId,
+		})
+	}
+
+	return record
+}
+
+func toFollowRecord(follow *habitspb.Follow) *followRecord {
+	return &followRecord{
+		FollowerID:       follow.FollowerId,
+		FollowerUsername: follow.FollowerUsername,
+		FolloweeID:       follow.FolloweeId,
+		FolloweeUsername: follow.FolloweeUsername,
+		Status:           enumName(follow.Status.String(), "FOLLOW_STATUS_"),
+		CreatedAt:        fromProtoTime(follow.CreatedAt),
+		RespondedAt:      fromProtoTime(follow.RespondedAt),
+	}
+}
+
+func toFeedItemRecord(item *habitspb.FeedItem) *feedItemRecord {
+	return &feedItemRecord{
+		ID:               item.Id,
+		Kind:             enumName(item.Kind.String(), "FEED_ITEM_KIND_"),
+		ActorID:          item.ActorId,
+		ActorUsername:    item.ActorUsername,
+		HabitID:          item.HabitId,
+		HabitName:        item.HabitName,
+		ConfirmedForDate: item.ConfirmedForDate,
+		Streak:           item.Streak,
+		AchievementCode:  item.AchievementCode,
+		AchievementName:  item.AchievementName,
+		OccurredAt:       fromProtoTime(item.OccurredAt),
+	}
+}
+
+func toJournalEntryRecord(entry *habitspb.JournalEntry) *journalEntryRecord {
+	return &journalEntryRecord{
+		ID:              entry.Id,
+		Date:            entry.Date,
+		Mood:            entry.Mood,
+		Energy:          entry.Energy,
+		Content:         entry.Content,
+		Tags:            entry.Tags,
+		ConfirmationIDs: entry.ConfirmationIds,
+		CreatedAt:       fromProtoTime(entry.CreatedAt),
+		UpdatedAt:       fromProtoTime(entry.UpdatedAt),
+	}
+}
+
+func toAttachmentRecord(attachment *habitspb.Attachment) *attachmentRecord {
+	return &attachmentRecord{
+		ID:             attachment.Id,
+		File:           attachmentFileName(attachment),
+		ContentType:    attachment.ContentType,
+		SizeBytes:      attachment.SizeBytes,
+		Width:          attachment.Width,
+		Height:         attachment.Height,
+		ConfirmationID: attachment.ConfirmationId,
+		CreatedAt:      fromProtoTime(attachment.CreatedAt),
+	}
+}
+
+// attachmentFileName is the path of a photo in the export archive
+func attachmentFileName(attachment *habitspb.Attachment) string {
+	extension := ".jpg"
+	if attachment.ContentType == "image/png" {
+		extension = ".png"
+	}
+	return "habits/photos/" + attachment.Id + extension
+}
+
+func toNotificationRecord(notification *notificationpb.Notification) *notificationRecord {
+	return &notificationRecord{
+		ID:        notification.Id,
+		Type:      notification.Type,
+		Status:    notification.Status,
+		Subject:   notification.Subject,
+		Content:   notification.Content,
+		Recipient: notification.Recipient,
+		Metadata:  notification.Metadata,
+		SentAt:    fromProtoTime(notification.SentAt),
+		FailedAt:  fromProtoTime(notification.FailedAt),
+		Error:     notification.Error,
+		CreatedAt: fromProtoTime(notification.CreatedAt),
+		UpdatedAt: fromProtoTime(notification.UpdatedAt),
+	}
+}
+
+func sessionCSVRow(session *entity.SessionResponse) []string {
+	return []string{
+		session.ID.String(),
+		derefString(session.IPAddress),
+		derefString(session.UserAgent),
+		formatTime(&session.CreatedAt),
+		formatTime(&session.LastActivityAt),
+		formatTime(&session.ExpiresAt),
+	}
+}
+
+func habitCSVRow(habit *habitRecord) []string {
+	return []string{
+		habit.ID,
+		habit.Name,
+		derefString(habit.Description),
+		derefString(habit.Color),
+		habit.ScheduleType,
+		formatInt(habit.IntervalDays),
+		joinInts(habit.WeeklyDays),
+		strconv.Itoa(int(habit.TimezoneOffsetHours)),
+		strconv.Itoa(int(habit.Streak)),
+		formatTime(habit.NextDeadlineUTC),
+		strconv.FormatBool(habit.ConfirmedForCurrentPeriod),
+		formatTime(habit.LastConfirmedAt),
+		strconv.FormatBool(habit.IsActive),
+		derefString(habit.GroupID),
+		derefString(habit.Icon),
+		strconv.Itoa(int(habit.Position)),
+		strings.Join(habit.Tags, ";"),
+		habit.Visibility,
+		formatTime(habit.CreatedAt),
+		formatTime(habit.UpdatedAt),
+		formatTime(habit.ArchivedAt),
+	}
+}
+
+func confirmationCSVRow(confirmation *confirmationRecord) []string {
+	return []string{
+		confirmation.ID,
+		confirmation.HabitID,
+		formatTime(confirmation.ConfirmedAt),
+		confirmation.ConfirmedForDate,
+		derefString(confirmation.Notes),
+		strings.Join(confirmation.AttachmentIDs, ";"),
+		formatTime(confirmation.CreatedAt),
+	}
+}
+
+func pauseCSVRow(pause *pauseRecord) []string {
+	return []string{
+		pause.ID,
+		pause.HabitID,
+		pause.StartDate,
+		pause.EndDate,
+		derefString(pause.Reason),
+		formatTime(pause.ResumedAt),
+		formatTime(pause.CreatedAt),
+	}
+}
+
+func groupCSVRow(group *groupRecord) []string {
+	return []string{
+		group.ID,
+		group.Name,
+		derefString(group.Color),
+		derefString(group.Icon),
+		strconv.Itoa(int(group.Position)),
+		formatTime(group.CreatedAt),
+		formatTime(group.UpdatedAt),
+	}
+}
+
+func templateCSVRow(template *templateRecord) []string {
+	return []string{
+		template.ID,
+		template.Name,
+		derefString(template.Description),
+		derefString(template.Color),
+		derefString(template.Icon),
+		strings.Join(template.Tags, ";"),
+		template.ScheduleType,
+		formatInt(template.IntervalDays),
+		joinInts(template.WeeklyDays),
+		derefString(template.SuggestedTarget),
+		formatTime(template.CreatedAt),
+	}
+}
+
+func partnerCSVRow(partner *partnerRecord) []string {
+	return []string{
+		partner.ID,
+		partner.HabitID,
+		partner.HabitName,
+		partner.OwnerID,
+		partner.OwnerUsername,
+		partner.PartnerID,
+		partner.PartnerUsername,
+		partner.Status,
+		formatTime(partner.LastNudgedAt),
+		formatTime(partner.CreatedAt),
+		formatTime(partner.RespondedAt),
+	}
+}
+
+func challengeCSVRow(challenge *challengeRecord) []string {
+	return []string{
+		challenge.ID,
+		challenge.CreatorID,
+		challenge.Name,
+		derefString(challenge.Description),
+		challenge.StartDate,
+		challenge.EndDate,
+		challenge.InviteCode,
+		strconv.Itoa(int(challenge.ParticipantCount)),
+		formatTime(challenge.CreatedAt),
+		formatTime(challenge.UpdatedAt),
+	}
+}
+
+func achievementCSVRow(achievement *achievementRecord) []string {
+	return []string{
+		achievement.Code,
+		achievement.Name,
+		achievement.Description,
+		strconv.Itoa(int(achievement.XP)),
+		formatTime(achievement.UnlockedAt),
+		derefString(achievement.HabitID),
+	}
+}
+
+func followCSVRow(follow *followRecord) []string {
+	return []string{
+		follow.FollowerID,
+		follow.FollowerUsername,
+		follow.FolloweeID,
+		follow.FolloweeUsername,
+		follow.Status,
+		formatTime(follow.CreatedAt),
+		formatTime(follow.RespondedAt),
+	}
+}
+
+func feedItemCSVRow(item *feedItemRecord) []string {
+	return []string{
+		item.ID,
+		item.Kind,
+		item.ActorID,
+		item.ActorUsername,
+		item.HabitID,
+		item.HabitName,
+		derefString(item.ConfirmedForDate),
+		formatInt(item.Streak),
+		derefString(item.AchievementCode),
+		derefString(item.AchievementName),
+		formatTime(item.OccurredAt),
+	}
+}
+
+func journalEntryCSVRow(entry *journalEntryRecord) []string {
+	return []string{
+		entry.ID,
+		entry.Date,
+		formatInt(entry.Mood),
+		formatInt(entry.Energy),
+		derefString(entry.Content),
+		strings.Join(entry.Tags, ";"),
+		strings.Join(entry.ConfirmationIDs, ";"),
+		formatTime(entry.CreatedAt),
+		formatTime(entry.UpdatedAt),
+	}
+}
+
+func attachmentCSVRow(attachment *attachmentRecord) []string {
+	return []string{
+		attachment.ID,
+		attachment.File,
+		attachment.ContentType,
+		strconv.FormatInt(attachment.SizeBytes, 10),
+		strconv.Itoa(int(attachment.Width)),
+		strconv.Itoa(int(attachment.Height)),
+		derefString(attachment.ConfirmationID),
+		formatTime(attachment.CreatedAt),
+	}
+}
+
+func notificationCSVRow(notification *notificationRecord) []string {
+	return []string{
+		notification.ID,
+		notification.Type,
+		notification.Status,
+		notification.Subject,
+		notification.Recipient,
+		formatTime(notification.SentAt),
+		formatTime(notification.FailedAt),
+		derefString(notification.Error),
+		formatTime(notification.CreatedAt),
+	}
+}
+
+func scheduleTypeName(scheduleType habitspb.ScheduleType) string {
+	if scheduleType == habitspb.ScheduleType_SCHEDULE_TYPE_WEEKLY {
+		return "weekly"
+	}
+	return "interval"
+}
+
+// enumName turns a proto enum value like PARTNER_STATUS_ACCEPTED into "accepted"
+func enumName(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+func fromProtoTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func formatInt(i *int32) string {
+	if i == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*i))
+}
+
+func joinInts(values []int32) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = strconv.Itoa(int(value))
+	}
+	return strings.Join(parts, ";")
+}
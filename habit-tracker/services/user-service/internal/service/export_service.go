@@ -0,0 +1,553 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"user-service/internal/domain/entity"
+	"user-service/internal/domain/repository"
+	"user-service/internal/domain/service"
+	"user-service/internal/infrastructure/export"
+	"user-service/internal/infrastructure/kafka"
+	"user-service/internal/infrastructure/redis"
+	habitspb "user-service/proto/habits/v1"
+	notificationpb "user-service/proto/notification/v1"
+
+	"github.com/google/uuid"
+)
+
+const (
+	exportJobTimeout      = 10 * time.Minute
+	notificationsPageSize = 500
+)
+
+// exportService implements service.ExportService
+type exportService struct {
+	exportRepo         repository.DataExportRepository
+	userService        service.UserService
+	sessionRepo        repository.SessionRepository
+	habitsClient       habitspb.HabitServiceClient
+	notificationClient notificationpb.NotificationServiceClient
+	storage            *export.FileStorage
+	tokenStore         *redis.DataExportTokenStorage
+	kafkaProducer      *kafka.Producer
+}
+
+// NewExportService creates a new export service
+func NewExportService(
+	exportRepo repository.DataExportRepository,
+	userService service.UserService,
+	sessionRepo repository.SessionRepository,
+	habitsClient habitspb.HabitServiceClient,
+	notificationClient notificationpb.NotificationServiceClient,
+	storage *export.FileStorage,
+	tokenStore *redis.DataExportTokenStorage,
+	kafkaProducer *kafka.Producer,
+) service.ExportService {
+	return &exportService{
+		exportRepo:         exportRepo,
+		userService:        userService,
+		sessionRepo:        sessionRepo,
+		habitsClient:       habitsClient,
+		notificationClient: notificationClient,
+		storage:            storage,
+		tokenStore:         tokenStore,
+		kafkaProducer:      kafkaProducer,
+	}
+}
+
+// RequestExport starts an asynchronous export of all user data
+func (s *exportService) RequestExport(ctx context.Context, userID uuid.UUID) (*entity.DataExport, error) {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	inProgress, err := s.exportRepo.GetInProgressByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if inProgress != nil {
+		return inProgress, nil
+	}
+
+	dataExport := &entity.DataExport{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Status:    entity.DataExportStatusPending,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.exportRepo.Create(ctx, dataExport); err != nil {
+		// Lost the race with a concurrent request, return the job it created
+		if inProgress, getErr := s.exportRepo.GetInProgressByUserID(ctx, userID); getErr == nil && inProgress != nil {
+			return inProgress, nil
+		}
+		return nil, err
+	}
+
+	go s.runExport(dataExport.ID, user)
+
+	return dataExport, nil
+}
+
+// GetExport retrieves export job of the user
+func (s *exportService) GetExport(ctx context.Context, userID, exportID uuid.UUID) (*entity.DataExport, error) {
+	dataExport, err := s.exportRepo.GetByID(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dataExport.UserID != userID {
+		return nil, fmt.Errorf("data export not found")
+	}
+
+	return dataExport, nil
+}
+
+// OpenDownload opens export archive by download token
+func (s *exportService) OpenDownload(ctx context.Context, token string) (*entity.DataExport, io.ReadCloser, error) {
+	exportIDStr, err := s.tokenStore.GetExportID(ctx, token)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid or expired download link")
+	}
+
+	exportID, err := uuid.Parse(exportIDStr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid or expired download link")
+	}
+
+	dataExport, err := s.exportRepo.GetByID(ctx, exportID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !dataExport.IsDownloadable() || dataExport.FilePath == nil {
+		return nil, nil, fmt.Errorf("invalid or expired download link")
+	}
+
+	file, err := s.storage.Open(*dataExport.FilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dataExport, file, nil
+}
+
+// FailInterrupted fails jobs left in progress by a previous run
+func (s *exportService) FailInterrupted(ctx context.Context) error {
+	failed, err := s.exportRepo.FailInProgress(ctx, "export was interrupted, please request a new one")
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		fmt.Printf("Marked %d interrupted data exports as failed\n", failed)
+	}
+	return nil
+}
+
+// CleanupExpired removes archives whose download link has expired
+func (s *exportService) CleanupExpired(ctx context.Context) error {
+	expired, err := s.exportRepo.GetExpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, dataExport := range expired {
+		if dataExport.FilePath != nil {
+			if err := s.storage.Remove(*dataExport.FilePath); err != nil {
+				fmt.Printf("Warning: failed to remove data export %s: %v\n", dataExport.ID, err)
+				continue
+			}
+		}
+
+		if err := s.exportRepo.MarkExpired(ctx, dataExport.ID); err != nil {
+			fmt.Printf("Warning: failed to mark data export %s as expired: %v\n", dataExport.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveUserArchives removes archive files of all user exports, used before account is deleted
+func (s *exportService) RemoveUserArchives(ctx context.Context, userID uuid.UUID) error {
+	exports, err := s.exportRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, dataExport := range exports {
+		if dataExport.FilePath == nil {
+			continue
+		}
+		if err := s.storage.Remove(*dataExport.FilePath); err != nil {
+			return fmt.Errorf("failed to remove data export %s: %w", dataExport.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// runExport builds export archive and emails download link, it runs detached from the request
+func (s *exportService) runExport(exportID uuid.UUID, user *entity.User) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportJobTimeout)
+	defer cancel()
+
+	if err := s.exportRepo.MarkProcessing(ctx, exportID); err != nil {
+		fmt.Printf("Warning: failed to mark data export %s as processing: %v\n", exportID, err)
+	}
+
+	filePath, fileSize, err := s.buildArchive(ctx, exportID, user)
+	if err != nil {
+		s.failExport(ctx, exportID, err)
+		return
+	}
+
+	token, err := s.tokenStore.GenerateToken()
+	if err != nil {
+		s.storage.Remove(filePath)
+		s.failExport(ctx, exportID, err)
+		return
+	}
+
+	if err := s.tokenStore.StoreToken(ctx, token, exportID.String()); err != nil {
+		s.storage.Remove(filePath)
+		s.failExport(ctx, exportID, err)
+		return
+	}
+
+	completedAt := time.Now()
+	expiresAt := completedAt.Add(s.tokenStore.TTL())
+	if err := s.exportRepo.MarkCompleted(ctx, exportID, filePath, fileSize, expiresAt); err != nil {
+		s.storage.Remove(filePath)
+		s.failExport(ctx, exportID, err)
+		return
+	}
+
+	firstName := ""
+	if user.FirstName != nil {
+		firstName = *user.FirstName
+	}
+
+	event := &kafka.DataExportReadyEvent{
+		EventID:       kafka.NewEventID(),
+		UserID:        user.ID.String(),
+		Email:         user.Email,
+		Username:      user.Username,
+		FirstName:     firstName,
+		ExportID:      exportID.String(),
+		DownloadToken: token,
+		ExpiresAt:     expiresAt,
+		CompletedAt:   completedAt,
+	}
+
+	if err := s.kafkaProducer.PublishDataExportReadyEvent(ctx, event); err != nil {
+		fmt.Printf("Warning: failed to publish data export ready event: %v\n", err)
+	}
+}
+
+// failExport records export failure
+func (s *exportService) failExport(ctx context.Context, exportID uuid.UUID, cause error) {
+	fmt.Printf("Warning: data export %s failed: %v\n", exportID, cause)
+
+	if err := s.exportRepo.MarkFailed(ctx, exportID, "failed to build export archive"); err != nil {
+		fmt.Printf("Warning: failed to mark data export %s as failed: %v\n", exportID, err)
+	}
+}
+
+// buildArchive gathers user data from all services and writes it into a ZIP archive
+func (s *exportService) buildArchive(ctx context.Context, exportID uuid.UUID, user *entity.User) (string, int64, error) {
+	sessions, err := s.sessionRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	habitsResp, err := s.habitsClient.ExportUserHabits(ctx, &habitspb.ExportUserHabitsRequest{
+		UserId: user.ID.String(),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to export habits: %w", err)
+	}
+
+	notifications, err := s.listNotifications(ctx, user.ID)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to export notifications: %w", err)
+	}
+
+	file, filePath, err := s.storage.Create(exportID.String() + ".zip")
+	if err != nil {
+		return "", 0, err
+	}
+
+	if err := s.writeArchive(ctx, file, exportID, user, sessions, habitsResp, notifications); err != nil {
+		file.Close()
+		s.storage.Remove(filePath)
+		return "", 0, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		s.storage.Remove(filePath)
+		return "", 0, fmt.Errorf("failed to stat export file: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		s.storage.Remove(filePath)
+		return "", 0, fmt.Errorf("failed to close export file: %w", err)
+	}
+
+	return filePath, info.Size(), nil
+}
+
+// listNotifications pages through all notifications of the user
+func (s *exportService) listNotifications(ctx context.Context, userID uuid.UUID) ([]*notificationpb.Notification, error) {
+	var notifications []*notificationpb.Notification
+
+	limit := int32(notificationsPageSize)
+	pageToken := ""
+	for {
+		resp, err := s.notificationClient.ListUserNotifications(ctx, &notificationpb.ListUserNotificationsRequest{
+			UserId:    userID.String(),
+			Limit:     &limit,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, resp.Notifications...)
+		if resp.NextPageToken == "" {
+			return notifications, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// writeArchive writes every data section as JSON and CSV files, followed by the photos
+func (s *exportService) writeArchive(
+	ctx context.Context,
+	w io.Writer,
+	exportID uuid.UUID,
+	user *entity.User,
+	sessions []*entity.Session,
+	habitsResp *habitspb.ExportUserHabitsResponse,
+	notifications []*notificationpb.Notification,
+) error {
+	archive := export.NewArchiveWriter(w)
+
+	sessionResponses := make([]*entity.SessionResponse, len(sessions))
+	sessionRows := make([][]string, len(sessions))
+	for i, session := range sessions {
+		sessionResponses[i] = session.ToResponse()
+		sessionRows[i] = sessionCSVRow(sessionResponses[i])
+	}
+
+	habits := make([]*habitRecord, len(habitsResp.Habits))
+	habitRows := make([][]string, len(habitsResp.Habits))
+	for i, habit := range habitsResp.Habits {
+		habits[i] = toHabitRecord(habit)
+		habitRows[i] = habitCSVRow(habits[i])
+	}
+
+	confirmations := make([]*confirmationRecord, len(habitsResp.Confirmations))
+	confirmationRows := make([][]string, len(habitsResp.Confirmations))
+	for i, confirmation := range habitsResp.Confirmations {
+		confirmations[i] = toConfirmationRecord(confirmation)
+		confirmationRows[i] = confirmationCSVRow(confirmations[i])
+	}
+
+	pauses := make([]*pauseRecord, len(habitsResp.Pauses))
+	pauseRows := make([][]string, len(habitsResp.Pauses))
+	for i, pause := range habitsResp.Pauses {
+		pauses[i] = toPauseRecord(pause)
+		pauseRows[i] = pauseCSVRow(pauses[i])
+	}
+
+	groups := make([]*groupRecord, len(habitsResp.Groups))
+	groupRows := make([][]string, len(habitsResp.Groups))
+	for i, group := range habitsResp.Groups {
+		groups[i] = toGroupRecord(group)
+		groupRows[i] = groupCSVRow(groups[i])
+	}
+
+	templates := make([]*templateRecord, len(habitsResp.Templates))
+	templateRows := make([][]string, len(habitsResp.Templates))
+	for i, template := range habitsResp.Templates {
+		templates[i] = toTemplateRecord(template)
+		templateRows[i] = templateCSVRow(templates[i])
+	}
+
+	partners := make([]*partnerRecord, len(habitsResp.Partners))
+	partnerRows := make([][]string, len(habitsResp.Partners))
+	for i, partner := range habitsResp.Partners {
+		partners[i] = toPartnerRecord(partner)
+		partnerRows[i] = partnerCSVRow(partners[i])
+	}
+
+	challenges := make([]*challengeRecord, len(habitsResp.Challenges))
+	challengeRows := make([][]string, len(habitsResp.Challenges))
+	for i, challenge := range habitsResp.Challenges {
+		challenges[i] = toChallengeRecord(challenge)
+		challengeRows[i] = challengeCSVRow(challenges[i])
+	}
+
+	achievements := toAchievementsRecord(habitsResp.Achievements)
+	achievementRows := make([][]string, len(achievements.Achievements))
+	for i, achievement := range achievements.Achievements {
+		achievementRows[i] = achievementCSVRow(achievement)
+	}
+
+	follows := make([]*followRecord, len(habitsResp.Follows))
+	followRows := make([][]string, len(habitsResp.Follows))
+	for i, follow := range habitsResp.Follows {
+		follows[i] = toFollowRecord(follow)
+		followRows[i] = followCSVRow(follows[i])
+	}
+
+	feedItems := make([]*feedItemRecord, len(habitsResp.FeedItems))
+	feedItemRows := make([][]string, len(habitsResp.FeedItems))
+	for i, item := range habitsResp.FeedItems {
+		feedItems[i] = toFeedItemRecord(item)
+		feedItemRows[i] = feedItemCSVRow(feedItems[i])
+	}
+
+	journalEntries := make([]*journalEntryRecord, len(habitsResp.JournalEntries))
+	journalEntryRows := make([][]string, len(habitsResp.JournalEntries))
+	for i, entry := range habitsResp.JournalEntries {
+		journalEntries[i] = toJournalEntryRecord(entry)
+		journalEntryRows[i] = journalEntryCSVRow(journalEntries[i])
+	}
+
+	attachments := make([]*attachmentRecord, len(habitsResp.Attachments))
+	attachmentRows := make([][]string, len(habitsResp.Attachments))
+	for i, attachment := range habitsResp.Attachments {
+		attachments[i] = toAttachmentRecord(attachment)
+		attachmentRows[i] = attachmentCSVRow(attachments[i])
+	}
+
+	notificationRecords := make([]*notificationRecord, len(notifications))
+	notificationRows := make([][]string, len(notifications))
+	for i, notification := range notifications {
+		notificationRecords[i] = toNotificationRecord(notification)
+		notificationRows[i] = notificationCSVRow(notificationRecords[i])
+	}
+
+	manifest := &exportManifest{
+		ExportID:    exportID.String(),
+		UserID:      user.ID.String(),
+		GeneratedAt: time.Now().UTC(),
+		Counts: map[string]int{
+			"sessions":            len(sessions),
+			"habits":              len(habits),
+			"habit_confirmations": len(confirmations),
+			"habit_pauses":        len(pauses),
+			"habit_groups":        len(groups),
+			"habit_templates":     len(templates),
+			"habit_partners":      len(partners),
+			"challenges":          len(challenges),
+			"achievements":        len(achievements.Achievements),
+			"follows":             len(follows),
+			"feed_items":          len(feedItems),
+			"journal_entries":     len(journalEntries),
+			"attachments":         len(attachments),
+			"notifications":       len(notificationRecords),
+		},
+		Notes: []string{
+			"Password hash and session token hashes are never exported.",
+			"Token values are removed from notification metadata.",
+			"The calendar feed token is a credential and is never exported.",
+			"Analytics rollups are not exported, they are computed from the habit confirmations.",
+			"Photos are stored in habits/photos, habits/attachments.json lists their files.",
+			// TODO: Include bad habits and occurrences when bad-habits-service is ready
+			"Bad habits are not included: bad-habits-service does not store any data yet.",
+		},
+	}
+
+	if err := archive.AddJSON("manifest.json", manifest); err != nil {
+		return err
+	}
+	if err := archive.AddJSON("profile.json", user.ToResponse()); err != nil {
+		return err
+	}
+
+	sections := []struct {
+		name    string
+		records interface{}
+		header  []string
+		rows    [][]string
+	}{
+		{"sessions", sessionResponses, sessionCSVHeader, sessionRows},
+		{"habits/habits", habits, habitCSVHeader, habitRows},
+		{"habits/habit_confirmations", confirmations, confirmationCSVHeader, confirmationRows},
+		{"habits/habit_pauses", pauses, pauseCSVHeader, pauseRows},
+		{"habits/habit_groups", groups, groupCSVHeader, groupRows},
+		{"habits/habit_templates", templates, templateCSVHeader, templateRows},
+		{"habits/habit_partners", partners, partnerCSVHeader, partnerRows},
+		{"habits/challenges", challenges, challengeCSVHeader, challengeRows},
+		{"habits/achievements", achievements, achievementCSVHeader, achievementRows},
+		{"habits/follows", follows, followCSVHeader, followRows},
+		{"habits/feed", feedItems, feedItemCSVHeader, feedItemRows},
+		{"habits/journal_entries", journalEntries, journalEntryCSVHeader, journalEntryRows},
+		{"habits/attachments", attachments, attachmentCSVHeader, attachmentRows},
+		{"notifications/notifications", notificationRecords, notificationCSVHeader, notificationRows},
+	}
+
+	for _, section := range sections {
+		if err := archive.AddJSON(section.name+".json", section.records); err != nil {
+			return err
+		}
+		if err := archive.AddCSV(section.name+".csv", section.header, section.rows); err != nil {
+			return err
+		}
+	}
+
+	for _, attachment := range habitsResp.Attachments {
+		if err := s.writeAttachment(ctx, archive, user.ID, attachment); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+// writeAttachment streams a photo from habits-service into the archive
+func (s *exportService) writeAttachment(ctx context.Context, archive *export.ArchiveWriter, userID uuid.UUID, attachment *habitspb.Attachment) error {
+	stream, err := s.habitsClient.DownloadAttachment(ctx, &habitspb.DownloadAttachmentRequest{
+		UserId:       userID.String(),
+		AttachmentId: attachment.Id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to download attachment %s: %w", attachment.Id, err)
+	}
+
+	if err := archive.AddFile(attachmentFileName(attachment), &attachmentReader{stream: stream}); err != nil {
+		return fmt.Errorf("failed to download attachment %s: %w", attachment.Id, err)
+	}
+
+	return nil
+}
+
+// attachmentReader reads the chunks of an attachment download as one stream
+type attachmentReader struct {
+	stream habitspb.HabitService_DownloadAttachmentClient
+	buf    []byte
+}
+
+func (r *attachmentReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			// io.EOF ends the stream
+			return 0, err
+		}
+		r.buf = chunk.Data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eventspb "user-service/proto/events/v1"
+)
+
+const magicLinkPrefix = "magic_link:token:"
+
+// requestMagicLink requests a link for email and returns the token it would carry
+func (f *authFixture) requestMagicLink(t *testing.T, email string) string {
+	t.Helper()
+
+	if err := f.auth.RequestMagicLink(context.Background(), email); err != nil {
+		t.Fatalf("RequestMagicLink() error = %v", err)
+	}
+
+	tokens := f.storedTokens(magicLinkPrefix)
+	if len(tokens) != 1 {
+		t.Fatalf("RequestMagicLink() stored %d tokens, want 1", len(tokens))
+	}
+
+	// The link is emailed by notification-service from the published event
+	events := f.events(t, eventspb.EventType_EVENT_TYPE_MAGIC_LINK_REQUESTED)
+	if len(events) != 1 {
+		t.Fatalf("RequestMagicLink() published %d events, want 1", len(events))
+	}
+	if token := events[0].GetMagicLinkRequested().GetToken(); token != tokens[0] {
+		t.Fatalf("published token %q differs from the stored one %q", token, tokens[0])
+	}
+	return tokens[0]
+}
+
+func TestMagicLinkSignsIn(t *testing.T) {
+	f := newAuthFixture(t)
+	user := f.addUser(t, "ada@example.com", "password")
+
+	token := f.requestMagicLink(t, "ADA@example.com")
+
+	signedIn, tokenPair, err := f.auth.ConsumeMagicLink(context.Background(), token, nil, nil)
+	if err != nil {
+		t.Fatalf("ConsumeMagicLink() error = %v", err)
+	}
+	if signedIn.ID != user.ID {
+		t.Errorf("ConsumeMagicLink() signed in %s, want %s", signedIn.ID, user.ID)
+	}
+
+	claims, err := f.tokens.ValidateAccessToken(tokenPair.AccessToken)
+	if err != nil {
+		t.Fatalf("issued access token is invalid: %v", err)
+	}
+	if claims.UserID != user.ID {
+		t.Errorf("access token user = %s, want %s", claims.UserID, user.ID)
+	}
+	if !f.sessionActive(t, user.ID, claims.SessionID) {
+		t.Error("session of the issued tokens is not active")
+	}
+}
+
+func TestMagicLinkIsSingleUse(t *testing.T) {
+	f := newAuthFixture(t)
+	f.addUser(t, "ada@example.com", "password")
+
+	token := f.requestMagicLink(t, "ada@example.com")
+
+	if _, _, err := f.auth.ConsumeMagicLink(context.Background(), token, nil, nil); err != nil {
+		t.Fatalf("first ConsumeMagicLink() error = %v", err)
+	}
+	if _, _, err := f.auth.ConsumeMagicLink(context.Background(), token, nil, nil); err == nil {
+		t.Error("second ConsumeMagicLink() signed in with a used link")
+	}
+}
+
+func TestMagicLinkExpires(t *testing.T) {
+	f := newAuthFixture(t)
+	f.addUser(t, "ada@example.com", "password")
+
+	token := f.requestMagicLink(t, "ada@example.com")
+	f.redis.FastForward(16 * time.Minute)
+
+	if _, _, err := f.auth.ConsumeMagicLink(context.Background(), token, nil, nil); err == nil {
+		t.Error("ConsumeMagicLink() signed in with an expired link")
+	}
+}
+
+func TestMagicLinkNotIssued(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		setup func(f *authFixture)
+	}{
+		{
+			name:  "unknown email",
+			email: "nobody@example.com",
+			setup: func(f *authFixture) { f.addUser(t, "ada@example.com", "password") },
+		},
+		{
+			name:  "unverified email",
+			email: "ada@example.com",
+			setup: func(f *authFixture) {
+				user := f.addUser(t, "ada@example.com", "password")
+				user.EmailVerified = false
+				f.users.add(user)
+			},
+		},
+		{
+			name:  "deactivated account",
+			email: "ada@example.com",
+			setup: func(f *authFixture) {
+				user := f.addUser(t, "ada@example.com", "password")
+				user.IsActive = false
+				f.users.add(user)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthFixture(t)
+			tt.setup(f)
+
+			// The response must not reveal whether the account exists
+			if err := f.auth.RequestMagicLink(context.Background(), tt.email); err != nil {
+				t.Errorf("RequestMagicLink() error = %v, want nil", err)
+			}
+			if tokens := f.storedTokens(magicLinkPrefix); len(tokens) != 0 {
+				t.Errorf("RequestMagicLink() stored %d tokens, want 0", len(tokens))
+			}
+			if events := f.events(t, eventspb.EventType_EVENT_TYPE_MAGIC_LINK_REQUESTED); len(events) != 0 {
+				t.Errorf("RequestMagicLink() published %d events, want 0", len(events))
+			}
+		})
+	}
+}
+
+func TestMagicLinkRejectsAccountDeactivatedAfterRequest(t *testing.T) {
+	f := newAuthFixture(t)
+	user := f.addUser(t, "ada@example.com", "password")
+
+	token := f.requestMagicLink(t, "ada@example.com")
+
+	user.IsActive = false
+	f.users.add(user)
+
+	if _, _, err := f.auth.ConsumeMagicLink(context.Background(), token, nil, nil); err == nil {
+		t.Error("ConsumeMagicLink() signed in to a deactivated account")
+	}
+}
+
+func TestMagicLinkRejectsUnknownToken(t *testing.T) {
+	f := newAuthFixture(t)
+	f.addUser(t, "ada@example.com", "password")
+
+	if _, _, err := f.auth.ConsumeMagicLink(context.Background(), "forged", nil, nil); err == nil {
+		t.Error("ConsumeMagicLink() signed in with a forged token")
+	}
+}
+
+func TestMagicLinkUnaffectedByOtherUsers(t *testing.T) {
+	f := newAuthFixture(t)
+	f.addUser(t, "ada@example.com", "password")
+	grace := f.addUser(t, "grace@example.com", "password")
+
+	token := f.requestMagicLink(t, "ada@example.com")
+
+	if err := f.magicLinks.DeleteUserTokens(context.Background(), grace.ID.String()); err != nil {
+		t.Fatalf("DeleteUserTokens() error = %v", err)
+	}
+	if _, _, err := f.auth.ConsumeMagicLink(context.Background(), token, nil, nil); err != nil {
+		t.Errorf("ConsumeMagicLink() error = %v", err)
+	}
+}
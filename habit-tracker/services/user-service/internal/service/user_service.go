@@ -109,8 +109,8 @@ func (s *userService) UpdateUser(ctx context.Context, userID uuid.UUID, userUpda
 	if userUpdate.Timezone != nil {
 		user.Timezone = *userUpdate.Timezone
 	}
-	if userUpdate.EmailVerified != nil {
-		user.EmailVerified = *userUpdate.EmailVerified
+	if userUpdate.PublicProfile != nil {
+		user.PublicProfile = *userUpdate.PublicProfile
 	}
 
 	if err := s.userRepo.Update(ctx, user); err != nil {
@@ -165,6 +165,14 @@ func (s *userService) VerifyEmail(ctx context.Context, userID uuid.UUID) error {
 	return nil
 }
 
+// ChangeEmail atomically swaps user email from oldEmail to newEmail
+func (s *userService) ChangeEmail(ctx context.Context, userID uuid.UUID, oldEmail, newEmail string) error {
+	if err := s.userRepo.UpdateEmail(ctx, userID, oldEmail, newEmail); err != nil {
+		return fmt.Errorf("failed to change email: %w", err)
+	}
+	return nil
+}
+
 // DeactivateUser deactivates a user account
 func (s *userService) DeactivateUser(ctx context.Context, userID uuid.UUID) error {
 	if err := s.userRepo.Delete(ctx, userID); err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"user-service/internal/domain/entity"
 	"user-service/internal/domain/service"
+	"user-service/pkg/jwt"
 	pb "user-service/proto/user/v1"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -21,12 +22,17 @@ func toProtoUser(user *entity.User) *pb.User {
 		Timezone:      user.Timezone,
 		CreatedAt:     timestamppb.New(user.CreatedAt),
 		UpdatedAt:     timestamppb.New(user.UpdatedAt),
+		PublicProfile: user.PublicProfile,
 	}
 
 	if user.FirstName != nil {
 		protoUser.FirstName = *user.FirstName
 	}
 
+	if user.DeletionScheduledAt != nil {
+		protoUser.DeletionScheduledAt = timestamppb.New(*user.DeletionScheduledAt)
+	}
+
 	return protoUser
 }
 
@@ -52,6 +58,46 @@ func toProtoSession(session *entity.Session) *pb.Session {
 	return protoSession
 }
 
+// toProtoDataExport converts domain data export to proto data export
+func toProtoDataExport(dataExport *entity.DataExport) *pb.DataExport {
+	protoExport := &pb.DataExport{
+		Id:        dataExport.ID.String(),
+		UserId:    dataExport.UserID.String(),
+		Status:    toProtoDataExportStatus(dataExport.Status),
+		Error:     dataExport.Error,
+		FileSize:  dataExport.FileSize,
+		CreatedAt: timestamppb.New(dataExport.CreatedAt),
+	}
+
+	if dataExport.CompletedAt != nil {
+		protoExport.CompletedAt = timestamppb.New(*dataExport.CompletedAt)
+	}
+
+	if dataExport.ExpiresAt != nil {
+		protoExport.ExpiresAt = timestamppb.New(*dataExport.ExpiresAt)
+	}
+
+	return protoExport
+}
+
+// toProtoDataExportStatus converts domain data export status to proto enum
+func toProtoDataExportStatus(status entity.DataExportStatus) pb.DataExportStatus {
+	switch status {
+	case entity.DataExportStatusPending:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_PENDING
+	case entity.DataExportStatusProcessing:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_PROCESSING
+	case entity.DataExportStatusCompleted:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_COMPLETED
+	case entity.DataExportStatusFailed:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_FAILED
+	case entity.DataExportStatusExpired:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_EXPIRED
+	default:
+		return pb.DataExportStatus_DATA_EXPORT_STATUS_UNSPECIFIED
+	}
+}
+
 // toProtoTokenPair converts service token pair to proto response
 func toProtoTokenPair(tokenPair *service.TokenPair) (string, string, *timestamppb.Timestamp, *timestamppb.Timestamp) {
 	return tokenPair.AccessToken,
@@ -60,6 +106,35 @@ func toProtoTokenPair(tokenPair *service.TokenPair) (string, string, *timestampp
 		timestamppb.New(tokenPair.RefreshTokenExpiresAt)
 }
 
+// toProtoJWKs converts JSON web keys to proto keys
+func toProtoJWKs(keys []jwt.JWK) []*pb.JSONWebKey {
+	protoKeys := make([]*pb.JSONWebKey, 0, len(keys))
+	for _, key := range keys {
+		protoKey := &pb.JSONWebKey{
+			Kid: key.KeyID,
+			Kty: key.KeyType,
+			Alg: key.Algorithm,
+			Use: key.Use,
+		}
+
+		if key.Curve != "" {
+			protoKey.Crv = &key.Curve
+		}
+		if key.X != "" {
+			protoKey.X = &key.X
+		}
+		if key.N != "" {
+			protoKey.N = &key.N
+		}
+		if key.E != "" {
+			protoKey.E = &key.E
+		}
+
+		protoKeys = append(protoKeys, protoKey)
+	}
+	return protoKeys
+}
+
 // parseIPAddress parses IP address string to net.IP
 func parseIPAddress(ipStr *string) *net.IP {
 	if ipStr == nil || *ipStr == "" {
@@ -100,8 +175,8 @@ func toUserUpdate(req *pb.UpdateUserRequest) *entity.UserUpdate {
 		userUpdate.Timezone = req.Timezone
 	}
 
-	if req.EmailVerified != nil {
-		userUpdate.EmailVerified = req.EmailVerified
+	if req.PublicProfile != nil {
+		userUpdate.PublicProfile = req.PublicProfile
 	}
 
 	return userUpdate
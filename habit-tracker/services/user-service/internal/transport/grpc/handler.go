@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"user-service/internal/domain/service"
 	"user-service/pkg/validation"
@@ -11,20 +12,33 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// downloadChunkSize is the size of data export chunks streamed to clients
+const downloadChunkSize = 64 * 1024
+
 // UserServiceHandler implements pb.UserServiceServer
 type UserServiceHandler struct {
 	pb.UnimplementedUserServiceServer
-	userService service.UserService
-	authService service.AuthService
+	userService    service.UserService
+	authService    service.AuthService
+	exportService  service.ExportService
+	accountService service.AccountService
 }
 
 // NewUserServiceHandler creates a new gRPC handler
-func NewUserServiceHandler(userService service.UserService, authService service.AuthService) *UserServiceHandler {
+func NewUserServiceHandler(
+	userService service.UserService,
+	authService service.AuthService,
+	exportService service.ExportService,
+	accountService service.AccountService,
+) *UserServiceHandler {
 	return &UserServiceHandler{
-		userService: userService,
-		authService: authService,
+		userService:    userService,
+		authService:    authService,
+		exportService:  exportService,
+		accountService: accountService,
 	}
 }
 
@@ -150,6 +164,37 @@ func (h *UserServiceHandler) ValidateToken(ctx context.Context, req *pb.Validate
 	}, nil
 }
 
+// GetJWKS returns public keys used to verify access tokens
+func (h *UserServiceHandler) GetJWKS(ctx context.Context, req *pb.GetJWKSRequest) (*pb.GetJWKSResponse, error) {
+	jwks := h.authService.GetJWKS(ctx)
+
+	return &pb.GetJWKSResponse{
+		Keys: toProtoJWKs(jwks.Keys),
+	}, nil
+}
+
+// CheckSession checks that session has not been revoked
+func (h *UserServiceHandler) CheckSession(ctx context.Context, req *pb.CheckSessionRequest) (*pb.CheckSessionResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
+	}
+
+	active, err := h.authService.CheckSession(ctx, userID, sessionID)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, fmt.Sprintf("failed to check session: %v", err))
+	}
+
+	return &pb.CheckSessionResponse{
+		Active: active,
+	}, nil
+}
+
 // GetUser retrieves user by ID
 func (h *UserServiceHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
 	userID, err := uuid.Parse(req.UserId)
@@ -183,6 +228,22 @@ func (h *UserServiceHandler) GetUserByEmail(ctx context.Context, req *pb.GetUser
 	}, nil
 }
 
+// GetUserByUsername retrieves user by username
+func (h *UserServiceHandler) GetUserByUsername(ctx context.Context, req *pb.GetUserByUsernameRequest) (*pb.GetUserResponse, error) {
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing username")
+	}
+
+	user, err := h.userService.GetUserByUsername(ctx, req.Username)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	return &pb.GetUserResponse{
+		User: toProtoUser(user),
+	}, nil
+}
+
 // UpdateUser updates user information
 func (h *UserServiceHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
 	userID, err := uuid.Parse(req.UserId)
@@ -388,3 +449,247 @@ func (h *UserServiceHandler) ResetPassword(ctx context.Context, req *pb.ResetPas
 		Success: true,
 	}, nil
 }
+
+// RequestMagicLink emails a single-use sign-in link
+func (h *UserServiceHandler) RequestMagicLink(ctx context.Context, req *pb.RequestMagicLinkRequest) (*pb.RequestMagicLinkResponse, error) {
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	if err := h.authService.RequestMagicLink(ctx, req.Email); err != nil {
+		return nil, status.Error(codes.Internal, "failed to request magic link")
+	}
+
+	return &pb.RequestMagicLinkResponse{
+		Success: true,
+		Message: strPtr("If the email exists, a sign-in link has been sent"),
+	}, nil
+}
+
+// ConsumeMagicLink signs user in with a magic link token
+func (h *UserServiceHandler) ConsumeMagicLink(ctx context.Context, req *pb.ConsumeMagicLinkRequest) (*pb.LoginResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	ipAddress := parseIPAddress(req.IpAddress)
+	userAgent := req.UserAgent
+
+	user, tokenPair, err := h.authService.ConsumeMagicLink(ctx, req.Token, ipAddress, userAgent)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired magic link")
+	}
+
+	accessToken, refreshToken, accessExpiresAt, refreshExpiresAt := toProtoTokenPair(tokenPair)
+
+	return &pb.LoginResponse{
+		User:                  toProtoUser(user),
+		AccessToken:           accessToken,
+		RefreshToken:          refreshToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshTokenExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+// RequestEmailChange starts email change for authenticated user
+func (h *UserServiceHandler) RequestEmailChange(ctx context.Context, req *pb.RequestEmailChangeRequest) (*pb.RequestEmailChangeResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
+	}
+
+	if req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "password is required")
+	}
+
+	if err := validation.ValidateEmail(req.NewEmail); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.authService.RequestEmailChange(ctx, userID, sessionID, req.Password, req.NewEmail); err != nil {
+		switch err.Error() {
+		case "invalid password":
+			return nil, status.Error(codes.PermissionDenied, "invalid password")
+		case "email already registered":
+			return nil, status.Error(codes.AlreadyExists, "email already registered")
+		case "new email must differ from current email":
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to request email change: %v", err))
+	}
+
+	return &pb.RequestEmailChangeResponse{
+		Success: true,
+		Message: strPtr("Confirmation link has been sent to the new email address"),
+	}, nil
+}
+
+// ConfirmEmailChange applies email change with token from the new address
+func (h *UserServiceHandler) ConfirmEmailChange(ctx context.Context, req *pb.ConfirmEmailChangeRequest) (*pb.ConfirmEmailChangeResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	user, revoked, err := h.authService.ConfirmEmailChange(ctx, req.Token)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("failed to confirm email change: %v", err))
+	}
+
+	return &pb.ConfirmEmailChangeResponse{
+		User:            toProtoUser(user),
+		RevokedSessions: int32(revoked),
+	}, nil
+}
+
+// UndoEmailChange cancels or reverts email change with token from the old address
+func (h *UserServiceHandler) UndoEmailChange(ctx context.Context, req *pb.UndoEmailChangeRequest) (*pb.UndoEmailChangeResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	reverted, err := h.authService.UndoEmailChange(ctx, req.Token)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("failed to undo email change: %v", err))
+	}
+
+	return &pb.UndoEmailChangeResponse{
+		Success:  true,
+		Reverted: reverted,
+	}, nil
+}
+
+// ExportUserData starts an asynchronous export of all user data
+func (h *UserServiceHandler) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	dataExport, err := h.exportService.RequestExport(ctx, userID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to start data export: %v", err))
+	}
+
+	return &pb.ExportUserDataResponse{
+		Export: toProtoDataExport(dataExport),
+	}, nil
+}
+
+// GetDataExport returns status of a data export job
+func (h *UserServiceHandler) GetDataExport(ctx context.Context, req *pb.GetDataExportRequest) (*pb.GetDataExportResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	exportID, err := uuid.Parse(req.ExportId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid export ID")
+	}
+
+	dataExport, err := h.exportService.GetExport(ctx, userID, exportID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "data export not found")
+	}
+
+	return &pb.GetDataExportResponse{
+		Export: toProtoDataExport(dataExport),
+	}, nil
+}
+
+// DownloadDataExport streams export archive by download token
+func (h *UserServiceHandler) DownloadDataExport(req *pb.DownloadDataExportRequest, stream pb.UserService_DownloadDataExportServer) error {
+	if req.Token == "" {
+		return status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	dataExport, reader, err := h.exportService.OpenDownload(stream.Context(), req.Token)
+	if err != nil {
+		return status.Error(codes.NotFound, "invalid or expired download link")
+	}
+	defer reader.Close()
+
+	fileName := fmt.Sprintf("habit-tracker-export-%s.zip", dataExport.CreatedAt.UTC().Format("2006-01-02"))
+
+	buf := make([]byte, downloadChunkSize)
+	first := true
+	for {
+		n, readErr := reader.Read(buf)
+		if n > 0 || first {
+			chunk := &pb.DataExportChunk{
+				Data: buf[:n],
+			}
+			if first {
+				chunk.FileName = &fileName
+				chunk.FileSize = dataExport.FileSize
+				first = false
+			}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+		}
+
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return status.Error(codes.Internal, "failed to read data export")
+		}
+	}
+}
+
+// DeleteAccount schedules permanent account deletion after a grace period
+func (h *UserServiceHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "password is required")
+	}
+
+	user, err := h.accountService.ScheduleDeletion(ctx, userID, req.Password)
+	if err != nil {
+		switch err.Error() {
+		case "invalid password":
+			return nil, status.Error(codes.PermissionDenied, "invalid password")
+		case "account deletion already scheduled", "user not found or deletion already scheduled":
+			return nil, status.Error(codes.FailedPrecondition, "account deletion already scheduled")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to schedule account deletion: %v", err))
+	}
+
+	return &pb.DeleteAccountResponse{
+		User:         toProtoUser(user),
+		ScheduledFor: timestamppb.New(*user.DeletionScheduledAt),
+	}, nil
+}
+
+// CancelAccountDeletion cancels scheduled account deletion during grace period
+func (h *UserServiceHandler) CancelAccountDeletion(ctx context.Context, req *pb.CancelAccountDeletionRequest) (*pb.CancelAccountDeletionResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	user, err := h.accountService.CancelDeletion(ctx, userID)
+	if err != nil {
+		if err.Error() == "user not found or deletion not scheduled" {
+			return nil, status.Error(codes.FailedPrecondition, "account deletion is not scheduled")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to cancel account deletion: %v", err))
+	}
+
+	return &pb.CancelAccountDeletionResponse{
+		User: toProtoUser(user),
+	}, nil
+}
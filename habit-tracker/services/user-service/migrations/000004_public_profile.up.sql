@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN public_profile BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,65 @@
+package jwt_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	pkgjwt "user-service/pkg/jwt"
+)
+
+func TestKeySetJWKS(t *testing.T) {
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+
+	ks, err := pkgjwt.NewKeySet([]*pkgjwt.SigningKey{
+		{ID: "b-ed25519", Method: jwt.SigningMethodEdDSA, PrivateKey: edPrivate},
+		{ID: "a-rsa", Method: jwt.SigningMethodRS256, PrivateKey: rsaPrivate},
+	}, "b-ed25519")
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+
+	jwks := ks.JWKS()
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("JWKS() returned %d keys, want 2", len(jwks.Keys))
+	}
+
+	rsaJWK, edJWK := jwks.Keys[0], jwks.Keys[1]
+
+	if rsaJWK.KeyID != "a-rsa" || rsaJWK.KeyType != "RSA" || rsaJWK.Algorithm != "RS256" || rsaJWK.Use != "sig" {
+		t.Errorf("rsa JWK = %+v", rsaJWK)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(rsaJWK.N)
+	if err != nil || new(big.Int).SetBytes(n).Cmp(rsaPrivate.N) != 0 {
+		t.Errorf("rsa JWK modulus does not match the key")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(rsaJWK.E)
+	if err != nil || new(big.Int).SetBytes(e).Int64() != int64(rsaPrivate.E) {
+		t.Errorf("rsa JWK exponent does not match the key")
+	}
+
+	if edJWK.KeyID != "b-ed25519" || edJWK.KeyType != "OKP" || edJWK.Curve != "Ed25519" || edJWK.Algorithm != "EdDSA" {
+		t.Errorf("ed25519 JWK = %+v", edJWK)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(edJWK.X)
+	if err != nil || !edPublic.Equal(ed25519.PublicKey(x)) {
+		t.Errorf("ed25519 JWK x does not match the key")
+	}
+
+	// Private parts are never published
+	if edJWK.N != "" || edJWK.E != "" || rsaJWK.X != "" || rsaJWK.Curve != "" {
+		t.Errorf("JWKS() mixed key parameters: %+v", jwks.Keys)
+	}
+}
@@ -28,22 +28,37 @@ type Claims struct {
 
 // TokenManager handles JWT token operations
 type TokenManager struct {
-	secret            string
-	accessTokenTTL    time.Duration
-	refreshTokenTTL   time.Duration
-	issuer            string
+	keySet          *KeySet
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	issuer          string
 }
 
 // NewTokenManager creates a new token manager
-func NewTokenManager(secret string, accessTokenTTL, refreshTokenTTL time.Duration, issuer string) *TokenManager {
+func NewTokenManager(keySet *KeySet, accessTokenTTL, refreshTokenTTL time.Duration, issuer string) *TokenManager {
 	return &TokenManager{
-		secret:            secret,
-		accessTokenTTL:    accessTokenTTL,
-		refreshTokenTTL:   refreshTokenTTL,
-		issuer:            issuer,
+		keySet:          keySet,
+		accessTokenTTL:  accessTokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+		issuer:          issuer,
 	}
 }
 
+// JWKS returns public keys that can be used to verify issued tokens
+func (tm *TokenManager) JWKS() JWKS {
+	return tm.keySet.JWKS()
+}
+
+// sign signs claims with the active key and sets kid header
+func (tm *TokenManager) sign(claims *Claims) (string, error) {
+	key := tm.keySet.Active()
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.PrivateKey)
+}
+
 // GenerateAccessToken generates a new access token
 func (tm *TokenManager) GenerateAccessToken(userID, sessionID uuid.UUID) (string, time.Time, error) {
 	expiresAt := time.Now().Add(tm.accessTokenTTL)
@@ -62,8 +77,7 @@ func (tm *TokenManager) GenerateAccessToken(userID, sessionID uuid.UUID) (string
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(tm.secret))
+	tokenString, err := tm.sign(claims)
 	if err != nil {
 		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
 	}
@@ -89,8 +103,7 @@ func (tm *TokenManager) GenerateRefreshToken(userID, sessionID uuid.UUID) (strin
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(tm.secret))
+	tokenString, err := tm.sign(claims)
 	if err != nil {
 		return "", time.Time{}, fmt.Errorf("failed to sign refresh token: %w", err)
 	}
@@ -101,11 +114,21 @@ func (tm *TokenManager) GenerateRefreshToken(userID, sessionID uuid.UUID) (strin
 // ValidateToken validates a token and returns claims
 func (tm *TokenManager) ValidateToken(tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return nil, fmt.Errorf("missing kid header")
+		}
+
+		key, ok := tm.keySet.Get(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key: %s", kid)
+		}
+
+		if token.Method.Alg() != key.Method.Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(tm.secret), nil
-	})
+		return key.PublicKey(), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
@@ -0,0 +1,149 @@
+package jwt_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	pkgjwt "user-service/pkg/jwt"
+)
+
+func newTestKey(t *testing.T, kid string) *pkgjwt.SigningKey {
+	t.Helper()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+	return &pkgjwt.SigningKey{ID: kid, Method: jwt.SigningMethodEdDSA, PrivateKey: privateKey}
+}
+
+func newTestManager(t *testing.T, keys ...*pkgjwt.SigningKey) *pkgjwt.TokenManager {
+	t.Helper()
+
+	ks, err := pkgjwt.NewKeySet(keys, keys[0].ID)
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+	return pkgjwt.NewTokenManager(ks, 15*time.Minute, 24*time.Hour, "user-service")
+}
+
+// signWith signs access claims with an arbitrary method, key and kid
+func signWith(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims *pkgjwt.Claims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func accessClaims(expiresAt time.Time) *pkgjwt.Claims {
+	return &pkgjwt.Claims{
+		UserID:    uuid.New(),
+		TokenType: pkgjwt.AccessToken,
+		SessionID: uuid.New(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "user-service",
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+}
+
+func TestValidateAccessToken(t *testing.T) {
+	active := newTestKey(t, "active")
+	previous := newTestKey(t, "previous")
+	tm := newTestManager(t, active, previous)
+
+	validAccess, _, err := tm.GenerateAccessToken(uuid.New(), uuid.New())
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+	refresh, _, err := tm.GenerateRefreshToken(uuid.New(), uuid.New())
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken() error = %v", err)
+	}
+
+	inAnHour := time.Now().Add(time.Hour)
+	publicKey := active.PrivateKey.Public().(ed25519.PublicKey)
+	stranger := newTestKey(t, "active")
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "valid", token: validAccess},
+		{name: "signed with a rotated out key still in the set", token: signWith(t, jwt.SigningMethodEdDSA, previous.PrivateKey, "previous", accessClaims(inAnHour))},
+		{name: "refresh token", token: refresh, wantErr: true},
+		{name: "expired", token: signWith(t, jwt.SigningMethodEdDSA, active.PrivateKey, "active", accessClaims(time.Now().Add(-time.Minute))), wantErr: true},
+		{name: "alg none", token: signWith(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "active", accessClaims(inAnHour)), wantErr: true},
+		{name: "hmac keyed with the public key", token: signWith(t, jwt.SigningMethodHS256, []byte(publicKey), "active", accessClaims(inAnHour)), wantErr: true},
+		{name: "unknown kid", token: signWith(t, jwt.SigningMethodEdDSA, active.PrivateKey, "unknown", accessClaims(inAnHour)), wantErr: true},
+		{name: "missing kid", token: signWith(t, jwt.SigningMethodEdDSA, active.PrivateKey, "", accessClaims(inAnHour)), wantErr: true},
+		{name: "signed by a foreign key with a known kid", token: signWith(t, jwt.SigningMethodEdDSA, stranger.PrivateKey, "active", accessClaims(inAnHour)), wantErr: true},
+		{name: "tampered payload", token: tamper(validAccess), wantErr: true},
+		{name: "malformed", token: "not.a.token", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tm.ValidateAccessToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRefreshTokenRejectsAccessToken(t *testing.T) {
+	tm := newTestManager(t, newTestKey(t, "active"))
+
+	access, _, err := tm.GenerateAccessToken(uuid.New(), uuid.New())
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+	if _, err := tm.ValidateRefreshToken(access); err == nil {
+		t.Error("ValidateRefreshToken() accepted an access token")
+	}
+}
+
+func TestGeneratedTokenCarriesActiveKID(t *testing.T) {
+	tm := newTestManager(t, newTestKey(t, "active"), newTestKey(t, "previous"))
+
+	access, _, err := tm.GenerateAccessToken(uuid.New(), uuid.New())
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+
+	token, _, err := jwt.NewParser().ParseUnverified(access, &pkgjwt.Claims{})
+	if err != nil {
+		t.Fatalf("ParseUnverified() error = %v", err)
+	}
+	if kid := token.Header["kid"]; kid != "active" {
+		t.Errorf("kid = %v, want active", kid)
+	}
+	if alg := token.Header["alg"]; alg != "EdDSA" {
+		t.Errorf("alg = %v, want EdDSA", alg)
+	}
+}
+
+// tamper flips the user id in the payload while keeping the signature
+func tamper(token string) string {
+	parts := strings.Split(token, ".")
+	claims := accessClaims(time.Now().Add(time.Hour))
+	payload, _ := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims).SigningString()
+	return parts[0] + "." + strings.Split(payload, ".")[1] + "." + parts[2]
+}
@@ -0,0 +1,170 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// SigningKey represents a single asymmetric key identified by kid
+type SigningKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer
+}
+
+// PublicKey returns the public part of the signing key
+func (k *SigningKey) PublicKey() crypto.PublicKey {
+	return k.PrivateKey.Public()
+}
+
+// KeySet holds all keys accepted for verification and the key used for signing.
+// Keeping several keys in the set allows rotation: a new key is added and made
+// active while the previous one stays published until issued tokens expire.
+type KeySet struct {
+	keys      map[string]*SigningKey
+	activeKID string
+}
+
+// NewKeySet creates a key set from the given keys with activeKID used for signing
+func NewKeySet(keys []*SigningKey, activeKID string) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("key set must contain at least one key")
+	}
+
+	ks := &KeySet{
+		keys: make(map[string]*SigningKey, len(keys)),
+	}
+
+	for _, key := range keys {
+		if _, exists := ks.keys[key.ID]; exists {
+			return nil, fmt.Errorf("duplicate key id: %s", key.ID)
+		}
+		ks.keys[key.ID] = key
+	}
+
+	if activeKID == "" {
+		if len(keys) > 1 {
+			return nil, fmt.Errorf("active key id must be set when key set contains several keys")
+		}
+		activeKID = keys[0].ID
+	}
+
+	if _, ok := ks.keys[activeKID]; !ok {
+		return nil, fmt.Errorf("active key %q not found in key set", activeKID)
+	}
+	ks.activeKID = activeKID
+
+	return ks, nil
+}
+
+// LoadKeySet loads PEM encoded private keys from dir. The file name without
+// extension is used as the key id, e.g. 2024-06.pem becomes kid "2024-06".
+func LoadKeySet(dir, activeKID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list key files: %w", err)
+	}
+	sort.Strings(paths)
+
+	keys := make([]*SigningKey, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file %s: %w", path, err)
+		}
+
+		kid := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		key, err := ParsePrivateKeyPEM(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key file %s: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+
+	return NewKeySet(keys, activeKID)
+}
+
+// GenerateKeySet creates a key set with a single ephemeral Ed25519 key.
+// Tokens signed with it do not survive a restart, so it is meant for local development only.
+func GenerateKeySet() (*KeySet, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ed25519 key: %w", err)
+	}
+
+	key := &SigningKey{
+		ID:         "ephemeral",
+		Method:     jwt.SigningMethodEdDSA,
+		PrivateKey: privateKey,
+	}
+
+	return NewKeySet([]*SigningKey{key}, key.ID)
+}
+
+// ParsePrivateKeyPEM parses a PKCS#8 (RSA or Ed25519) or PKCS#1 (RSA) private key
+func ParsePrivateKeyPEM(kid string, data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type: %s", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("rsa key must be at least 2048 bits")
+		}
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodRS256, PrivateKey: key}, nil
+	case ed25519.PrivateKey:
+		return &SigningKey{ID: kid, Method: jwt.SigningMethodEdDSA, PrivateKey: key}, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", parsed)
+	}
+}
+
+// Active returns the key used for signing new tokens
+func (ks *KeySet) Active() *SigningKey {
+	return ks.keys[ks.activeKID]
+}
+
+// Get returns the key with the given id
+func (ks *KeySet) Get(kid string) (*SigningKey, bool) {
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+// Keys returns all keys sorted by id
+func (ks *KeySet) Keys() []*SigningKey {
+	keys := make([]*SigningKey, 0, len(ks.keys))
+	for _, key := range ks.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ID < keys[j].ID
+	})
+	return keys
+}
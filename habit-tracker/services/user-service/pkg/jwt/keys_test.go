@@ -0,0 +1,162 @@
+package jwt_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	pkgjwt "user-service/pkg/jwt"
+)
+
+func ed25519PEM(t *testing.T) []byte {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal ed25519 key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func rsaPEM(t *testing.T, bits int) []byte {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func TestParsePrivateKeyPEM(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       []byte
+		wantMethod jwt.SigningMethod
+		wantErr    bool
+	}{
+		{name: "ed25519 pkcs8", data: ed25519PEM(t), wantMethod: jwt.SigningMethodEdDSA},
+		{name: "rsa pkcs1", data: rsaPEM(t, 2048), wantMethod: jwt.SigningMethodRS256},
+		{name: "rsa below 2048 bits", data: rsaPEM(t, 1024), wantErr: true},
+		{name: "not pem", data: []byte("not a key"), wantErr: true},
+		{name: "unsupported block type", data: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte{1}}), wantErr: true},
+		{name: "corrupt key", data: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte{1, 2, 3}}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := pkgjwt.ParsePrivateKeyPEM("kid", tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePrivateKeyPEM() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if key.ID != "kid" || key.Method != tt.wantMethod {
+				t.Errorf("ParsePrivateKeyPEM() = %s %s, want kid %s", key.ID, key.Method.Alg(), tt.wantMethod.Alg())
+			}
+		})
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string][]byte
+		activeKID string
+		wantKIDs  []string
+		wantErr   bool
+	}{
+		{
+			name:      "single key without active kid",
+			files:     map[string][]byte{"2024-06.pem": ed25519PEM(t)},
+			wantKIDs:  []string{"2024-06"},
+			activeKID: "",
+		},
+		{
+			name:      "rotation with mixed algorithms",
+			files:     map[string][]byte{"2024-06.pem": rsaPEM(t, 2048), "2024-12.pem": ed25519PEM(t), "README": []byte("ignored")},
+			activeKID: "2024-12",
+			wantKIDs:  []string{"2024-06", "2024-12"},
+		},
+		{
+			name:    "empty dir",
+			files:   map[string][]byte{},
+			wantErr: true,
+		},
+		{
+			name:    "several keys without active kid",
+			files:   map[string][]byte{"a.pem": ed25519PEM(t), "b.pem": ed25519PEM(t)},
+			wantErr: true,
+		},
+		{
+			name:      "active kid not in set",
+			files:     map[string][]byte{"a.pem": ed25519PEM(t)},
+			activeKID: "b",
+			wantErr:   true,
+		},
+		{
+			name:      "malformed key file",
+			files:     map[string][]byte{"a.pem": ed25519PEM(t), "b.pem": []byte("garbage")},
+			activeKID: "a",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, data := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+					t.Fatalf("failed to write %s: %v", name, err)
+				}
+			}
+
+			ks, err := pkgjwt.LoadKeySet(dir, tt.activeKID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadKeySet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			keys := ks.Keys()
+			if len(keys) != len(tt.wantKIDs) {
+				t.Fatalf("LoadKeySet() loaded %d keys, want %d", len(keys), len(tt.wantKIDs))
+			}
+			for i, key := range keys {
+				if key.ID != tt.wantKIDs[i] {
+					t.Errorf("key %d id = %s, want %s", i, key.ID, tt.wantKIDs[i])
+				}
+			}
+
+			wantActive := tt.activeKID
+			if wantActive == "" {
+				wantActive = tt.wantKIDs[0]
+			}
+			if ks.Active().ID != wantActive {
+				t.Errorf("Active() = %s, want %s", ks.Active().ID, wantActive)
+			}
+		})
+	}
+}
+
+func TestNewKeySetRejectsDuplicateKIDs(t *testing.T) {
+	_, privateKey, _ := ed25519.GenerateKey(rand.Reader)
+	key := &pkgjwt.SigningKey{ID: "a", Method: jwt.SigningMethodEdDSA, PrivateKey: privateKey}
+
+	if _, err := pkgjwt.NewKeySet([]*pkgjwt.SigningKey{key, key}, "a"); err == nil {
+		t.Error("NewKeySet() accepted duplicate key ids")
+	}
+}
@@ -0,0 +1,13094 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: habits.proto
+
+package habitspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Schedule type enum
+type ScheduleType int32
+
+const (
+	ScheduleType_SCHEDULE_TYPE_UNSPECIFIED ScheduleType = 0
+	ScheduleType_SCHEDULE_TYPE_INTERVAL    ScheduleType = 1 // Every N days
+	ScheduleType_SCHEDULE_TYPE_WEEKLY      ScheduleType = 2 // Specific days of week
+)
+
+// Enum value maps for ScheduleType.
+var (
+	ScheduleType_name = map[int32]string{
+		0: "SCHEDULE_TYPE_UNSPECIFIED",
+		1: "SCHEDULE_TYPE_INTERVAL",
+		2: "SCHEDULE_TYPE_WEEKLY",
+	}
+	ScheduleType_value = map[string]int32{
+		"SCHEDULE_TYPE_UNSPECIFIED": 0,
+		"SCHEDULE_TYPE_INTERVAL":    1,
+		"SCHEDULE_TYPE_WEEKLY":      2,
+	}
+)
+
+func (x ScheduleType) Enum() *ScheduleType {
+	p := new(ScheduleType)
+	*p = x
+	return p
+}
+
+func (x ScheduleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[0].Descriptor()
+}
+
+func (ScheduleType) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[0]
+}
+
+func (x ScheduleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleType.Descriptor instead.
+func (ScheduleType) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{0}
+}
+
+// HabitStatusFilter selects habits by archive state
+type HabitStatusFilter int32
+
+const (
+	HabitStatusFilter_HABIT_STATUS_FILTER_UNSPECIFIED HabitStatusFilter = 0
+	HabitStatusFilter_HABIT_STATUS_FILTER_ACTIVE      HabitStatusFilter = 1
+	HabitStatusFilter_HABIT_STATUS_FILTER_ARCHIVED    HabitStatusFilter = 2
+	HabitStatusFilter_HABIT_STATUS_FILTER_ALL         HabitStatusFilter = 3
+)
+
+// Enum value maps for HabitStatusFilter.
+var (
+	HabitStatusFilter_name = map[int32]string{
+		0: "HABIT_STATUS_FILTER_UNSPECIFIED",
+		1: "HABIT_STATUS_FILTER_ACTIVE",
+		2: "HABIT_STATUS_FILTER_ARCHIVED",
+		3: "HABIT_STATUS_FILTER_ALL",
+	}
+	HabitStatusFilter_value = map[string]int32{
+		"HABIT_STATUS_FILTER_UNSPECIFIED": 0,
+		"HABIT_STATUS_FILTER_ACTIVE":      1,
+		"HABIT_STATUS_FILTER_ARCHIVED":    2,
+		"HABIT_STATUS_FILTER_ALL":         3,
+	}
+)
+
+func (x HabitStatusFilter) Enum() *HabitStatusFilter {
+	p := new(HabitStatusFilter)
+	*p = x
+	return p
+}
+
+func (x HabitStatusFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HabitStatusFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[1].Descriptor()
+}
+
+func (HabitStatusFilter) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[1]
+}
+
+func (x HabitStatusFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HabitStatusFilter.Descriptor instead.
+func (HabitStatusFilter) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{1}
+}
+
+// HabitVisibility controls who besides the owner and accepted partners can see a habit
+type HabitVisibility int32
+
+const (
+	HabitVisibility_HABIT_VISIBILITY_UNSPECIFIED HabitVisibility = 0
+	HabitVisibility_HABIT_VISIBILITY_PRIVATE     HabitVisibility = 1 // Default
+	HabitVisibility_HABIT_VISIBILITY_FRIENDS     HabitVisibility = 2 // Accepted followers
+	HabitVisibility_HABIT_VISIBILITY_PUBLIC      HabitVisibility = 3 // Any user
+)
+
+// Enum value maps for HabitVisibility.
+var (
+	HabitVisibility_name = map[int32]string{
+		0: "HABIT_VISIBILITY_UNSPECIFIED",
+		1: "HABIT_VISIBILITY_PRIVATE",
+		2: "HABIT_VISIBILITY_FRIENDS",
+		3: "HABIT_VISIBILITY_PUBLIC",
+	}
+	HabitVisibility_value = map[string]int32{
+		"HABIT_VISIBILITY_UNSPECIFIED": 0,
+		"HABIT_VISIBILITY_PRIVATE":     1,
+		"HABIT_VISIBILITY_FRIENDS":     2,
+		"HABIT_VISIBILITY_PUBLIC":      3,
+	}
+)
+
+func (x HabitVisibility) Enum() *HabitVisibility {
+	p := new(HabitVisibility)
+	*p = x
+	return p
+}
+
+func (x HabitVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HabitVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[2].Descriptor()
+}
+
+func (HabitVisibility) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[2]
+}
+
+func (x HabitVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HabitVisibility.Descriptor instead.
+func (HabitVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{2}
+}
+
+// PartnerStatus is the state of a partner invitation
+type PartnerStatus int32
+
+const (
+	PartnerStatus_PARTNER_STATUS_UNSPECIFIED PartnerStatus = 0
+	PartnerStatus_PARTNER_STATUS_PENDING     PartnerStatus = 1
+	PartnerStatus_PARTNER_STATUS_ACCEPTED    PartnerStatus = 2
+	PartnerStatus_PARTNER_STATUS_DECLINED    PartnerStatus = 3
+)
+
+// Enum value maps for PartnerStatus.
+var (
+	PartnerStatus_name = map[int32]string{
+		0: "PARTNER_STATUS_UNSPECIFIED",
+		1: "PARTNER_STATUS_PENDING",
+		2: "PARTNER_STATUS_ACCEPTED",
+		3: "PARTNER_STATUS_DECLINED",
+	}
+	PartnerStatus_value = map[string]int32{
+		"PARTNER_STATUS_UNSPECIFIED": 0,
+		"PARTNER_STATUS_PENDING":     1,
+		"PARTNER_STATUS_ACCEPTED":    2,
+		"PARTNER_STATUS_DECLINED":    3,
+	}
+)
+
+func (x PartnerStatus) Enum() *PartnerStatus {
+	p := new(PartnerStatus)
+	*p = x
+	return p
+}
+
+func (x PartnerStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartnerStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[3].Descriptor()
+}
+
+func (PartnerStatus) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[3]
+}
+
+func (x PartnerStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartnerStatus.Descriptor instead.
+func (PartnerStatus) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{3}
+}
+
+// FollowStatus is the state of a follow request
+type FollowStatus int32
+
+const (
+	FollowStatus_FOLLOW_STATUS_UNSPECIFIED FollowStatus = 0
+	FollowStatus_FOLLOW_STATUS_PENDING     FollowStatus = 1
+	FollowStatus_FOLLOW_STATUS_ACCEPTED    FollowStatus = 2
+)
+
+// Enum value maps for FollowStatus.
+var (
+	FollowStatus_name = map[int32]string{
+		0: "FOLLOW_STATUS_UNSPECIFIED",
+		1: "FOLLOW_STATUS_PENDING",
+		2: "FOLLOW_STATUS_ACCEPTED",
+	}
+	FollowStatus_value = map[string]int32{
+		"FOLLOW_STATUS_UNSPECIFIED": 0,
+		"FOLLOW_STATUS_PENDING":     1,
+		"FOLLOW_STATUS_ACCEPTED":    2,
+	}
+)
+
+func (x FollowStatus) Enum() *FollowStatus {
+	p := new(FollowStatus)
+	*p = x
+	return p
+}
+
+func (x FollowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[4].Descriptor()
+}
+
+func (FollowStatus) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[4]
+}
+
+func (x FollowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowStatus.Descriptor instead.
+func (FollowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{4}
+}
+
+// FeedItemKind is the kind of activity a feed item reports
+type FeedItemKind int32
+
+const (
+	FeedItemKind_FEED_ITEM_KIND_UNSPECIFIED  FeedItemKind = 0
+	FeedItemKind_FEED_ITEM_KIND_CONFIRMATION FeedItemKind = 1 // A habit was confirmed
+	FeedItemKind_FEED_ITEM_KIND_MILESTONE    FeedItemKind = 2 // A habit unlocked an achievement
+)
+
+// Enum value maps for FeedItemKind.
+var (
+	FeedItemKind_name = map[int32]string{
+		0: "FEED_ITEM_KIND_UNSPECIFIED",
+		1: "FEED_ITEM_KIND_CONFIRMATION",
+		2: "FEED_ITEM_KIND_MILESTONE",
+	}
+	FeedItemKind_value = map[string]int32{
+		"FEED_ITEM_KIND_UNSPECIFIED":  0,
+		"FEED_ITEM_KIND_CONFIRMATION": 1,
+		"FEED_ITEM_KIND_MILESTONE":    2,
+	}
+)
+
+func (x FeedItemKind) Enum() *FeedItemKind {
+	p := new(FeedItemKind)
+	*p = x
+	return p
+}
+
+func (x FeedItemKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedItemKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[5].Descriptor()
+}
+
+func (FeedItemKind) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[5]
+}
+
+func (x FeedItemKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedItemKind.Descriptor instead.
+func (FeedItemKind) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{5}
+}
+
+// HabitImportSource is the tracker an import file was exported from
+type HabitImportSource int32
+
+const (
+	HabitImportSource_HABIT_IMPORT_SOURCE_UNSPECIFIED HabitImportSource = 0
+	HabitImportSource_HABIT_IMPORT_SOURCE_LOOP        HabitImportSource = 1 // Loop Habit Tracker ZIP export, or its Checkmarks.csv
+	HabitImportSource_HABIT_IMPORT_SOURCE_HABITICA    HabitImportSource = 2 // Habitica user data JSON
+	HabitImportSource_HABIT_IMPORT_SOURCE_STREAKS     HabitImportSource = 3 // Streaks CSV export
+)
+
+// Enum value maps for HabitImportSource.
+var (
+	HabitImportSource_name = map[int32]string{
+		0: "HABIT_IMPORT_SOURCE_UNSPECIFIED",
+		1: "HABIT_IMPORT_SOURCE_LOOP",
+		2: "HABIT_IMPORT_SOURCE_HABITICA",
+		3: "HABIT_IMPORT_SOURCE_STREAKS",
+	}
+	HabitImportSource_value = map[string]int32{
+		"HABIT_IMPORT_SOURCE_UNSPECIFIED": 0,
+		"HABIT_IMPORT_SOURCE_LOOP":        1,
+		"HABIT_IMPORT_SOURCE_HABITICA":    2,
+		"HABIT_IMPORT_SOURCE_STREAKS":     3,
+	}
+)
+
+func (x HabitImportSource) Enum() *HabitImportSource {
+	p := new(HabitImportSource)
+	*p = x
+	return p
+}
+
+func (x HabitImportSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HabitImportSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[6].Descriptor()
+}
+
+func (HabitImportSource) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[6]
+}
+
+func (x HabitImportSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HabitImportSource.Descriptor instead.
+func (HabitImportSource) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{6}
+}
+
+type HabitImportStatus int32
+
+const (
+	HabitImportStatus_HABIT_IMPORT_STATUS_UNSPECIFIED HabitImportStatus = 0
+	HabitImportStatus_HABIT_IMPORT_STATUS_PENDING     HabitImportStatus = 1
+	HabitImportStatus_HABIT_IMPORT_STATUS_PROCESSING  HabitImportStatus = 2
+	HabitImportStatus_HABIT_IMPORT_STATUS_COMPLETED   HabitImportStatus = 3
+	HabitImportStatus_HABIT_IMPORT_STATUS_FAILED      HabitImportStatus = 4
+)
+
+// Enum value maps for HabitImportStatus.
+var (
+	HabitImportStatus_name = map[int32]string{
+		0: "HABIT_IMPORT_STATUS_UNSPECIFIED",
+		1: "HABIT_IMPORT_STATUS_PENDING",
+		2: "HABIT_IMPORT_STATUS_PROCESSING",
+		3: "HABIT_IMPORT_STATUS_COMPLETED",
+		4: "HABIT_IMPORT_STATUS_FAILED",
+	}
+	HabitImportStatus_value = map[string]int32{
+		"HABIT_IMPORT_STATUS_UNSPECIFIED": 0,
+		"HABIT_IMPORT_STATUS_PENDING":     1,
+		"HABIT_IMPORT_STATUS_PROCESSING":  2,
+		"HABIT_IMPORT_STATUS_COMPLETED":   3,
+		"HABIT_IMPORT_STATUS_FAILED":      4,
+	}
+)
+
+func (x HabitImportStatus) Enum() *HabitImportStatus {
+	p := new(HabitImportStatus)
+	*p = x
+	return p
+}
+
+func (x HabitImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HabitImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[7].Descriptor()
+}
+
+func (HabitImportStatus) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[7]
+}
+
+func (x HabitImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HabitImportStatus.Descriptor instead.
+func (HabitImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{7}
+}
+
+// HistoryExportFormat is the file format of a habit history export
+type HistoryExportFormat int32
+
+const (
+	HistoryExportFormat_HISTORY_EXPORT_FORMAT_UNSPECIFIED HistoryExportFormat = 0
+	HistoryExportFormat_HISTORY_EXPORT_FORMAT_CSV         HistoryExportFormat = 1 // One row per confirmation
+	HistoryExportFormat_HISTORY_EXPORT_FORMAT_JSON        HistoryExportFormat = 2 // {"habits": [...], "confirmations": [...]}
+)
+
+// Enum value maps for HistoryExportFormat.
+var (
+	HistoryExportFormat_name = map[int32]string{
+		0: "HISTORY_EXPORT_FORMAT_UNSPECIFIED",
+		1: "HISTORY_EXPORT_FORMAT_CSV",
+		2: "HISTORY_EXPORT_FORMAT_JSON",
+	}
+	HistoryExportFormat_value = map[string]int32{
+		"HISTORY_EXPORT_FORMAT_UNSPECIFIED": 0,
+		"HISTORY_EXPORT_FORMAT_CSV":         1,
+		"HISTORY_EXPORT_FORMAT_JSON":        2,
+	}
+)
+
+func (x HistoryExportFormat) Enum() *HistoryExportFormat {
+	p := new(HistoryExportFormat)
+	*p = x
+	return p
+}
+
+func (x HistoryExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistoryExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[8].Descriptor()
+}
+
+func (HistoryExportFormat) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[8]
+}
+
+func (x HistoryExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoryExportFormat.Descriptor instead.
+func (HistoryExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{8}
+}
+
+// GetHabitCalendar
+type CalendarDayState int32
+
+const (
+	CalendarDayState_CALENDAR_DAY_STATE_UNSPECIFIED CalendarDayState = 0
+	CalendarDayState_CALENDAR_DAY_STATE_DONE        CalendarDayState = 1 // Confirmed on this date
+	CalendarDayState_CALENDAR_DAY_STATE_MISSED      CalendarDayState = 2 // Due on this date and the period was not completed
+	CalendarDayState_CALENDAR_DAY_STATE_PENDING     CalendarDayState = 3 // Due today and not confirmed yet
+	CalendarDayState_CALENDAR_DAY_STATE_NOT_DUE     CalendarDayState = 4 // Nothing was expected on this date
+	CalendarDayState_CALENDAR_DAY_STATE_FROZEN      CalendarDayState = 5 // Paused, or after the habit was archived
+	CalendarDayState_CALENDAR_DAY_STATE_FUTURE      CalendarDayState = 6 // After today
+)
+
+// Enum value maps for CalendarDayState.
+var (
+	CalendarDayState_name = map[int32]string{
+		0: "CALENDAR_DAY_STATE_UNSPECIFIED",
+		1: "CALENDAR_DAY_STATE_DONE",
+		2: "CALENDAR_DAY_STATE_MISSED",
+		3: "CALENDAR_DAY_STATE_PENDING",
+		4: "CALENDAR_DAY_STATE_NOT_DUE",
+		5: "CALENDAR_DAY_STATE_FROZEN",
+		6: "CALENDAR_DAY_STATE_FUTURE",
+	}
+	CalendarDayState_value = map[string]int32{
+		"CALENDAR_DAY_STATE_UNSPECIFIED": 0,
+		"CALENDAR_DAY_STATE_DONE":        1,
+		"CALENDAR_DAY_STATE_MISSED":      2,
+		"CALENDAR_DAY_STATE_PENDING":     3,
+		"CALENDAR_DAY_STATE_NOT_DUE":     4,
+		"CALENDAR_DAY_STATE_FROZEN":      5,
+		"CALENDAR_DAY_STATE_FUTURE":      6,
+	}
+)
+
+func (x CalendarDayState) Enum() *CalendarDayState {
+	p := new(CalendarDayState)
+	*p = x
+	return p
+}
+
+func (x CalendarDayState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CalendarDayState) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[9].Descriptor()
+}
+
+func (CalendarDayState) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[9]
+}
+
+func (x CalendarDayState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CalendarDayState.Descriptor instead.
+func (CalendarDayState) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{9}
+}
+
+// GetCompletionTrend
+type TrendGranularity int32
+
+const (
+	TrendGranularity_TREND_GRANULARITY_UNSPECIFIED TrendGranularity = 0 // Defaults to week
+	TrendGranularity_TREND_GRANULARITY_DAY         TrendGranularity = 1
+	TrendGranularity_TREND_GRANULARITY_WEEK        TrendGranularity = 2 // Calendar weeks starting on Monday
+	TrendGranularity_TREND_GRANULARITY_MONTH       TrendGranularity = 3
+)
+
+// Enum value maps for TrendGranularity.
+var (
+	TrendGranularity_name = map[int32]string{
+		0: "TREND_GRANULARITY_UNSPECIFIED",
+		1: "TREND_GRANULARITY_DAY",
+		2: "TREND_GRANULARITY_WEEK",
+		3: "TREND_GRANULARITY_MONTH",
+	}
+	TrendGranularity_value = map[string]int32{
+		"TREND_GRANULARITY_UNSPECIFIED": 0,
+		"TREND_GRANULARITY_DAY":         1,
+		"TREND_GRANULARITY_WEEK":        2,
+		"TREND_GRANULARITY_MONTH":       3,
+	}
+)
+
+func (x TrendGranularity) Enum() *TrendGranularity {
+	p := new(TrendGranularity)
+	*p = x
+	return p
+}
+
+func (x TrendGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrendGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[10].Descriptor()
+}
+
+func (TrendGranularity) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[10]
+}
+
+func (x TrendGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrendGranularity.Descriptor instead.
+func (TrendGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{10}
+}
+
+type TrendDirection int32
+
+const (
+	TrendDirection_TREND_DIRECTION_UNSPECIFIED       TrendDirection = 0
+	TrendDirection_TREND_DIRECTION_IMPROVING         TrendDirection = 1
+	TrendDirection_TREND_DIRECTION_DECLINING         TrendDirection = 2
+	TrendDirection_TREND_DIRECTION_STABLE            TrendDirection = 3
+	TrendDirection_TREND_DIRECTION_INSUFFICIENT_DATA TrendDirection = 4 // Fewer than 3 buckets with activity
+)
+
+// Enum value maps for TrendDirection.
+var (
+	TrendDirection_name = map[int32]string{
+		0: "TREND_DIRECTION_UNSPECIFIED",
+		1: "TREND_DIRECTION_IMPROVING",
+		2: "TREND_DIRECTION_DECLINING",
+		3: "TREND_DIRECTION_STABLE",
+		4: "TREND_DIRECTION_INSUFFICIENT_DATA",
+	}
+	TrendDirection_value = map[string]int32{
+		"TREND_DIRECTION_UNSPECIFIED":       0,
+		"TREND_DIRECTION_IMPROVING":         1,
+		"TREND_DIRECTION_DECLINING":         2,
+		"TREND_DIRECTION_STABLE":            3,
+		"TREND_DIRECTION_INSUFFICIENT_DATA": 4,
+	}
+)
+
+func (x TrendDirection) Enum() *TrendDirection {
+	p := new(TrendDirection)
+	*p = x
+	return p
+}
+
+func (x TrendDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrendDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[11].Descriptor()
+}
+
+func (TrendDirection) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[11]
+}
+
+func (x TrendDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrendDirection.Descriptor instead.
+func (TrendDirection) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{11}
+}
+
+// SearchNotes
+type NoteKind int32
+
+const (
+	NoteKind_NOTE_KIND_UNSPECIFIED  NoteKind = 0
+	NoteKind_NOTE_KIND_JOURNAL      NoteKind = 1
+	NoteKind_NOTE_KIND_CONFIRMATION NoteKind = 2
+)
+
+// Enum value maps for NoteKind.
+var (
+	NoteKind_name = map[int32]string{
+		0: "NOTE_KIND_UNSPECIFIED",
+		1: "NOTE_KIND_JOURNAL",
+		2: "NOTE_KIND_CONFIRMATION",
+	}
+	NoteKind_value = map[string]int32{
+		"NOTE_KIND_UNSPECIFIED":  0,
+		"NOTE_KIND_JOURNAL":      1,
+		"NOTE_KIND_CONFIRMATION": 2,
+	}
+)
+
+func (x NoteKind) Enum() *NoteKind {
+	p := new(NoteKind)
+	*p = x
+	return p
+}
+
+func (x NoteKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NoteKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_habits_proto_enumTypes[12].Descriptor()
+}
+
+func (NoteKind) Type() protoreflect.EnumType {
+	return &file_habits_proto_enumTypes[12]
+}
+
+func (x NoteKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NoteKind.Descriptor instead.
+func (NoteKind) EnumDescriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{12}
+}
+
+// Habit message
+type Habit struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Basic info
+	Name        string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Color       *string `protobuf:"bytes,5,opt,name=color,proto3,oneof" json:"color,omitempty"` // HEX color, e.g., "#FF5722"
+	// Schedule configuration
+	ScheduleType ScheduleType `protobuf:"varint,6,opt,name=schedule_type,json=scheduleType,proto3,enum=habits.v1.ScheduleType" json:"schedule_type,omitempty"`
+	IntervalDays *int32       `protobuf:"varint,7,opt,name=interval_days,json=intervalDays,proto3,oneof" json:"interval_days,omitempty"` // Required for INTERVAL type (1=daily, 2=every other day, etc.)
+	WeeklyDays   []int32      `protobuf:"varint,8,rep,packed,name=weekly_days,json=weeklyDays,proto3" json:"weekly_days,omitempty"`      // Required for WEEKLY type (0=Sunday, 1=Monday, ..., 6=Saturday)
+	// Timezone offset in hours from UTC (-12 to +14)
+	TimezoneOffsetHours int32 `protobuf:"varint,9,opt,name=timezone_offset_hours,json=timezoneOffsetHours,proto3" json:"timezone_offset_hours,omitempty"`
+	// Streak state
+	Streak                    int32                  `protobuf:"varint,10,opt,name=streak,proto3" json:"streak,omitempty"`
+	NextDeadlineUtc           *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_deadline_utc,json=nextDeadlineUtc,proto3" json:"next_deadline_utc,omitempty"`
+	ConfirmedForCurrentPeriod bool                   `protobuf:"varint,12,opt,name=confirmed_for_current_period,json=confirmedForCurrentPeriod,proto3" json:"confirmed_for_current_period,omitempty"`
+	LastConfirmedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_confirmed_at,json=lastConfirmedAt,proto3,oneof" json:"last_confirmed_at,omitempty"`
+	// Metadata
+	IsActive   bool                   `protobuf:"varint,14,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"` // False when habit is archived
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	IsPaused   bool                   `protobuf:"varint,18,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"` // True during a pause, the current period is treated as confirmed
+	// Organization
+	GroupId       *string         `protobuf:"bytes,19,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	Icon          *string         `protobuf:"bytes,20,opt,name=icon,proto3,oneof" json:"icon,omitempty"`    // Emoji or icon name
+	Position      int32           `protobuf:"varint,21,opt,name=position,proto3" json:"position,omitempty"` // Manual sort order, lower comes first
+	Tags          []string        `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`          // Lowercase, sorted
+	Visibility    HabitVisibility `protobuf:"varint,23,opt,name=visibility,proto3,enum=habits.v1.HabitVisibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Habit) Reset() {
+	*x = Habit{}
+	mi := &file_habits_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Habit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Habit) ProtoMessage() {}
+
+func (x *Habit) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Habit.ProtoReflect.Descriptor instead.
+func (*Habit) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Habit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Habit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Habit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Habit) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Habit) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *Habit) GetScheduleType() ScheduleType {
+	if x != nil {
+		return x.ScheduleType
+	}
+	return ScheduleType_SCHEDULE_TYPE_UNSPECIFIED
+}
+
+func (x *Habit) GetIntervalDays() int32 {
+	if x != nil && x.IntervalDays != nil {
+		return *x.IntervalDays
+	}
+	return 0
+}
+
+func (x *Habit) GetWeeklyDays() []int32 {
+	if x != nil {
+		return x.WeeklyDays
+	}
+	return nil
+}
+
+func (x *Habit) GetTimezoneOffsetHours() int32 {
+	if x != nil {
+		return x.TimezoneOffsetHours
+	}
+	return 0
+}
+
+func (x *Habit) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *Habit) GetNextDeadlineUtc() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDeadlineUtc
+	}
+	return nil
+}
+
+func (x *Habit) GetConfirmedForCurrentPeriod() bool {
+	if x != nil {
+		return x.ConfirmedForCurrentPeriod
+	}
+	return false
+}
+
+func (x *Habit) GetLastConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastConfirmedAt
+	}
+	return nil
+}
+
+func (x *Habit) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Habit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Habit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Habit) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Habit) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *Habit) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *Habit) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *Habit) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Habit) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Habit) GetVisibility() HabitVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return HabitVisibility_HABIT_VISIBILITY_UNSPECIFIED
+}
+
+// HabitGroup is a named set of habits shown together, e.g. "Morning routine"
+type HabitGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Color         *string                `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Icon          *string                `protobuf:"bytes,5,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	Position      int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`                       // Manual sort order, lower comes first
+	HabitCount    int32                  `protobuf:"varint,7,opt,name=habit_count,json=habitCount,proto3" json:"habit_count,omitempty"` // Number of habits in the group, including archived
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitGroup) Reset() {
+	*x = HabitGroup{}
+	mi := &file_habits_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitGroup) ProtoMessage() {}
+
+func (x *HabitGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitGroup.ProtoReflect.Descriptor instead.
+func (*HabitGroup) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HabitGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HabitGroup) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HabitGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HabitGroup) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *HabitGroup) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *HabitGroup) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *HabitGroup) GetHabitCount() int32 {
+	if x != nil {
+		return x.HabitCount
+	}
+	return 0
+}
+
+func (x *HabitGroup) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HabitGroup) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// HabitTemplate is a predefined habit. Catalog templates are shared, private templates
+// are saved by a user from their own habits
+type HabitTemplate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsPrivate       bool                   `protobuf:"varint,2,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Color           *string                `protobuf:"bytes,5,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Icon            *string                `protobuf:"bytes,6,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	Category        *string                `protobuf:"bytes,7,opt,name=category,proto3,oneof" json:"category,omitempty"` // Catalog section, e.g., "health", unset for private templates
+	Tags            []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	ScheduleType    ScheduleType           `protobuf:"varint,9,opt,name=schedule_type,json=scheduleType,proto3,enum=habits.v1.ScheduleType" json:"schedule_type,omitempty"`
+	IntervalDays    *int32                 `protobuf:"varint,10,opt,name=interval_days,json=intervalDays,proto3,oneof" json:"interval_days,omitempty"`
+	WeeklyDays      []int32                `protobuf:"varint,11,rep,packed,name=weekly_days,json=weeklyDays,proto3" json:"weekly_days,omitempty"`
+	SuggestedTarget *string                `protobuf:"bytes,12,opt,name=suggested_target,json=suggestedTarget,proto3,oneof" json:"suggested_target,omitempty"` // Free text, e.g., "8 glasses" or "20 minutes"
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HabitTemplate) Reset() {
+	*x = HabitTemplate{}
+	mi := &file_habits_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitTemplate) ProtoMessage() {}
+
+func (x *HabitTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitTemplate.ProtoReflect.Descriptor instead.
+func (*HabitTemplate) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HabitTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HabitTemplate) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *HabitTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HabitTemplate) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *HabitTemplate) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *HabitTemplate) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *HabitTemplate) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *HabitTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *HabitTemplate) GetScheduleType() ScheduleType {
+	if x != nil {
+		return x.ScheduleType
+	}
+	return ScheduleType_SCHEDULE_TYPE_UNSPECIFIED
+}
+
+func (x *HabitTemplate) GetIntervalDays() int32 {
+	if x != nil && x.IntervalDays != nil {
+		return *x.IntervalDays
+	}
+	return 0
+}
+
+func (x *HabitTemplate) GetWeeklyDays() []int32 {
+	if x != nil {
+		return x.WeeklyDays
+	}
+	return nil
+}
+
+func (x *HabitTemplate) GetSuggestedTarget() string {
+	if x != nil && x.SuggestedTarget != nil {
+		return *x.SuggestedTarget
+	}
+	return ""
+}
+
+func (x *HabitTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// HabitPartner is an accountability partner on a habit, or an invitation to become one
+type HabitPartner struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HabitId         string                 `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	HabitName       string                 `protobuf:"bytes,3,opt,name=habit_name,json=habitName,proto3" json:"habit_name,omitempty"`
+	OwnerId         string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OwnerUsername   string                 `protobuf:"bytes,5,opt,name=owner_username,json=ownerUsername,proto3" json:"owner_username,omitempty"`
+	PartnerId       string                 `protobuf:"bytes,6,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	PartnerUsername string                 `protobuf:"bytes,7,opt,name=partner_username,json=partnerUsername,proto3" json:"partner_username,omitempty"`
+	Status          PartnerStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=habits.v1.PartnerStatus" json:"status,omitempty"`
+	LastNudgedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_nudged_at,json=lastNudgedAt,proto3,oneof" json:"last_nudged_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RespondedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=responded_at,json=respondedAt,proto3,oneof" json:"responded_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HabitPartner) Reset() {
+	*x = HabitPartner{}
+	mi := &file_habits_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitPartner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitPartner) ProtoMessage() {}
+
+func (x *HabitPartner) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitPartner.ProtoReflect.Descriptor instead.
+func (*HabitPartner) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HabitPartner) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HabitPartner) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitPartner) GetHabitName() string {
+	if x != nil {
+		return x.HabitName
+	}
+	return ""
+}
+
+func (x *HabitPartner) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *HabitPartner) GetOwnerUsername() string {
+	if x != nil {
+		return x.OwnerUsername
+	}
+	return ""
+}
+
+func (x *HabitPartner) GetPartnerId() string {
+	if x != nil {
+		return x.PartnerId
+	}
+	return ""
+}
+
+func (x *HabitPartner) GetPartnerUsername() string {
+	if x != nil {
+		return x.PartnerUsername
+	}
+	return ""
+}
+
+func (x *HabitPartner) GetStatus() PartnerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PartnerStatus_PARTNER_STATUS_UNSPECIFIED
+}
+
+func (x *HabitPartner) GetLastNudgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastNudgedAt
+	}
+	return nil
+}
+
+func (x *HabitPartner) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HabitPartner) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+// SharedHabit is a habit of another user shared with the requesting partner
+type SharedHabit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartnershipId string                 `protobuf:"bytes,1,opt,name=partnership_id,json=partnershipId,proto3" json:"partnership_id,omitempty"`
+	OwnerUsername string                 `protobuf:"bytes,2,opt,name=owner_username,json=ownerUsername,proto3" json:"owner_username,omitempty"`
+	Habit         *Habit                 `protobuf:"bytes,3,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedHabit) Reset() {
+	*x = SharedHabit{}
+	mi := &file_habits_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedHabit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedHabit) ProtoMessage() {}
+
+func (x *SharedHabit) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedHabit.ProtoReflect.Descriptor instead.
+func (*SharedHabit) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SharedHabit) GetPartnershipId() string {
+	if x != nil {
+		return x.PartnershipId
+	}
+	return ""
+}
+
+func (x *SharedHabit) GetOwnerUsername() string {
+	if x != nil {
+		return x.OwnerUsername
+	}
+	return ""
+}
+
+func (x *SharedHabit) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+// Challenge is a time-boxed challenge users join with one of their habits
+type Challenge struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId        string                 `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	StartDate        string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Inclusive, format: "2006-01-02"
+	EndDate          string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Inclusive, format: "2006-01-02"
+	InviteCode       string                 `protobuf:"bytes,7,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	ParticipantCount int32                  `protobuf:"varint,8,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	mi := &file_habits_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Challenge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Challenge) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Challenge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Challenge) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Challenge) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Challenge) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Challenge) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *Challenge) GetParticipantCount() int32 {
+	if x != nil {
+		return x.ParticipantCount
+	}
+	return 0
+}
+
+func (x *Challenge) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Challenge) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ChallengeLeaderboardEntry is the position of a participant, equal scores share a rank
+type ChallengeLeaderboardEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"` // 1-based
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Completions   int32                  `protobuf:"varint,4,opt,name=completions,proto3" json:"completions,omitempty"` // Confirmations for dates inside the window
+	CurrentStreak int32                  `protobuf:"varint,5,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeLeaderboardEntry) Reset() {
+	*x = ChallengeLeaderboardEntry{}
+	mi := &file_habits_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeLeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeLeaderboardEntry) ProtoMessage() {}
+
+func (x *ChallengeLeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeLeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*ChallengeLeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChallengeLeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ChallengeLeaderboardEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChallengeLeaderboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChallengeLeaderboardEntry) GetCompletions() int32 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *ChallengeLeaderboardEntry) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+// Achievement is a badge of the catalog as seen by a user
+type Achievement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Stable identifier, e.g. "streak_7"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Xp            int32                  `protobuf:"varint,4,opt,name=xp,proto3" json:"xp,omitempty"` // XP granted when unlocked
+	Unlocked      bool                   `protobuf:"varint,5,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	UnlockedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=unlocked_at,json=unlockedAt,proto3,oneof" json:"unlocked_at,omitempty"`
+	HabitId       *string                `protobuf:"bytes,7,opt,name=habit_id,json=habitId,proto3,oneof" json:"habit_id,omitempty"` // Habit that unlocked it, not set when locked or the habit was purged
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	mi := &file_habits_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievement) ProtoMessage() {}
+
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Achievement) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Achievement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Achievement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Achievement) GetXp() int32 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *Achievement) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+func (x *Achievement) GetUnlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return nil
+}
+
+func (x *Achievement) GetHabitId() string {
+	if x != nil && x.HabitId != nil {
+		return *x.HabitId
+	}
+	return ""
+}
+
+// Follow is a follow relationship between two users, or a request to establish one
+type Follow struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId       string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FollowerUsername string                 `protobuf:"bytes,2,opt,name=follower_username,json=followerUsername,proto3" json:"follower_username,omitempty"`
+	FolloweeId       string                 `protobuf:"bytes,3,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	FolloweeUsername string                 `protobuf:"bytes,4,opt,name=followee_username,json=followeeUsername,proto3" json:"followee_username,omitempty"`
+	Status           FollowStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=habits.v1.FollowStatus" json:"status,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RespondedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=responded_at,json=respondedAt,proto3,oneof" json:"responded_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Follow) Reset() {
+	*x = Follow{}
+	mi := &file_habits_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Follow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Follow) ProtoMessage() {}
+
+func (x *Follow) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Follow.ProtoReflect.Descriptor instead.
+func (*Follow) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Follow) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *Follow) GetFollowerUsername() string {
+	if x != nil {
+		return x.FollowerUsername
+	}
+	return ""
+}
+
+func (x *Follow) GetFolloweeId() string {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return ""
+}
+
+func (x *Follow) GetFolloweeUsername() string {
+	if x != nil {
+		return x.FolloweeUsername
+	}
+	return ""
+}
+
+func (x *Follow) GetStatus() FollowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FollowStatus_FOLLOW_STATUS_UNSPECIFIED
+}
+
+func (x *Follow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Follow) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+// FeedItem is an activity of a followed user
+type FeedItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind            FeedItemKind           `protobuf:"varint,2,opt,name=kind,proto3,enum=habits.v1.FeedItemKind" json:"kind,omitempty"`
+	ActorId         string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorUsername   string                 `protobuf:"bytes,4,opt,name=actor_username,json=actorUsername,proto3" json:"actor_username,omitempty"`
+	HabitId         string                 `protobuf:"bytes,5,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	HabitName       string                 `protobuf:"bytes,6,opt,name=habit_name,json=habitName,proto3" json:"habit_name,omitempty"`
+	HabitVisibility HabitVisibility        `protobuf:"varint,7,opt,name=habit_visibility,json=habitVisibility,proto3,enum=habits.v1.HabitVisibility" json:"habit_visibility,omitempty"`
+	// Confirmation details
+	ConfirmedForDate *string `protobuf:"bytes,8,opt,name=confirmed_for_date,json=confirmedForDate,proto3,oneof" json:"confirmed_for_date,omitempty"` // Format: "2006-01-02"
+	Streak           *int32  `protobuf:"varint,9,opt,name=streak,proto3,oneof" json:"streak,omitempty"`
+	// Milestone details
+	AchievementCode *string                `protobuf:"bytes,10,opt,name=achievement_code,json=achievementCode,proto3,oneof" json:"achievement_code,omitempty"`
+	AchievementName *string                `protobuf:"bytes,11,opt,name=achievement_name,json=achievementName,proto3,oneof" json:"achievement_name,omitempty"`
+	OccurredAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FeedItem) Reset() {
+	*x = FeedItem{}
+	mi := &file_habits_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedItem) ProtoMessage() {}
+
+func (x *FeedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedItem.ProtoReflect.Descriptor instead.
+func (*FeedItem) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FeedItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeedItem) GetKind() FeedItemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FeedItemKind_FEED_ITEM_KIND_UNSPECIFIED
+}
+
+func (x *FeedItem) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *FeedItem) GetActorUsername() string {
+	if x != nil {
+		return x.ActorUsername
+	}
+	return ""
+}
+
+func (x *FeedItem) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *FeedItem) GetHabitName() string {
+	if x != nil {
+		return x.HabitName
+	}
+	return ""
+}
+
+func (x *FeedItem) GetHabitVisibility() HabitVisibility {
+	if x != nil {
+		return x.HabitVisibility
+	}
+	return HabitVisibility_HABIT_VISIBILITY_UNSPECIFIED
+}
+
+func (x *FeedItem) GetConfirmedForDate() string {
+	if x != nil && x.ConfirmedForDate != nil {
+		return *x.ConfirmedForDate
+	}
+	return ""
+}
+
+func (x *FeedItem) GetStreak() int32 {
+	if x != nil && x.Streak != nil {
+		return *x.Streak
+	}
+	return 0
+}
+
+func (x *FeedItem) GetAchievementCode() string {
+	if x != nil && x.AchievementCode != nil {
+		return *x.AchievementCode
+	}
+	return ""
+}
+
+func (x *FeedItem) GetAchievementName() string {
+	if x != nil && x.AchievementName != nil {
+		return *x.AchievementName
+	}
+	return ""
+}
+
+func (x *FeedItem) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// PublicHabit is the part of a habit shown on a public profile
+type PublicHabit struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Id                        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color                     *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Icon                      *string                `protobuf:"bytes,4,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	ScheduleType              ScheduleType           `protobuf:"varint,5,opt,name=schedule_type,json=scheduleType,proto3,enum=habits.v1.ScheduleType" json:"schedule_type,omitempty"`
+	IntervalDays              *int32                 `protobuf:"varint,6,opt,name=interval_days,json=intervalDays,proto3,oneof" json:"interval_days,omitempty"`
+	WeeklyDays                []int32                `protobuf:"varint,7,rep,packed,name=weekly_days,json=weeklyDays,proto3" json:"weekly_days,omitempty"`
+	Streak                    int32                  `protobuf:"varint,8,opt,name=streak,proto3" json:"streak,omitempty"`
+	ConfirmedForCurrentPeriod bool                   `protobuf:"varint,9,opt,name=confirmed_for_current_period,json=confirmedForCurrentPeriod,proto3" json:"confirmed_for_current_period,omitempty"`
+	CreatedAt                 *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *PublicHabit) Reset() {
+	*x = PublicHabit{}
+	mi := &file_habits_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicHabit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicHabit) ProtoMessage() {}
+
+func (x *PublicHabit) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicHabit.ProtoReflect.Descriptor instead.
+func (*PublicHabit) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PublicHabit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublicHabit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublicHabit) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *PublicHabit) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *PublicHabit) GetScheduleType() ScheduleType {
+	if x != nil {
+		return x.ScheduleType
+	}
+	return ScheduleType_SCHEDULE_TYPE_UNSPECIFIED
+}
+
+func (x *PublicHabit) GetIntervalDays() int32 {
+	if x != nil && x.IntervalDays != nil {
+		return *x.IntervalDays
+	}
+	return 0
+}
+
+func (x *PublicHabit) GetWeeklyDays() []int32 {
+	if x != nil {
+		return x.WeeklyDays
+	}
+	return nil
+}
+
+func (x *PublicHabit) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *PublicHabit) GetConfirmedForCurrentPeriod() bool {
+	if x != nil {
+		return x.ConfirmedForCurrentPeriod
+	}
+	return false
+}
+
+func (x *PublicHabit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// PublicProfile is a user who opted in to a public profile
+type PublicProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	MemberSince   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=member_since,json=memberSince,proto3" json:"member_since,omitempty"`
+	Habits        []*PublicHabit         `protobuf:"bytes,4,rep,name=habits,proto3" json:"habits,omitempty"` // Ordered by the user's manual order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicProfile) Reset() {
+	*x = PublicProfile{}
+	mi := &file_habits_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicProfile) ProtoMessage() {}
+
+func (x *PublicProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicProfile.ProtoReflect.Descriptor instead.
+func (*PublicProfile) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PublicProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *PublicProfile) GetMemberSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MemberSince
+	}
+	return nil
+}
+
+func (x *PublicProfile) GetHabits() []*PublicHabit {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+// ImportedHabit is a habit mapped from an import file
+type ImportedHabit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	ScheduleType  ScheduleType           `protobuf:"varint,4,opt,name=schedule_type,json=scheduleType,proto3,enum=habits.v1.ScheduleType" json:"schedule_type,omitempty"`
+	IntervalDays  *int32                 `protobuf:"varint,5,opt,name=interval_days,json=intervalDays,proto3,oneof" json:"interval_days,omitempty"`
+	WeeklyDays    []int32                `protobuf:"varint,6,rep,packed,name=weekly_days,json=weeklyDays,proto3" json:"weekly_days,omitempty"`
+	Confirmations int32                  `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`               // Days the habit was done
+	FirstDate     *string                `protobuf:"bytes,8,opt,name=first_date,json=firstDate,proto3,oneof" json:"first_date,omitempty"` // Format: YYYY-MM-DD
+	LastDate      *string                `protobuf:"bytes,9,opt,name=last_date,json=lastDate,proto3,oneof" json:"last_date,omitempty"`    // Format: YYYY-MM-DD
+	Note          *string                `protobuf:"bytes,10,opt,name=note,proto3,oneof" json:"note,omitempty"`                           // How the schedule was approximated, unset when it maps exactly
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedHabit) Reset() {
+	*x = ImportedHabit{}
+	mi := &file_habits_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedHabit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedHabit) ProtoMessage() {}
+
+func (x *ImportedHabit) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedHabit.ProtoReflect.Descriptor instead.
+func (*ImportedHabit) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportedHabit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedHabit) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ImportedHabit) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *ImportedHabit) GetScheduleType() ScheduleType {
+	if x != nil {
+		return x.ScheduleType
+	}
+	return ScheduleType_SCHEDULE_TYPE_UNSPECIFIED
+}
+
+func (x *ImportedHabit) GetIntervalDays() int32 {
+	if x != nil && x.IntervalDays != nil {
+		return *x.IntervalDays
+	}
+	return 0
+}
+
+func (x *ImportedHabit) GetWeeklyDays() []int32 {
+	if x != nil {
+		return x.WeeklyDays
+	}
+	return nil
+}
+
+func (x *ImportedHabit) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *ImportedHabit) GetFirstDate() string {
+	if x != nil && x.FirstDate != nil {
+		return *x.FirstDate
+	}
+	return ""
+}
+
+func (x *ImportedHabit) GetLastDate() string {
+	if x != nil && x.LastDate != nil {
+		return *x.LastDate
+	}
+	return ""
+}
+
+func (x *ImportedHabit) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+// HabitImportIssue is a row or habit of an import file that was skipped or could not be mapped
+type HabitImportIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           *int32                 `protobuf:"varint,1,opt,name=row,proto3,oneof" json:"row,omitempty"` // Line of the file, unset when the issue concerns a whole habit
+	HabitName     *string                `protobuf:"bytes,2,opt,name=habit_name,json=habitName,proto3,oneof" json:"habit_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitImportIssue) Reset() {
+	*x = HabitImportIssue{}
+	mi := &file_habits_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitImportIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitImportIssue) ProtoMessage() {}
+
+func (x *HabitImportIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitImportIssue.ProtoReflect.Descriptor instead.
+func (*HabitImportIssue) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HabitImportIssue) GetRow() int32 {
+	if x != nil && x.Row != nil {
+		return *x.Row
+	}
+	return 0
+}
+
+func (x *HabitImportIssue) GetHabitName() string {
+	if x != nil && x.HabitName != nil {
+		return *x.HabitName
+	}
+	return ""
+}
+
+func (x *HabitImportIssue) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// HabitImport is an import of habits from another tracker
+type HabitImport struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Empty for dry runs
+	Source                HabitImportSource      `protobuf:"varint,2,opt,name=source,proto3,enum=habits.v1.HabitImportSource" json:"source,omitempty"`
+	Status                HabitImportStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=habits.v1.HabitImportStatus" json:"status,omitempty"`
+	DryRun                bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TotalHabits           int32                  `protobuf:"varint,5,opt,name=total_habits,json=totalHabits,proto3" json:"total_habits,omitempty"`
+	TotalConfirmations    int32                  `protobuf:"varint,6,opt,name=total_confirmations,json=totalConfirmations,proto3" json:"total_confirmations,omitempty"`
+	SkippedRows           int32                  `protobuf:"varint,7,opt,name=skipped_rows,json=skippedRows,proto3" json:"skipped_rows,omitempty"`
+	HabitsImported        int32                  `protobuf:"varint,8,opt,name=habits_imported,json=habitsImported,proto3" json:"habits_imported,omitempty"`
+	ConfirmationsImported int32                  `protobuf:"varint,9,opt,name=confirmations_imported,json=confirmationsImported,proto3" json:"confirmations_imported,omitempty"`
+	Habits                []*ImportedHabit       `protobuf:"bytes,10,rep,name=habits,proto3" json:"habits,omitempty"` // Only set in the ImportHabits response
+	Issues                []*HabitImportIssue    `protobuf:"bytes,11,rep,name=issues,proto3" json:"issues,omitempty"`
+	Error                 *string                `protobuf:"bytes,12,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt            *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HabitImport) Reset() {
+	*x = HabitImport{}
+	mi := &file_habits_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitImport) ProtoMessage() {}
+
+func (x *HabitImport) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitImport.ProtoReflect.Descriptor instead.
+func (*HabitImport) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HabitImport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HabitImport) GetSource() HabitImportSource {
+	if x != nil {
+		return x.Source
+	}
+	return HabitImportSource_HABIT_IMPORT_SOURCE_UNSPECIFIED
+}
+
+func (x *HabitImport) GetStatus() HabitImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HabitImportStatus_HABIT_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *HabitImport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *HabitImport) GetTotalHabits() int32 {
+	if x != nil {
+		return x.TotalHabits
+	}
+	return 0
+}
+
+func (x *HabitImport) GetTotalConfirmations() int32 {
+	if x != nil {
+		return x.TotalConfirmations
+	}
+	return 0
+}
+
+func (x *HabitImport) GetSkippedRows() int32 {
+	if x != nil {
+		return x.SkippedRows
+	}
+	return 0
+}
+
+func (x *HabitImport) GetHabitsImported() int32 {
+	if x != nil {
+		return x.HabitsImported
+	}
+	return 0
+}
+
+func (x *HabitImport) GetConfirmationsImported() int32 {
+	if x != nil {
+		return x.ConfirmationsImported
+	}
+	return 0
+}
+
+func (x *HabitImport) GetHabits() []*ImportedHabit {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+func (x *HabitImport) GetIssues() []*HabitImportIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *HabitImport) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *HabitImport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HabitImport) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// TagUsage is a tag with the number of habits carrying it
+type TagUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	HabitCount    int32                  `protobuf:"varint,2,opt,name=habit_count,json=habitCount,proto3" json:"habit_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagUsage) Reset() {
+	*x = TagUsage{}
+	mi := &file_habits_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagUsage) ProtoMessage() {}
+
+func (x *TagUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagUsage.ProtoReflect.Descriptor instead.
+func (*TagUsage) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TagUsage) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagUsage) GetHabitCount() int32 {
+	if x != nil {
+		return x.HabitCount
+	}
+	return 0
+}
+
+// HabitConfirmation message
+type HabitConfirmation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HabitId          string                 `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConfirmedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	ConfirmedForDate string                 `protobuf:"bytes,5,opt,name=confirmed_for_date,json=confirmedForDate,proto3" json:"confirmed_for_date,omitempty"` // Date in format "YYYY-MM-DD"
+	Notes            *string                `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AttachmentIds    []string               `protobuf:"bytes,8,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"` // Set by ConfirmHabit and GetHabitHistory
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HabitConfirmation) Reset() {
+	*x = HabitConfirmation{}
+	mi := &file_habits_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitConfirmation) ProtoMessage() {}
+
+func (x *HabitConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitConfirmation.ProtoReflect.Descriptor instead.
+func (*HabitConfirmation) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HabitConfirmation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HabitConfirmation) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitConfirmation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HabitConfirmation) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *HabitConfirmation) GetConfirmedForDate() string {
+	if x != nil {
+		return x.ConfirmedForDate
+	}
+	return ""
+}
+
+func (x *HabitConfirmation) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *HabitConfirmation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HabitConfirmation) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+// HabitPause is a vacation period during which habit deadlines are frozen
+type HabitPause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HabitId       string                 `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Date in format "YYYY-MM-DD" (in habit's timezone)
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Inclusive, date in format "YYYY-MM-DD" (in habit's timezone)
+	Reason        *string                `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ResumedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=resumed_at,json=resumedAt,proto3,oneof" json:"resumed_at,omitempty"` // Set once deadlines were recalculated
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitPause) Reset() {
+	*x = HabitPause{}
+	mi := &file_habits_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitPause) ProtoMessage() {}
+
+func (x *HabitPause) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitPause.ProtoReflect.Descriptor instead.
+func (*HabitPause) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HabitPause) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HabitPause) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *HabitPause) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HabitPause) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *HabitPause) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *HabitPause) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *HabitPause) GetResumedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResumedAt
+	}
+	return nil
+}
+
+func (x *HabitPause) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateHabit
+type CreateHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Color         *string                `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	ScheduleType  ScheduleType           `protobuf:"varint,5,opt,name=schedule_type,json=scheduleType,proto3,enum=habits.v1.ScheduleType" json:"schedule_type,omitempty"`
+	IntervalDays  *int32                 `protobuf:"varint,6,opt,name=interval_days,json=intervalDays,proto3,oneof" json:"interval_days,omitempty"`
+	WeeklyDays    []int32                `protobuf:"varint,7,rep,packed,name=weekly_days,json=weeklyDays,proto3" json:"weekly_days,omitempty"`
+	Timezone      string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA timezone string (e.g., "Europe/Moscow", "America/New_York")
+	GroupId       *string                `protobuf:"bytes,9,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	Icon          *string                `protobuf:"bytes,10,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`                                                   // Up to 20, trimmed and lowercased
+	Visibility    *HabitVisibility       `protobuf:"varint,12,opt,name=visibility,proto3,enum=habits.v1.HabitVisibility,oneof" json:"visibility,omitempty"` // Private when not set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHabitRequest) Reset() {
+	*x = CreateHabitRequest{}
+	mi := &file_habits_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHabitRequest) ProtoMessage() {}
+
+func (x *CreateHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHabitRequest.ProtoReflect.Descriptor instead.
+func (*CreateHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateHabitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetScheduleType() ScheduleType {
+	if x != nil {
+		return x.ScheduleType
+	}
+	return ScheduleType_SCHEDULE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateHabitRequest) GetIntervalDays() int32 {
+	if x != nil && x.IntervalDays != nil {
+		return *x.IntervalDays
+	}
+	return 0
+}
+
+func (x *CreateHabitRequest) GetWeeklyDays() []int32 {
+	if x != nil {
+		return x.WeeklyDays
+	}
+	return nil
+}
+
+func (x *CreateHabitRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateHabitRequest) GetVisibility() HabitVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return HabitVisibility_HABIT_VISIBILITY_UNSPECIFIED
+}
+
+type CreateHabitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHabitResponse) Reset() {
+	*x = CreateHabitResponse{}
+	mi := &file_habits_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHabitResponse) ProtoMessage() {}
+
+func (x *CreateHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHabitResponse.ProtoReflect.Descriptor instead.
+func (*CreateHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateHabitResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+// GetHabit
+type GetHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitRequest) Reset() {
+	*x = GetHabitRequest{}
+	mi := &file_habits_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitRequest) ProtoMessage() {}
+
+func (x *GetHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *GetHabitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetHabitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitResponse) Reset() {
+	*x = GetHabitResponse{}
+	mi := &file_habits_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitResponse) ProtoMessage() {}
+
+func (x *GetHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitResponse.ProtoReflect.Descriptor instead.
+func (*GetHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetHabitResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+// ListHabits
+// Habits are listed in manual order, new habits go on top. Pages are addressed by opaque tokens, pass next_page_token
+// of a response as page_token to get the following page
+type ListHabitsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActiveOnly        *bool                  `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3,oneof" json:"active_only,omitempty"` // Deprecated: use status, applied only when status is unspecified
+	Status            HabitStatusFilter      `protobuf:"varint,3,opt,name=status,proto3,enum=habits.v1.HabitStatusFilter" json:"status,omitempty"`
+	PageSize          *int32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`                        // Default 50, max 200
+	PageToken         string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                            // Empty for the first page
+	IncludeTotalCount bool                   `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"` // Counting needs an extra query
+	GroupId           *string                `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`                            // "none" lists habits without a group
+	Tag               *string                `protobuf:"bytes,8,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListHabitsRequest) Reset() {
+	*x = ListHabitsRequest{}
+	mi := &file_habits_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitsRequest) ProtoMessage() {}
+
+func (x *ListHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitsRequest.ProtoReflect.Descriptor instead.
+func (*ListHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListHabitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListHabitsRequest) GetActiveOnly() bool {
+	if x != nil && x.ActiveOnly != nil {
+		return *x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListHabitsRequest) GetStatus() HabitStatusFilter {
+	if x != nil {
+		return x.Status
+	}
+	return HabitStatusFilter_HABIT_STATUS_FILTER_UNSPECIFIED
+}
+
+func (x *ListHabitsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListHabitsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListHabitsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *ListHabitsRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *ListHabitsRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+type ListHabitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habits        []*Habit               `protobuf:"bytes,1,rep,name=habits,proto3" json:"habits,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // Set only when include_total_count is true
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitsResponse) Reset() {
+	*x = ListHabitsResponse{}
+	mi := &file_habits_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitsResponse) ProtoMessage() {}
+
+func (x *ListHabitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitsResponse.ProtoReflect.Descriptor instead.
+func (*ListHabitsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListHabitsResponse) GetHabits() []*Habit {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+func (x *ListHabitsResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListHabitsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetTodayAgenda
+type GetTodayAgendaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AtRiskHours   *int32                 `protobuf:"varint,2,opt,name=at_risk_hours,json=atRiskHours,proto3,oneof" json:"at_risk_hours,omitempty"` // Habits due within this many hours are at risk, default 3
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodayAgendaRequest) Reset() {
+	*x = GetTodayAgendaRequest{}
+	mi := &file_habits_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodayAgendaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodayAgendaRequest) ProtoMessage() {}
+
+func (x *GetTodayAgendaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodayAgendaRequest.ProtoReflect.Descriptor instead.
+func (*GetTodayAgendaRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTodayAgendaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTodayAgendaRequest) GetAtRiskHours() int32 {
+	if x != nil && x.AtRiskHours != nil {
+		return *x.AtRiskHours
+	}
+	return 0
+}
+
+type AgendaItem struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Habit                *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	DueDate              string                 `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`                                           // Local date of the next deadline in format "YYYY-MM-DD"
+	SecondsUntilDeadline int64                  `protobuf:"varint,3,opt,name=seconds_until_deadline,json=secondsUntilDeadline,proto3" json:"seconds_until_deadline,omitempty"` // Negative if the deadline already passed
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AgendaItem) Reset() {
+	*x = AgendaItem{}
+	mi := &file_habits_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgendaItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgendaItem) ProtoMessage() {}
+
+func (x *AgendaItem) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgendaItem.ProtoReflect.Descriptor instead.
+func (*AgendaItem) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AgendaItem) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+func (x *AgendaItem) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *AgendaItem) GetSecondsUntilDeadline() int64 {
+	if x != nil {
+		return x.SecondsUntilDeadline
+	}
+	return 0
+}
+
+type GetTodayAgendaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Due           []*AgendaItem          `protobuf:"bytes,1,rep,name=due,proto3" json:"due,omitempty"`                     // Deadline is today and not confirmed yet
+	AtRisk        []*AgendaItem          `protobuf:"bytes,2,rep,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"` // Due within at_risk_hours
+	Done          []*AgendaItem          `protobuf:"bytes,3,rep,name=done,proto3" json:"done,omitempty"`                   // Confirmed today
+	Upcoming      []*AgendaItem          `protobuf:"bytes,4,rep,name=upcoming,proto3" json:"upcoming,omitempty"`           // Next deadline is after today, or the habit is paused
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodayAgendaResponse) Reset() {
+	*x = GetTodayAgendaResponse{}
+	mi := &file_habits_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodayAgendaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodayAgendaResponse) ProtoMessage() {}
+
+func (x *GetTodayAgendaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodayAgendaResponse.ProtoReflect.Descriptor instead.
+func (*GetTodayAgendaResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTodayAgendaResponse) GetDue() []*AgendaItem {
+	if x != nil {
+		return x.Due
+	}
+	return nil
+}
+
+func (x *GetTodayAgendaResponse) GetAtRisk() []*AgendaItem {
+	if x != nil {
+		return x.AtRisk
+	}
+	return nil
+}
+
+func (x *GetTodayAgendaResponse) GetDone() []*AgendaItem {
+	if x != nil {
+		return x.Done
+	}
+	return nil
+}
+
+func (x *GetTodayAgendaResponse) GetUpcoming() []*AgendaItem {
+	if x != nil {
+		return x.Upcoming
+	}
+	return nil
+}
+
+// UpdateHabit
+type UpdateHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Color         *string                `protobuf:"bytes,5,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	ScheduleType  *ScheduleType          `protobuf:"varint,6,opt,name=schedule_type,json=scheduleType,proto3,enum=habits.v1.ScheduleType,oneof" json:"schedule_type,omitempty"`
+	IntervalDays  *int32                 `protobuf:"varint,7,opt,name=interval_days,json=intervalDays,proto3,oneof" json:"interval_days,omitempty"`
+	WeeklyDays    []int32                `protobuf:"varint,8,rep,packed,name=weekly_days,json=weeklyDays,proto3" json:"weekly_days,omitempty"` // Empty array means no update
+	Timezone      *string                `protobuf:"bytes,9,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`                         // IANA timezone string (service will convert to offset)
+	GroupId       *string                `protobuf:"bytes,10,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`           // Empty string removes the habit from its group
+	Icon          *string                `protobuf:"bytes,11,opt,name=icon,proto3,oneof" json:"icon,omitempty"`                                // Empty string clears the icon
+	Tags          []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`                                      // Replaces all tags when update_tags is true
+	UpdateTags    bool                   `protobuf:"varint,13,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"`       // Needed to tell an empty tags list from no update
+	Visibility    *HabitVisibility       `protobuf:"varint,14,opt,name=visibility,proto3,enum=habits.v1.HabitVisibility,oneof" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHabitRequest) Reset() {
+	*x = UpdateHabitRequest{}
+	mi := &file_habits_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHabitRequest) ProtoMessage() {}
+
+func (x *UpdateHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHabitRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetScheduleType() ScheduleType {
+	if x != nil && x.ScheduleType != nil {
+		return *x.ScheduleType
+	}
+	return ScheduleType_SCHEDULE_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateHabitRequest) GetIntervalDays() int32 {
+	if x != nil && x.IntervalDays != nil {
+		return *x.IntervalDays
+	}
+	return 0
+}
+
+func (x *UpdateHabitRequest) GetWeeklyDays() []int32 {
+	if x != nil {
+		return x.WeeklyDays
+	}
+	return nil
+}
+
+func (x *UpdateHabitRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *UpdateHabitRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateHabitRequest) GetUpdateTags() bool {
+	if x != nil {
+		return x.UpdateTags
+	}
+	return false
+}
+
+func (x *UpdateHabitRequest) GetVisibility() HabitVisibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return HabitVisibility_HABIT_VISIBILITY_UNSPECIFIED
+}
+
+type UpdateHabitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHabitResponse) Reset() {
+	*x = UpdateHabitResponse{}
+	mi := &file_habits_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHabitResponse) ProtoMessage() {}
+
+func (x *UpdateHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHabitResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateHabitResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+// DeleteHabit
+type DeleteHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHabitRequest) Reset() {
+	*x = DeleteHabitRequest{}
+	mi := &file_habits_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHabitRequest) ProtoMessage() {}
+
+func (x *DeleteHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHabitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *DeleteHabitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteHabitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHabitResponse) Reset() {
+	*x = DeleteHabitResponse{}
+	mi := &file_habits_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHabitResponse) ProtoMessage() {}
+
+func (x *DeleteHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHabitResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteHabitResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ArchiveHabit
+type ArchiveHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveHabitRequest) Reset() {
+	*x = ArchiveHabitRequest{}
+	mi := &file_habits_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveHabitRequest) ProtoMessage() {}
+
+func (x *ArchiveHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveHabitRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ArchiveHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *ArchiveHabitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ArchiveHabitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveHabitResponse) Reset() {
+	*x = ArchiveHabitResponse{}
+	mi := &file_habits_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveHabitResponse) ProtoMessage() {}
+
+func (x *ArchiveHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveHabitResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ArchiveHabitResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+// UnarchiveHabit
+type UnarchiveHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveHabitRequest) Reset() {
+	*x = UnarchiveHabitRequest{}
+	mi := &file_habits_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveHabitRequest) ProtoMessage() {}
+
+func (x *UnarchiveHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveHabitRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnarchiveHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *UnarchiveHabitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnarchiveHabitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveHabitResponse) Reset() {
+	*x = UnarchiveHabitResponse{}
+	mi := &file_habits_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveHabitResponse) ProtoMessage() {}
+
+func (x *UnarchiveHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveHabitResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UnarchiveHabitResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+// PurgeHabit
+type PurgeHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeHabitRequest) Reset() {
+	*x = PurgeHabitRequest{}
+	mi := &file_habits_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeHabitRequest) ProtoMessage() {}
+
+func (x *PurgeHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeHabitRequest.ProtoReflect.Descriptor instead.
+func (*PurgeHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PurgeHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *PurgeHabitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PurgeHabitResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Success              bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	DeletedConfirmations int32                  `protobuf:"varint,2,opt,name=deleted_confirmations,json=deletedConfirmations,proto3" json:"deleted_confirmations,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PurgeHabitResponse) Reset() {
+	*x = PurgeHabitResponse{}
+	mi := &file_habits_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeHabitResponse) ProtoMessage() {}
+
+func (x *PurgeHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeHabitResponse.ProtoReflect.Descriptor instead.
+func (*PurgeHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PurgeHabitResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PurgeHabitResponse) GetDeletedConfirmations() int32 {
+	if x != nil {
+		return x.DeletedConfirmations
+	}
+	return 0
+}
+
+// PauseHabits
+type PauseHabitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitId       *string                `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3,oneof" json:"habit_id,omitempty"` // All active habits of the user when not set
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Date in format "YYYY-MM-DD"
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Inclusive, date in format "YYYY-MM-DD"
+	Reason        *string                `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseHabitsRequest) Reset() {
+	*x = PauseHabitsRequest{}
+	mi := &file_habits_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseHabitsRequest) ProtoMessage() {}
+
+func (x *PauseHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseHabitsRequest.ProtoReflect.Descriptor instead.
+func (*PauseHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PauseHabitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PauseHabitsRequest) GetHabitId() string {
+	if x != nil && x.HabitId != nil {
+		return *x.HabitId
+	}
+	return ""
+}
+
+func (x *PauseHabitsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PauseHabitsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *PauseHabitsRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type PauseHabitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pauses        []*HabitPause          `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseHabitsResponse) Reset() {
+	*x = PauseHabitsResponse{}
+	mi := &file_habits_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseHabitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseHabitsResponse) ProtoMessage() {}
+
+func (x *PauseHabitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseHabitsResponse.ProtoReflect.Descriptor instead.
+func (*PauseHabitsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PauseHabitsResponse) GetPauses() []*HabitPause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+// ResumeHabits
+type ResumeHabitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitId       *string                `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3,oneof" json:"habit_id,omitempty"` // All habits of the user when not set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeHabitsRequest) Reset() {
+	*x = ResumeHabitsRequest{}
+	mi := &file_habits_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeHabitsRequest) ProtoMessage() {}
+
+func (x *ResumeHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeHabitsRequest.ProtoReflect.Descriptor instead.
+func (*ResumeHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResumeHabitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResumeHabitsRequest) GetHabitId() string {
+	if x != nil && x.HabitId != nil {
+		return *x.HabitId
+	}
+	return ""
+}
+
+type ResumeHabitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumedCount  int32                  `protobuf:"varint,1,opt,name=resumed_count,json=resumedCount,proto3" json:"resumed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeHabitsResponse) Reset() {
+	*x = ResumeHabitsResponse{}
+	mi := &file_habits_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeHabitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeHabitsResponse) ProtoMessage() {}
+
+func (x *ResumeHabitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeHabitsResponse.ProtoReflect.Descriptor instead.
+func (*ResumeHabitsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResumeHabitsResponse) GetResumedCount() int32 {
+	if x != nil {
+		return x.ResumedCount
+	}
+	return 0
+}
+
+// ListHabitPauses
+type ListHabitPausesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitId       *string                `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3,oneof" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitPausesRequest) Reset() {
+	*x = ListHabitPausesRequest{}
+	mi := &file_habits_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitPausesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitPausesRequest) ProtoMessage() {}
+
+func (x *ListHabitPausesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitPausesRequest.ProtoReflect.Descriptor instead.
+func (*ListHabitPausesRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListHabitPausesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListHabitPausesRequest) GetHabitId() string {
+	if x != nil && x.HabitId != nil {
+		return *x.HabitId
+	}
+	return ""
+}
+
+type ListHabitPausesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pauses        []*HabitPause          `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitPausesResponse) Reset() {
+	*x = ListHabitPausesResponse{}
+	mi := &file_habits_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitPausesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitPausesResponse) ProtoMessage() {}
+
+func (x *ListHabitPausesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitPausesResponse.ProtoReflect.Descriptor instead.
+func (*ListHabitPausesResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListHabitPausesResponse) GetPauses() []*HabitPause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+// ConfirmHabit
+type ConfirmHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	Notes         *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	AttachmentIds []string               `protobuf:"bytes,4,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"` // Uploaded photos as proof, at most 4
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmHabitRequest) Reset() {
+	*x = ConfirmHabitRequest{}
+	mi := &file_habits_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHabitRequest) ProtoMessage() {}
+
+func (x *ConfirmHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHabitRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ConfirmHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *ConfirmHabitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmHabitRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *ConfirmHabitRequest) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+type ConfirmHabitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"` // Updated habit with incremented streak
+	Confirmation  *HabitConfirmation     `protobuf:"bytes,2,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmHabitResponse) Reset() {
+	*x = ConfirmHabitResponse{}
+	mi := &file_habits_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHabitResponse) ProtoMessage() {}
+
+func (x *ConfirmHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHabitResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ConfirmHabitResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+func (x *ConfirmHabitResponse) GetConfirmation() *HabitConfirmation {
+	if x != nil {
+		return x.Confirmation
+	}
+	return nil
+}
+
+// GetHabitHistory
+// Confirmations are listed newest first, paginated like ListHabits
+type GetHabitHistoryRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	HabitId           string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // For authorization
+	Limit             *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                   // Page size, default 30, max 100
+	PageToken         string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Empty for the first page
+	IncludeTotalCount bool                   `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetHabitHistoryRequest) Reset() {
+	*x = GetHabitHistoryRequest{}
+	mi := &file_habits_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitHistoryRequest) ProtoMessage() {}
+
+func (x *GetHabitHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetHabitHistoryRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *GetHabitHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetHabitHistoryRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetHabitHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetHabitHistoryRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+type GetHabitHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Confirmations []*HabitConfirmation   `protobuf:"bytes,1,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	TotalCount    *int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`     // Set only when include_total_count is true
+	Pauses        []*HabitPause          `protobuf:"bytes,3,rep,name=pauses,proto3" json:"pauses,omitempty"`                                      // All pauses of the habit, newest first, on the first page only
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitHistoryResponse) Reset() {
+	*x = GetHabitHistoryResponse{}
+	mi := &file_habits_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitHistoryResponse) ProtoMessage() {}
+
+func (x *GetHabitHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHabitHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetHabitHistoryResponse) GetConfirmations() []*HabitConfirmation {
+	if x != nil {
+		return x.Confirmations
+	}
+	return nil
+}
+
+func (x *GetHabitHistoryResponse) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetHabitHistoryResponse) GetPauses() []*HabitPause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+func (x *GetHabitHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CalendarDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // Date in format "YYYY-MM-DD" (in habit's timezone)
+	State         CalendarDayState       `protobuf:"varint,2,opt,name=state,proto3,enum=habits.v1.CalendarDayState" json:"state,omitempty"`
+	Due           bool                   `protobuf:"varint,3,opt,name=due,proto3" json:"due,omitempty"`                                         // True when a period of the schedule ends on this date
+	Notes         *string                `protobuf:"bytes,4,opt,name=notes,proto3,oneof" json:"notes,omitempty"`                                // Confirmation notes, only for the habit owner
+	AttachmentIds []string               `protobuf:"bytes,5,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"` // Photos attached to the confirmation as proof
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarDay) Reset() {
+	*x = CalendarDay{}
+	mi := &file_habits_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDay) ProtoMessage() {}
+
+func (x *CalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDay.ProtoReflect.Descriptor instead.
+func (*CalendarDay) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CalendarDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetState() CalendarDayState {
+	if x != nil {
+		return x.State
+	}
+	return CalendarDayState_CALENDAR_DAY_STATE_UNSPECIFIED
+}
+
+func (x *CalendarDay) GetDue() bool {
+	if x != nil {
+		return x.Due
+	}
+	return false
+}
+
+func (x *CalendarDay) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+type GetHabitCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // For authorization
+	FromDate      string                 `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // Date in format "YYYY-MM-DD"
+	ToDate        string                 `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // Inclusive, at most 366 days after from_date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitCalendarRequest) Reset() {
+	*x = GetHabitCalendarRequest{}
+	mi := &file_habits_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitCalendarRequest) ProtoMessage() {}
+
+func (x *GetHabitCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetHabitCalendarRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *GetHabitCalendarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetHabitCalendarRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetHabitCalendarRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GetHabitCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*CalendarDay         `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitCalendarResponse) Reset() {
+	*x = GetHabitCalendarResponse{}
+	mi := &file_habits_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitCalendarResponse) ProtoMessage() {}
+
+func (x *GetHabitCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetHabitCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetHabitCalendarResponse) GetDays() []*CalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// GetHabitStats
+type GetHabitStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitStatsRequest) Reset() {
+	*x = GetHabitStatsRequest{}
+	mi := &file_habits_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitStatsRequest) ProtoMessage() {}
+
+func (x *GetHabitStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitStatsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetHabitStatsRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *GetHabitStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Stats are computed against the periods expected by the habit's schedule history.
+// A period is the span of days in which one confirmation is due
+type GetHabitStatsResponse struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	CurrentStreak      int32                   `protobuf:"varint,1,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"` // Consecutive completed periods
+	LongestStreak      int32                   `protobuf:"varint,2,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	TotalConfirmations int32                   `protobuf:"varint,3,opt,name=total_confirmations,json=totalConfirmations,proto3" json:"total_confirmations,omitempty"`
+	CompletionRate     float64                 `protobuf:"fixed64,4,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"` // Percentage (0-100) of expected periods completed, paused periods are excluded
+	FirstConfirmation  *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=first_confirmation,json=firstConfirmation,proto3" json:"first_confirmation,omitempty"`
+	LastConfirmation   *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=last_confirmation,json=lastConfirmation,proto3" json:"last_confirmation,omitempty"`
+	PausedDays         int32                   `protobuf:"varint,7,opt,name=paused_days,json=pausedDays,proto3" json:"paused_days,omitempty"` // Days paused since the habit was created
+	ExpectedPeriods    int32                   `protobuf:"varint,8,opt,name=expected_periods,json=expectedPeriods,proto3" json:"expected_periods,omitempty"`
+	CompletedPeriods   int32                   `protobuf:"varint,9,opt,name=completed_periods,json=completedPeriods,proto3" json:"completed_periods,omitempty"`
+	WeeklyRates        []*PeriodCompletionRate `protobuf:"bytes,10,rep,name=weekly_rates,json=weeklyRates,proto3" json:"weekly_rates,omitempty"`    // Per calendar week starting on Monday
+	MonthlyRates       []*PeriodCompletionRate `protobuf:"bytes,11,rep,name=monthly_rates,json=monthlyRates,proto3" json:"monthly_rates,omitempty"` // Per calendar month
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetHabitStatsResponse) Reset() {
+	*x = GetHabitStatsResponse{}
+	mi := &file_habits_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitStatsResponse) ProtoMessage() {}
+
+func (x *GetHabitStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetHabitStatsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetHabitStatsResponse) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *GetHabitStatsResponse) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+func (x *GetHabitStatsResponse) GetTotalConfirmations() int32 {
+	if x != nil {
+		return x.TotalConfirmations
+	}
+	return 0
+}
+
+func (x *GetHabitStatsResponse) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+func (x *GetHabitStatsResponse) GetFirstConfirmation() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstConfirmation
+	}
+	return nil
+}
+
+func (x *GetHabitStatsResponse) GetLastConfirmation() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastConfirmation
+	}
+	return nil
+}
+
+func (x *GetHabitStatsResponse) GetPausedDays() int32 {
+	if x != nil {
+		return x.PausedDays
+	}
+	return 0
+}
+
+func (x *GetHabitStatsResponse) GetExpectedPeriods() int32 {
+	if x != nil {
+		return x.ExpectedPeriods
+	}
+	return 0
+}
+
+func (x *GetHabitStatsResponse) GetCompletedPeriods() int32 {
+	if x != nil {
+		return x.CompletedPeriods
+	}
+	return 0
+}
+
+func (x *GetHabitStatsResponse) GetWeeklyRates() []*PeriodCompletionRate {
+	if x != nil {
+		return x.WeeklyRates
+	}
+	return nil
+}
+
+func (x *GetHabitStatsResponse) GetMonthlyRates() []*PeriodCompletionRate {
+	if x != nil {
+		return x.MonthlyRates
+	}
+	return nil
+}
+
+// PeriodCompletionRate is the completion rate of the periods due within a week or month
+type PeriodCompletionRate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart    string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // Date in format "YYYY-MM-DD"
+	Expected       int32                  `protobuf:"varint,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Completed      int32                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	CompletionRate float64                `protobuf:"fixed64,4,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"` // Percentage (0-100)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PeriodCompletionRate) Reset() {
+	*x = PeriodCompletionRate{}
+	mi := &file_habits_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodCompletionRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodCompletionRate) ProtoMessage() {}
+
+func (x *PeriodCompletionRate) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodCompletionRate.ProtoReflect.Descriptor instead.
+func (*PeriodCompletionRate) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PeriodCompletionRate) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *PeriodCompletionRate) GetExpected() int32 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *PeriodCompletionRate) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *PeriodCompletionRate) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+// ExportUserHabits
+type ExportUserHabitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserHabitsRequest) Reset() {
+	*x = ExportUserHabitsRequest{}
+	mi := &file_habits_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserHabitsRequest) ProtoMessage() {}
+
+func (x *ExportUserHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserHabitsRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExportUserHabitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExportUserHabitsResponse struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Habits         []*Habit                  `protobuf:"bytes,1,rep,name=habits,proto3" json:"habits,omitempty"`
+	Confirmations  []*HabitConfirmation      `protobuf:"bytes,2,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Pauses         []*HabitPause             `protobuf:"bytes,3,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	Groups         []*HabitGroup             `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	Templates      []*HabitTemplate          `protobuf:"bytes,5,rep,name=templates,proto3" json:"templates,omitempty"`   // Private templates only
+	Partners       []*HabitPartner           `protobuf:"bytes,6,rep,name=partners,proto3" json:"partners,omitempty"`     // As owner or partner, including declined invitations
+	Challenges     []*Challenge              `protobuf:"bytes,7,rep,name=challenges,proto3" json:"challenges,omitempty"` // Created or joined
+	Achievements   *ListAchievementsResponse `protobuf:"bytes,8,opt,name=achievements,proto3" json:"achievements,omitempty"`
+	Follows        []*Follow                 `protobuf:"bytes,9,rep,name=follows,proto3" json:"follows,omitempty"`                       // Followers, follow requests and followed users
+	FeedItems      []*FeedItem               `protobuf:"bytes,10,rep,name=feed_items,json=feedItems,proto3" json:"feed_items,omitempty"` // Activity of followed users in the user's feed
+	JournalEntries []*JournalEntry           `protobuf:"bytes,11,rep,name=journal_entries,json=journalEntries,proto3" json:"journal_entries,omitempty"`
+	Attachments    []*Attachment             `protobuf:"bytes,12,rep,name=attachments,proto3" json:"attachments,omitempty"` // Metadata, the files are read with DownloadAttachment
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportUserHabitsResponse) Reset() {
+	*x = ExportUserHabitsResponse{}
+	mi := &file_habits_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserHabitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserHabitsResponse) ProtoMessage() {}
+
+func (x *ExportUserHabitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserHabitsResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserHabitsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExportUserHabitsResponse) GetHabits() []*Habit {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetConfirmations() []*HabitConfirmation {
+	if x != nil {
+		return x.Confirmations
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetPauses() []*HabitPause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetGroups() []*HabitGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetTemplates() []*HabitTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetPartners() []*HabitPartner {
+	if x != nil {
+		return x.Partners
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetChallenges() []*Challenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetAchievements() *ListAchievementsResponse {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetFollows() []*Follow {
+	if x != nil {
+		return x.Follows
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetFeedItems() []*FeedItem {
+	if x != nil {
+		return x.FeedItems
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetJournalEntries() []*JournalEntry {
+	if x != nil {
+		return x.JournalEntries
+	}
+	return nil
+}
+
+func (x *ExportUserHabitsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type CompletionPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart    string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`            // Date in format "YYYY-MM-DD"
+	Completed      int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`                                  // Confirmations within the bucket
+	Missed         int32                  `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`                                        // Missed deadlines within the bucket
+	CompletionRate float64                `protobuf:"fixed64,4,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"` // Percentage (0-100)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompletionPoint) Reset() {
+	*x = CompletionPoint{}
+	mi := &file_habits_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletionPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionPoint) ProtoMessage() {}
+
+func (x *CompletionPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionPoint.ProtoReflect.Descriptor instead.
+func (*CompletionPoint) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CompletionPoint) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *CompletionPoint) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *CompletionPoint) GetMissed() int32 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *CompletionPoint) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+type GetCompletionTrendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Granularity   TrendGranularity       `protobuf:"varint,2,opt,name=granularity,proto3,enum=habits.v1.TrendGranularity" json:"granularity,omitempty"`
+	FromDate      string                 `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // Date in format "YYYY-MM-DD"
+	ToDate        string                 `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // Inclusive, at most 731 days after from_date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompletionTrendRequest) Reset() {
+	*x = GetCompletionTrendRequest{}
+	mi := &file_habits_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompletionTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompletionTrendRequest) ProtoMessage() {}
+
+func (x *GetCompletionTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompletionTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetCompletionTrendRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetCompletionTrendRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetCompletionTrendRequest) GetGranularity() TrendGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return TrendGranularity_TREND_GRANULARITY_UNSPECIFIED
+}
+
+func (x *GetCompletionTrendRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetCompletionTrendRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GetCompletionTrendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*CompletionPoint     `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"` // Buckets without activity are omitted
+	OverallRate   float64                `protobuf:"fixed64,2,opt,name=overall_rate,json=overallRate,proto3" json:"overall_rate,omitempty"`
+	Direction     TrendDirection         `protobuf:"varint,3,opt,name=direction,proto3,enum=habits.v1.TrendDirection" json:"direction,omitempty"`
+	Slope         float64                `protobuf:"fixed64,4,opt,name=slope,proto3" json:"slope,omitempty"` // Change of the completion rate in percentage points per bucket
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompletionTrendResponse) Reset() {
+	*x = GetCompletionTrendResponse{}
+	mi := &file_habits_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompletionTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompletionTrendResponse) ProtoMessage() {}
+
+func (x *GetCompletionTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompletionTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetCompletionTrendResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetCompletionTrendResponse) GetPoints() []*CompletionPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetCompletionTrendResponse) GetOverallRate() float64 {
+	if x != nil {
+		return x.OverallRate
+	}
+	return 0
+}
+
+func (x *GetCompletionTrendResponse) GetDirection() TrendDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TrendDirection_TREND_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetCompletionTrendResponse) GetSlope() float64 {
+	if x != nil {
+		return x.Slope
+	}
+	return 0
+}
+
+// GetWeekdayBreakdown
+type WeekdayCompletionRate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Weekday        int32                  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"` // 0=Sunday, 1=Monday, ..., 6=Saturday
+	Completed      int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Missed         int32                  `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	CompletionRate float64                `protobuf:"fixed64,4,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"` // Percentage (0-100)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WeekdayCompletionRate) Reset() {
+	*x = WeekdayCompletionRate{}
+	mi := &file_habits_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeekdayCompletionRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekdayCompletionRate) ProtoMessage() {}
+
+func (x *WeekdayCompletionRate) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekdayCompletionRate.ProtoReflect.Descriptor instead.
+func (*WeekdayCompletionRate) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WeekdayCompletionRate) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *WeekdayCompletionRate) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *WeekdayCompletionRate) GetMissed() int32 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *WeekdayCompletionRate) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+type GetWeekdayBreakdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeekdayBreakdownRequest) Reset() {
+	*x = GetWeekdayBreakdownRequest{}
+	mi := &file_habits_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeekdayBreakdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeekdayBreakdownRequest) ProtoMessage() {}
+
+func (x *GetWeekdayBreakdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeekdayBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*GetWeekdayBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetWeekdayBreakdownRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWeekdayBreakdownResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Weekdays      []*WeekdayCompletionRate `protobuf:"bytes,1,rep,name=weekdays,proto3" json:"weekdays,omitempty"`                                 // Always seven entries, from Sunday
+	BestWeekday   *int32                   `protobuf:"varint,2,opt,name=best_weekday,json=bestWeekday,proto3,oneof" json:"best_weekday,omitempty"` // Unset until any day has activity
+	WorstWeekday  *int32                   `protobuf:"varint,3,opt,name=worst_weekday,json=worstWeekday,proto3,oneof" json:"worst_weekday,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeekdayBreakdownResponse) Reset() {
+	*x = GetWeekdayBreakdownResponse{}
+	mi := &file_habits_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeekdayBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeekdayBreakdownResponse) ProtoMessage() {}
+
+func (x *GetWeekdayBreakdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeekdayBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*GetWeekdayBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetWeekdayBreakdownResponse) GetWeekdays() []*WeekdayCompletionRate {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *GetWeekdayBreakdownResponse) GetBestWeekday() int32 {
+	if x != nil && x.BestWeekday != nil {
+		return *x.BestWeekday
+	}
+	return 0
+}
+
+func (x *GetWeekdayBreakdownResponse) GetWorstWeekday() int32 {
+	if x != nil && x.WorstWeekday != nil {
+		return *x.WorstWeekday
+	}
+	return 0
+}
+
+// GetHourDistribution
+type GetHourDistributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHourDistributionRequest) Reset() {
+	*x = GetHourDistributionRequest{}
+	mi := &file_habits_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHourDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHourDistributionRequest) ProtoMessage() {}
+
+func (x *GetHourDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHourDistributionRequest.ProtoReflect.Descriptor instead.
+func (*GetHourDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetHourDistributionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetHourDistributionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Confirmations []int32                `protobuf:"varint,1,rep,packed,name=confirmations,proto3" json:"confirmations,omitempty"` // 24 entries indexed by local hour
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHourDistributionResponse) Reset() {
+	*x = GetHourDistributionResponse{}
+	mi := &file_habits_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHourDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHourDistributionResponse) ProtoMessage() {}
+
+func (x *GetHourDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHourDistributionResponse.ProtoReflect.Descriptor instead.
+func (*GetHourDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetHourDistributionResponse) GetConfirmations() []int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return nil
+}
+
+// GetHabitCorrelations
+type HabitCorrelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitAId      string                 `protobuf:"bytes,1,opt,name=habit_a_id,json=habitAId,proto3" json:"habit_a_id,omitempty"`
+	HabitAName    string                 `protobuf:"bytes,2,opt,name=habit_a_name,json=habitAName,proto3" json:"habit_a_name,omitempty"`
+	HabitBId      string                 `protobuf:"bytes,3,opt,name=habit_b_id,json=habitBId,proto3" json:"habit_b_id,omitempty"`
+	HabitBName    string                 `protobuf:"bytes,4,opt,name=habit_b_name,json=habitBName,proto3" json:"habit_b_name,omitempty"`
+	DaysTogether  int32                  `protobuf:"varint,5,opt,name=days_together,json=daysTogether,proto3" json:"days_together,omitempty"` // Days on which both habits were confirmed
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`                                  // Jaccard index of the confirmed days (0-1)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitCorrelation) Reset() {
+	*x = HabitCorrelation{}
+	mi := &file_habits_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitCorrelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitCorrelation) ProtoMessage() {}
+
+func (x *HabitCorrelation) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitCorrelation.ProtoReflect.Descriptor instead.
+func (*HabitCorrelation) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *HabitCorrelation) GetHabitAId() string {
+	if x != nil {
+		return x.HabitAId
+	}
+	return ""
+}
+
+func (x *HabitCorrelation) GetHabitAName() string {
+	if x != nil {
+		return x.HabitAName
+	}
+	return ""
+}
+
+func (x *HabitCorrelation) GetHabitBId() string {
+	if x != nil {
+		return x.HabitBId
+	}
+	return ""
+}
+
+func (x *HabitCorrelation) GetHabitBName() string {
+	if x != nil {
+		return x.HabitBName
+	}
+	return ""
+}
+
+func (x *HabitCorrelation) GetDaysTogether() int32 {
+	if x != nil {
+		return x.DaysTogether
+	}
+	return 0
+}
+
+func (x *HabitCorrelation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetHabitCorrelationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"` // Default and maximum 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitCorrelationsRequest) Reset() {
+	*x = GetHabitCorrelationsRequest{}
+	mi := &file_habits_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitCorrelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitCorrelationsRequest) ProtoMessage() {}
+
+func (x *GetHabitCorrelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitCorrelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitCorrelationsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetHabitCorrelationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetHabitCorrelationsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetHabitCorrelationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Correlations  []*HabitCorrelation    `protobuf:"bytes,1,rep,name=correlations,proto3" json:"correlations,omitempty"` // Highest score first, archived habits excluded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitCorrelationsResponse) Reset() {
+	*x = GetHabitCorrelationsResponse{}
+	mi := &file_habits_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitCorrelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitCorrelationsResponse) ProtoMessage() {}
+
+func (x *GetHabitCorrelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitCorrelationsResponse.ProtoReflect.Descriptor instead.
+func (*GetHabitCorrelationsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetHabitCorrelationsResponse) GetCorrelations() []*HabitCorrelation {
+	if x != nil {
+		return x.Correlations
+	}
+	return nil
+}
+
+// GetMoodCorrelations
+type MoodCorrelation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HabitId        string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	HabitName      string                 `protobuf:"bytes,2,opt,name=habit_name,json=habitName,proto3" json:"habit_name,omitempty"`
+	DaysDone       int32                  `protobuf:"varint,3,opt,name=days_done,json=daysDone,proto3" json:"days_done,omitempty"` // Rated days on which the habit was confirmed
+	AvgMoodDone    *float64               `protobuf:"fixed64,4,opt,name=avg_mood_done,json=avgMoodDone,proto3,oneof" json:"avg_mood_done,omitempty"`
+	DaysNotDone    int32                  `protobuf:"varint,5,opt,name=days_not_done,json=daysNotDone,proto3" json:"days_not_done,omitempty"` // Rated days since the habit was created without a confirmation
+	AvgMoodNotDone *float64               `protobuf:"fixed64,6,opt,name=avg_mood_not_done,json=avgMoodNotDone,proto3,oneof" json:"avg_mood_not_done,omitempty"`
+	Difference     *float64               `protobuf:"fixed64,7,opt,name=difference,proto3,oneof" json:"difference,omitempty"` // avg_mood_done - avg_mood_not_done
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MoodCorrelation) Reset() {
+	*x = MoodCorrelation{}
+	mi := &file_habits_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoodCorrelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoodCorrelation) ProtoMessage() {}
+
+func (x *MoodCorrelation) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoodCorrelation.ProtoReflect.Descriptor instead.
+func (*MoodCorrelation) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MoodCorrelation) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *MoodCorrelation) GetHabitName() string {
+	if x != nil {
+		return x.HabitName
+	}
+	return ""
+}
+
+func (x *MoodCorrelation) GetDaysDone() int32 {
+	if x != nil {
+		return x.DaysDone
+	}
+	return 0
+}
+
+func (x *MoodCorrelation) GetAvgMoodDone() float64 {
+	if x != nil && x.AvgMoodDone != nil {
+		return *x.AvgMoodDone
+	}
+	return 0
+}
+
+func (x *MoodCorrelation) GetDaysNotDone() int32 {
+	if x != nil {
+		return x.DaysNotDone
+	}
+	return 0
+}
+
+func (x *MoodCorrelation) GetAvgMoodNotDone() float64 {
+	if x != nil && x.AvgMoodNotDone != nil {
+		return *x.AvgMoodNotDone
+	}
+	return 0
+}
+
+func (x *MoodCorrelation) GetDifference() float64 {
+	if x != nil && x.Difference != nil {
+		return *x.Difference
+	}
+	return 0
+}
+
+type GetMoodCorrelationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // Format: YYYY-MM-DD, inclusive
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // Format: YYYY-MM-DD, inclusive, at most 731 days after from_date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMoodCorrelationsRequest) Reset() {
+	*x = GetMoodCorrelationsRequest{}
+	mi := &file_habits_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMoodCorrelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoodCorrelationsRequest) ProtoMessage() {}
+
+func (x *GetMoodCorrelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoodCorrelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetMoodCorrelationsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetMoodCorrelationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMoodCorrelationsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetMoodCorrelationsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GetMoodCorrelationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatedDays     int32                  `protobuf:"varint,1,opt,name=rated_days,json=ratedDays,proto3" json:"rated_days,omitempty"`
+	AverageMood   *float64               `protobuf:"fixed64,2,opt,name=average_mood,json=averageMood,proto3,oneof" json:"average_mood,omitempty"`
+	Habits        []*MoodCorrelation     `protobuf:"bytes,3,rep,name=habits,proto3" json:"habits,omitempty"` // Largest difference first, habits without a comparison last
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMoodCorrelationsResponse) Reset() {
+	*x = GetMoodCorrelationsResponse{}
+	mi := &file_habits_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMoodCorrelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoodCorrelationsResponse) ProtoMessage() {}
+
+func (x *GetMoodCorrelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoodCorrelationsResponse.ProtoReflect.Descriptor instead.
+func (*GetMoodCorrelationsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetMoodCorrelationsResponse) GetRatedDays() int32 {
+	if x != nil {
+		return x.RatedDays
+	}
+	return 0
+}
+
+func (x *GetMoodCorrelationsResponse) GetAverageMood() float64 {
+	if x != nil && x.AverageMood != nil {
+		return *x.AverageMood
+	}
+	return 0
+}
+
+func (x *GetMoodCorrelationsResponse) GetHabits() []*MoodCorrelation {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+// ReorderHabits
+type ReorderHabitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitIds      []string               `protobuf:"bytes,2,rep,name=habit_ids,json=habitIds,proto3" json:"habit_ids,omitempty"` // New order of the top habits
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHabitsRequest) Reset() {
+	*x = ReorderHabitsRequest{}
+	mi := &file_habits_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHabitsRequest) ProtoMessage() {}
+
+func (x *ReorderHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHabitsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReorderHabitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderHabitsRequest) GetHabitIds() []string {
+	if x != nil {
+		return x.HabitIds
+	}
+	return nil
+}
+
+type ReorderHabitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHabitsResponse) Reset() {
+	*x = ReorderHabitsResponse{}
+	mi := &file_habits_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHabitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHabitsResponse) ProtoMessage() {}
+
+func (x *ReorderHabitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHabitsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderHabitsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReorderHabitsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListHabitTags
+type ListHabitTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitTagsRequest) Reset() {
+	*x = ListHabitTagsRequest{}
+	mi := &file_habits_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitTagsRequest) ProtoMessage() {}
+
+func (x *ListHabitTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListHabitTagsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListHabitTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListHabitTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagUsage            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // Ordered by tag
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitTagsResponse) Reset() {
+	*x = ListHabitTagsResponse{}
+	mi := &file_habits_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitTagsResponse) ProtoMessage() {}
+
+func (x *ListHabitTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListHabitTagsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListHabitTagsResponse) GetTags() []*TagUsage {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// CreateHabitGroup
+type CreateHabitGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Unique per user
+	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Icon          *string                `protobuf:"bytes,4,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHabitGroupRequest) Reset() {
+	*x = CreateHabitGroupRequest{}
+	mi := &file_habits_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHabitGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHabitGroupRequest) ProtoMessage() {}
+
+func (x *CreateHabitGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHabitGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateHabitGroupRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateHabitGroupRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateHabitGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHabitGroupRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *CreateHabitGroupRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+type CreateHabitGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *HabitGroup            `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHabitGroupResponse) Reset() {
+	*x = CreateHabitGroupResponse{}
+	mi := &file_habits_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHabitGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHabitGroupResponse) ProtoMessage() {}
+
+func (x *CreateHabitGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHabitGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateHabitGroupResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateHabitGroupResponse) GetGroup() *HabitGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// ListHabitGroups
+type ListHabitGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitGroupsRequest) Reset() {
+	*x = ListHabitGroupsRequest{}
+	mi := &file_habits_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitGroupsRequest) ProtoMessage() {}
+
+func (x *ListHabitGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListHabitGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListHabitGroupsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListHabitGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*HabitGroup          `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitGroupsResponse) Reset() {
+	*x = ListHabitGroupsResponse{}
+	mi := &file_habits_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitGroupsResponse) ProtoMessage() {}
+
+func (x *ListHabitGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListHabitGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListHabitGroupsResponse) GetGroups() []*HabitGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// UpdateHabitGroup
+type UpdateHabitGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Color         *string                `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color,omitempty"` // Empty string clears the color
+	Icon          *string                `protobuf:"bytes,5,opt,name=icon,proto3,oneof" json:"icon,omitempty"`   // Empty string clears the icon
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHabitGroupRequest) Reset() {
+	*x = UpdateHabitGroupRequest{}
+	mi := &file_habits_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHabitGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHabitGroupRequest) ProtoMessage() {}
+
+func (x *UpdateHabitGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHabitGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHabitGroupRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UpdateHabitGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateHabitGroupRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateHabitGroupRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateHabitGroupRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *UpdateHabitGroupRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+type UpdateHabitGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *HabitGroup            `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHabitGroupResponse) Reset() {
+	*x = UpdateHabitGroupResponse{}
+	mi := &file_habits_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHabitGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHabitGroupResponse) ProtoMessage() {}
+
+func (x *UpdateHabitGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHabitGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHabitGroupResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateHabitGroupResponse) GetGroup() *HabitGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// DeleteHabitGroup
+type DeleteHabitGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHabitGroupRequest) Reset() {
+	*x = DeleteHabitGroupRequest{}
+	mi := &file_habits_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHabitGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHabitGroupRequest) ProtoMessage() {}
+
+func (x *DeleteHabitGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHabitGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHabitGroupRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteHabitGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DeleteHabitGroupRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteHabitGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHabitGroupResponse) Reset() {
+	*x = DeleteHabitGroupResponse{}
+	mi := &file_habits_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHabitGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHabitGroupResponse) ProtoMessage() {}
+
+func (x *DeleteHabitGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHabitGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHabitGroupResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteHabitGroupResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ReorderHabitGroups
+type ReorderHabitGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GroupIds      []string               `protobuf:"bytes,2,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"` // New order of the top groups
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHabitGroupsRequest) Reset() {
+	*x = ReorderHabitGroupsRequest{}
+	mi := &file_habits_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHabitGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHabitGroupsRequest) ProtoMessage() {}
+
+func (x *ReorderHabitGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHabitGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderHabitGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ReorderHabitGroupsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderHabitGroupsRequest) GetGroupIds() []string {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
+type ReorderHabitGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*HabitGroup          `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // All groups in the new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHabitGroupsResponse) Reset() {
+	*x = ReorderHabitGroupsResponse{}
+	mi := &file_habits_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHabitGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHabitGroupsResponse) ProtoMessage() {}
+
+func (x *ReorderHabitGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHabitGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderHabitGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ReorderHabitGroupsResponse) GetGroups() []*HabitGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// ListHabitTemplates
+type ListHabitTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category      *string                `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"` // Only catalog templates of this category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitTemplatesRequest) Reset() {
+	*x = ListHabitTemplatesRequest{}
+	mi := &file_habits_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitTemplatesRequest) ProtoMessage() {}
+
+func (x *ListHabitTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListHabitTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListHabitTemplatesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListHabitTemplatesRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+type ListHabitTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*HabitTemplate       `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"` // Catalog by category and name, then private templates
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitTemplatesResponse) Reset() {
+	*x = ListHabitTemplatesResponse{}
+	mi := &file_habits_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitTemplatesResponse) ProtoMessage() {}
+
+func (x *ListHabitTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListHabitTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListHabitTemplatesResponse) GetTemplates() []*HabitTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// CreateHabitFromTemplate
+type CreateHabitFromTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA timezone string (e.g., "Europe/Moscow", "America/New_York")
+	Name          *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`   // Overrides the template name
+	GroupId       *string                `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHabitFromTemplateRequest) Reset() {
+	*x = CreateHabitFromTemplateRequest{}
+	mi := &file_habits_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHabitFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHabitFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateHabitFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHabitFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateHabitFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateHabitFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateHabitFromTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateHabitFromTemplateRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateHabitFromTemplateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CreateHabitFromTemplateRequest) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+type CreateHabitFromTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHabitFromTemplateResponse) Reset() {
+	*x = CreateHabitFromTemplateResponse{}
+	mi := &file_habits_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHabitFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHabitFromTemplateResponse) ProtoMessage() {}
+
+func (x *CreateHabitFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHabitFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateHabitFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateHabitFromTemplateResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+// SaveHabitAsTemplate
+type SaveHabitAsTemplateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HabitId         string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	Name            *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`             // Defaults to the habit name
+	SuggestedTarget *string                `protobuf:"bytes,4,opt,name=suggested_target,json=suggestedTarget,proto3,oneof" json:"suggested_target,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SaveHabitAsTemplateRequest) Reset() {
+	*x = SaveHabitAsTemplateRequest{}
+	mi := &file_habits_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveHabitAsTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveHabitAsTemplateRequest) ProtoMessage() {}
+
+func (x *SaveHabitAsTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveHabitAsTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveHabitAsTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SaveHabitAsTemplateRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *SaveHabitAsTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SaveHabitAsTemplateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SaveHabitAsTemplateRequest) GetSuggestedTarget() string {
+	if x != nil && x.SuggestedTarget != nil {
+		return *x.SuggestedTarget
+	}
+	return ""
+}
+
+type SaveHabitAsTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *HabitTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveHabitAsTemplateResponse) Reset() {
+	*x = SaveHabitAsTemplateResponse{}
+	mi := &file_habits_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveHabitAsTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveHabitAsTemplateResponse) ProtoMessage() {}
+
+func (x *SaveHabitAsTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveHabitAsTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SaveHabitAsTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SaveHabitAsTemplateResponse) GetTemplate() *HabitTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// DeleteHabitTemplate
+type DeleteHabitTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHabitTemplateRequest) Reset() {
+	*x = DeleteHabitTemplateRequest{}
+	mi := &file_habits_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHabitTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHabitTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteHabitTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHabitTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHabitTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteHabitTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *DeleteHabitTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteHabitTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHabitTemplateResponse) Reset() {
+	*x = DeleteHabitTemplateResponse{}
+	mi := &file_habits_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHabitTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHabitTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteHabitTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHabitTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHabitTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeleteHabitTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// InviteHabitPartner
+type InviteHabitPartnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Owner of the habit
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`           // Username of the invited partner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteHabitPartnerRequest) Reset() {
+	*x = InviteHabitPartnerRequest{}
+	mi := &file_habits_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteHabitPartnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteHabitPartnerRequest) ProtoMessage() {}
+
+func (x *InviteHabitPartnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteHabitPartnerRequest.ProtoReflect.Descriptor instead.
+func (*InviteHabitPartnerRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *InviteHabitPartnerRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *InviteHabitPartnerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InviteHabitPartnerRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type InviteHabitPartnerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partner       *HabitPartner          `protobuf:"bytes,1,opt,name=partner,proto3" json:"partner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteHabitPartnerResponse) Reset() {
+	*x = InviteHabitPartnerResponse{}
+	mi := &file_habits_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteHabitPartnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteHabitPartnerResponse) ProtoMessage() {}
+
+func (x *InviteHabitPartnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteHabitPartnerResponse.ProtoReflect.Descriptor instead.
+func (*InviteHabitPartnerResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *InviteHabitPartnerResponse) GetPartner() *HabitPartner {
+	if x != nil {
+		return x.Partner
+	}
+	return nil
+}
+
+// ListHabitPartners
+type ListHabitPartnersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitPartnersRequest) Reset() {
+	*x = ListHabitPartnersRequest{}
+	mi := &file_habits_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitPartnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitPartnersRequest) ProtoMessage() {}
+
+func (x *ListHabitPartnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitPartnersRequest.ProtoReflect.Descriptor instead.
+func (*ListHabitPartnersRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListHabitPartnersRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *ListHabitPartnersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListHabitPartnersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partners      []*HabitPartner        `protobuf:"bytes,1,rep,name=partners,proto3" json:"partners,omitempty"` // Pending and accepted, oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHabitPartnersResponse) Reset() {
+	*x = ListHabitPartnersResponse{}
+	mi := &file_habits_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHabitPartnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHabitPartnersResponse) ProtoMessage() {}
+
+func (x *ListHabitPartnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHabitPartnersResponse.ProtoReflect.Descriptor instead.
+func (*ListHabitPartnersResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListHabitPartnersResponse) GetPartners() []*HabitPartner {
+	if x != nil {
+		return x.Partners
+	}
+	return nil
+}
+
+// ListPartnerInvitations
+type ListPartnerInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartnerInvitationsRequest) Reset() {
+	*x = ListPartnerInvitationsRequest{}
+	mi := &file_habits_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartnerInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartnerInvitationsRequest) ProtoMessage() {}
+
+func (x *ListPartnerInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartnerInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartnerInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListPartnerInvitationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListPartnerInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*HabitPartner        `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartnerInvitationsResponse) Reset() {
+	*x = ListPartnerInvitationsResponse{}
+	mi := &file_habits_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartnerInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartnerInvitationsResponse) ProtoMessage() {}
+
+func (x *ListPartnerInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartnerInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartnerInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListPartnerInvitationsResponse) GetInvitations() []*HabitPartner {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+// RespondToPartnerInvitation
+type RespondToPartnerInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Invited partner
+	Accept        bool                   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToPartnerInvitationRequest) Reset() {
+	*x = RespondToPartnerInvitationRequest{}
+	mi := &file_habits_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToPartnerInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToPartnerInvitationRequest) ProtoMessage() {}
+
+func (x *RespondToPartnerInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToPartnerInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RespondToPartnerInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RespondToPartnerInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *RespondToPartnerInvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RespondToPartnerInvitationRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondToPartnerInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partner       *HabitPartner          `protobuf:"bytes,1,opt,name=partner,proto3" json:"partner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToPartnerInvitationResponse) Reset() {
+	*x = RespondToPartnerInvitationResponse{}
+	mi := &file_habits_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToPartnerInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToPartnerInvitationResponse) ProtoMessage() {}
+
+func (x *RespondToPartnerInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToPartnerInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RespondToPartnerInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RespondToPartnerInvitationResponse) GetPartner() *HabitPartner {
+	if x != nil {
+		return x.Partner
+	}
+	return nil
+}
+
+// RemoveHabitPartner
+type RemoveHabitPartnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartnershipId string                 `protobuf:"bytes,1,opt,name=partnership_id,json=partnershipId,proto3" json:"partnership_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Owner of the habit or the partner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHabitPartnerRequest) Reset() {
+	*x = RemoveHabitPartnerRequest{}
+	mi := &file_habits_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHabitPartnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHabitPartnerRequest) ProtoMessage() {}
+
+func (x *RemoveHabitPartnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHabitPartnerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveHabitPartnerRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RemoveHabitPartnerRequest) GetPartnershipId() string {
+	if x != nil {
+		return x.PartnershipId
+	}
+	return ""
+}
+
+func (x *RemoveHabitPartnerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveHabitPartnerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHabitPartnerResponse) Reset() {
+	*x = RemoveHabitPartnerResponse{}
+	mi := &file_habits_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHabitPartnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHabitPartnerResponse) ProtoMessage() {}
+
+func (x *RemoveHabitPartnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHabitPartnerResponse.ProtoReflect.Descriptor instead.
+func (*RemoveHabitPartnerResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RemoveHabitPartnerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListSharedHabits
+type ListSharedHabitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedHabitsRequest) Reset() {
+	*x = ListSharedHabitsRequest{}
+	mi := &file_habits_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedHabitsRequest) ProtoMessage() {}
+
+func (x *ListSharedHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedHabitsRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListSharedHabitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListSharedHabitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habits        []*SharedHabit         `protobuf:"bytes,1,rep,name=habits,proto3" json:"habits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedHabitsResponse) Reset() {
+	*x = ListSharedHabitsResponse{}
+	mi := &file_habits_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedHabitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedHabitsResponse) ProtoMessage() {}
+
+func (x *ListSharedHabitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedHabitsResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedHabitsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListSharedHabitsResponse) GetHabits() []*SharedHabit {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+// NudgeHabit
+type NudgeHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       string                 `protobuf:"bytes,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Accepted partner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NudgeHabitRequest) Reset() {
+	*x = NudgeHabitRequest{}
+	mi := &file_habits_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NudgeHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NudgeHabitRequest) ProtoMessage() {}
+
+func (x *NudgeHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NudgeHabitRequest.ProtoReflect.Descriptor instead.
+func (*NudgeHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *NudgeHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+func (x *NudgeHabitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type NudgeHabitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NudgedAt      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=nudged_at,json=nudgedAt,proto3" json:"nudged_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NudgeHabitResponse) Reset() {
+	*x = NudgeHabitResponse{}
+	mi := &file_habits_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NudgeHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NudgeHabitResponse) ProtoMessage() {}
+
+func (x *NudgeHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NudgeHabitResponse.ProtoReflect.Descriptor instead.
+func (*NudgeHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *NudgeHabitResponse) GetNudgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NudgedAt
+	}
+	return nil
+}
+
+// CreateChallenge
+type CreateChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Creator
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Format: "2006-01-02"
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Format: "2006-01-02", at most 365 days after start_date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChallengeRequest) Reset() {
+	*x = CreateChallengeRequest{}
+	mi := &file_habits_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChallengeRequest) ProtoMessage() {}
+
+func (x *CreateChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChallengeRequest.ProtoReflect.Descriptor instead.
+func (*CreateChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CreateChallengeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateChallengeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateChallengeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateChallengeRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateChallengeRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type CreateChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenge     *Challenge             `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChallengeResponse) Reset() {
+	*x = CreateChallengeResponse{}
+	mi := &file_habits_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChallengeResponse) ProtoMessage() {}
+
+func (x *CreateChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChallengeResponse.ProtoReflect.Descriptor instead.
+func (*CreateChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CreateChallengeResponse) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+// GetChallenge
+type GetChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChallengeRequest) Reset() {
+	*x = GetChallengeRequest{}
+	mi := &file_habits_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeRequest) ProtoMessage() {}
+
+func (x *GetChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *GetChallengeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenge     *Challenge             `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChallengeResponse) Reset() {
+	*x = GetChallengeResponse{}
+	mi := &file_habits_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeResponse) ProtoMessage() {}
+
+func (x *GetChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeResponse.ProtoReflect.Descriptor instead.
+func (*GetChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetChallengeResponse) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+// ListChallenges
+type ListChallengesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChallengesRequest) Reset() {
+	*x = ListChallengesRequest{}
+	mi := &file_habits_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChallengesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChallengesRequest) ProtoMessage() {}
+
+func (x *ListChallengesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChallengesRequest.ProtoReflect.Descriptor instead.
+func (*ListChallengesRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListChallengesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListChallengesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenges    []*Challenge           `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChallengesResponse) Reset() {
+	*x = ListChallengesResponse{}
+	mi := &file_habits_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChallengesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChallengesResponse) ProtoMessage() {}
+
+func (x *ListChallengesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChallengesResponse.ProtoReflect.Descriptor instead.
+func (*ListChallengesResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListChallengesResponse) GetChallenges() []*Challenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+// UpdateChallenge
+type UpdateChallengeRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId          string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Creator
+	Name                 *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description          *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"` // Empty string clears the description
+	StartDate            *string                `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate              *string                `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	RegenerateInviteCode bool                   `protobuf:"varint,7,opt,name=regenerate_invite_code,json=regenerateInviteCode,proto3" json:"regenerate_invite_code,omitempty"` // The old code stops working
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateChallengeRequest) Reset() {
+	*x = UpdateChallengeRequest{}
+	mi := &file_habits_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChallengeRequest) ProtoMessage() {}
+
+func (x *UpdateChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChallengeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdateChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *UpdateChallengeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateChallengeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateChallengeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateChallengeRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateChallengeRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *UpdateChallengeRequest) GetRegenerateInviteCode() bool {
+	if x != nil {
+		return x.RegenerateInviteCode
+	}
+	return false
+}
+
+type UpdateChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenge     *Challenge             `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChallengeResponse) Reset() {
+	*x = UpdateChallengeResponse{}
+	mi := &file_habits_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChallengeResponse) ProtoMessage() {}
+
+func (x *UpdateChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChallengeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *UpdateChallengeResponse) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+// DeleteChallenge
+type DeleteChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Creator
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChallengeRequest) Reset() {
+	*x = DeleteChallengeRequest{}
+	mi := &file_habits_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChallengeRequest) ProtoMessage() {}
+
+func (x *DeleteChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChallengeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DeleteChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *DeleteChallengeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChallengeResponse) Reset() {
+	*x = DeleteChallengeResponse{}
+	mi := &file_habits_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChallengeResponse) ProtoMessage() {}
+
+func (x *DeleteChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChallengeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DeleteChallengeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// JoinChallenge
+type JoinChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InviteCode    string                 `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"` // Case-insensitive
+	HabitId       string                 `protobuf:"bytes,3,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`          // Habit of the user whose confirmations count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinChallengeRequest) Reset() {
+	*x = JoinChallengeRequest{}
+	mi := &file_habits_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinChallengeRequest) ProtoMessage() {}
+
+func (x *JoinChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinChallengeRequest.ProtoReflect.Descriptor instead.
+func (*JoinChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *JoinChallengeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinChallengeRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *JoinChallengeRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+type JoinChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenge     *Challenge             `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinChallengeResponse) Reset() {
+	*x = JoinChallengeResponse{}
+	mi := &file_habits_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinChallengeResponse) ProtoMessage() {}
+
+func (x *JoinChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinChallengeResponse.ProtoReflect.Descriptor instead.
+func (*JoinChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *JoinChallengeResponse) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+// LeaveChallenge
+type LeaveChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveChallengeRequest) Reset() {
+	*x = LeaveChallengeRequest{}
+	mi := &file_habits_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveChallengeRequest) ProtoMessage() {}
+
+func (x *LeaveChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveChallengeRequest.ProtoReflect.Descriptor instead.
+func (*LeaveChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *LeaveChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *LeaveChallengeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LeaveChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveChallengeResponse) Reset() {
+	*x = LeaveChallengeResponse{}
+	mi := &file_habits_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveChallengeResponse) ProtoMessage() {}
+
+func (x *LeaveChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveChallengeResponse.ProtoReflect.Descriptor instead.
+func (*LeaveChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *LeaveChallengeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetChallengeLeaderboard
+type GetChallengeLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For authorization
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // Default 50, max 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChallengeLeaderboardRequest) Reset() {
+	*x = GetChallengeLeaderboardRequest{}
+	mi := &file_habits_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetChallengeLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetChallengeLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetChallengeLeaderboardRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *GetChallengeLeaderboardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetChallengeLeaderboardRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetChallengeLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetChallengeLeaderboardResponse struct {
+	state             protoimpl.MessageState       `protogen:"open.v1"`
+	Entries           []*ChallengeLeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Best first
+	Me                *ChallengeLeaderboardEntry   `protobuf:"bytes,2,opt,name=me,proto3,oneof" json:"me,omitempty"`     // Not set when the user is not a participant
+	TotalParticipants int32                        `protobuf:"varint,3,opt,name=total_participants,json=totalParticipants,proto3" json:"total_participants,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetChallengeLeaderboardResponse) Reset() {
+	*x = GetChallengeLeaderboardResponse{}
+	mi := &file_habits_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetChallengeLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetChallengeLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetChallengeLeaderboardResponse) GetEntries() []*ChallengeLeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetChallengeLeaderboardResponse) GetMe() *ChallengeLeaderboardEntry {
+	if x != nil {
+		return x.Me
+	}
+	return nil
+}
+
+func (x *GetChallengeLeaderboardResponse) GetTotalParticipants() int32 {
+	if x != nil {
+		return x.TotalParticipants
+	}
+	return 0
+}
+
+// ListAchievements
+type ListAchievementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAchievementsRequest) Reset() {
+	*x = ListAchievementsRequest{}
+	mi := &file_habits_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAchievementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAchievementsRequest) ProtoMessage() {}
+
+func (x *ListAchievementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAchievementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAchievementsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListAchievementsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListAchievementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Xp            int32                  `protobuf:"varint,1,opt,name=xp,proto3" json:"xp,omitempty"`
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`                                  // Starts at 1
+	LevelXp       int32                  `protobuf:"varint,3,opt,name=level_xp,json=levelXp,proto3" json:"level_xp,omitempty"`               // XP at which the current level started
+	NextLevelXp   int32                  `protobuf:"varint,4,opt,name=next_level_xp,json=nextLevelXp,proto3" json:"next_level_xp,omitempty"` // XP at which the next level starts
+	Achievements  []*Achievement         `protobuf:"bytes,5,rep,name=achievements,proto3" json:"achievements,omitempty"`                     // Catalog order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAchievementsResponse) Reset() {
+	*x = ListAchievementsResponse{}
+	mi := &file_habits_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAchievementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAchievementsResponse) ProtoMessage() {}
+
+func (x *ListAchievementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAchievementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAchievementsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListAchievementsResponse) GetXp() int32 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *ListAchievementsResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ListAchievementsResponse) GetLevelXp() int32 {
+	if x != nil {
+		return x.LevelXp
+	}
+	return 0
+}
+
+func (x *ListAchievementsResponse) GetNextLevelXp() int32 {
+	if x != nil {
+		return x.NextLevelXp
+	}
+	return 0
+}
+
+func (x *ListAchievementsResponse) GetAchievements() []*Achievement {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+// FollowUser
+type FollowUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"` // User to follow
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
+	mi := &file_habits_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserRequest) ProtoMessage() {}
+
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *FollowUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FollowUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type FollowUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Follow        *Follow                `protobuf:"bytes,1,opt,name=follow,proto3" json:"follow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
+	mi := &file_habits_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserResponse) ProtoMessage() {}
+
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *FollowUserResponse) GetFollow() *Follow {
+	if x != nil {
+		return x.Follow
+	}
+	return nil
+}
+
+// ListFollowers
+type ListFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowersRequest) Reset() {
+	*x = ListFollowersRequest{}
+	mi := &file_habits_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersRequest) ProtoMessage() {}
+
+func (x *ListFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListFollowersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Followers     []*Follow              `protobuf:"bytes,1,rep,name=followers,proto3" json:"followers,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowersResponse) Reset() {
+	*x = ListFollowersResponse{}
+	mi := &file_habits_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersResponse) ProtoMessage() {}
+
+func (x *ListFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListFollowersResponse) GetFollowers() []*Follow {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+// ListFollowing
+type ListFollowingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowingRequest) Reset() {
+	*x = ListFollowingRequest{}
+	mi := &file_habits_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingRequest) ProtoMessage() {}
+
+func (x *ListFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListFollowingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListFollowingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Following     []*Follow              `protobuf:"bytes,1,rep,name=following,proto3" json:"following,omitempty"` // Pending and accepted, newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowingResponse) Reset() {
+	*x = ListFollowingResponse{}
+	mi := &file_habits_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingResponse) ProtoMessage() {}
+
+func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListFollowingResponse) GetFollowing() []*Follow {
+	if x != nil {
+		return x.Following
+	}
+	return nil
+}
+
+// ListFollowRequests
+type ListFollowRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsRequest) Reset() {
+	*x = ListFollowRequestsRequest{}
+	mi := &file_habits_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsRequest) ProtoMessage() {}
+
+func (x *ListFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListFollowRequestsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListFollowRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*Follow              `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsResponse) Reset() {
+	*x = ListFollowRequestsResponse{}
+	mi := &file_habits_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsResponse) ProtoMessage() {}
+
+func (x *ListFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListFollowRequestsResponse) GetRequests() []*Follow {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// RespondToFollowRequest
+type RespondToFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // User the request was sent to
+	FollowerId    string                 `protobuf:"bytes,2,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"` // User who sent the request
+	Accept        bool                   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToFollowRequestRequest) Reset() {
+	*x = RespondToFollowRequestRequest{}
+	mi := &file_habits_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToFollowRequestRequest) ProtoMessage() {}
+
+func (x *RespondToFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RespondToFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *RespondToFollowRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RespondToFollowRequestRequest) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *RespondToFollowRequestRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondToFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Follow        *Follow                `protobuf:"bytes,1,opt,name=follow,proto3" json:"follow,omitempty"` // Not set when the request was declined
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToFollowRequestResponse) Reset() {
+	*x = RespondToFollowRequestResponse{}
+	mi := &file_habits_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToFollowRequestResponse) ProtoMessage() {}
+
+func (x *RespondToFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*RespondToFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RespondToFollowRequestResponse) GetFollow() *Follow {
+	if x != nil {
+		return x.Follow
+	}
+	return nil
+}
+
+// UnfollowUser
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FolloweeId    string                 `protobuf:"bytes,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	mi := &file_habits_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserRequest) ProtoMessage() {}
+
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *UnfollowUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnfollowUserRequest) GetFolloweeId() string {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return ""
+}
+
+type UnfollowUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
+	mi := &file_habits_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserResponse) ProtoMessage() {}
+
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *UnfollowUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// RemoveFollower
+type RemoveFollowerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowerId    string                 `protobuf:"bytes,2,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFollowerRequest) Reset() {
+	*x = RemoveFollowerRequest{}
+	mi := &file_habits_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFollowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFollowerRequest) ProtoMessage() {}
+
+func (x *RemoveFollowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFollowerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFollowerRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *RemoveFollowerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFollowerRequest) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+type RemoveFollowerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFollowerResponse) Reset() {
+	*x = RemoveFollowerResponse{}
+	mi := &file_habits_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFollowerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFollowerResponse) ProtoMessage() {}
+
+func (x *RemoveFollowerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFollowerResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFollowerResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *RemoveFollowerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetActivityFeed
+// Pages are addressed by opaque tokens, pass next_page_token of a response as page_token to get older items
+type GetActivityFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"` // Default 20, max 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`     // Empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityFeedRequest) Reset() {
+	*x = GetActivityFeedRequest{}
+	mi := &file_habits_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityFeedRequest) ProtoMessage() {}
+
+func (x *GetActivityFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetActivityFeedRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetActivityFeedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetActivityFeedRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *GetActivityFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetActivityFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*FeedItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityFeedResponse) Reset() {
+	*x = GetActivityFeedResponse{}
+	mi := &file_habits_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityFeedResponse) ProtoMessage() {}
+
+func (x *GetActivityFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetActivityFeedResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetActivityFeedResponse) GetItems() []*FeedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetActivityFeedResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetPublicProfile
+type GetPublicProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfileRequest) Reset() {
+	*x = GetPublicProfileRequest{}
+	mi := &file_habits_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileRequest) ProtoMessage() {}
+
+func (x *GetPublicProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetPublicProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetPublicProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *PublicProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfileResponse) Reset() {
+	*x = GetPublicProfileResponse{}
+	mi := &file_habits_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfileResponse) ProtoMessage() {}
+
+func (x *GetPublicProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicProfileResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetPublicProfileResponse) GetProfile() *PublicProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// GetPublicHabit
+type GetPublicHabitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	HabitId       string                 `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicHabitRequest) Reset() {
+	*x = GetPublicHabitRequest{}
+	mi := &file_habits_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicHabitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicHabitRequest) ProtoMessage() {}
+
+func (x *GetPublicHabitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicHabitRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicHabitRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GetPublicHabitRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetPublicHabitRequest) GetHabitId() string {
+	if x != nil {
+		return x.HabitId
+	}
+	return ""
+}
+
+type GetPublicHabitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Habit         *PublicHabit           `protobuf:"bytes,2,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicHabitResponse) Reset() {
+	*x = GetPublicHabitResponse{}
+	mi := &file_habits_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicHabitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicHabitResponse) ProtoMessage() {}
+
+func (x *GetPublicHabitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicHabitResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicHabitResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GetPublicHabitResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetPublicHabitResponse) GetHabit() *PublicHabit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+// RotateCalendarFeedToken
+type RotateCalendarFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCalendarFeedTokenRequest) Reset() {
+	*x = RotateCalendarFeedTokenRequest{}
+	mi := &file_habits_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCalendarFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCalendarFeedTokenRequest) ProtoMessage() {}
+
+func (x *RotateCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RotateCalendarFeedTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RotateCalendarFeedTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCalendarFeedTokenResponse) Reset() {
+	*x = RotateCalendarFeedTokenResponse{}
+	mi := &file_habits_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCalendarFeedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCalendarFeedTokenResponse) ProtoMessage() {}
+
+func (x *RotateCalendarFeedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCalendarFeedTokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *RotateCalendarFeedTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// RevokeCalendarFeedToken
+type RevokeCalendarFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedTokenRequest) Reset() {
+	*x = RevokeCalendarFeedTokenRequest{}
+	mi := &file_habits_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedTokenRequest) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *RevokeCalendarFeedTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeCalendarFeedTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedTokenResponse) Reset() {
+	*x = RevokeCalendarFeedTokenResponse{}
+	mi := &file_habits_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedTokenResponse) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *RevokeCalendarFeedTokenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetCalendarFeed
+type GetCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_habits_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      string                 `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"` // text/calendar document
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedResponse) Reset() {
+	*x = GetCalendarFeedResponse{}
+	mi := &file_habits_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedResponse) ProtoMessage() {}
+
+func (x *GetCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetCalendarFeedResponse) GetCalendar() string {
+	if x != nil {
+		return x.Calendar
+	}
+	return ""
+}
+
+// ImportHabits
+type ImportHabitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source        HabitImportSource      `protobuf:"varint,2,opt,name=source,proto3,enum=habits.v1.HabitImportSource" json:"source,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`         // Uploaded file
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA timezone the history was recorded in
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportHabitsRequest) Reset() {
+	*x = ImportHabitsRequest{}
+	mi := &file_habits_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHabitsRequest) ProtoMessage() {}
+
+func (x *ImportHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHabitsRequest.ProtoReflect.Descriptor instead.
+func (*ImportHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ImportHabitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportHabitsRequest) GetSource() HabitImportSource {
+	if x != nil {
+		return x.Source
+	}
+	return HabitImportSource_HABIT_IMPORT_SOURCE_UNSPECIFIED
+}
+
+func (x *ImportHabitsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportHabitsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ImportHabitsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportHabitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Import        *HabitImport           `protobuf:"bytes,1,opt,name=import,proto3" json:"import,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportHabitsResponse) Reset() {
+	*x = ImportHabitsResponse{}
+	mi := &file_habits_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportHabitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHabitsResponse) ProtoMessage() {}
+
+func (x *ImportHabitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHabitsResponse.ProtoReflect.Descriptor instead.
+func (*ImportHabitsResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ImportHabitsResponse) GetImport() *HabitImport {
+	if x != nil {
+		return x.Import
+	}
+	return nil
+}
+
+// GetHabitImport
+type GetHabitImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ImportId      string                 `protobuf:"bytes,2,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitImportRequest) Reset() {
+	*x = GetHabitImportRequest{}
+	mi := &file_habits_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitImportRequest) ProtoMessage() {}
+
+func (x *GetHabitImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitImportRequest.ProtoReflect.Descriptor instead.
+func (*GetHabitImportRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetHabitImportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetHabitImportRequest) GetImportId() string {
+	if x != nil {
+		return x.ImportId
+	}
+	return ""
+}
+
+type GetHabitImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Import        *HabitImport           `protobuf:"bytes,1,opt,name=import,proto3" json:"import,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHabitImportResponse) Reset() {
+	*x = GetHabitImportResponse{}
+	mi := &file_habits_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHabitImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHabitImportResponse) ProtoMessage() {}
+
+func (x *GetHabitImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHabitImportResponse.ProtoReflect.Descriptor instead.
+func (*GetHabitImportResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetHabitImportResponse) GetImport() *HabitImport {
+	if x != nil {
+		return x.Import
+	}
+	return nil
+}
+
+// ExportHabitHistory
+type ExportHabitHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitId       *string                `protobuf:"bytes,2,opt,name=habit_id,json=habitId,proto3,oneof" json:"habit_id,omitempty"`    // All habits of the user if not set
+	FromDate      *string                `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date,omitempty"` // Format: YYYY-MM-DD, inclusive
+	ToDate        *string                `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3,oneof" json:"to_date,omitempty"`       // Format: YYYY-MM-DD, inclusive
+	Format        HistoryExportFormat    `protobuf:"varint,5,opt,name=format,proto3,enum=habits.v1.HistoryExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportHabitHistoryRequest) Reset() {
+	*x = ExportHabitHistoryRequest{}
+	mi := &file_habits_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportHabitHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportHabitHistoryRequest) ProtoMessage() {}
+
+func (x *ExportHabitHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportHabitHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ExportHabitHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ExportHabitHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportHabitHistoryRequest) GetHabitId() string {
+	if x != nil && x.HabitId != nil {
+		return *x.HabitId
+	}
+	return ""
+}
+
+func (x *ExportHabitHistoryRequest) GetFromDate() string {
+	if x != nil && x.FromDate != nil {
+		return *x.FromDate
+	}
+	return ""
+}
+
+func (x *ExportHabitHistoryRequest) GetToDate() string {
+	if x != nil && x.ToDate != nil {
+		return *x.ToDate
+	}
+	return ""
+}
+
+func (x *ExportHabitHistoryRequest) GetFormat() HistoryExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return HistoryExportFormat_HISTORY_EXPORT_FORMAT_UNSPECIFIED
+}
+
+type HabitHistoryChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	FileName      *string                `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"` // Set in the first chunk only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HabitHistoryChunk) Reset() {
+	*x = HabitHistoryChunk{}
+	mi := &file_habits_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HabitHistoryChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HabitHistoryChunk) ProtoMessage() {}
+
+func (x *HabitHistoryChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HabitHistoryChunk.ProtoReflect.Descriptor instead.
+func (*HabitHistoryChunk) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *HabitHistoryChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *HabitHistoryChunk) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+// Journal
+type JournalEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date            string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`            // Format: YYYY-MM-DD, in the user's timezone
+	Mood            *int32                 `protobuf:"varint,3,opt,name=mood,proto3,oneof" json:"mood,omitempty"`     // 1-5
+	Energy          *int32                 `protobuf:"varint,4,opt,name=energy,proto3,oneof" json:"energy,omitempty"` // 1-5
+	Content         *string                `protobuf:"bytes,5,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	Tags            []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	ConfirmationIds []string               `protobuf:"bytes,7,rep,name=confirmation_ids,json=confirmationIds,proto3" json:"confirmation_ids,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
+	mi := &file_habits_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEntry) ProtoMessage() {}
+
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *JournalEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetMood() int32 {
+	if x != nil && x.Mood != nil {
+		return *x.Mood
+	}
+	return 0
+}
+
+func (x *JournalEntry) GetEnergy() int32 {
+	if x != nil && x.Energy != nil {
+		return *x.Energy
+	}
+	return 0
+}
+
+func (x *JournalEntry) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetConfirmationIds() []string {
+	if x != nil {
+		return x.ConfirmationIds
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SaveJournalEntry
+type SaveJournalEntryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date            string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // Format: YYYY-MM-DD
+	Mood            *int32                 `protobuf:"varint,3,opt,name=mood,proto3,oneof" json:"mood,omitempty"`
+	Energy          *int32                 `protobuf:"varint,4,opt,name=energy,proto3,oneof" json:"energy,omitempty"`
+	Content         *string                `protobuf:"bytes,5,opt,name=content,proto3,oneof" json:"content,omitempty"` // At most 10000 characters
+	Tags            []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	ConfirmationIds []string               `protobuf:"bytes,7,rep,name=confirmation_ids,json=confirmationIds,proto3" json:"confirmation_ids,omitempty"` // Confirmations of the user on the same date
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SaveJournalEntryRequest) Reset() {
+	*x = SaveJournalEntryRequest{}
+	mi := &file_habits_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveJournalEntryRequest) ProtoMessage() {}
+
+func (x *SaveJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*SaveJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *SaveJournalEntryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SaveJournalEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SaveJournalEntryRequest) GetMood() int32 {
+	if x != nil && x.Mood != nil {
+		return *x.Mood
+	}
+	return 0
+}
+
+func (x *SaveJournalEntryRequest) GetEnergy() int32 {
+	if x != nil && x.Energy != nil {
+		return *x.Energy
+	}
+	return 0
+}
+
+func (x *SaveJournalEntryRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *SaveJournalEntryRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SaveJournalEntryRequest) GetConfirmationIds() []string {
+	if x != nil {
+		return x.ConfirmationIds
+	}
+	return nil
+}
+
+type SaveJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveJournalEntryResponse) Reset() {
+	*x = SaveJournalEntryResponse{}
+	mi := &file_habits_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveJournalEntryResponse) ProtoMessage() {}
+
+func (x *SaveJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*SaveJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SaveJournalEntryResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// GetJournalEntry
+type GetJournalEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalEntryRequest) Reset() {
+	*x = GetJournalEntryRequest{}
+	mi := &file_habits_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalEntryRequest) ProtoMessage() {}
+
+func (x *GetJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *GetJournalEntryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetJournalEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalEntryResponse) Reset() {
+	*x = GetJournalEntryResponse{}
+	mi := &file_habits_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalEntryResponse) ProtoMessage() {}
+
+func (x *GetJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetJournalEntryResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// ListJournalEntries
+type ListJournalEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // Format: YYYY-MM-DD, inclusive
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // Format: YYYY-MM-DD, inclusive, at most 366 days after from_date
+	Tag           *string                `protobuf:"bytes,4,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesRequest) Reset() {
+	*x = ListJournalEntriesRequest{}
+	mi := &file_habits_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesRequest) ProtoMessage() {}
+
+func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ListJournalEntriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+type ListJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesResponse) Reset() {
+	*x = ListJournalEntriesResponse{}
+	mi := &file_habits_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesResponse) ProtoMessage() {}
+
+func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ListJournalEntriesResponse) GetEntries() []*JournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// DeleteJournalEntry
+type DeleteJournalEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJournalEntryRequest) Reset() {
+	*x = DeleteJournalEntryRequest{}
+	mi := &file_habits_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJournalEntryRequest) ProtoMessage() {}
+
+func (x *DeleteJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *DeleteJournalEntryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteJournalEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type DeleteJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJournalEntryResponse) Reset() {
+	*x = DeleteJournalEntryResponse{}
+	mi := &file_habits_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJournalEntryResponse) ProtoMessage() {}
+
+func (x *DeleteJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *DeleteJournalEntryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type NoteSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          NoteKind               `protobuf:"varint,1,opt,name=kind,proto3,enum=habits.v1.NoteKind" json:"kind,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"` // Journal entry or confirmation ID
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	HabitId       *string                `protobuf:"bytes,4,opt,name=habit_id,json=habitId,proto3,oneof" json:"habit_id,omitempty"` // Set for confirmation notes
+	Snippet       string                 `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"`                      // Matches are wrapped in **
+	Rank          float64                `protobuf:"fixed64,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteSearchResult) Reset() {
+	*x = NoteSearchResult{}
+	mi := &file_habits_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteSearchResult) ProtoMessage() {}
+
+func (x *NoteSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteSearchResult.ProtoReflect.Descriptor instead.
+func (*NoteSearchResult) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *NoteSearchResult) GetKind() NoteKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NoteKind_NOTE_KIND_UNSPECIFIED
+}
+
+func (x *NoteSearchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NoteSearchResult) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *NoteSearchResult) GetHabitId() string {
+	if x != nil && x.HabitId != nil {
+		return *x.HabitId
+	}
+	return ""
+}
+
+func (x *NoteSearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *NoteSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type SearchNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // Web search syntax: words, "quoted phrases", OR and -excluded
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"` // Default 20, maximum 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotesRequest) Reset() {
+	*x = SearchNotesRequest{}
+	mi := &file_habits_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotesRequest) ProtoMessage() {}
+
+func (x *SearchNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotesRequest.ProtoReflect.Descriptor instead.
+func (*SearchNotesRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *SearchNotesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchNotesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchNotesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type SearchNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*NoteSearchResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Best matches first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotesResponse) Reset() {
+	*x = SearchNotesResponse{}
+	mi := &file_habits_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotesResponse) ProtoMessage() {}
+
+func (x *SearchNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotesResponse.ProtoReflect.Descriptor instead.
+func (*SearchNotesResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *SearchNotesResponse) GetResults() []*NoteSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Attachments
+type Attachment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentType    string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes      int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Width          int32                  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height         int32                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	ConfirmationId *string                `protobuf:"bytes,6,opt,name=confirmation_id,json=confirmationId,proto3,oneof" json:"confirmation_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_habits_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment) GetConfirmationId() string {
+	if x != nil && x.ConfirmationId != nil {
+		return *x.ConfirmationId
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// UploadAttachment
+type UploadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // JPEG or PNG, at most 10 MB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_habits_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *UploadAttachmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UploadAttachmentRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_habits_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// DownloadAttachment
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AttachmentId  string                 `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	Thumbnail     bool                   `protobuf:"varint,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"` // JPEG with the longer side at most 320 pixels
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_habits_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *DownloadAttachmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetThumbnail() bool {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return false
+}
+
+type AttachmentChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   *string                `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"` // Set in the first chunk only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentChunk) Reset() {
+	*x = AttachmentChunk{}
+	mi := &file_habits_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentChunk) ProtoMessage() {}
+
+func (x *AttachmentChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentChunk.ProtoReflect.Descriptor instead.
+func (*AttachmentChunk) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *AttachmentChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AttachmentChunk) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+// GetAttachmentUsage
+type GetAttachmentUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentUsageRequest) Reset() {
+	*x = GetAttachmentUsageRequest{}
+	mi := &file_habits_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentUsageRequest) ProtoMessage() {}
+
+func (x *GetAttachmentUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentUsageRequest) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *GetAttachmentUsageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAttachmentUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UsedBytes     int64                  `protobuf:"varint,1,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	QuotaBytes    int64                  `protobuf:"varint,2,opt,name=quota_bytes,json=quotaBytes,proto3" json:"quota_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentUsageResponse) Reset() {
+	*x = GetAttachmentUsageResponse{}
+	mi := &file_habits_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentUsageResponse) ProtoMessage() {}
+
+func (x *GetAttachmentUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habits_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentUsageResponse) Descriptor() ([]byte, []int) {
+	return file_habits_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *GetAttachmentUsageResponse) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *GetAttachmentUsageResponse) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+var File_habits_proto protoreflect.FileDescriptor
+
+const file_habits_proto_rawDesc = "" +
+	"\n" +
+	"\fhabits.proto\x12\thabits.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\b\n" +
+	"\x05Habit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x05 \x01(\tH\x01R\x05color\x88\x01\x01\x12<\n" +
+	"\rschedule_type\x18\x06 \x01(\x0e2\x17.habits.v1.ScheduleTypeR\fscheduleType\x12(\n" +
+	"\rinterval_days\x18\a \x01(\x05H\x02R\fintervalDays\x88\x01\x01\x12\x1f\n" +
+	"\vweekly_days\x18\b \x03(\x05R\n" +
+	"weeklyDays\x122\n" +
+	"\x15timezone_offset_hours\x18\t \x01(\x05R\x13timezoneOffsetHours\x12\x16\n" +
+	"\x06streak\x18\n" +
+	" \x01(\x05R\x06streak\x12F\n" +
+	"\x11next_deadline_utc\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0fnextDeadlineUtc\x12?\n" +
+	"\x1cconfirmed_for_current_period\x18\f \x01(\bR\x19confirmedForCurrentPeriod\x12K\n" +
+	"\x11last_confirmed_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x0flastConfirmedAt\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\x0e \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\varchived_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\n" +
+	"archivedAt\x88\x01\x01\x12\x1b\n" +
+	"\tis_paused\x18\x12 \x01(\bR\bisPaused\x12\x1e\n" +
+	"\bgroup_id\x18\x13 \x01(\tH\x05R\agroupId\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x14 \x01(\tH\x06R\x04icon\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\x15 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04tags\x18\x16 \x03(\tR\x04tags\x12:\n" +
+	"\n" +
+	"visibility\x18\x17 \x01(\x0e2\x1a.habits.v1.HabitVisibilityR\n" +
+	"visibilityB\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_colorB\x10\n" +
+	"\x0e_interval_daysB\x14\n" +
+	"\x12_last_confirmed_atB\x0e\n" +
+	"\f_archived_atB\v\n" +
+	"\t_group_idB\a\n" +
+	"\x05_icon\"\xc3\x02\n" +
+	"\n" +
+	"HabitGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\x05color\x18\x04 \x01(\tH\x00R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x05 \x01(\tH\x01R\x04icon\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12\x1f\n" +
+	"\vhabit_count\x18\a \x01(\x05R\n" +
+	"habitCount\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_icon\"\xad\x04\n" +
+	"\rHabitTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x02 \x01(\bR\tisPrivate\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x05 \x01(\tH\x01R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x06 \x01(\tH\x02R\x04icon\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\a \x01(\tH\x03R\bcategory\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12<\n" +
+	"\rschedule_type\x18\t \x01(\x0e2\x17.habits.v1.ScheduleTypeR\fscheduleType\x12(\n" +
+	"\rinterval_days\x18\n" +
+	" \x01(\x05H\x04R\fintervalDays\x88\x01\x01\x12\x1f\n" +
+	"\vweekly_days\x18\v \x03(\x05R\n" +
+	"weeklyDays\x12.\n" +
+	"\x10suggested_target\x18\f \x01(\tH\x05R\x0fsuggestedTarget\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_iconB\v\n" +
+	"\t_categoryB\x10\n" +
+	"\x0e_interval_daysB\x13\n" +
+	"\x11_suggested_target\"\x80\x04\n" +
+	"\fHabitPartner\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"habit_name\x18\x03 \x01(\tR\thabitName\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\x12%\n" +
+	"\x0eowner_username\x18\x05 \x01(\tR\rownerUsername\x12\x1d\n" +
+	"\n" +
+	"partner_id\x18\x06 \x01(\tR\tpartnerId\x12)\n" +
+	"\x10partner_username\x18\a \x01(\tR\x0fpartnerUsername\x120\n" +
+	"\x06status\x18\b \x01(\x0e2\x18.habits.v1.PartnerStatusR\x06status\x12E\n" +
+	"\x0elast_nudged_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\flastNudgedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\fresponded_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vrespondedAt\x88\x01\x01B\x11\n" +
+	"\x0f_last_nudged_atB\x0f\n" +
+	"\r_responded_at\"\x83\x01\n" +
+	"\vSharedHabit\x12%\n" +
+	"\x0epartnership_id\x18\x01 \x01(\tR\rpartnershipId\x12%\n" +
+	"\x0eowner_username\x18\x02 \x01(\tR\rownerUsername\x12&\n" +
+	"\x05habit\x18\x03 \x01(\v2\x10.habits.v1.HabitR\x05habit\"\x83\x03\n" +
+	"\tChallenge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\tR\tcreatorId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\tR\aendDate\x12\x1f\n" +
+	"\vinvite_code\x18\a \x01(\tR\n" +
+	"inviteCode\x12+\n" +
+	"\x11participant_count\x18\b \x01(\x05R\x10participantCount\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_description\"\xad\x01\n" +
+	"\x19ChallengeLeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12 \n" +
+	"\vcompletions\x18\x04 \x01(\x05R\vcompletions\x12%\n" +
+	"\x0ecurrent_streak\x18\x05 \x01(\x05R\rcurrentStreak\"\x82\x02\n" +
+	"\vAchievement\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x0e\n" +
+	"\x02xp\x18\x04 \x01(\x05R\x02xp\x12\x1a\n" +
+	"\bunlocked\x18\x05 \x01(\bR\bunlocked\x12@\n" +
+	"\vunlocked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"unlockedAt\x88\x01\x01\x12\x1e\n" +
+	"\bhabit_id\x18\a \x01(\tH\x01R\ahabitId\x88\x01\x01B\x0e\n" +
+	"\f_unlocked_atB\v\n" +
+	"\t_habit_id\"\xe5\x02\n" +
+	"\x06Follow\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\tR\n" +
+	"followerId\x12+\n" +
+	"\x11follower_username\x18\x02 \x01(\tR\x10followerUsername\x12\x1f\n" +
+	"\vfollowee_id\x18\x03 \x01(\tR\n" +
+	"followeeId\x12+\n" +
+	"\x11followee_username\x18\x04 \x01(\tR\x10followeeUsername\x12/\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.habits.v1.FollowStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\fresponded_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vrespondedAt\x88\x01\x01B\x0f\n" +
+	"\r_responded_at\"\xc3\x04\n" +
+	"\bFeedItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x17.habits.v1.FeedItemKindR\x04kind\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12%\n" +
+	"\x0eactor_username\x18\x04 \x01(\tR\ractorUsername\x12\x19\n" +
+	"\bhabit_id\x18\x05 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"habit_name\x18\x06 \x01(\tR\thabitName\x12E\n" +
+	"\x10habit_visibility\x18\a \x01(\x0e2\x1a.habits.v1.HabitVisibilityR\x0fhabitVisibility\x121\n" +
+	"\x12confirmed_for_date\x18\b \x01(\tH\x00R\x10confirmedForDate\x88\x01\x01\x12\x1b\n" +
+	"\x06streak\x18\t \x01(\x05H\x01R\x06streak\x88\x01\x01\x12.\n" +
+	"\x10achievement_code\x18\n" +
+	" \x01(\tH\x02R\x0fachievementCode\x88\x01\x01\x12.\n" +
+	"\x10achievement_name\x18\v \x01(\tH\x03R\x0fachievementName\x88\x01\x01\x12;\n" +
+	"\voccurred_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\x15\n" +
+	"\x13_confirmed_for_dateB\t\n" +
+	"\a_streakB\x13\n" +
+	"\x11_achievement_codeB\x13\n" +
+	"\x11_achievement_name\"\xa7\x03\n" +
+	"\vPublicHabit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tH\x00R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x04 \x01(\tH\x01R\x04icon\x88\x01\x01\x12<\n" +
+	"\rschedule_type\x18\x05 \x01(\x0e2\x17.habits.v1.ScheduleTypeR\fscheduleType\x12(\n" +
+	"\rinterval_days\x18\x06 \x01(\x05H\x02R\fintervalDays\x88\x01\x01\x12\x1f\n" +
+	"\vweekly_days\x18\a \x03(\x05R\n" +
+	"weeklyDays\x12\x16\n" +
+	"\x06streak\x18\b \x01(\x05R\x06streak\x12?\n" +
+	"\x1cconfirmed_for_current_period\x18\t \x01(\bR\x19confirmedForCurrentPeriod\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_iconB\x10\n" +
+	"\x0e_interval_days\"\xb9\x01\n" +
+	"\rPublicProfile\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12=\n" +
+	"\fmember_since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vmemberSince\x12.\n" +
+	"\x06habits\x18\x04 \x03(\v2\x16.habits.v1.PublicHabitR\x06habits\"\xc5\x03\n" +
+	"\rImportedHabit\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tH\x01R\x05color\x88\x01\x01\x12<\n" +
+	"\rschedule_type\x18\x04 \x01(\x0e2\x17.habits.v1.ScheduleTypeR\fscheduleType\x12(\n" +
+	"\rinterval_days\x18\x05 \x01(\x05H\x02R\fintervalDays\x88\x01\x01\x12\x1f\n" +
+	"\vweekly_days\x18\x06 \x03(\x05R\n" +
+	"weeklyDays\x12$\n" +
+	"\rconfirmations\x18\a \x01(\x05R\rconfirmations\x12\"\n" +
+	"\n" +
+	"first_date\x18\b \x01(\tH\x03R\tfirstDate\x88\x01\x01\x12 \n" +
+	"\tlast_date\x18\t \x01(\tH\x04R\blastDate\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\n" +
+	" \x01(\tH\x05R\x04note\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_colorB\x10\n" +
+	"\x0e_interval_daysB\r\n" +
+	"\v_first_dateB\f\n" +
+	"\n" +
+	"_last_dateB\a\n" +
+	"\x05_note\"|\n" +
+	"\x10HabitImportIssue\x12\x15\n" +
+	"\x03row\x18\x01 \x01(\x05H\x00R\x03row\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"habit_name\x18\x02 \x01(\tH\x01R\thabitName\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reasonB\x06\n" +
+	"\x04_rowB\r\n" +
+	"\v_habit_name\"\x92\x05\n" +
+	"\vHabitImport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x06source\x18\x02 \x01(\x0e2\x1c.habits.v1.HabitImportSourceR\x06source\x124\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1c.habits.v1.HabitImportStatusR\x06status\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12!\n" +
+	"\ftotal_habits\x18\x05 \x01(\x05R\vtotalHabits\x12/\n" +
+	"\x13total_confirmations\x18\x06 \x01(\x05R\x12totalConfirmations\x12!\n" +
+	"\fskipped_rows\x18\a \x01(\x05R\vskippedRows\x12'\n" +
+	"\x0fhabits_imported\x18\b \x01(\x05R\x0ehabitsImported\x125\n" +
+	"\x16confirmations_imported\x18\t \x01(\x05R\x15confirmationsImported\x120\n" +
+	"\x06habits\x18\n" +
+	" \x03(\v2\x18.habits.v1.ImportedHabitR\x06habits\x123\n" +
+	"\x06issues\x18\v \x03(\v2\x1b.habits.v1.HabitImportIssueR\x06issues\x12\x19\n" +
+	"\x05error\x18\f \x01(\tH\x00R\x05error\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12@\n" +
+	"\vfinished_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"finishedAt\x88\x01\x01B\b\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_finished_at\"=\n" +
+	"\bTagUsage\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1f\n" +
+	"\vhabit_count\x18\x02 \x01(\x05R\n" +
+	"habitCount\"\xcb\x02\n" +
+	"\x11HabitConfirmation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12=\n" +
+	"\fconfirmed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmedAt\x12,\n" +
+	"\x12confirmed_for_date\x18\x05 \x01(\tR\x10confirmedForDate\x12\x19\n" +
+	"\x05notes\x18\x06 \x01(\tH\x00R\x05notes\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0eattachment_ids\x18\b \x03(\tR\rattachmentIdsB\b\n" +
+	"\x06_notes\"\xbc\x02\n" +
+	"\n" +
+	"HabitPause\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x1b\n" +
+	"\x06reason\x18\x06 \x01(\tH\x00R\x06reason\x88\x01\x01\x12>\n" +
+	"\n" +
+	"resumed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tresumedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\t\n" +
+	"\a_reasonB\r\n" +
+	"\v_resumed_at\"\x87\x04\n" +
+	"\x12CreateHabitRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x04 \x01(\tH\x01R\x05color\x88\x01\x01\x12<\n" +
+	"\rschedule_type\x18\x05 \x01(\x0e2\x17.habits.v1.ScheduleTypeR\fscheduleType\x12(\n" +
+	"\rinterval_days\x18\x06 \x01(\x05H\x02R\fintervalDays\x88\x01\x01\x12\x1f\n" +
+	"\vweekly_days\x18\a \x03(\x05R\n" +
+	"weeklyDays\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x12\x1e\n" +
+	"\bgroup_id\x18\t \x01(\tH\x03R\agroupId\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\n" +
+	" \x01(\tH\x04R\x04icon\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12?\n" +
+	"\n" +
+	"visibility\x18\f \x01(\x0e2\x1a.habits.v1.HabitVisibilityH\x05R\n" +
+	"visibility\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_colorB\x10\n" +
+	"\x0e_interval_daysB\v\n" +
+	"\t_group_idB\a\n" +
+	"\x05_iconB\r\n" +
+	"\v_visibility\"=\n" +
+	"\x13CreateHabitResponse\x12&\n" +
+	"\x05habit\x18\x01 \x01(\v2\x10.habits.v1.HabitR\x05habit\"E\n" +
+	"\x0fGetHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\":\n" +
+	"\x10GetHabitResponse\x12&\n" +
+	"\x05habit\x18\x01 \x01(\v2\x10.habits.v1.HabitR\x05habit\"\xe3\x02\n" +
+	"\x11ListHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\vactive_only\x18\x02 \x01(\bH\x00R\n" +
+	"activeOnly\x88\x01\x01\x124\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1c.habits.v1.HabitStatusFilterR\x06status\x12 \n" +
+	"\tpage_size\x18\x04 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x06 \x01(\bR\x11includeTotalCount\x12\x1e\n" +
+	"\bgroup_id\x18\a \x01(\tH\x02R\agroupId\x88\x01\x01\x12\x15\n" +
+	"\x03tag\x18\b \x01(\tH\x03R\x03tag\x88\x01\x01B\x0e\n" +
+	"\f_active_onlyB\f\n" +
+	"\n" +
+	"_page_sizeB\v\n" +
+	"\t_group_idB\x06\n" +
+	"\x04_tag\"\x9c\x01\n" +
+	"\x12ListHabitsResponse\x12(\n" +
+	"\x06habits\x18\x01 \x03(\v2\x10.habits.v1.HabitR\x06habits\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"k\n" +
+	"\x15GetTodayAgendaRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\rat_risk_hours\x18\x02 \x01(\x05H\x00R\vatRiskHours\x88\x01\x01B\x10\n" +
+	"\x0e_at_risk_hours\"\x85\x01\n" +
+	"\n" +
+	"AgendaItem\x12&\n" +
+	"\x05habit\x18\x01 \x01(\v2\x10.habits.v1.HabitR\x05habit\x12\x19\n" +
+	"\bdue_date\x18\x02 \x01(\tR\adueDate\x124\n" +
+	"\x16seconds_until_deadline\x18\x03 \x01(\x03R\x14secondsUntilDeadline\"\xcf\x01\n" +
+	"\x16GetTodayAgendaResponse\x12'\n" +
+	"\x03due\x18\x01 \x03(\v2\x15.habits.v1.AgendaItemR\x03due\x12.\n" +
+	"\aat_risk\x18\x02 \x03(\v2\x15.habits.v1.AgendaItemR\x06atRisk\x12)\n" +
+	"\x04done\x18\x03 \x03(\v2\x15.habits.v1.AgendaItemR\x04done\x121\n" +
+	"\bupcoming\x18\x04 \x03(\v2\x15.habits.v1.AgendaItemR\bupcoming\"\xfa\x04\n" +
+	"\x12UpdateHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x05 \x01(\tH\x02R\x05color\x88\x01\x01\x12A\n" +
+	"\rschedule_type\x18\x06 \x01(\x0e2\x17.habits.v1.ScheduleTypeH\x03R\fscheduleType\x88\x01\x01\x12(\n" +
+	"\rinterval_days\x18\a \x01(\x05H\x04R\fintervalDays\x88\x01\x01\x12\x1f\n" +
+	"\vweekly_days\x18\b \x03(\x05R\n" +
+	"weeklyDays\x12\x1f\n" +
+	"\btimezone\x18\t \x01(\tH\x05R\btimezone\x88\x01\x01\x12\x1e\n" +
+	"\bgroup_id\x18\n" +
+	" \x01(\tH\x06R\agroupId\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\v \x01(\tH\aR\x04icon\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x1f\n" +
+	"\vupdate_tags\x18\r \x01(\bR\n" +
+	"updateTags\x12?\n" +
+	"\n" +
+	"visibility\x18\x0e \x01(\x0e2\x1a.habits.v1.HabitVisibilityH\bR\n" +
+	"visibility\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_colorB\x10\n" +
+	"\x0e_schedule_typeB\x10\n" +
+	"\x0e_interval_daysB\v\n" +
+	"\t_timezoneB\v\n" +
+	"\t_group_idB\a\n" +
+	"\x05_iconB\r\n" +
+	"\v_visibility\"=\n" +
+	"\x13UpdateHabitResponse\x12&\n" +
+	"\x05habit\x18\x01 \x01(\v2\x10.habits.v1.HabitR\x05habit\"H\n" +
+	"\x12DeleteHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"/\n" +
+	"\x13DeleteHabitResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"I\n" +
+	"\x13ArchiveHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\">\n" +
+	"\x14ArchiveHabitResponse\x12&\n" +
+	"\x05habit\x18\x01 \x01(\v2\x10.habits.v1.HabitR\x05habit\"K\n" +
+	"\x15UnarchiveHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"@\n" +
+	"\x16UnarchiveHabitResponse\x12&\n" +
+	"\x05habit\x18\x01 \x01(\v2\x10.habits.v1.HabitR\x05habit\"G\n" +
+	"\x11PurgeHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"c\n" +
+	"\x12PurgeHabitResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x123\n" +
+	"\x15deleted_confirmations\x18\x02 \x01(\x05R\x14deletedConfirmations\"\xbc\x01\n" +
+	"\x12PauseHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\bhabit_id\x18\x02 \x01(\tH\x00R\ahabitId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x1b\n" +
+	"\x06reason\x18\x05 \x01(\tH\x01R\x06reason\x88\x01\x01B\v\n" +
+	"\t_habit_idB\t\n" +
+	"\a_reason\"D\n" +
+	"\x13PauseHabitsResponse\x12-\n" +
+	"\x06pauses\x18\x01 \x03(\v2\x15.habits.v1.HabitPauseR\x06pauses\"[\n" +
+	"\x13ResumeHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\bhabit_id\x18\x02 \x01(\tH\x00R\ahabitId\x88\x01\x01B\v\n" +
+	"\t_habit_id\";\n" +
+	"\x14ResumeHabitsResponse\x12#\n" +
+	"\rresumed_count\x18\x01 \x01(\x05R\fresumedCount\"^\n" +
+	"\x16ListHabitPausesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\bhabit_id\x18\x02 \x01(\tH\x00R\ahabitId\x88\x01\x01B\v\n" +
+	"\t_habit_id\"H\n" +
+	"\x17ListHabitPausesResponse\x12-\n" +
+	"\x06pauses\x18\x01 \x03(\v2\x15.habits.v1.HabitPauseR\x06pauses\"\x95\x01\n" +
+	"\x13ConfirmHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\x05notes\x18\x03 \x01(\tH\x00R\x05notes\x88\x01\x01\x12%\n" +
+	"\x0eattachment_ids\x18\x04 \x03(\tR\rattachmentIdsB\b\n" +
+	"\x06_notes\"\x80\x01\n" +
+	"\x14ConfirmHabitResponse\x12&\n" +
+	"\x05habit\x18\x01 \x01(\v2\x10.habits.v1.HabitR\x05habit\x12@\n" +
+	"\fconfirmation\x18\x02 \x01(\v2\x1c.habits.v1.HabitConfirmationR\fconfirmation\"\xc6\x01\n" +
+	"\x16GetHabitHistoryRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12.\n" +
+	"\x13include_total_count\x18\x06 \x01(\bR\x11includeTotalCountB\b\n" +
+	"\x06_limitJ\x04\b\x04\x10\x05\"\xea\x01\n" +
+	"\x17GetHabitHistoryResponse\x12B\n" +
+	"\rconfirmations\x18\x01 \x03(\v2\x1c.habits.v1.HabitConfirmationR\rconfirmations\x12$\n" +
+	"\vtotal_count\x18\x02 \x01(\x05H\x00R\n" +
+	"totalCount\x88\x01\x01\x12-\n" +
+	"\x06pauses\x18\x03 \x03(\v2\x15.habits.v1.HabitPauseR\x06pauses\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageTokenB\x0e\n" +
+	"\f_total_count\"\xb2\x01\n" +
+	"\vCalendarDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x121\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1b.habits.v1.CalendarDayStateR\x05state\x12\x10\n" +
+	"\x03due\x18\x03 \x01(\bR\x03due\x12\x19\n" +
+	"\x05notes\x18\x04 \x01(\tH\x00R\x05notes\x88\x01\x01\x12%\n" +
+	"\x0eattachment_ids\x18\x05 \x03(\tR\rattachmentIdsB\b\n" +
+	"\x06_notes\"\x83\x01\n" +
+	"\x17GetHabitCalendarRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfrom_date\x18\x03 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x04 \x01(\tR\x06toDate\"F\n" +
+	"\x18GetHabitCalendarResponse\x12*\n" +
+	"\x04days\x18\x01 \x03(\v2\x16.habits.v1.CalendarDayR\x04days\"J\n" +
+	"\x14GetHabitStatsRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xd6\x04\n" +
+	"\x15GetHabitStatsResponse\x12%\n" +
+	"\x0ecurrent_streak\x18\x01 \x01(\x05R\rcurrentStreak\x12%\n" +
+	"\x0elongest_streak\x18\x02 \x01(\x05R\rlongestStreak\x12/\n" +
+	"\x13total_confirmations\x18\x03 \x01(\x05R\x12totalConfirmations\x12'\n" +
+	"\x0fcompletion_rate\x18\x04 \x01(\x01R\x0ecompletionRate\x12I\n" +
+	"\x12first_confirmation\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11firstConfirmation\x12G\n" +
+	"\x11last_confirmation\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10lastConfirmation\x12\x1f\n" +
+	"\vpaused_days\x18\a \x01(\x05R\n" +
+	"pausedDays\x12)\n" +
+	"\x10expected_periods\x18\b \x01(\x05R\x0fexpectedPeriods\x12+\n" +
+	"\x11completed_periods\x18\t \x01(\x05R\x10completedPeriods\x12B\n" +
+	"\fweekly_rates\x18\n" +
+	" \x03(\v2\x1f.habits.v1.PeriodCompletionRateR\vweeklyRates\x12D\n" +
+	"\rmonthly_rates\x18\v \x03(\v2\x1f.habits.v1.PeriodCompletionRateR\fmonthlyRates\"\x9c\x01\n" +
+	"\x14PeriodCompletionRate\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12\x1a\n" +
+	"\bexpected\x18\x02 \x01(\x05R\bexpected\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x05R\tcompleted\x12'\n" +
+	"\x0fcompletion_rate\x18\x04 \x01(\x01R\x0ecompletionRate\"2\n" +
+	"\x17ExportUserHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xae\x05\n" +
+	"\x18ExportUserHabitsResponse\x12(\n" +
+	"\x06habits\x18\x01 \x03(\v2\x10.habits.v1.HabitR\x06habits\x12B\n" +
+	"\rconfirmations\x18\x02 \x03(\v2\x1c.habits.v1.HabitConfirmationR\rconfirmations\x12-\n" +
+	"\x06pauses\x18\x03 \x03(\v2\x15.habits.v1.HabitPauseR\x06pauses\x12-\n" +
+	"\x06groups\x18\x04 \x03(\v2\x15.habits.v1.HabitGroupR\x06groups\x126\n" +
+	"\ttemplates\x18\x05 \x03(\v2\x18.habits.v1.HabitTemplateR\ttemplates\x123\n" +
+	"\bpartners\x18\x06 \x03(\v2\x17.habits.v1.HabitPartnerR\bpartners\x124\n" +
+	"\n" +
+	"challenges\x18\a \x03(\v2\x14.habits.v1.ChallengeR\n" +
+	"challenges\x12G\n" +
+	"\fachievements\x18\b \x01(\v2#.habits.v1.ListAchievementsResponseR\fachievements\x12+\n" +
+	"\afollows\x18\t \x03(\v2\x11.habits.v1.FollowR\afollows\x122\n" +
+	"\n" +
+	"feed_items\x18\n" +
+	" \x03(\v2\x13.habits.v1.FeedItemR\tfeedItems\x12@\n" +
+	"\x0fjournal_entries\x18\v \x03(\v2\x17.habits.v1.JournalEntryR\x0ejournalEntries\x127\n" +
+	"\vattachments\x18\f \x03(\v2\x15.habits.v1.AttachmentR\vattachments\"\x93\x01\n" +
+	"\x0fCompletionPoint\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\x12\x16\n" +
+	"\x06missed\x18\x03 \x01(\x05R\x06missed\x12'\n" +
+	"\x0fcompletion_rate\x18\x04 \x01(\x01R\x0ecompletionRate\"\xa9\x01\n" +
+	"\x19GetCompletionTrendRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\vgranularity\x18\x02 \x01(\x0e2\x1b.habits.v1.TrendGranularityR\vgranularity\x12\x1b\n" +
+	"\tfrom_date\x18\x03 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x04 \x01(\tR\x06toDate\"\xc2\x01\n" +
+	"\x1aGetCompletionTrendResponse\x122\n" +
+	"\x06points\x18\x01 \x03(\v2\x1a.habits.v1.CompletionPointR\x06points\x12!\n" +
+	"\foverall_rate\x18\x02 \x01(\x01R\voverallRate\x127\n" +
+	"\tdirection\x18\x03 \x01(\x0e2\x19.habits.v1.TrendDirectionR\tdirection\x12\x14\n" +
+	"\x05slope\x18\x04 \x01(\x01R\x05slope\"\x90\x01\n" +
+	"\x15WeekdayCompletionRate\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\x12\x16\n" +
+	"\x06missed\x18\x03 \x01(\x05R\x06missed\x12'\n" +
+	"\x0fcompletion_rate\x18\x04 \x01(\x01R\x0ecompletionRate\"5\n" +
+	"\x1aGetWeekdayBreakdownRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd0\x01\n" +
+	"\x1bGetWeekdayBreakdownResponse\x12<\n" +
+	"\bweekdays\x18\x01 \x03(\v2 .habits.v1.WeekdayCompletionRateR\bweekdays\x12&\n" +
+	"\fbest_weekday\x18\x02 \x01(\x05H\x00R\vbestWeekday\x88\x01\x01\x12(\n" +
+	"\rworst_weekday\x18\x03 \x01(\x05H\x01R\fworstWeekday\x88\x01\x01B\x0f\n" +
+	"\r_best_weekdayB\x10\n" +
+	"\x0e_worst_weekday\"5\n" +
+	"\x1aGetHourDistributionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"C\n" +
+	"\x1bGetHourDistributionResponse\x12$\n" +
+	"\rconfirmations\x18\x01 \x03(\x05R\rconfirmations\"\xcd\x01\n" +
+	"\x10HabitCorrelation\x12\x1c\n" +
+	"\n" +
+	"habit_a_id\x18\x01 \x01(\tR\bhabitAId\x12 \n" +
+	"\fhabit_a_name\x18\x02 \x01(\tR\n" +
+	"habitAName\x12\x1c\n" +
+	"\n" +
+	"habit_b_id\x18\x03 \x01(\tR\bhabitBId\x12 \n" +
+	"\fhabit_b_name\x18\x04 \x01(\tR\n" +
+	"habitBName\x12#\n" +
+	"\rdays_together\x18\x05 \x01(\x05R\fdaysTogether\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\"[\n" +
+	"\x1bGetHabitCorrelationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"_\n" +
+	"\x1cGetHabitCorrelationsResponse\x12?\n" +
+	"\fcorrelations\x18\x01 \x03(\v2\x1b.habits.v1.HabitCorrelationR\fcorrelations\"\xc1\x02\n" +
+	"\x0fMoodCorrelation\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x1d\n" +
+	"\n" +
+	"habit_name\x18\x02 \x01(\tR\thabitName\x12\x1b\n" +
+	"\tdays_done\x18\x03 \x01(\x05R\bdaysDone\x12'\n" +
+	"\ravg_mood_done\x18\x04 \x01(\x01H\x00R\vavgMoodDone\x88\x01\x01\x12\"\n" +
+	"\rdays_not_done\x18\x05 \x01(\x05R\vdaysNotDone\x12.\n" +
+	"\x11avg_mood_not_done\x18\x06 \x01(\x01H\x01R\x0eavgMoodNotDone\x88\x01\x01\x12#\n" +
+	"\n" +
+	"difference\x18\a \x01(\x01H\x02R\n" +
+	"difference\x88\x01\x01B\x10\n" +
+	"\x0e_avg_mood_doneB\x14\n" +
+	"\x12_avg_mood_not_doneB\r\n" +
+	"\v_difference\"k\n" +
+	"\x1aGetMoodCorrelationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\"\xa9\x01\n" +
+	"\x1bGetMoodCorrelationsResponse\x12\x1d\n" +
+	"\n" +
+	"rated_days\x18\x01 \x01(\x05R\tratedDays\x12&\n" +
+	"\faverage_mood\x18\x02 \x01(\x01H\x00R\vaverageMood\x88\x01\x01\x122\n" +
+	"\x06habits\x18\x03 \x03(\v2\x1a.habits.v1.MoodCorrelationR\x06habitsB\x0f\n" +
+	"\r_average_mood\"L\n" +
+	"\x14ReorderHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\thabit_ids\x18\x02 \x03(\tR\bhabitIds\"1\n" +
+	"\x15ReorderHabitsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\x14ListHabitTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"@\n" +
+	"\x15ListHabitTagsResponse\x12'\n" +
+	"\x04tags\x18\x01 \x03(\v2\x13.habits.v1.TagUsageR\x04tags\"\x8d\x01\n" +
+	"\x17CreateHabitGroupRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tH\x00R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x04 \x01(\tH\x01R\x04icon\x88\x01\x01B\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_icon\"G\n" +
+	"\x18CreateHabitGroupResponse\x12+\n" +
+	"\x05group\x18\x01 \x01(\v2\x15.habits.v1.HabitGroupR\x05group\"1\n" +
+	"\x16ListHabitGroupsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x17ListHabitGroupsResponse\x12-\n" +
+	"\x06groups\x18\x01 \x03(\v2\x15.habits.v1.HabitGroupR\x06groups\"\xb6\x01\n" +
+	"\x17UpdateHabitGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x04 \x01(\tH\x01R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x05 \x01(\tH\x02R\x04icon\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_icon\"G\n" +
+	"\x18UpdateHabitGroupResponse\x12+\n" +
+	"\x05group\x18\x01 \x01(\v2\x15.habits.v1.HabitGroupR\x05group\"M\n" +
+	"\x17DeleteHabitGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"4\n" +
+	"\x18DeleteHabitGroupResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\x19ReorderHabitGroupsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tgroup_ids\x18\x02 \x03(\tR\bgroupIds\"K\n" +
+	"\x1aReorderHabitGroupsResponse\x12-\n" +
+	"\x06groups\x18\x01 \x03(\v2\x15.habits.v1.HabitGroupR\x06groups\"b\n" +
+	"\x19ListHabitTemplatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\bcategory\x18\x02 \x01(\tH\x00R\bcategory\x88\x01\x01B\v\n" +
+	"\t_category\"T\n" +
+	"\x1aListHabitTemplatesResponse\x126\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x18.habits.v1.HabitTemplateR\ttemplates\"\xc5\x01\n" +
+	"\x1eCreateHabitFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x17\n" +
+	"\x04name\x18\x04 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1e\n" +
+	"\bgroup_id\x18\x05 \x01(\tH\x01R\agroupId\x88\x01\x01B\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_group_id\"I\n" +
+	"\x1fCreateHabitFromTemplateResponse\x12&\n" +
+	"\x05habit\x18\x01 \x01(\v2\x10.habits.v1.HabitR\x05habit\"\xb7\x01\n" +
+	"\x1aSaveHabitAsTemplateRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12.\n" +
+	"\x10suggested_target\x18\x04 \x01(\tH\x01R\x0fsuggestedTarget\x88\x01\x01B\a\n" +
+	"\x05_nameB\x13\n" +
+	"\x11_suggested_target\"S\n" +
+	"\x1bSaveHabitAsTemplateResponse\x124\n" +
+	"\btemplate\x18\x01 \x01(\v2\x18.habits.v1.HabitTemplateR\btemplate\"V\n" +
+	"\x1aDeleteHabitTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"7\n" +
+	"\x1bDeleteHabitTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"k\n" +
+	"\x19InviteHabitPartnerRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"O\n" +
+	"\x1aInviteHabitPartnerResponse\x121\n" +
+	"\apartner\x18\x01 \x01(\v2\x17.habits.v1.HabitPartnerR\apartner\"N\n" +
+	"\x18ListHabitPartnersRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"P\n" +
+	"\x19ListHabitPartnersResponse\x123\n" +
+	"\bpartners\x18\x01 \x03(\v2\x17.habits.v1.HabitPartnerR\bpartners\"8\n" +
+	"\x1dListPartnerInvitationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"[\n" +
+	"\x1eListPartnerInvitationsResponse\x129\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x17.habits.v1.HabitPartnerR\vinvitations\"y\n" +
+	"!RespondToPartnerInvitationRequest\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06accept\x18\x03 \x01(\bR\x06accept\"W\n" +
+	"\"RespondToPartnerInvitationResponse\x121\n" +
+	"\apartner\x18\x01 \x01(\v2\x17.habits.v1.HabitPartnerR\apartner\"[\n" +
+	"\x19RemoveHabitPartnerRequest\x12%\n" +
+	"\x0epartnership_id\x18\x01 \x01(\tR\rpartnershipId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"6\n" +
+	"\x1aRemoveHabitPartnerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x17ListSharedHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"J\n" +
+	"\x18ListSharedHabitsResponse\x12.\n" +
+	"\x06habits\x18\x01 \x03(\v2\x16.habits.v1.SharedHabitR\x06habits\"G\n" +
+	"\x11NudgeHabitRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\tR\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"M\n" +
+	"\x12NudgeHabitResponse\x127\n" +
+	"\tnudged_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bnudgedAt\"\xb6\x01\n" +
+	"\x16CreateChallengeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDateB\x0e\n" +
+	"\f_description\"M\n" +
+	"\x17CreateChallengeResponse\x122\n" +
+	"\tchallenge\x18\x01 \x01(\v2\x14.habits.v1.ChallengeR\tchallenge\"Q\n" +
+	"\x13GetChallengeRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"J\n" +
+	"\x14GetChallengeResponse\x122\n" +
+	"\tchallenge\x18\x01 \x01(\v2\x14.habits.v1.ChallengeR\tchallenge\"0\n" +
+	"\x15ListChallengesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"N\n" +
+	"\x16ListChallengesResponse\x124\n" +
+	"\n" +
+	"challenges\x18\x01 \x03(\v2\x14.habits.v1.ChallengeR\n" +
+	"challenges\"\xc3\x02\n" +
+	"\x16UpdateChallengeRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tH\x02R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x06 \x01(\tH\x03R\aendDate\x88\x01\x01\x124\n" +
+	"\x16regenerate_invite_code\x18\a \x01(\bR\x14regenerateInviteCodeB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"M\n" +
+	"\x17UpdateChallengeResponse\x122\n" +
+	"\tchallenge\x18\x01 \x01(\v2\x14.habits.v1.ChallengeR\tchallenge\"T\n" +
+	"\x16DeleteChallengeRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"3\n" +
+	"\x17DeleteChallengeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"k\n" +
+	"\x14JoinChallengeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vinvite_code\x18\x02 \x01(\tR\n" +
+	"inviteCode\x12\x19\n" +
+	"\bhabit_id\x18\x03 \x01(\tR\ahabitId\"K\n" +
+	"\x15JoinChallengeResponse\x122\n" +
+	"\tchallenge\x18\x01 \x01(\v2\x14.habits.v1.ChallengeR\tchallenge\"S\n" +
+	"\x15LeaveChallengeRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"2\n" +
+	"\x16LeaveChallengeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8a\x01\n" +
+	"\x1eGetChallengeLeaderboardRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xd2\x01\n" +
+	"\x1fGetChallengeLeaderboardResponse\x12>\n" +
+	"\aentries\x18\x01 \x03(\v2$.habits.v1.ChallengeLeaderboardEntryR\aentries\x129\n" +
+	"\x02me\x18\x02 \x01(\v2$.habits.v1.ChallengeLeaderboardEntryH\x00R\x02me\x88\x01\x01\x12-\n" +
+	"\x12total_participants\x18\x03 \x01(\x05R\x11totalParticipantsB\x05\n" +
+	"\x03_me\"2\n" +
+	"\x17ListAchievementsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xbb\x01\n" +
+	"\x18ListAchievementsResponse\x12\x0e\n" +
+	"\x02xp\x18\x01 \x01(\x05R\x02xp\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x19\n" +
+	"\blevel_xp\x18\x03 \x01(\x05R\alevelXp\x12\"\n" +
+	"\rnext_level_xp\x18\x04 \x01(\x05R\vnextLevelXp\x12:\n" +
+	"\fachievements\x18\x05 \x03(\v2\x16.habits.v1.AchievementR\fachievements\"H\n" +
+	"\x11FollowUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"?\n" +
+	"\x12FollowUserResponse\x12)\n" +
+	"\x06follow\x18\x01 \x01(\v2\x11.habits.v1.FollowR\x06follow\"/\n" +
+	"\x14ListFollowersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x15ListFollowersResponse\x12/\n" +
+	"\tfollowers\x18\x01 \x03(\v2\x11.habits.v1.FollowR\tfollowers\"/\n" +
+	"\x14ListFollowingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x15ListFollowingResponse\x12/\n" +
+	"\tfollowing\x18\x01 \x03(\v2\x11.habits.v1.FollowR\tfollowing\"4\n" +
+	"\x19ListFollowRequestsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"K\n" +
+	"\x1aListFollowRequestsResponse\x12-\n" +
+	"\brequests\x18\x01 \x03(\v2\x11.habits.v1.FollowR\brequests\"q\n" +
+	"\x1dRespondToFollowRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vfollower_id\x18\x02 \x01(\tR\n" +
+	"followerId\x12\x16\n" +
+	"\x06accept\x18\x03 \x01(\bR\x06accept\"K\n" +
+	"\x1eRespondToFollowRequestResponse\x12)\n" +
+	"\x06follow\x18\x01 \x01(\v2\x11.habits.v1.FollowR\x06follow\"O\n" +
+	"\x13UnfollowUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vfollowee_id\x18\x02 \x01(\tR\n" +
+	"followeeId\"0\n" +
+	"\x14UnfollowUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\x15RemoveFollowerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vfollower_id\x18\x02 \x01(\tR\n" +
+	"followerId\"2\n" +
+	"\x16RemoveFollowerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x80\x01\n" +
+	"\x16GetActivityFeedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x00R\bpageSize\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageTokenB\f\n" +
+	"\n" +
+	"_page_size\"l\n" +
+	"\x17GetActivityFeedResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.habits.v1.FeedItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"5\n" +
+	"\x17GetPublicProfileRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"N\n" +
+	"\x18GetPublicProfileResponse\x122\n" +
+	"\aprofile\x18\x01 \x01(\v2\x18.habits.v1.PublicProfileR\aprofile\"N\n" +
+	"\x15GetPublicHabitRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\tR\ahabitId\"b\n" +
+	"\x16GetPublicHabitResponse\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12,\n" +
+	"\x05habit\x18\x02 \x01(\v2\x16.habits.v1.PublicHabitR\x05habit\"9\n" +
+	"\x1eRotateCalendarFeedTokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"7\n" +
+	"\x1fRotateCalendarFeedTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"9\n" +
+	"\x1eRevokeCalendarFeedTokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\";\n" +
+	"\x1fRevokeCalendarFeedTokenResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\".\n" +
+	"\x16GetCalendarFeedRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"5\n" +
+	"\x17GetCalendarFeedResponse\x12\x1a\n" +
+	"\bcalendar\x18\x01 \x01(\tR\bcalendar\"\xad\x01\n" +
+	"\x13ImportHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x124\n" +
+	"\x06source\x18\x02 \x01(\x0e2\x1c.habits.v1.HabitImportSourceR\x06source\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"F\n" +
+	"\x14ImportHabitsResponse\x12.\n" +
+	"\x06import\x18\x01 \x01(\v2\x16.habits.v1.HabitImportR\x06import\"M\n" +
+	"\x15GetHabitImportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\timport_id\x18\x02 \x01(\tR\bimportId\"H\n" +
+	"\x16GetHabitImportResponse\x12.\n" +
+	"\x06import\x18\x01 \x01(\v2\x16.habits.v1.HabitImportR\x06import\"\xf3\x01\n" +
+	"\x19ExportHabitHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\bhabit_id\x18\x02 \x01(\tH\x00R\ahabitId\x88\x01\x01\x12 \n" +
+	"\tfrom_date\x18\x03 \x01(\tH\x01R\bfromDate\x88\x01\x01\x12\x1c\n" +
+	"\ato_date\x18\x04 \x01(\tH\x02R\x06toDate\x88\x01\x01\x126\n" +
+	"\x06format\x18\x05 \x01(\x0e2\x1e.habits.v1.HistoryExportFormatR\x06formatB\v\n" +
+	"\t_habit_idB\f\n" +
+	"\n" +
+	"_from_dateB\n" +
+	"\n" +
+	"\b_to_date\"W\n" +
+	"\x11HabitHistoryChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12 \n" +
+	"\tfile_name\x18\x02 \x01(\tH\x00R\bfileName\x88\x01\x01B\f\n" +
+	"\n" +
+	"_file_name\"\xdc\x02\n" +
+	"\fJournalEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x17\n" +
+	"\x04mood\x18\x03 \x01(\x05H\x00R\x04mood\x88\x01\x01\x12\x1b\n" +
+	"\x06energy\x18\x04 \x01(\x05H\x01R\x06energy\x88\x01\x01\x12\x1d\n" +
+	"\acontent\x18\x05 \x01(\tH\x02R\acontent\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12)\n" +
+	"\x10confirmation_ids\x18\a \x03(\tR\x0fconfirmationIds\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\a\n" +
+	"\x05_moodB\t\n" +
+	"\a_energyB\n" +
+	"\n" +
+	"\b_content\"\xfa\x01\n" +
+	"\x17SaveJournalEntryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x17\n" +
+	"\x04mood\x18\x03 \x01(\x05H\x00R\x04mood\x88\x01\x01\x12\x1b\n" +
+	"\x06energy\x18\x04 \x01(\x05H\x01R\x06energy\x88\x01\x01\x12\x1d\n" +
+	"\acontent\x18\x05 \x01(\tH\x02R\acontent\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12)\n" +
+	"\x10confirmation_ids\x18\a \x03(\tR\x0fconfirmationIdsB\a\n" +
+	"\x05_moodB\t\n" +
+	"\a_energyB\n" +
+	"\n" +
+	"\b_content\"I\n" +
+	"\x18SaveJournalEntryResponse\x12-\n" +
+	"\x05entry\x18\x01 \x01(\v2\x17.habits.v1.JournalEntryR\x05entry\"E\n" +
+	"\x16GetJournalEntryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"H\n" +
+	"\x17GetJournalEntryResponse\x12-\n" +
+	"\x05entry\x18\x01 \x01(\v2\x17.habits.v1.JournalEntryR\x05entry\"\x89\x01\n" +
+	"\x19ListJournalEntriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\x12\x15\n" +
+	"\x03tag\x18\x04 \x01(\tH\x00R\x03tag\x88\x01\x01B\x06\n" +
+	"\x04_tag\"O\n" +
+	"\x1aListJournalEntriesResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.habits.v1.JournalEntryR\aentries\"H\n" +
+	"\x19DeleteJournalEntryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"6\n" +
+	"\x1aDeleteJournalEntryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xba\x01\n" +
+	"\x10NoteSearchResult\x12'\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x13.habits.v1.NoteKindR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1e\n" +
+	"\bhabit_id\x18\x04 \x01(\tH\x00R\ahabitId\x88\x01\x01\x12\x18\n" +
+	"\asnippet\x18\x05 \x01(\tR\asnippet\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x01R\x04rankB\v\n" +
+	"\t_habit_id\"h\n" +
+	"\x12SearchNotesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"L\n" +
+	"\x13SearchNotesResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.habits.v1.NoteSearchResultR\aresults\"\x89\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x05R\x06height\x12,\n" +
+	"\x0fconfirmation_id\x18\x06 \x01(\tH\x00R\x0econfirmationId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x12\n" +
+	"\x10_confirmation_id\"F\n" +
+	"\x17UploadAttachmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"Q\n" +
+	"\x18UploadAttachmentResponse\x125\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x15.habits.v1.AttachmentR\n" +
+	"attachment\"w\n" +
+	"\x19DownloadAttachmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rattachment_id\x18\x02 \x01(\tR\fattachmentId\x12\x1c\n" +
+	"\tthumbnail\x18\x03 \x01(\bR\tthumbnail\"^\n" +
+	"\x0fAttachmentChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12&\n" +
+	"\fcontent_type\x18\x02 \x01(\tH\x00R\vcontentType\x88\x01\x01B\x0f\n" +
+	"\r_content_type\"4\n" +
+	"\x19GetAttachmentUsageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\\\n" +
+	"\x1aGetAttachmentUsageResponse\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x01 \x01(\x03R\tusedBytes\x12\x1f\n" +
+	"\vquota_bytes\x18\x02 \x01(\x03R\n" +
+	"quotaBytes*c\n" +
+	"\fScheduleType\x12\x1d\n" +
+	"\x19SCHEDULE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SCHEDULE_TYPE_INTERVAL\x10\x01\x12\x18\n" +
+	"\x14SCHEDULE_TYPE_WEEKLY\x10\x02*\x97\x01\n" +
+	"\x11HabitStatusFilter\x12#\n" +
+	"\x1fHABIT_STATUS_FILTER_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aHABIT_STATUS_FILTER_ACTIVE\x10\x01\x12 \n" +
+	"\x1cHABIT_STATUS_FILTER_ARCHIVED\x10\x02\x12\x1b\n" +
+	"\x17HABIT_STATUS_FILTER_ALL\x10\x03*\x8c\x01\n" +
+	"\x0fHabitVisibility\x12 \n" +
+	"\x1cHABIT_VISIBILITY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18HABIT_VISIBILITY_PRIVATE\x10\x01\x12\x1c\n" +
+	"\x18HABIT_VISIBILITY_FRIENDS\x10\x02\x12\x1b\n" +
+	"\x17HABIT_VISIBILITY_PUBLIC\x10\x03*\x85\x01\n" +
+	"\rPartnerStatus\x12\x1e\n" +
+	"\x1aPARTNER_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PARTNER_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17PARTNER_STATUS_ACCEPTED\x10\x02\x12\x1b\n" +
+	"\x17PARTNER_STATUS_DECLINED\x10\x03*d\n" +
+	"\fFollowStatus\x12\x1d\n" +
+	"\x19FOLLOW_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15FOLLOW_STATUS_PENDING\x10\x01\x12\x1a\n" +
+	"\x16FOLLOW_STATUS_ACCEPTED\x10\x02*m\n" +
+	"\fFeedItemKind\x12\x1e\n" +
+	"\x1aFEED_ITEM_KIND_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bFEED_ITEM_KIND_CONFIRMATION\x10\x01\x12\x1c\n" +
+	"\x18FEED_ITEM_KIND_MILESTONE\x10\x02*\x99\x01\n" +
+	"\x11HabitImportSource\x12#\n" +
+	"\x1fHABIT_IMPORT_SOURCE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18HABIT_IMPORT_SOURCE_LOOP\x10\x01\x12 \n" +
+	"\x1cHABIT_IMPORT_SOURCE_HABITICA\x10\x02\x12\x1f\n" +
+	"\x1bHABIT_IMPORT_SOURCE_STREAKS\x10\x03*\xc0\x01\n" +
+	"\x11HabitImportStatus\x12#\n" +
+	"\x1fHABIT_IMPORT_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bHABIT_IMPORT_STATUS_PENDING\x10\x01\x12\"\n" +
+	"\x1eHABIT_IMPORT_STATUS_PROCESSING\x10\x02\x12!\n" +
+	"\x1dHABIT_IMPORT_STATUS_COMPLETED\x10\x03\x12\x1e\n" +
+	"\x1aHABIT_IMPORT_STATUS_FAILED\x10\x04*{\n" +
+	"\x13HistoryExportFormat\x12%\n" +
+	"!HISTORY_EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19HISTORY_EXPORT_FORMAT_CSV\x10\x01\x12\x1e\n" +
+	"\x1aHISTORY_EXPORT_FORMAT_JSON\x10\x02*\xf0\x01\n" +
+	"\x10CalendarDayState\x12\"\n" +
+	"\x1eCALENDAR_DAY_STATE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17CALENDAR_DAY_STATE_DONE\x10\x01\x12\x1d\n" +
+	"\x19CALENDAR_DAY_STATE_MISSED\x10\x02\x12\x1e\n" +
+	"\x1aCALENDAR_DAY_STATE_PENDING\x10\x03\x12\x1e\n" +
+	"\x1aCALENDAR_DAY_STATE_NOT_DUE\x10\x04\x12\x1d\n" +
+	"\x19CALENDAR_DAY_STATE_FROZEN\x10\x05\x12\x1d\n" +
+	"\x19CALENDAR_DAY_STATE_FUTURE\x10\x06*\x89\x01\n" +
+	"\x10TrendGranularity\x12!\n" +
+	"\x1dTREND_GRANULARITY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15TREND_GRANULARITY_DAY\x10\x01\x12\x1a\n" +
+	"\x16TREND_GRANULARITY_WEEK\x10\x02\x12\x1b\n" +
+	"\x17TREND_GRANULARITY_MONTH\x10\x03*\xb2\x01\n" +
+	"\x0eTrendDirection\x12\x1f\n" +
+	"\x1bTREND_DIRECTION_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19TREND_DIRECTION_IMPROVING\x10\x01\x12\x1d\n" +
+	"\x19TREND_DIRECTION_DECLINING\x10\x02\x12\x1a\n" +
+	"\x16TREND_DIRECTION_STABLE\x10\x03\x12%\n" +
+	"!TREND_DIRECTION_INSUFFICIENT_DATA\x10\x04*X\n" +
+	"\bNoteKind\x12\x19\n" +
+	"\x15NOTE_KIND_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11NOTE_KIND_JOURNAL\x10\x01\x12\x1a\n" +
+	"\x16NOTE_KIND_CONFIRMATION\x10\x022\xab0\n" +
+	"\fHabitService\x12L\n" +
+	"\vCreateHabit\x12\x1d.habits.v1.CreateHabitRequest\x1a\x1e.habits.v1.CreateHabitResponse\x12C\n" +
+	"\bGetHabit\x12\x1a.habits.v1.GetHabitRequest\x1a\x1b.habits.v1.GetHabitResponse\x12I\n" +
+	"\n" +
+	"ListHabits\x12\x1c.habits.v1.ListHabitsRequest\x1a\x1d.habits.v1.ListHabitsResponse\x12U\n" +
+	"\x0eGetTodayAgenda\x12 .habits.v1.GetTodayAgendaRequest\x1a!.habits.v1.GetTodayAgendaResponse\x12L\n" +
+	"\vUpdateHabit\x12\x1d.habits.v1.UpdateHabitRequest\x1a\x1e.habits.v1.UpdateHabitResponse\x12L\n" +
+	"\vDeleteHabit\x12\x1d.habits.v1.DeleteHabitRequest\x1a\x1e.habits.v1.DeleteHabitResponse\x12O\n" +
+	"\fArchiveHabit\x12\x1e.habits.v1.ArchiveHabitRequest\x1a\x1f.habits.v1.ArchiveHabitResponse\x12U\n" +
+	"\x0eUnarchiveHabit\x12 .habits.v1.UnarchiveHabitRequest\x1a!.habits.v1.UnarchiveHabitResponse\x12I\n" +
+	"\n" +
+	"PurgeHabit\x12\x1c.habits.v1.PurgeHabitRequest\x1a\x1d.habits.v1.PurgeHabitResponse\x12L\n" +
+	"\vPauseHabits\x12\x1d.habits.v1.PauseHabitsRequest\x1a\x1e.habits.v1.PauseHabitsResponse\x12O\n" +
+	"\fResumeHabits\x12\x1e.habits.v1.ResumeHabitsRequest\x1a\x1f.habits.v1.ResumeHabitsResponse\x12X\n" +
+	"\x0fListHabitPauses\x12!.habits.v1.ListHabitPausesRequest\x1a\".habits.v1.ListHabitPausesResponse\x12O\n" +
+	"\fConfirmHabit\x12\x1e.habits.v1.ConfirmHabitRequest\x1a\x1f.habits.v1.ConfirmHabitResponse\x12X\n" +
+	"\x0fGetHabitHistory\x12!.habits.v1.GetHabitHistoryRequest\x1a\".habits.v1.GetHabitHistoryResponse\x12[\n" +
+	"\x10GetHabitCalendar\x12\".habits.v1.GetHabitCalendarRequest\x1a#.habits.v1.GetHabitCalendarResponse\x12R\n" +
+	"\rGetHabitStats\x12\x1f.habits.v1.GetHabitStatsRequest\x1a .habits.v1.GetHabitStatsResponse\x12[\n" +
+	"\x10ExportUserHabits\x12\".habits.v1.ExportUserHabitsRequest\x1a#.habits.v1.ExportUserHabitsResponse\x12R\n" +
+	"\rReorderHabits\x12\x1f.habits.v1.ReorderHabitsRequest\x1a .habits.v1.ReorderHabitsResponse\x12R\n" +
+	"\rListHabitTags\x12\x1f.habits.v1.ListHabitTagsRequest\x1a .habits.v1.ListHabitTagsResponse\x12[\n" +
+	"\x10CreateHabitGroup\x12\".habits.v1.CreateHabitGroupRequest\x1a#.habits.v1.CreateHabitGroupResponse\x12X\n" +
+	"\x0fListHabitGroups\x12!.habits.v1.ListHabitGroupsRequest\x1a\".habits.v1.ListHabitGroupsResponse\x12[\n" +
+	"\x10UpdateHabitGroup\x12\".habits.v1.UpdateHabitGroupRequest\x1a#.habits.v1.UpdateHabitGroupResponse\x12[\n" +
+	"\x10DeleteHabitGroup\x12\".habits.v1.DeleteHabitGroupRequest\x1a#.habits.v1.DeleteHabitGroupResponse\x12a\n" +
+	"\x12ReorderHabitGroups\x12$.habits.v1.ReorderHabitGroupsRequest\x1a%.habits.v1.ReorderHabitGroupsResponse\x12a\n" +
+	"\x12ListHabitTemplates\x12$.habits.v1.ListHabitTemplatesRequest\x1a%.habits.v1.ListHabitTemplatesResponse\x12p\n" +
+	"\x17CreateHabitFromTemplate\x12).habits.v1.CreateHabitFromTemplateRequest\x1a*.habits.v1.CreateHabitFromTemplateResponse\x12d\n" +
+	"\x13SaveHabitAsTemplate\x12%.habits.v1.SaveHabitAsTemplateRequest\x1a&.habits.v1.SaveHabitAsTemplateResponse\x12d\n" +
+	"\x13DeleteHabitTemplate\x12%.habits.v1.DeleteHabitTemplateRequest\x1a&.habits.v1.DeleteHabitTemplateResponse\x12a\n" +
+	"\x12InviteHabitPartner\x12$.habits.v1.InviteHabitPartnerRequest\x1a%.habits.v1.InviteHabitPartnerResponse\x12^\n" +
+	"\x11ListHabitPartners\x12#.habits.v1.ListHabitPartnersRequest\x1a$.habits.v1.ListHabitPartnersResponse\x12m\n" +
+	"\x16ListPartnerInvitations\x12(.habits.v1.ListPartnerInvitationsRequest\x1a).habits.v1.ListPartnerInvitationsResponse\x12y\n" +
+	"\x1aRespondToPartnerInvitation\x12,.habits.v1.RespondToPartnerInvitationRequest\x1a-.habits.v1.RespondToPartnerInvitationResponse\x12a\n" +
+	"\x12RemoveHabitPartner\x12$.habits.v1.RemoveHabitPartnerRequest\x1a%.habits.v1.RemoveHabitPartnerResponse\x12[\n" +
+	"\x10ListSharedHabits\x12\".habits.v1.ListSharedHabitsRequest\x1a#.habits.v1.ListSharedHabitsResponse\x12I\n" +
+	"\n" +
+	"NudgeHabit\x12\x1c.habits.v1.NudgeHabitRequest\x1a\x1d.habits.v1.NudgeHabitResponse\x12X\n" +
+	"\x0fCreateChallenge\x12!.habits.v1.CreateChallengeRequest\x1a\".habits.v1.CreateChallengeResponse\x12O\n" +
+	"\fGetChallenge\x12\x1e.habits.v1.GetChallengeRequest\x1a\x1f.habits.v1.GetChallengeResponse\x12U\n" +
+	"\x0eListChallenges\x12 .habits.v1.ListChallengesRequest\x1a!.habits.v1.ListChallengesResponse\x12X\n" +
+	"\x0fUpdateChallenge\x12!.habits.v1.UpdateChallengeRequest\x1a\".habits.v1.UpdateChallengeResponse\x12X\n" +
+	"\x0fDeleteChallenge\x12!.habits.v1.DeleteChallengeRequest\x1a\".habits.v1.DeleteChallengeResponse\x12R\n" +
+	"\rJoinChallenge\x12\x1f.habits.v1.JoinChallengeRequest\x1a .habits.v1.JoinChallengeResponse\x12U\n" +
+	"\x0eLeaveChallenge\x12 .habits.v1.LeaveChallengeRequest\x1a!.habits.v1.LeaveChallengeResponse\x12p\n" +
+	"\x17GetChallengeLeaderboard\x12).habits.v1.GetChallengeLeaderboardRequest\x1a*.habits.v1.GetChallengeLeaderboardResponse\x12[\n" +
+	"\x10ListAchievements\x12\".habits.v1.ListAchievementsRequest\x1a#.habits.v1.ListAchievementsResponse\x12I\n" +
+	"\n" +
+	"FollowUser\x12\x1c.habits.v1.FollowUserRequest\x1a\x1d.habits.v1.FollowUserResponse\x12R\n" +
+	"\rListFollowers\x12\x1f.habits.v1.ListFollowersRequest\x1a .habits.v1.ListFollowersResponse\x12R\n" +
+	"\rListFollowing\x12\x1f.habits.v1.ListFollowingRequest\x1a .habits.v1.ListFollowingResponse\x12a\n" +
+	"\x12ListFollowRequests\x12$.habits.v1.ListFollowRequestsRequest\x1a%.habits.v1.ListFollowRequestsResponse\x12m\n" +
+	"\x16RespondToFollowRequest\x12(.habits.v1.RespondToFollowRequestRequest\x1a).habits.v1.RespondToFollowRequestResponse\x12O\n" +
+	"\fUnfollowUser\x12\x1e.habits.v1.UnfollowUserRequest\x1a\x1f.habits.v1.UnfollowUserResponse\x12U\n" +
+	"\x0eRemoveFollower\x12 .habits.v1.RemoveFollowerRequest\x1a!.habits.v1.RemoveFollowerResponse\x12X\n" +
+	"\x0fGetActivityFeed\x12!.habits.v1.GetActivityFeedRequest\x1a\".habits.v1.GetActivityFeedResponse\x12[\n" +
+	"\x10GetPublicProfile\x12\".habits.v1.GetPublicProfileRequest\x1a#.habits.v1.GetPublicProfileResponse\x12U\n" +
+	"\x0eGetPublicHabit\x12 .habits.v1.GetPublicHabitRequest\x1a!.habits.v1.GetPublicHabitResponse\x12p\n" +
+	"\x17RotateCalendarFeedToken\x12).habits.v1.RotateCalendarFeedTokenRequest\x1a*.habits.v1.RotateCalendarFeedTokenResponse\x12p\n" +
+	"\x17RevokeCalendarFeedToken\x12).habits.v1.RevokeCalendarFeedTokenRequest\x1a*.habits.v1.RevokeCalendarFeedTokenResponse\x12X\n" +
+	"\x0fGetCalendarFeed\x12!.habits.v1.GetCalendarFeedRequest\x1a\".habits.v1.GetCalendarFeedResponse\x12O\n" +
+	"\fImportHabits\x12\x1e.habits.v1.ImportHabitsRequest\x1a\x1f.habits.v1.ImportHabitsResponse\x12U\n" +
+	"\x0eGetHabitImport\x12 .habits.v1.GetHabitImportRequest\x1a!.habits.v1.GetHabitImportResponse\x12Z\n" +
+	"\x12ExportHabitHistory\x12$.habits.v1.ExportHabitHistoryRequest\x1a\x1c.habits.v1.HabitHistoryChunk0\x01\x12[\n" +
+	"\x10SaveJournalEntry\x12\".habits.v1.SaveJournalEntryRequest\x1a#.habits.v1.SaveJournalEntryResponse\x12X\n" +
+	"\x0fGetJournalEntry\x12!.habits.v1.GetJournalEntryRequest\x1a\".habits.v1.GetJournalEntryResponse\x12a\n" +
+	"\x12ListJournalEntries\x12$.habits.v1.ListJournalEntriesRequest\x1a%.habits.v1.ListJournalEntriesResponse\x12a\n" +
+	"\x12DeleteJournalEntry\x12$.habits.v1.DeleteJournalEntryRequest\x1a%.habits.v1.DeleteJournalEntryResponse\x12L\n" +
+	"\vSearchNotes\x12\x1d.habits.v1.SearchNotesRequest\x1a\x1e.habits.v1.SearchNotesResponse\x12[\n" +
+	"\x10UploadAttachment\x12\".habits.v1.UploadAttachmentRequest\x1a#.habits.v1.UploadAttachmentResponse\x12X\n" +
+	"\x12DownloadAttachment\x12$.habits.v1.DownloadAttachmentRequest\x1a\x1a.habits.v1.AttachmentChunk0\x01\x12a\n" +
+	"\x12GetAttachmentUsage\x12$.habits.v1.GetAttachmentUsageRequest\x1a%.habits.v1.GetAttachmentUsageResponse2\x95\x04\n" +
+	"\x15HabitAnalyticsService\x12a\n" +
+	"\x12GetCompletionTrend\x12$.habits.v1.GetCompletionTrendRequest\x1a%.habits.v1.GetCompletionTrendResponse\x12d\n" +
+	"\x13GetWeekdayBreakdown\x12%.habits.v1.GetWeekdayBreakdownRequest\x1a&.habits.v1.GetWeekdayBreakdownResponse\x12d\n" +
+	"\x13GetHourDistribution\x12%.habits.v1.GetHourDistributionRequest\x1a&.habits.v1.GetHourDistributionResponse\x12g\n" +
+	"\x14GetHabitCorrelations\x12&.habits.v1.GetHabitCorrelationsRequest\x1a'.habits.v1.GetHabitCorrelationsResponse\x12d\n" +
+	"\x13GetMoodCorrelations\x12%.habits.v1.GetMoodCorrelationsRequest\x1a&.habits.v1.GetMoodCorrelationsResponseB)Z'habits-service/proto/habits/v1;habitspbb\x06proto3"
+
+var (
+	file_habits_proto_rawDescOnce sync.Once
+	file_habits_proto_rawDescData []byte
+)
+
+func file_habits_proto_rawDescGZIP() []byte {
+	file_habits_proto_rawDescOnce.Do(func() {
+		file_habits_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_habits_proto_rawDesc), len(file_habits_proto_rawDesc)))
+	})
+	return file_habits_proto_rawDescData
+}
+
+var file_habits_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_habits_proto_msgTypes = make([]protoimpl.MessageInfo, 174)
+var file_habits_proto_goTypes = []any{
+	(ScheduleType)(0),                          // 0: habits.v1.ScheduleType
+	(HabitStatusFilter)(0),                     // 1: habits.v1.HabitStatusFilter
+	(HabitVisibility)(0),                       // 2: habits.v1.HabitVisibility
+	(PartnerStatus)(0),                         // 3: habits.v1.PartnerStatus
+	(FollowStatus)(0),                          // 4: habits.v1.FollowStatus
+	(FeedItemKind)(0),                          // 5: habits.v1.FeedItemKind
+	(HabitImportSource)(0),                     // 6: habits.v1.HabitImportSource
+	(HabitImportStatus)(0),                     // 7: habits.v1.HabitImportStatus
+	(HistoryExportFormat)(0),                   // 8: habits.v1.HistoryExportFormat
+	(CalendarDayState)(0),                      // 9: habits.v1.CalendarDayState
+	(TrendGranularity)(0),                      // 10: habits.v1.TrendGranularity
+	(TrendDirection)(0),                        // 11: habits.v1.TrendDirection
+	(NoteKind)(0),                              // 12: habits.v1.NoteKind
+	(*Habit)(nil),                              // 13: habits.v1.Habit
+	(*HabitGroup)(nil),                         // 14: habits.v1.HabitGroup
+	(*HabitTemplate)(nil),                      // 15: habits.v1.HabitTemplate
+	(*HabitPartner)(nil),                       // 16: habits.v1.HabitPartner
+	(*SharedHabit)(nil),                        // 17: habits.v1.SharedHabit
+	(*Challenge)(nil),                          // 18: habits.v1.Challenge
+	(*ChallengeLeaderboardEntry)(nil),          // 19: habits.v1.ChallengeLeaderboardEntry
+	(*Achievement)(nil),                        // 20: habits.v1.Achievement
+	(*Follow)(nil),                             // 21: habits.v1.Follow
+	(*FeedItem)(nil),                           // 22: habits.v1.FeedItem
+	(*PublicHabit)(nil),                        // 23: habits.v1.PublicHabit
+	(*PublicProfile)(nil),                      // 24: habits.v1.PublicProfile
+	(*ImportedHabit)(nil),                      // 25: habits.v1.ImportedHabit
+	(*HabitImportIssue)(nil),                   // 26: habits.v1.HabitImportIssue
+	(*HabitImport)(nil),                        // 27: habits.v1.HabitImport
+	(*TagUsage)(nil),                           // 28: habits.v1.TagUsage
+	(*HabitConfirmation)(nil),                  // 29: habits.v1.HabitConfirmation
+	(*HabitPause)(nil),                         // 30: habits.v1.HabitPause
+	(*CreateHabitRequest)(nil),                 // 31: habits.v1.CreateHabitRequest
+	(*CreateHabitResponse)(nil),                // 32: habits.v1.CreateHabitResponse
+	(*GetHabitRequest)(nil),                    // 33: habits.v1.GetHabitRequest
+	(*GetHabitResponse)(nil),                   // 34: habits.v1.GetHabitResponse
+	(*ListHabitsRequest)(nil),                  // 35: habits.v1.ListHabitsRequest
+	(*ListHabitsResponse)(nil),                 // 36: habits.v1.ListHabitsResponse
+	(*GetTodayAgendaRequest)(nil),              // 37: habits.v1.GetTodayAgendaRequest
+	(*AgendaItem)(nil),                         // 38: habits.v1.AgendaItem
+	(*GetTodayAgendaResponse)(nil),             // 39: habits.v1.GetTodayAgendaResponse
+	(*UpdateHabitRequest)(nil),                 // 40: habits.v1.UpdateHabitRequest
+	(*UpdateHabitResponse)(nil),                // 41: habits.v1.UpdateHabitResponse
+	(*DeleteHabitRequest)(nil),                 // 42: habits.v1.DeleteHabitRequest
+	(*DeleteHabitResponse)(nil),                // 43: habits.v1.DeleteHabitResponse
+	(*ArchiveHabitRequest)(nil),                // 44: habits.v1.ArchiveHabitRequest
+	(*ArchiveHabitResponse)(nil),               // 45: habits.v1.ArchiveHabitResponse
+	(*UnarchiveHabitRequest)(nil),              // 46: habits.v1.UnarchiveHabitRequest
+	(*UnarchiveHabitResponse)(nil),             // 47: habits.v1.UnarchiveHabitResponse
+	(*PurgeHabitRequest)(nil),                  // 48: habits.v1.PurgeHabitRequest
+	(*PurgeHabitResponse)(nil),                 // 49: habits.v1.PurgeHabitResponse
+	(*PauseHabitsRequest)(nil),                 // 50: habits.v1.PauseHabitsRequest
+	(*PauseHabitsResponse)(nil),                // 51: habits.v1.PauseHabitsResponse
+	(*ResumeHabitsRequest)(nil),                // 52: habits.v1.ResumeHabitsRequest
+	(*ResumeHabitsResponse)(nil),               // 53: habits.v1.ResumeHabitsResponse
+	(*ListHabitPausesRequest)(nil),             // 54: habits.v1.ListHabitPausesRequest
+	(*ListHabitPausesResponse)(nil),            // 55: habits.v1.ListHabitPausesResponse
+	(*ConfirmHabitRequest)(nil),                // 56: habits.v1.ConfirmHabitRequest
+	(*ConfirmHabitResponse)(nil),               // 57: habits.v1.ConfirmHabitResponse
+	(*GetHabitHistoryRequest)(nil),             // 58: habits.v1.GetHabitHistoryRequest
+	(*GetHabitHistoryResponse)(nil),            // 59: habits.v1.GetHabitHistoryResponse
+	(*CalendarDay)(nil),                        // 60: habits.v1.CalendarDay
+	(*GetHabitCalendarRequest)(nil),            // 61: habits.v1.GetHabitCalendarRequest
+	(*GetHabitCalendarResponse)(nil),           // 62: habits.v1.GetHabitCalendarResponse
+	(*GetHabitStatsRequest)(nil),               // 63: habits.v1.GetHabitStatsRequest
+	(*GetHabitStatsResponse)(nil),              // 64: habits.v1.GetHabitStatsResponse
+	(*PeriodCompletionRate)(nil),               // 65: habits.v1.PeriodCompletionRate
+	(*ExportUserHabitsRequest)(nil),            // 66: habits.v1.ExportUserHabitsRequest
+	(*ExportUserHabitsResponse)(nil),           // 67: habits.v1.ExportUserHabitsResponse
+	(*CompletionPoint)(nil),                    // 68: habits.v1.CompletionPoint
+	(*GetCompletionTrendRequest)(nil),          // 69: habits.v1.GetCompletionTrendRequest
+	(*GetCompletionTrendResponse)(nil),         // 70: habits.v1.GetCompletionTrendResponse
+	(*WeekdayCompletionRate)(nil),              // 71: habits.v1.WeekdayCompletionRate
+	(*GetWeekdayBreakdownRequest)(nil),         // 72: habits.v1.GetWeekdayBreakdownRequest
+	(*GetWeekdayBreakdownResponse)(nil),        // 73: habits.v1.GetWeekdayBreakdownResponse
+	(*GetHourDistributionRequest)(nil),         // 74: habits.v1.GetHourDistributionRequest
+	(*GetHourDistributionResponse)(nil),        // 75: habits.v1.GetHourDistributionResponse
+	(*HabitCorrelation)(nil),                   // 76: habits.v1.HabitCorrelation
+	(*GetHabitCorrelationsRequest)(nil),        // 77: habits.v1.GetHabitCorrelationsRequest
+	(*GetHabitCorrelationsResponse)(nil),       // 78: habits.v1.GetHabitCorrelationsResponse
+	(*MoodCorrelation)(nil),                    // 79: habits.v1.MoodCorrelation
+	(*GetMoodCorrelationsRequest)(nil),         // 80: habits.v1.GetMoodCorrelationsRequest
+	(*GetMoodCorrelationsResponse)(nil),        // 81: habits.v1.GetMoodCorrelationsResponse
+	(*ReorderHabitsRequest)(nil),               // 82: habits.v1.ReorderHabitsRequest
+	(*ReorderHabitsResponse)(nil),              // 83: habits.v1.ReorderHabitsResponse
+	(*ListHabitTagsRequest)(nil),               // 84: habits.v1.ListHabitTagsRequest
+	(*ListHabitTagsResponse)(nil),              // 85: habits.v1.ListHabitTagsResponse
+	(*CreateHabitGroupRequest)(nil),            // 86: habits.v1.CreateHabitGroupRequest
+	(*CreateHabitGroupResponse)(nil),           // 87: habits.v1.CreateHabitGroupResponse
+	(*ListHabitGroupsRequest)(nil),             // 88: habits.v1.ListHabitGroupsRequest
+	(*ListHabitGroupsResponse)(nil),            // 89: habits.v1.ListHabitGroupsResponse
+	(*UpdateHabitGroupRequest)(nil),            // 90: habits.v1.UpdateHabitGroupRequest
+	(*UpdateHabitGroupResponse)(nil),           // 91: habits.v1.UpdateHabitGroupResponse
+	(*DeleteHabitGroupRequest)(nil),            // 92: habits.v1.DeleteHabitGroupRequest
+	(*DeleteHabitGroupResponse)(nil),           // 93: habits.v1.DeleteHabitGroupResponse
+	(*ReorderHabitGroupsRequest)(nil),          // 94: habits.v1.ReorderHabitGroupsRequest
+	(*ReorderHabitGroupsResponse)(nil),         // 95: habits.v1.ReorderHabitGroupsResponse
+	(*ListHabitTemplatesRequest)(nil),          // 96: habits.v1.ListHabitTemplatesRequest
+	(*ListHabitTemplatesResponse)(nil),         // 97: habits.v1.ListHabitTemplatesResponse
+	(*CreateHabitFromTemplateRequest)(nil),     // 98: habits.v1.CreateHabitFromTemplateRequest
+	(*CreateHabitFromTemplateResponse)(nil),    // 99: habits.v1.CreateHabitFromTemplateResponse
+	(*SaveHabitAsTemplateRequest)(nil),         // 100: habits.v1.SaveHabitAsTemplateRequest
+	(*SaveHabitAsTemplateResponse)(nil),        // 101: habits.v1.SaveHabitAsTemplateResponse
+	(*DeleteHabitTemplateRequest)(nil),         // 102: habits.v1.DeleteHabitTemplateRequest
+	(*DeleteHabitTemplateResponse)(nil),        // 103: habits.v1.DeleteHabitTemplateResponse
+	(*InviteHabitPartnerRequest)(nil),          // 104: habits.v1.InviteHabitPartnerRequest
+	(*InviteHabitPartnerResponse)(nil),         // 105: habits.v1.InviteHabitPartnerResponse
+	(*ListHabitPartnersRequest)(nil),           // 106: habits.v1.ListHabitPartnersRequest
+	(*ListHabitPartnersResponse)(nil),          // 107: habits.v1.ListHabitPartnersResponse
+	(*ListPartnerInvitationsRequest)(nil),      // 108: habits.v1.ListPartnerInvitationsRequest
+	(*ListPartnerInvitationsResponse)(nil),     // 109: habits.v1.ListPartnerInvitationsResponse
+	(*RespondToPartnerInvitationRequest)(nil),  // 110: habits.v1.RespondToPartnerInvitationRequest
+	(*RespondToPartnerInvitationResponse)(nil), // 111: habits.v1.RespondToPartnerInvitationResponse
+	(*RemoveHabitPartnerRequest)(nil),          // 112: habits.v1.RemoveHabitPartnerRequest
+	(*RemoveHabitPartnerResponse)(nil),         // 113: habits.v1.RemoveHabitPartnerResponse
+	(*ListSharedHabitsRequest)(nil),            // 114: habits.v1.ListSharedHabitsRequest
+	(*ListSharedHabitsResponse)(nil),           // 115: habits.v1.ListSharedHabitsResponse
+	(*NudgeHabitRequest)(nil),                  // 116: habits.v1.NudgeHabitRequest
+	(*NudgeHabitResponse)(nil),                 // 117: habits.v1.NudgeHabitResponse
+	(*CreateChallengeRequest)(nil),             // 118: habits.v1.CreateChallengeRequest
+	(*CreateChallengeResponse)(nil),            // 119: habits.v1.CreateChallengeResponse
+	(*GetChallengeRequest)(nil),                // 120: habits.v1.GetChallengeRequest
+	(*GetChallengeResponse)(nil),               // 121: habits.v1.GetChallengeResponse
+	(*ListChallengesRequest)(nil),              // 122: habits.v1.ListChallengesRequest
+	(*ListChallengesResponse)(nil),             // 123: habits.v1.ListChallengesResponse
+	(*UpdateChallengeRequest)(nil),             // 124: habits.v1.UpdateChallengeRequest
+	(*UpdateChallengeResponse)(nil),            // 125: habits.v1.UpdateChallengeResponse
+	(*DeleteChallengeRequest)(nil),             // 126: habits.v1.DeleteChallengeRequest
+	(*DeleteChallengeResponse)(nil),            // 127: habits.v1.DeleteChallengeResponse
+	(*JoinChallengeRequest)(nil),               // 128: habits.v1.JoinChallengeRequest
+	(*JoinChallengeResponse)(nil),              // 129: habits.v1.JoinChallengeResponse
+	(*LeaveChallengeRequest)(nil),              // 130: habits.v1.LeaveChallengeRequest
+	(*LeaveChallengeResponse)(nil),             // 131: habits.v1.LeaveChallengeResponse
+	(*GetChallengeLeaderboardRequest)(nil),     // 132: habits.v1.GetChallengeLeaderboardRequest
+	(*GetChallengeLeaderboardResponse)(nil),    // 133: habits.v1.GetChallengeLeaderboardResponse
+	(*ListAchievementsRequest)(nil),            // 134: habits.v1.ListAchievementsRequest
+	(*ListAchievementsResponse)(nil),           // 135: habits.v1.ListAchievementsResponse
+	(*FollowUserRequest)(nil),                  // 136: habits.v1.FollowUserRequest
+	(*FollowUserResponse)(nil),                 // 137: habits.v1.FollowUserResponse
+	(*ListFollowersRequest)(nil),               // 138: habits.v1.ListFollowersRequest
+	(*ListFollowersResponse)(nil),              // 139: habits.v1.ListFollowersResponse
+	(*ListFollowingRequest)(nil),               // 140: habits.v1.ListFollowingRequest
+	(*ListFollowingResponse)(nil),              // 141: habits.v1.ListFollowingResponse
+	(*ListFollowRequestsRequest)(nil),          // 142: habits.v1.ListFollowRequestsRequest
+	(*ListFollowRequestsResponse)(nil),         // 143: habits.v1.ListFollowRequestsResponse
+	(*RespondToFollowRequestRequest)(nil),      // 144: habits.v1.RespondToFollowRequestRequest
+	(*RespondToFollowRequestResponse)(nil),     // 145: habits.v1.RespondToFollowRequestResponse
+	(*UnfollowUserRequest)(nil),                // 146: habits.v1.UnfollowUserRequest
+	(*UnfollowUserResponse)(nil),               // 147: habits.v1.UnfollowUserResponse
+	(*RemoveFollowerRequest)(nil),              // 148: habits.v1.RemoveFollowerRequest
+	(*RemoveFollowerResponse)(nil),             // 149: habits.v1.RemoveFollowerResponse
+	(*GetActivityFeedRequest)(nil),             // 150: habits.v1.GetActivityFeedRequest
+	(*GetActivityFeedResponse)(nil),            // 151: habits.v1.GetActivityFeedResponse
+	(*GetPublicProfileRequest)(nil),            // 152: habits.v1.GetPublicProfileRequest
+	(*GetPublicProfileResponse)(nil),           // 153: habits.v1.GetPublicProfileResponse
+	(*GetPublicHabitRequest)(nil),              // 154: habits.v1.GetPublicHabitRequest
+	(*GetPublicHabitResponse)(nil),             // 155: habits.v1.GetPublicHabitResponse
+	(*RotateCalendarFeedTokenRequest)(nil),     // 156: habits.v1.RotateCalendarFeedTokenRequest
+	(*RotateCalendarFeedTokenResponse)(nil),    // 157: habits.v1.RotateCalendarFeedTokenResponse
+	(*RevokeCalendarFeedTokenRequest)(nil),     // 158: habits.v1.RevokeCalendarFeedTokenRequest
+	(*RevokeCalendarFeedTokenResponse)(nil),    // 159: habits.v1.RevokeCalendarFeedTokenResponse
+	(*GetCalendarFeedRequest)(nil),             // 160: habits.v1.GetCalendarFeedRequest
+	(*GetCalendarFeedResponse)(nil),            // 161: habits.v1.GetCalendarFeedResponse
+	(*ImportHabitsRequest)(nil),                // 162: habits.v1.ImportHabitsRequest
+	(*ImportHabitsResponse)(nil),               // 163: habits.v1.ImportHabitsResponse
+	(*GetHabitImportRequest)(nil),              // 164: habits.v1.GetHabitImportRequest
+	(*GetHabitImportResponse)(nil),             // 165: habits.v1.GetHabitImportResponse
+	(*ExportHabitHistoryRequest)(nil),          // 166: habits.v1.ExportHabitHistoryRequest
+	(*HabitHistoryChunk)(nil),                  // 167: habits.v1.HabitHistoryChunk
+	(*JournalEntry)(nil),                       // 168: habits.v1.JournalEntry
+	(*SaveJournalEntryRequest)(nil),            // 169: habits.v1.SaveJournalEntryRequest
+	(*SaveJournalEntryResponse)(nil),           // 170: habits.v1.SaveJournalEntryResponse
+	(*GetJournalEntryRequest)(nil),             // 171: habits.v1.GetJournalEntryRequest
+	(*GetJournalEntryResponse)(nil),            // 172: habits.v1.GetJournalEntryResponse
+	(*ListJournalEntriesRequest)(nil),          // 173: habits.v1.ListJournalEntriesRequest
+	(*ListJournalEntriesResponse)(nil),         // 174: habits.v1.ListJournalEntriesResponse
+	(*DeleteJournalEntryRequest)(nil),          // 175: habits.v1.DeleteJournalEntryRequest
+	(*DeleteJournalEntryResponse)(nil),         // 176: habits.v1.DeleteJournalEntryResponse
+	(*NoteSearchResult)(nil),                   // 177: habits.v1.NoteSearchResult
+	(*SearchNotesRequest)(nil),                 // 178: habits.v1.SearchNotesRequest
+	(*SearchNotesResponse)(nil),                // 179: habits.v1.SearchNotesResponse
+	(*Attachment)(nil),                         // 180: habits.v1.Attachment
+	(*UploadAttachmentRequest)(nil),            // 181: habits.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),           // 182: habits.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),          // 183: habits.v1.DownloadAttachmentRequest
+	(*AttachmentChunk)(nil),                    // 184: habits.v1.AttachmentChunk
+	(*GetAttachmentUsageRequest)(nil),          // 185: habits.v1.GetAttachmentUsageRequest
+	(*GetAttachmentUsageResponse)(nil),         // 186: habits.v1.GetAttachmentUsageResponse
+	(*timestamppb.Timestamp)(nil),              // 187: google.protobuf.Timestamp
+}
+var file_habits_proto_depIdxs = []int32{
+	0,   // 0: habits.v1.Habit.schedule_type:type_name -> habits.v1.ScheduleType
+	187, // 1: habits.v1.Habit.next_deadline_utc:type_name -> google.protobuf.Timestamp
+	187, // 2: habits.v1.Habit.last_confirmed_at:type_name -> google.protobuf.Timestamp
+	187, // 3: habits.v1.Habit.created_at:type_name -> google.protobuf.Timestamp
+	187, // 4: habits.v1.Habit.updated_at:type_name -> google.protobuf.Timestamp
+	187, // 5: habits.v1.Habit.archived_at:type_name -> google.protobuf.Timestamp
+	2,   // 6: habits.v1.Habit.visibility:type_name -> habits.v1.HabitVisibility
+	187, // 7: habits.v1.HabitGroup.created_at:type_name -> google.protobuf.Timestamp
+	187, // 8: habits.v1.HabitGroup.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 9: habits.v1.HabitTemplate.schedule_type:type_name -> habits.v1.ScheduleType
+	187, // 10: habits.v1.HabitTemplate.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 11: habits.v1.HabitPartner.status:type_name -> habits.v1.PartnerStatus
+	187, // 12: habits.v1.HabitPartner.last_nudged_at:type_name -> google.protobuf.Timestamp
+	187, // 13: habits.v1.HabitPartner.created_at:type_name -> google.protobuf.Timestamp
+	187, // 14: habits.v1.HabitPartner.responded_at:type_name -> google.protobuf.Timestamp
+	13,  // 15: habits.v1.SharedHabit.habit:type_name -> habits.v1.Habit
+	187, // 16: habits.v1.Challenge.created_at:type_name -> google.protobuf.Timestamp
+	187, // 17: habits.v1.Challenge.updated_at:type_name -> google.protobuf.Timestamp
+	187, // 18: habits.v1.Achievement.unlocked_at:type_name -> google.protobuf.Timestamp
+	4,   // 19: habits.v1.Follow.status:type_name -> habits.v1.FollowStatus
+	187, // 20: habits.v1.Follow.created_at:type_name -> google.protobuf.Timestamp
+	187, // 21: habits.v1.Follow.responded_at:type_name -> google.protobuf.Timestamp
+	5,   // 22: habits.v1.FeedItem.kind:type_name -> habits.v1.FeedItemKind
+	2,   // 23: habits.v1.FeedItem.habit_visibility:type_name -> habits.v1.HabitVisibility
+	187, // 24: habits.v1.FeedItem.occurred_at:type_name -> google.protobuf.Timestamp
+	0,   // 25: habits.v1.PublicHabit.schedule_type:type_name -> habits.v1.ScheduleType
+	187, // 26: habits.v1.PublicHabit.created_at:type_name -> google.protobuf.Timestamp
+	187, // 27: habits.v1.PublicProfile.member_since:type_name -> google.protobuf.Timestamp
+	23,  // 28: habits.v1.PublicProfile.habits:type_name -> habits.v1.PublicHabit
+	0,   // 29: habits.v1.ImportedHabit.schedule_type:type_name -> habits.v1.ScheduleType
+	6,   // 30: habits.v1.HabitImport.source:type_name -> habits.v1.HabitImportSource
+	7,   // 31: habits.v1.HabitImport.status:type_name -> habits.v1.HabitImportStatus
+	25,  // 32: habits.v1.HabitImport.habits:type_name -> habits.v1.ImportedHabit
+	26,  // 33: habits.v1.HabitImport.issues:type_name -> habits.v1.HabitImportIssue
+	187, // 34: habits.v1.HabitImport.created_at:type_name -> google.protobuf.Timestamp
+	187, // 35: habits.v1.HabitImport.finished_at:type_name -> google.protobuf.Timestamp
+	187, // 36: habits.v1.HabitConfirmation.confirmed_at:type_name -> google.protobuf.Timestamp
+	187, // 37: habits.v1.HabitConfirmation.created_at:type_name -> google.protobuf.Timestamp
+	187, // 38: habits.v1.HabitPause.resumed_at:type_name -> google.protobuf.Timestamp
+	187, // 39: habits.v1.HabitPause.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 40: habits.v1.CreateHabitRequest.schedule_type:type_name -> habits.v1.ScheduleType
+	2,   // 41: habits.v1.CreateHabitRequest.visibility:type_name -> habits.v1.HabitVisibility
+	13,  // 42: habits.v1.CreateHabitResponse.habit:type_name -> habits.v1.Habit
+	13,  // 43: habits.v1.GetHabitResponse.habit:type_name -> habits.v1.Habit
+	1,   // 44: habits.v1.ListHabitsRequest.status:type_name -> habits.v1.HabitStatusFilter
+	13,  // 45: habits.v1.ListHabitsResponse.habits:type_name -> habits.v1.Habit
+	13,  // 46: habits.v1.AgendaItem.habit:type_name -> habits.v1.Habit
+	38,  // 47: habits.v1.GetTodayAgendaResponse.due:type_name -> habits.v1.AgendaItem
+	38,  // 48: habits.v1.GetTodayAgendaResponse.at_risk:type_name -> habits.v1.AgendaItem
+	38,  // 49: habits.v1.GetTodayAgendaResponse.done:type_name -> habits.v1.AgendaItem
+	38,  // 50: habits.v1.GetTodayAgendaResponse.upcoming:type_name -> habits.v1.AgendaItem
+	0,   // 51: habits.v1.UpdateHabitRequest.schedule_type:type_name -> habits.v1.ScheduleType
+	2,   // 52: habits.v1.UpdateHabitRequest.visibility:type_name -> habits.v1.HabitVisibility
+	13,  // 53: habits.v1.UpdateHabitResponse.habit:type_name -> habits.v1.Habit
+	13,  // 54: habits.v1.ArchiveHabitResponse.habit:type_name -> habits.v1.Habit
+	13,  // 55: habits.v1.UnarchiveHabitResponse.habit:type_name -> habits.v1.Habit
+	30,  // 56: habits.v1.PauseHabitsResponse.pauses:type_name -> habits.v1.HabitPause
+	30,  // 57: habits.v1.ListHabitPausesResponse.pauses:type_name -> habits.v1.HabitPause
+	13,  // 58: habits.v1.ConfirmHabitResponse.habit:type_name -> habits.v1.Habit
+	29,  // 59: habits.v1.ConfirmHabitResponse.confirmation:type_name -> habits.v1.HabitConfirmation
+	29,  // 60: habits.v1.GetHabitHistoryResponse.confirmations:type_name -> habits.v1.HabitConfirmation
+	30,  // 61: habits.v1.GetHabitHistoryResponse.pauses:type_name -> habits.v1.HabitPause
+	9,   // 62: habits.v1.CalendarDay.state:type_name -> habits.v1.CalendarDayState
+	60,  // 63: habits.v1.GetHabitCalendarResponse.days:type_name -> habits.v1.CalendarDay
+	187, // 64: habits.v1.GetHabitStatsResponse.first_confirmation:type_name -> google.protobuf.Timestamp
+	187, // 65: habits.v1.GetHabitStatsResponse.last_confirmation:type_name -> google.protobuf.Timestamp
+	65,  // 66: habits.v1.GetHabitStatsResponse.weekly_rates:type_name -> habits.v1.PeriodCompletionRate
+	65,  // 67: habits.v1.GetHabitStatsResponse.monthly_rates:type_name -> habits.v1.PeriodCompletionRate
+	13,  // 68: habits.v1.ExportUserHabitsResponse.habits:type_name -> habits.v1.Habit
+	29,  // 69: habits.v1.ExportUserHabitsResponse.confirmations:type_name -> habits.v1.HabitConfirmation
+	30,  // 70: habits.v1.ExportUserHabitsResponse.pauses:type_name -> habits.v1.HabitPause
+	14,  // 71: habits.v1.ExportUserHabitsResponse.groups:type_name -> habits.v1.HabitGroup
+	15,  // 72: habits.v1.ExportUserHabitsResponse.templates:type_name -> habits.v1.HabitTemplate
+	16,  // 73: habits.v1.ExportUserHabitsResponse.partners:type_name -> habits.v1.HabitPartner
+	18,  // 74: habits.v1.ExportUserHabitsResponse.challenges:type_name -> habits.v1.Challenge
+	135, // 75: habits.v1.ExportUserHabitsResponse.achievements:type_name -> habits.v1.ListAchievementsResponse
+	21,  // 76: habits.v1.ExportUserHabitsResponse.follows:type_name -> habits.v1.Follow
+	22,  // 77: habits.v1.ExportUserHabitsResponse.feed_items:type_name -> habits.v1.FeedItem
+	168, // 78: habits.v1.ExportUserHabitsResponse.journal_entries:type_name -> habits.v1.JournalEntry
+	180, // 79: habits.v1.ExportUserHabitsResponse.attachments:type_name -> habits.v1.Attachment
+	10,  // 80: habits.v1.GetCompletionTrendRequest.granularity:type_name -> habits.v1.TrendGranularity
+	68,  // 81: habits.v1.GetCompletionTrendResponse.points:type_name -> habits.v1.CompletionPoint
+	11,  // 82: habits.v1.GetCompletionTrendResponse.direction:type_name -> habits.v1.TrendDirection
+	71,  // 83: habits.v1.GetWeekdayBreakdownResponse.weekdays:type_name -> habits.v1.WeekdayCompletionRate
+	76,  // 84: habits.v1.GetHabitCorrelationsResponse.correlations:type_name -> habits.v1.HabitCorrelation
+	79,  // 85: habits.v1.GetMoodCorrelationsResponse.habits:type_name -> habits.v1.MoodCorrelation
+	28,  // 86: habits.v1.ListHabitTagsResponse.tags:type_name -> habits.v1.TagUsage
+	14,  // 87: habits.v1.CreateHabitGroupResponse.group:type_name -> habits.v1.HabitGroup
+	14,  // 88: habits.v1.ListHabitGroupsResponse.groups:type_name -> habits.v1.HabitGroup
+	14,  // 89: habits.v1.UpdateHabitGroupResponse.group:type_name -> habits.v1.HabitGroup
+	14,  // 90: habits.v1.ReorderHabitGroupsResponse.groups:type_name -> habits.v1.HabitGroup
+	15,  // 91: habits.v1.ListHabitTemplatesResponse.templates:type_name -> habits.v1.HabitTemplate
+	13,  // 92: habits.v1.CreateHabitFromTemplateResponse.habit:type_name -> habits.v1.Habit
+	15,  // 93: habits.v1.SaveHabitAsTemplateResponse.template:type_name -> habits.v1.HabitTemplate
+	16,  // 94: habits.v1.InviteHabitPartnerResponse.partner:type_name -> habits.v1.HabitPartner
+	16,  // 95: habits.v1.ListHabitPartnersResponse.partners:type_name -> habits.v1.HabitPartner
+	16,  // 96: habits.v1.ListPartnerInvitationsResponse.invitations:type_name -> habits.v1.HabitPartner
+	16,  // 97: habits.v1.RespondToPartnerInvitationResponse.partner:type_name -> habits.v1.HabitPartner
+	17,  // 98: habits.v1.ListSharedHabitsResponse.habits:type_name -> habits.v1.SharedHabit
+	187, // 99: habits.v1.NudgeHabitResponse.nudged_at:type_name -> google.protobuf.Timestamp
+	18,  // 100: habits.v1.CreateChallengeResponse.challenge:type_name -> habits.v1.Challenge
+	18,  // 101: habits.v1.GetChallengeResponse.challenge:type_name -> habits.v1.Challenge
+	18,  // 102: habits.v1.ListChallengesResponse.challenges:type_name -> habits.v1.Challenge
+	18,  // 103: habits.v1.UpdateChallengeResponse.challenge:type_name -> habits.v1.Challenge
+	18,  // 104: habits.v1.JoinChallengeResponse.challenge:type_name -> habits.v1.Challenge
+	19,  // 105: habits.v1.GetChallengeLeaderboardResponse.entries:type_name -> habits.v1.ChallengeLeaderboardEntry
+	19,  // 106: habits.v1.GetChallengeLeaderboardResponse.me:type_name -> habits.v1.ChallengeLeaderboardEntry
+	20,  // 107: habits.v1.ListAchievementsResponse.achievements:type_name -> habits.v1.Achievement
+	21,  // 108: habits.v1.FollowUserResponse.follow:type_name -> habits.v1.Follow
+	21,  // 109: habits.v1.ListFollowersResponse.followers:type_name -> habits.v1.Follow
+	21,  // 110: habits.v1.ListFollowingResponse.following:type_name -> habits.v1.Follow
+	21,  // 111: habits.v1.ListFollowRequestsResponse.requests:type_name -> habits.v1.Follow
+	21,  // 112: habits.v1.RespondToFollowRequestResponse.follow:type_name -> habits.v1.Follow
+	22,  // 113: habits.v1.GetActivityFeedResponse.items:type_name -> habits.v1.FeedItem
+	24,  // 114: habits.v1.GetPublicProfileResponse.profile:type_name -> habits.v1.PublicProfile
+	23,  // 115: habits.v1.GetPublicHabitResponse.habit:type_name -> habits.v1.PublicHabit
+	6,   // 116: habits.v1.ImportHabitsRequest.source:type_name -> habits.v1.HabitImportSource
+	27,  // 117: habits.v1.ImportHabitsResponse.import:type_name -> habits.v1.HabitImport
+	27,  // 118: habits.v1.GetHabitImportResponse.import:type_name -> habits.v1.HabitImport
+	8,   // 119: habits.v1.ExportHabitHistoryRequest.format:type_name -> habits.v1.HistoryExportFormat
+	187, // 120: habits.v1.JournalEntry.created_at:type_name -> google.protobuf.Timestamp
+	187, // 121: habits.v1.JournalEntry.updated_at:type_name -> google.protobuf.Timestamp
+	168, // 122: habits.v1.SaveJournalEntryResponse.entry:type_name -> habits.v1.JournalEntry
+	168, // 123: habits.v1.GetJournalEntryResponse.entry:type_name -> habits.v1.JournalEntry
+	168, // 124: habits.v1.ListJournalEntriesResponse.entries:type_name -> habits.v1.JournalEntry
+	12,  // 125: habits.v1.NoteSearchResult.kind:type_name -> habits.v1.NoteKind
+	177, // 126: habits.v1.SearchNotesResponse.results:type_name -> habits.v1.NoteSearchResult
+	187, // 127: habits.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	180, // 128: habits.v1.UploadAttachmentResponse.attachment:type_name -> habits.v1.Attachment
+	31,  // 129: habits.v1.HabitService.CreateHabit:input_type -> habits.v1.CreateHabitRequest
+	33,  // 130: habits.v1.HabitService.GetHabit:input_type -> habits.v1.GetHabitRequest
+	35,  // 131: habits.v1.HabitService.ListHabits:input_type -> habits.v1.ListHabitsRequest
+	37,  // 132: habits.v1.HabitService.GetTodayAgenda:input_type -> habits.v1.GetTodayAgendaRequest
+	40,  // 133: habits.v1.HabitService.UpdateHabit:input_type -> habits.v1.UpdateHabitRequest
+	42,  // 134: habits.v1.HabitService.DeleteHabit:input_type -> habits.v1.DeleteHabitRequest
+	44,  // 135: habits.v1.HabitService.ArchiveHabit:input_type -> habits.v1.ArchiveHabitRequest
+	46,  // 136: habits.v1.HabitService.UnarchiveHabit:input_type -> habits.v1.UnarchiveHabitRequest
+	48,  // 137: habits.v1.HabitService.PurgeHabit:input_type -> habits.v1.PurgeHabitRequest
+	50,  // 138: habits.v1.HabitService.PauseHabits:input_type -> habits.v1.PauseHabitsRequest
+	52,  // 139: habits.v1.HabitService.ResumeHabits:input_type -> habits.v1.ResumeHabitsRequest
+	54,  // 140: habits.v1.HabitService.ListHabitPauses:input_type -> habits.v1.ListHabitPausesRequest
+	56,  // 141: habits.v1.HabitService.ConfirmHabit:input_type -> habits.v1.ConfirmHabitRequest
+	58,  // 142: habits.v1.HabitService.GetHabitHistory:input_type -> habits.v1.GetHabitHistoryRequest
+	61,  // 143: habits.v1.HabitService.GetHabitCalendar:input_type -> habits.v1.GetHabitCalendarRequest
+	63,  // 144: habits.v1.HabitService.GetHabitStats:input_type -> habits.v1.GetHabitStatsRequest
+	66,  // 145: habits.v1.HabitService.ExportUserHabits:input_type -> habits.v1.ExportUserHabitsRequest
+	82,  // 146: habits.v1.HabitService.ReorderHabits:input_type -> habits.v1.ReorderHabitsRequest
+	84,  // 147: habits.v1.HabitService.ListHabitTags:input_type -> habits.v1.ListHabitTagsRequest
+	86,  // 148: habits.v1.HabitService.CreateHabitGroup:input_type -> habits.v1.CreateHabitGroupRequest
+	88,  // 149: habits.v1.HabitService.ListHabitGroups:input_type -> habits.v1.ListHabitGroupsRequest
+	90,  // 150: habits.v1.HabitService.UpdateHabitGroup:input_type -> habits.v1.UpdateHabitGroupRequest
+	92,  // 151: habits.v1.HabitService.DeleteHabitGroup:input_type -> habits.v1.DeleteHabitGroupRequest
+	94,  // 152: habits.v1.HabitService.ReorderHabitGroups:input_type -> habits.v1.ReorderHabitGroupsRequest
+	96,  // 153: habits.v1.HabitService.ListHabitTemplates:input_type -> habits.v1.ListHabitTemplatesRequest
+	98,  // 154: habits.v1.HabitService.CreateHabitFromTemplate:input_type -> habits.v1.CreateHabitFromTemplateRequest
+	100, // 155: habits.v1.HabitService.SaveHabitAsTemplate:input_type -> habits.v1.SaveHabitAsTemplateRequest
+	102, // 156: habits.v1.HabitService.DeleteHabitTemplate:input_type -> habits.v1.DeleteHabitTemplateRequest
+	104, // 157: habits.v1.HabitService.InviteHabitPartner:input_type -> habits.v1.InviteHabitPartnerRequest
+	106, // 158: habits.v1.HabitService.ListHabitPartners:input_type -> habits.v1.ListHabitPartnersRequest
+	108, // 159: habits.v1.HabitService.ListPartnerInvitations:input_type -> habits.v1.ListPartnerInvitationsRequest
+	110, // 160: habits.v1.HabitService.RespondToPartnerInvitation:input_type -> habits.v1.RespondToPartnerInvitationRequest
+	112, // 161: habits.v1.HabitService.RemoveHabitPartner:input_type -> habits.v1.RemoveHabitPartnerRequest
+	114, // 162: habits.v1.HabitService.ListSharedHabits:input_type -> habits.v1.ListSharedHabitsRequest
+	116, // 163: habits.v1.HabitService.NudgeHabit:input_type -> habits.v1.NudgeHabitRequest
+	118, // 164: habits.v1.HabitService.CreateChallenge:input_type -> habits.v1.CreateChallengeRequest
+	120, // 165: habits.v1.HabitService.GetChallenge:input_type -> habits.v1.GetChallengeRequest
+	122, // 166: habits.v1.HabitService.ListChallenges:input_type -> habits.v1.ListChallengesRequest
+	124, // 167: habits.v1.HabitService.UpdateChallenge:input_type -> habits.v1.UpdateChallengeRequest
+	126, // 168: habits.v1.HabitService.DeleteChallenge:input_type -> habits.v1.DeleteChallengeRequest
+	128, // 169: habits.v1.HabitService.JoinChallenge:input_type -> habits.v1.JoinChallengeRequest
+	130, // 170: habits.v1.HabitService.LeaveChallenge:input_type -> habits.v1.LeaveChallengeRequest
+	132, // 171: habits.v1.HabitService.GetChallengeLeaderboard:input_type -> habits.v1.GetChallengeLeaderboardRequest
+	134, // 172: habits.v1.HabitService.ListAchievements:input_type -> habits.v1.ListAchievementsRequest
+	136, // 173: habits.v1.HabitService.FollowUser:input_type -> habits.v1.FollowUserRequest
+	138, // 174: habits.v1.HabitService.ListFollowers:input_type -> habits.v1.ListFollowersRequest
+	140, // 175: habits.v1.HabitService.ListFollowing:input_type -> habits.v1.ListFollowingRequest
+	142, // 176: habits.v1.HabitService.ListFollowRequests:input_type -> habits.v1.ListFollowRequestsRequest
+	144, // 177: habits.v1.HabitService.RespondToFollowRequest:input_type -> habits.v1.RespondToFollowRequestRequest
+	146, // 178: habits.v1.HabitService.UnfollowUser:input_type -> habits.v1.UnfollowUserRequest
+	148, // 179: habits.v1.HabitService.RemoveFollower:input_type -> habits.v1.RemoveFollowerRequest
+	150, // 180: habits.v1.HabitService.GetActivityFeed:input_type -> habits.v1.GetActivityFeedRequest
+	152, // 181: habits.v1.HabitService.GetPublicProfile:input_type -> habits.v1.GetPublicProfileRequest
+	154, // 182: habits.v1.HabitService.GetPublicHabit:input_type -> habits.v1.GetPublicHabitRequest
+	156, // 183: habits.v1.HabitService.RotateCalendarFeedToken:input_type -> habits.v1.RotateCalendarFeedTokenRequest
+	158, // 184: habits.v1.HabitService.RevokeCalendarFeedToken:input_type -> habits.v1.RevokeCalendarFeedTokenRequest
+	160, // 185: habits.v1.HabitService.GetCalendarFeed:input_type -> habits.v1.GetCalendarFeedRequest
+	162, // 186: habits.v1.HabitService.ImportHabits:input_type -> habits.v1.ImportHabitsRequest
+	164, // 187: habits.v1.HabitService.GetHabitImport:input_type -> habits.v1.GetHabitImportRequest
+	166, // 188: habits.v1.HabitService.ExportHabitHistory:input_type -> habits.v1.ExportHabitHistoryRequest
+	169, // 189: habits.v1.HabitService.SaveJournalEntry:input_type -> habits.v1.SaveJournalEntryRequest
+	171, // 190: habits.v1.HabitService.GetJournalEntry:input_type -> habits.v1.GetJournalEntryRequest
+	173, // 191: habits.v1.HabitService.ListJournalEntries:input_type -> habits.v1.ListJournalEntriesRequest
+	175, // 192: habits.v1.HabitService.DeleteJournalEntry:input_type -> habits.v1.DeleteJournalEntryRequest
+	178, // 193: habits.v1.HabitService.SearchNotes:input_type -> habits.v1.SearchNotesRequest
+	181, // 194: habits.v1.HabitService.UploadAttachment:input_type -> habits.v1.UploadAttachmentRequest
+	183, // 195: habits.v1.HabitService.DownloadAttachment:input_type -> habits.v1.DownloadAttachmentRequest
+	185, // 196: habits.v1.HabitService.GetAttachmentUsage:input_type -> habits.v1.GetAttachmentUsageRequest
+	69,  // 197: habits.v1.HabitAnalyticsService.GetCompletionTrend:input_type -> habits.v1.GetCompletionTrendRequest
+	72,  // 198: habits.v1.HabitAnalyticsService.GetWeekdayBreakdown:input_type -> habits.v1.GetWeekdayBreakdownRequest
+	74,  // 199: habits.v1.HabitAnalyticsService.GetHourDistribution:input_type -> habits.v1.GetHourDistributionRequest
+	77,  // 200: habits.v1.HabitAnalyticsService.GetHabitCorrelations:input_type -> habits.v1.GetHabitCorrelationsRequest
+	80,  // 201: habits.v1.HabitAnalyticsService.GetMoodCorrelations:input_type -> habits.v1.GetMoodCorrelationsRequest
+	32,  // 202: habits.v1.HabitService.CreateHabit:output_type -> habits.v1.CreateHabitResponse
+	34,  // 203: habits.v1.HabitService.GetHabit:output_type -> habits.v1.GetHabitResponse
+	36,  // 204: habits.v1.HabitService.ListHabits:output_type -> habits.v1.ListHabitsResponse
+	39,  // 205: habits.v1.HabitService.GetTodayAgenda:output_type -> habits.v1.GetTodayAgendaResponse
+	41,  // 206: habits.v1.HabitService.UpdateHabit:output_type -> habits.v1.UpdateHabitResponse
+	43,  // 207: habits.v1.HabitService.DeleteHabit:output_type -> habits.v1.DeleteHabitResponse
+	45,  // 208: habits.v1.HabitService.ArchiveHabit:output_type -> habits.v1.ArchiveHabitResponse
+	47,  // 209: habits.v1.HabitService.UnarchiveHabit:output_type -> habits.v1.UnarchiveHabitResponse
+	49,  // 210: habits.v1.HabitService.PurgeHabit:output_type -> habits.v1.PurgeHabitResponse
+	51,  // 211: habits.v1.HabitService.PauseHabits:output_type -> habits.v1.PauseHabitsResponse
+	53,  // 212: habits.v1.HabitService.ResumeHabits:output_type -> habits.v1.ResumeHabitsResponse
+	55,  // 213: habits.v1.HabitService.ListHabitPauses:output_type -> habits.v1.ListHabitPausesResponse
+	57,  // 214: habits.v1.HabitService.ConfirmHabit:output_type -> habits.v1.ConfirmHabitResponse
+	59,  // 215: habits.v1.HabitService.GetHabitHistory:output_type -> habits.v1.GetHabitHistoryResponse
+	62,  // 216: habits.v1.HabitService.GetHabitCalendar:output_type -> habits.v1.GetHabitCalendarResponse
+	64,  // 217: habits.v1.HabitService.GetHabitStats:output_type -> habits.v1.GetHabitStatsResponse
+	67,  // 218: habits.v1.HabitService.ExportUserHabits:output_type -> habits.v1.ExportUserHabitsResponse
+	83,  // 219: habits.v1.HabitService.ReorderHabits:output_type -> habits.v1.ReorderHabitsResponse
+	85,  // 220: habits.v1.HabitService.ListHabitTags:output_type -> habits.v1.ListHabitTagsResponse
+	87,  // 221: habits.v1.HabitService.CreateHabitGroup:output_type -> habits.v1.CreateHabitGroupResponse
+	89,  // 222: habits.v1.HabitService.ListHabitGroups:output_type -> habits.v1.ListHabitGroupsResponse
+	91,  // 223: habits.v1.HabitService.UpdateHabitGroup:output_type -> habits.v1.UpdateHabitGroupResponse
+	93,  // 224: habits.v1.HabitService.DeleteHabitGroup:output_type -> habits.v1.DeleteHabitGroupResponse
+	95,  // 225: habits.v1.HabitService.ReorderHabitGroups:output_type -> habits.v1.ReorderHabitGroupsResponse
+	97,  // 226: habits.v1.HabitService.ListHabitTemplates:output_type -> habits.v1.ListHabitTemplatesResponse
+	99,  // 227: habits.v1.HabitService.CreateHabitFromTemplate:output_type -> habits.v1.CreateHabitFromTemplateResponse
+	101, // 228: habits.v1.HabitService.SaveHabitAsTemplate:output_type -> habits.v1.SaveHabitAsTemplateResponse
+	103, // 229: habits.v1.HabitService.DeleteHabitTemplate:output_type -> habits.v1.DeleteHabitTemplateResponse
+	105, // 230: habits.v1.HabitService.InviteHabitPartner:output_type -> habits.v1.InviteHabitPartnerResponse
+	107, // 231: habits.v1.HabitService.ListHabitPartners:output_type -> habits.v1.ListHabitPartnersResponse
+	109, // 232: habits.v1.HabitService.ListPartnerInvitations:output_type -> habits.v1.ListPartnerInvitationsResponse
+	111, // 233: habits.v1.HabitService.RespondToPartnerInvitation:output_type -> habits.v1.RespondToPartnerInvitationResponse
+	113, // 234: habits.v1.HabitService.RemoveHabitPartner:output_type -> habits.v1.RemoveHabitPartnerResponse
+	115, // 235: habits.v1.HabitService.ListSharedHabits:output_type -> habits.v1.ListSharedHabitsResponse
+	117, // 236: habits.v1.HabitService.NudgeHabit:output_type -> habits.v1.NudgeHabitResponse
+	119, // 237: habits.v1.HabitService.CreateChallenge:output_type -> habits.v1.CreateChallengeResponse
+	121, // 238: habits.v1.HabitService.GetChallenge:output_type -> habits.v1.GetChallengeResponse
+	123, // 239: habits.v1.HabitService.ListChallenges:output_type -> habits.v1.ListChallengesResponse
+	125, // 240: habits.v1.HabitService.UpdateChallenge:output_type -> habits.v1.UpdateChallengeResponse
+	127, // 241: habits.v1.HabitService.DeleteChallenge:output_type -> habits.v1.DeleteChallengeResponse
+	129, // 242: habits.v1.HabitService.JoinChallenge:output_type -> habits.v1.JoinChallengeResponse
+	131, // 243: habits.v1.HabitService.LeaveChallenge:output_type -> habits.v1.LeaveChallengeResponse
+	133, // 244: habits.v1.HabitService.GetChallengeLeaderboard:output_type -> habits.v1.GetChallengeLeaderboardResponse
+	135, // 245: habits.v1.HabitService.ListAchievements:output_type -> habits.v1.ListAchievementsResponse
+	137, // 246: habits.v1.HabitService.FollowUser:output_type -> habits.v1.FollowUserResponse
+	139, // 247: habits.v1.HabitService.ListFollowers:output_type -> habits.v1.ListFollowersResponse
+	141, // 248: habits.v1.HabitService.ListFollowing:output_type -> habits.v1.ListFollowingResponse
+	143, // 249: habits.v1.HabitService.ListFollowRequests:output_type -> habits.v1.ListFollowRequestsResponse
+	145, // 250: habits.v1.HabitService.RespondToFollowRequest:output_type -> habits.v1.RespondToFollowRequestResponse
+	147, // 251: habits.v1.HabitService.UnfollowUser:output_type -> habits.v1.UnfollowUserResponse
+	149, // 252: habits.v1.HabitService.RemoveFollower:output_type -> habits.v1.RemoveFollowerResponse
+	151, // 253: habits.v1.HabitService.GetActivityFeed:output_type -> habits.v1.GetActivityFeedResponse
+	153, // 254: habits.v1.HabitService.GetPublicProfile:output_type -> habits.v1.GetPublicProfileResponse
+	155, // 255: habits.v1.HabitService.GetPublicHabit:output_type -> habits.v1.GetPublicHabitResponse
+	157, // 256: habits.v1.HabitService.RotateCalendarFeedToken:output_type -> habits.v1.RotateCalendarFeedTokenResponse
+	159, // 257: habits.v1.HabitService.RevokeCalendarFeedToken:output_type -> habits.v1.RevokeCalendarFeedTokenResponse
+	161, // 258: habits.v1.HabitService.GetCalendarFeed:output_type -> habits.v1.GetCalendarFeedResponse
+	163, // 259: habits.v1.HabitService.ImportHabits:output_type -> habits.v1.ImportHabitsResponse
+	165, // 260: habits.v1.HabitService.GetHabitImport:output_type -> habits.v1.GetHabitImportResponse
+	167, // 261: habits.v1.HabitService.ExportHabitHistory:output_type -> habits.v1.HabitHistoryChunk
+	170, // 262: habits.v1.HabitService.SaveJournalEntry:output_type -> habits.v1.SaveJournalEntryResponse
+	172, // 263: habits.v1.HabitService.GetJournalEntry:output_type -> habits.v1.GetJournalEntryResponse
+	174, // 264: habits.v1.HabitService.ListJournalEntries:output_type -> habits.v1.ListJournalEntriesResponse
+	176, // 265: habits.v1.HabitService.DeleteJournalEntry:output_type -> habits.v1.DeleteJournalEntryResponse
+	179, // 266: habits.v1.HabitService.SearchNotes:output_type -> habits.v1.SearchNotesResponse
+	182, // 267: habits.v1.HabitService.UploadAttachment:output_type -> habits.v1.UploadAttachmentResponse
+	184, // 268: habits.v1.HabitService.DownloadAttachment:output_type -> habits.v1.AttachmentChunk
+	186, // 269: habits.v1.HabitService.GetAttachmentUsage:output_type -> habits.v1.GetAttachmentUsageResponse
+	70,  // 270: habits.v1.HabitAnalyticsService.GetCompletionTrend:output_type -> habits.v1.GetCompletionTrendResponse
+	73,  // 271: habits.v1.HabitAnalyticsService.GetWeekdayBreakdown:output_type -> habits.v1.GetWeekdayBreakdownResponse
+	75,  // 272: habits.v1.HabitAnalyticsService.GetHourDistribution:output_type -> habits.v1.GetHourDistributionResponse
+	78,  // 273: habits.v1.HabitAnalyticsService.GetHabitCorrelations:output_type -> habits.v1.GetHabitCorrelationsResponse
+	81,  // 274: habits.v1.HabitAnalyticsService.GetMoodCorrelations:output_type -> habits.v1.GetMoodCorrelationsResponse
+	202, // [202:275] is the sub-list for method output_type
+	129, // [129:202] is the sub-list for method input_type
+	129, // [129:129] is the sub-list for extension type_name
+	129, // [129:129] is the sub-list for extension extendee
+	0,   // [0:129] is the sub-list for field type_name
+}
+
+func init() { file_habits_proto_init() }
+func file_habits_proto_init() {
+	if File_habits_proto != nil {
+		return
+	}
+	file_habits_proto_msgTypes[0].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[1].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[2].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[3].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[5].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[7].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[8].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[9].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[10].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[12].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[13].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[14].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[16].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[17].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[18].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[22].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[23].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[24].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[27].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[37].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[39].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[41].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[43].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[45].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[46].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[47].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[60].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[64].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[66].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[68].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[73].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[77].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[83].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[85].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[87].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[105].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[111].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[120].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[137].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[153].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[154].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[155].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[156].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[160].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[164].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[165].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[167].OneofWrappers = []any{}
+	file_habits_proto_msgTypes[171].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_habits_proto_rawDesc), len(file_habits_proto_rawDesc)),
+			NumEnums:      13,
+			NumMessages:   174,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_habits_proto_goTypes,
+		DependencyIndexes: file_habits_proto_depIdxs,
+		EnumInfos:         file_habits_proto_enumTypes,
+		MessageInfos:      file_habits_proto_msgTypes,
+	}.Build()
+	File_habits_proto = out.File
+	file_habits_proto_goTypes = nil
+	file_habits_proto_depIdxs = nil
+}
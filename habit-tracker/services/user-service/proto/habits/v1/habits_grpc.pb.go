@@ -0,0 +1,3122 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: habits.proto
+
+package habitspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	HabitService_CreateHabit_FullMethodName                = "/habits.v1.HabitService/CreateHabit"
+	HabitService_GetHabit_FullMethodName                   = "/habits.v1.HabitService/GetHabit"
+	HabitService_ListHabits_FullMethodName                 = "/habits.v1.HabitService/ListHabits"
+	HabitService_GetTodayAgenda_FullMethodName             = "/habits.v1.HabitService/GetTodayAgenda"
+	HabitService_UpdateHabit_FullMethodName                = "/habits.v1.HabitService/UpdateHabit"
+	HabitService_DeleteHabit_FullMethodName                = "/habits.v1.HabitService/DeleteHabit"
+	HabitService_ArchiveHabit_FullMethodName               = "/habits.v1.HabitService/ArchiveHabit"
+	HabitService_UnarchiveHabit_FullMethodName             = "/habits.v1.HabitService/UnarchiveHabit"
+	HabitService_PurgeHabit_FullMethodName                 = "/habits.v1.HabitService/PurgeHabit"
+	HabitService_PauseHabits_FullMethodName                = "/habits.v1.HabitService/PauseHabits"
+	HabitService_ResumeHabits_FullMethodName               = "/habits.v1.HabitService/ResumeHabits"
+	HabitService_ListHabitPauses_FullMethodName            = "/habits.v1.HabitService/ListHabitPauses"
+	HabitService_ConfirmHabit_FullMethodName               = "/habits.v1.HabitService/ConfirmHabit"
+	HabitService_GetHabitHistory_FullMethodName            = "/habits.v1.HabitService/GetHabitHistory"
+	HabitService_GetHabitCalendar_FullMethodName           = "/habits.v1.HabitService/GetHabitCalendar"
+	HabitService_GetHabitStats_FullMethodName              = "/habits.v1.HabitService/GetHabitStats"
+	HabitService_ExportUserHabits_FullMethodName           = "/habits.v1.HabitService/ExportUserHabits"
+	HabitService_ReorderHabits_FullMethodName              = "/habits.v1.HabitService/ReorderHabits"
+	HabitService_ListHabitTags_FullMethodName              = "/habits.v1.HabitService/ListHabitTags"
+	HabitService_CreateHabitGroup_FullMethodName           = "/habits.v1.HabitService/CreateHabitGroup"
+	HabitService_ListHabitGroups_FullMethodName            = "/habits.v1.HabitService/ListHabitGroups"
+	HabitService_UpdateHabitGroup_FullMethodName           = "/habits.v1.HabitService/UpdateHabitGroup"
+	HabitService_DeleteHabitGroup_FullMethodName           = "/habits.v1.HabitService/DeleteHabitGroup"
+	HabitService_ReorderHabitGroups_FullMethodName         = "/habits.v1.HabitService/ReorderHabitGroups"
+	HabitService_ListHabitTemplates_FullMethodName         = "/habits.v1.HabitService/ListHabitTemplates"
+	HabitService_CreateHabitFromTemplate_FullMethodName    = "/habits.v1.HabitService/CreateHabitFromTemplate"
+	HabitService_SaveHabitAsTemplate_FullMethodName        = "/habits.v1.HabitService/SaveHabitAsTemplate"
+	HabitService_DeleteHabitTemplate_FullMethodName        = "/habits.v1.HabitService/DeleteHabitTemplate"
+	HabitService_InviteHabitPartner_FullMethodName         = "/habits.v1.HabitService/InviteHabitPartner"
+	HabitService_ListHabitPartners_FullMethodName          = "/habits.v1.HabitService/ListHabitPartners"
+	HabitService_ListPartnerInvitations_FullMethodName     = "/habits.v1.HabitService/ListPartnerInvitations"
+	HabitService_RespondToPartnerInvitation_FullMethodName = "/habits.v1.HabitService/RespondToPartnerInvitation"
+	HabitService_RemoveHabitPartner_FullMethodName         = "/habits.v1.HabitService/RemoveHabitPartner"
+	HabitService_ListSharedHabits_FullMethodName           = "/habits.v1.HabitService/ListSharedHabits"
+	HabitService_NudgeHabit_FullMethodName                 = "/habits.v1.HabitService/NudgeHabit"
+	HabitService_CreateChallenge_FullMethodName            = "/habits.v1.HabitService/CreateChallenge"
+	HabitService_GetChallenge_FullMethodName               = "/habits.v1.HabitService/GetChallenge"
+	HabitService_ListChallenges_FullMethodName             = "/habits.v1.HabitService/ListChallenges"
+	HabitService_UpdateChallenge_FullMethodName            = "/habits.v1.HabitService/UpdateChallenge"
+	HabitService_DeleteChallenge_FullMethodName            = "/habits.v1.HabitService/DeleteChallenge"
+	HabitService_JoinChallenge_FullMethodName              = "/habits.v1.HabitService/JoinChallenge"
+	HabitService_LeaveChallenge_FullMethodName             = "/habits.v1.HabitService/LeaveChallenge"
+	HabitService_GetChallengeLeaderboard_FullMethodName    = "/habits.v1.HabitService/GetChallengeLeaderboard"
+	HabitService_ListAchievements_FullMethodName           = "/habits.v1.HabitService/ListAchievements"
+	HabitService_FollowUser_FullMethodName                 = "/habits.v1.HabitService/FollowUser"
+	HabitService_ListFollowers_FullMethodName              = "/habits.v1.HabitService/ListFollowers"
+	HabitService_ListFollowing_FullMethodName              = "/habits.v1.HabitService/ListFollowing"
+	HabitService_ListFollowRequests_FullMethodName         = "/habits.v1.HabitService/ListFollowRequests"
+	HabitService_RespondToFollowRequest_FullMethodName     = "/habits.v1.HabitService/RespondToFollowRequest"
+	HabitService_UnfollowUser_FullMethodName               = "/habits.v1.HabitService/UnfollowUser"
+	HabitService_RemoveFollower_FullMethodName             = "/habits.v1.HabitService/RemoveFollower"
+	HabitService_GetActivityFeed_FullMethodName            = "/habits.v1.HabitService/GetActivityFeed"
+	HabitService_GetPublicProfile_FullMethodName           = "/habits.v1.HabitService/GetPublicProfile"
+	HabitService_GetPublicHabit_FullMethodName             = "/habits.v1.HabitService/GetPublicHabit"
+	HabitService_RotateCalendarFeedToken_FullMethodName    = "/habits.v1.HabitService/RotateCalendarFeedToken"
+	HabitService_RevokeCalendarFeedToken_FullMethodName    = "/habits.v1.HabitService/RevokeCalendarFeedToken"
+	HabitService_GetCalendarFeed_FullMethodName            = "/habits.v1.HabitService/GetCalendarFeed"
+	HabitService_ImportHabits_FullMethodName               = "/habits.v1.HabitService/ImportHabits"
+	HabitService_GetHabitImport_FullMethodName             = "/habits.v1.HabitService/GetHabitImport"
+	HabitService_ExportHabitHistory_FullMethodName         = "/habits.v1.HabitService/ExportHabitHistory"
+	HabitService_SaveJournalEntry_FullMethodName           = "/habits.v1.HabitService/SaveJournalEntry"
+	HabitService_GetJournalEntry_FullMethodName            = "/habits.v1.HabitService/GetJournalEntry"
+	HabitService_ListJournalEntries_FullMethodName         = "/habits.v1.HabitService/ListJournalEntries"
+	HabitService_DeleteJournalEntry_FullMethodName         = "/habits.v1.HabitService/DeleteJournalEntry"
+	HabitService_SearchNotes_FullMethodName                = "/habits.v1.HabitService/SearchNotes"
+	HabitService_UploadAttachment_FullMethodName           = "/habits.v1.HabitService/UploadAttachment"
+	HabitService_DownloadAttachment_FullMethodName         = "/habits.v1.HabitService/DownloadAttachment"
+	HabitService_GetAttachmentUsage_FullMethodName         = "/habits.v1.HabitService/GetAttachmentUsage"
+)
+
+// HabitServiceClient is the client API for HabitService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// HabitService provides habit management functionality
+type HabitServiceClient interface {
+	// CreateHabit creates a new habit
+	CreateHabit(ctx context.Context, in *CreateHabitRequest, opts ...grpc.CallOption) (*CreateHabitResponse, error)
+	// GetHabit retrieves a habit by ID
+	GetHabit(ctx context.Context, in *GetHabitRequest, opts ...grpc.CallOption) (*GetHabitResponse, error)
+	// ListHabits retrieves all habits for a user
+	ListHabits(ctx context.Context, in *ListHabitsRequest, opts ...grpc.CallOption) (*ListHabitsResponse, error)
+	// GetTodayAgenda groups the user's active habits into due, at risk, done and upcoming,
+	// each ordered by deadline
+	GetTodayAgenda(ctx context.Context, in *GetTodayAgendaRequest, opts ...grpc.CallOption) (*GetTodayAgendaResponse, error)
+	// UpdateHabit updates a habit
+	UpdateHabit(ctx context.Context, in *UpdateHabitRequest, opts ...grpc.CallOption) (*UpdateHabitResponse, error)
+	// DeleteHabit archives a habit, kept for compatibility with ArchiveHabit
+	DeleteHabit(ctx context.Context, in *DeleteHabitRequest, opts ...grpc.CallOption) (*DeleteHabitResponse, error)
+	// ArchiveHabit hides a habit and pauses its deadlines, streak and history are kept
+	ArchiveHabit(ctx context.Context, in *ArchiveHabitRequest, opts ...grpc.CallOption) (*ArchiveHabitResponse, error)
+	// UnarchiveHabit restores an archived habit with its streak, deadlines restart from now
+	UnarchiveHabit(ctx context.Context, in *UnarchiveHabitRequest, opts ...grpc.CallOption) (*UnarchiveHabitResponse, error)
+	// PurgeHabit permanently deletes an archived habit together with its confirmations
+	PurgeHabit(ctx context.Context, in *PurgeHabitRequest, opts ...grpc.CallOption) (*PurgeHabitResponse, error)
+	// PauseHabits starts a vacation for one habit or all active habits of a user.
+	// Deadlines are frozen and streaks preserved until the pause ends
+	PauseHabits(ctx context.Context, in *PauseHabitsRequest, opts ...grpc.CallOption) (*PauseHabitsResponse, error)
+	// ResumeHabits ends ongoing pauses early and cancels upcoming ones
+	ResumeHabits(ctx context.Context, in *ResumeHabitsRequest, opts ...grpc.CallOption) (*ResumeHabitsResponse, error)
+	// ListHabitPauses retrieves pauses of a user that have not ended yet
+	ListHabitPauses(ctx context.Context, in *ListHabitPausesRequest, opts ...grpc.CallOption) (*ListHabitPausesResponse, error)
+	// ConfirmHabit confirms habit completion for current period
+	ConfirmHabit(ctx context.Context, in *ConfirmHabitRequest, opts ...grpc.CallOption) (*ConfirmHabitResponse, error)
+	// GetHabitHistory retrieves confirmation history for a habit
+	GetHabitHistory(ctx context.Context, in *GetHabitHistoryRequest, opts ...grpc.CallOption) (*GetHabitHistoryResponse, error)
+	// GetHabitCalendar returns the state of every local date in a range, computed by the same
+	// schedule logic as GetHabitStats
+	GetHabitCalendar(ctx context.Context, in *GetHabitCalendarRequest, opts ...grpc.CallOption) (*GetHabitCalendarResponse, error)
+	// GetHabitStats retrieves statistics for a habit
+	GetHabitStats(ctx context.Context, in *GetHabitStatsRequest, opts ...grpc.CallOption) (*GetHabitStatsResponse, error)
+	// ExportUserHabits retrieves all data habits-service stores about a user for data export
+	ExportUserHabits(ctx context.Context, in *ExportUserHabitsRequest, opts ...grpc.CallOption) (*ExportUserHabitsResponse, error)
+	// ReorderHabits moves the listed habits to the top of the manual order in the given order.
+	// Habits not listed keep their relative order after them
+	ReorderHabits(ctx context.Context, in *ReorderHabitsRequest, opts ...grpc.CallOption) (*ReorderHabitsResponse, error)
+	// ListHabitTags retrieves the tags of a user with the number of habits carrying each
+	ListHabitTags(ctx context.Context, in *ListHabitTagsRequest, opts ...grpc.CallOption) (*ListHabitTagsResponse, error)
+	// CreateHabitGroup creates a group of habits, e.g. "Morning routine"
+	CreateHabitGroup(ctx context.Context, in *CreateHabitGroupRequest, opts ...grpc.CallOption) (*CreateHabitGroupResponse, error)
+	// ListHabitGroups retrieves all groups of a user in manual order
+	ListHabitGroups(ctx context.Context, in *ListHabitGroupsRequest, opts ...grpc.CallOption) (*ListHabitGroupsResponse, error)
+	// UpdateHabitGroup renames or restyles a group
+	UpdateHabitGroup(ctx context.Context, in *UpdateHabitGroupRequest, opts ...grpc.CallOption) (*UpdateHabitGroupResponse, error)
+	// DeleteHabitGroup deletes a group, its habits become ungrouped
+	DeleteHabitGroup(ctx context.Context, in *DeleteHabitGroupRequest, opts ...grpc.CallOption) (*DeleteHabitGroupResponse, error)
+	// ReorderHabitGroups sets the manual order of groups the same way as ReorderHabits
+	ReorderHabitGroups(ctx context.Context, in *ReorderHabitGroupsRequest, opts ...grpc.CallOption) (*ReorderHabitGroupsResponse, error)
+	// ListHabitTemplates retrieves the template catalog followed by the user's private templates
+	ListHabitTemplates(ctx context.Context, in *ListHabitTemplatesRequest, opts ...grpc.CallOption) (*ListHabitTemplatesResponse, error)
+	// CreateHabitFromTemplate creates a habit with the name, schedule and style of a template
+	CreateHabitFromTemplate(ctx context.Context, in *CreateHabitFromTemplateRequest, opts ...grpc.CallOption) (*CreateHabitFromTemplateResponse, error)
+	// SaveHabitAsTemplate saves one of the user's habits as a private template
+	SaveHabitAsTemplate(ctx context.Context, in *SaveHabitAsTemplateRequest, opts ...grpc.CallOption) (*SaveHabitAsTemplateResponse, error)
+	// DeleteHabitTemplate deletes a private template, catalog templates can't be deleted
+	DeleteHabitTemplate(ctx context.Context, in *DeleteHabitTemplateRequest, opts ...grpc.CallOption) (*DeleteHabitTemplateResponse, error)
+	// InviteHabitPartner invites another user by username as an accountability partner on a habit.
+	// Accepted partners can read the habit, its stats and calendar, and nudge the owner
+	InviteHabitPartner(ctx context.Context, in *InviteHabitPartnerRequest, opts ...grpc.CallOption) (*InviteHabitPartnerResponse, error)
+	// ListHabitPartners retrieves the partners and pending invitations of a habit for its owner
+	ListHabitPartners(ctx context.Context, in *ListHabitPartnersRequest, opts ...grpc.CallOption) (*ListHabitPartnersResponse, error)
+	// ListPartnerInvitations retrieves the pending invitations sent to a user
+	ListPartnerInvitations(ctx context.Context, in *ListPartnerInvitationsRequest, opts ...grpc.CallOption) (*ListPartnerInvitationsResponse, error)
+	// RespondToPartnerInvitation accepts or declines an invitation
+	RespondToPartnerInvitation(ctx context.Context, in *RespondToPartnerInvitationRequest, opts ...grpc.CallOption) (*RespondToPartnerInvitationResponse, error)
+	// RemoveHabitPartner ends a partnership or withdraws an invitation.
+	// Allowed for both the owner of the habit and the partner
+	RemoveHabitPartner(ctx context.Context, in *RemoveHabitPartnerRequest, opts ...grpc.CallOption) (*RemoveHabitPartnerResponse, error)
+	// ListSharedHabits retrieves the active habits a user is an accepted partner on
+	ListSharedHabits(ctx context.Context, in *ListSharedHabitsRequest, opts ...grpc.CallOption) (*ListSharedHabitsResponse, error)
+	// NudgeHabit sends the owner of a habit a reminder from one of its partners
+	NudgeHabit(ctx context.Context, in *NudgeHabitRequest, opts ...grpc.CallOption) (*NudgeHabitResponse, error)
+	// CreateChallenge creates a time-boxed challenge with an invite code to share
+	CreateChallenge(ctx context.Context, in *CreateChallengeRequest, opts ...grpc.CallOption) (*CreateChallengeResponse, error)
+	// GetChallenge retrieves a challenge for its creator or a participant
+	GetChallenge(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*GetChallengeResponse, error)
+	// ListChallenges retrieves the challenges a user created or joined, latest start first
+	ListChallenges(ctx context.Context, in *ListChallengesRequest, opts ...grpc.CallOption) (*ListChallengesResponse, error)
+	// UpdateChallenge updates a challenge of its creator. Changing the window recalculates the leaderboard
+	UpdateChallenge(ctx context.Context, in *UpdateChallengeRequest, opts ...grpc.CallOption) (*UpdateChallengeResponse, error)
+	// DeleteChallenge deletes a challenge of its creator with its leaderboard
+	DeleteChallenge(ctx context.Context, in *DeleteChallengeRequest, opts ...grpc.CallOption) (*DeleteChallengeResponse, error)
+	// JoinChallenge joins a challenge by invite code with one of the user's active habits.
+	// Confirmations of the habit for dates inside the window count toward the leaderboard
+	JoinChallenge(ctx context.Context, in *JoinChallengeRequest, opts ...grpc.CallOption) (*JoinChallengeResponse, error)
+	// LeaveChallenge removes the user from a challenge and its leaderboard
+	LeaveChallenge(ctx context.Context, in *LeaveChallengeRequest, opts ...grpc.CallOption) (*LeaveChallengeResponse, error)
+	// GetChallengeLeaderboard ranks participants by completions in the window, then by current streak,
+	// and includes the position of the requesting user
+	GetChallengeLeaderboard(ctx context.Context, in *GetChallengeLeaderboardRequest, opts ...grpc.CallOption) (*GetChallengeLeaderboardResponse, error)
+	// ListAchievements retrieves the XP and level of a user with every achievement, unlocked or locked.
+	// XP comes from confirmations and unlocked achievements
+	ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error)
+	// FollowUser sends a follow request to another user by username.
+	// Accepted followers see the user's friends-only habits and get them in their activity feed
+	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error)
+	// ListFollowers retrieves the accepted followers of a user
+	ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error)
+	// ListFollowing retrieves the users a user follows, including requests awaiting an answer
+	ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...grpc.CallOption) (*ListFollowingResponse, error)
+	// ListFollowRequests retrieves the follow requests awaiting the user's answer
+	ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error)
+	// RespondToFollowRequest accepts or declines a follow request. Declined requests are deleted
+	RespondToFollowRequest(ctx context.Context, in *RespondToFollowRequestRequest, opts ...grpc.CallOption) (*RespondToFollowRequestResponse, error)
+	// UnfollowUser stops following a user or withdraws a follow request
+	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error)
+	// RemoveFollower removes one of the user's followers
+	RemoveFollower(ctx context.Context, in *RemoveFollowerRequest, opts ...grpc.CallOption) (*RemoveFollowerResponse, error)
+	// GetActivityFeed retrieves confirmations and milestones of followed users, newest first.
+	// Items of habits that are private now or of users no longer followed are left out
+	GetActivityFeed(ctx context.Context, in *GetActivityFeedRequest, opts ...grpc.CallOption) (*GetActivityFeedResponse, error)
+	// GetPublicProfile retrieves the public profile of a user with their active public habits.
+	// Users who have not opted in to a public profile are not found
+	GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileResponse, error)
+	// GetPublicHabit retrieves a single active public habit from a public profile, e.g. for a streak card
+	GetPublicHabit(ctx context.Context, in *GetPublicHabitRequest, opts ...grpc.CallOption) (*GetPublicHabitResponse, error)
+	// RotateCalendarFeedToken creates a new secret token for the ICS subscription of a user.
+	// The previous token stops working, the token can't be retrieved again later
+	RotateCalendarFeedToken(ctx context.Context, in *RotateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*RotateCalendarFeedTokenResponse, error)
+	// RevokeCalendarFeedToken disables the ICS subscription of a user
+	RevokeCalendarFeedToken(ctx context.Context, in *RevokeCalendarFeedTokenRequest, opts ...grpc.CallOption) (*RevokeCalendarFeedTokenResponse, error)
+	// GetCalendarFeed renders the active habits of a token's user as an iCalendar document.
+	// Every habit is a recurring event on its due dates, completed occurrences are marked confirmed
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error)
+	// ImportHabits maps an export of Loop Habit Tracker, Habitica or Streaks to habits with their history.
+	// A dry run only reports the mapping and skipped rows. Otherwise the habits are imported by a background
+	// job, at most one per user at a time
+	ImportHabits(ctx context.Context, in *ImportHabitsRequest, opts ...grpc.CallOption) (*ImportHabitsResponse, error)
+	// GetHabitImport retrieves the progress and issues of an import job
+	GetHabitImport(ctx context.Context, in *GetHabitImportRequest, opts ...grpc.CallOption) (*GetHabitImportResponse, error)
+	// ExportHabitHistory streams the habits of a user and their confirmations over a date range
+	// as a CSV or JSON file. Validation errors arrive before the first chunk
+	ExportHabitHistory(ctx context.Context, in *ExportHabitHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HabitHistoryChunk], error)
+	// SaveJournalEntry creates or replaces the journal entry of a day, optionally linked to the day's confirmations
+	SaveJournalEntry(ctx context.Context, in *SaveJournalEntryRequest, opts ...grpc.CallOption) (*SaveJournalEntryResponse, error)
+	// GetJournalEntry retrieves the journal entry of a day
+	GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*GetJournalEntryResponse, error)
+	// ListJournalEntries retrieves the journal entries within a date range, optionally with a tag
+	ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error)
+	// DeleteJournalEntry deletes the journal entry of a day
+	DeleteJournalEntry(ctx context.Context, in *DeleteJournalEntryRequest, opts ...grpc.CallOption) (*DeleteJournalEntryResponse, error)
+	// SearchNotes runs a full-text search over journal entries and confirmation notes
+	SearchNotes(ctx context.Context, in *SearchNotesRequest, opts ...grpc.CallOption) (*SearchNotesResponse, error)
+	// UploadAttachment stores a JPEG or PNG photo with a thumbnail, counted against the user's quota.
+	// Uploads not attached to a confirmation within a day are deleted
+	UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error)
+	// DownloadAttachment streams a photo or its thumbnail to its owner, or to users who can read the habit
+	// of its confirmation. Errors arrive before the first chunk
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttachmentChunk], error)
+	// GetAttachmentUsage retrieves the storage used by the photos of a user and the quota
+	GetAttachmentUsage(ctx context.Context, in *GetAttachmentUsageRequest, opts ...grpc.CallOption) (*GetAttachmentUsageResponse, error)
+}
+
+type habitServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHabitServiceClient(cc grpc.ClientConnInterface) HabitServiceClient {
+	return &habitServiceClient{cc}
+}
+
+func (c *habitServiceClient) CreateHabit(ctx context.Context, in *CreateHabitRequest, opts ...grpc.CallOption) (*CreateHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_CreateHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetHabit(ctx context.Context, in *GetHabitRequest, opts ...grpc.CallOption) (*GetHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListHabits(ctx context.Context, in *ListHabitsRequest, opts ...grpc.CallOption) (*ListHabitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHabitsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetTodayAgenda(ctx context.Context, in *GetTodayAgendaRequest, opts ...grpc.CallOption) (*GetTodayAgendaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTodayAgendaResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetTodayAgenda_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) UpdateHabit(ctx context.Context, in *UpdateHabitRequest, opts ...grpc.CallOption) (*UpdateHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_UpdateHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) DeleteHabit(ctx context.Context, in *DeleteHabitRequest, opts ...grpc.CallOption) (*DeleteHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_DeleteHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ArchiveHabit(ctx context.Context, in *ArchiveHabitRequest, opts ...grpc.CallOption) (*ArchiveHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_ArchiveHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) UnarchiveHabit(ctx context.Context, in *UnarchiveHabitRequest, opts ...grpc.CallOption) (*UnarchiveHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_UnarchiveHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) PurgeHabit(ctx context.Context, in *PurgeHabitRequest, opts ...grpc.CallOption) (*PurgeHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_PurgeHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) PauseHabits(ctx context.Context, in *PauseHabitsRequest, opts ...grpc.CallOption) (*PauseHabitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseHabitsResponse)
+	err := c.cc.Invoke(ctx, HabitService_PauseHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ResumeHabits(ctx context.Context, in *ResumeHabitsRequest, opts ...grpc.CallOption) (*ResumeHabitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeHabitsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ResumeHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListHabitPauses(ctx context.Context, in *ListHabitPausesRequest, opts ...grpc.CallOption) (*ListHabitPausesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHabitPausesResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListHabitPauses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ConfirmHabit(ctx context.Context, in *ConfirmHabitRequest, opts ...grpc.CallOption) (*ConfirmHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_ConfirmHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetHabitHistory(ctx context.Context, in *GetHabitHistoryRequest, opts ...grpc.CallOption) (*GetHabitHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHabitHistoryResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetHabitHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetHabitCalendar(ctx context.Context, in *GetHabitCalendarRequest, opts ...grpc.CallOption) (*GetHabitCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHabitCalendarResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetHabitCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetHabitStats(ctx context.Context, in *GetHabitStatsRequest, opts ...grpc.CallOption) (*GetHabitStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHabitStatsResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetHabitStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ExportUserHabits(ctx context.Context, in *ExportUserHabitsRequest, opts ...grpc.CallOption) (*ExportUserHabitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserHabitsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ExportUserHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ReorderHabits(ctx context.Context, in *ReorderHabitsRequest, opts ...grpc.CallOption) (*ReorderHabitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderHabitsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ReorderHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListHabitTags(ctx context.Context, in *ListHabitTagsRequest, opts ...grpc.CallOption) (*ListHabitTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHabitTagsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListHabitTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) CreateHabitGroup(ctx context.Context, in *CreateHabitGroupRequest, opts ...grpc.CallOption) (*CreateHabitGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHabitGroupResponse)
+	err := c.cc.Invoke(ctx, HabitService_CreateHabitGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListHabitGroups(ctx context.Context, in *ListHabitGroupsRequest, opts ...grpc.CallOption) (*ListHabitGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHabitGroupsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListHabitGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) UpdateHabitGroup(ctx context.Context, in *UpdateHabitGroupRequest, opts ...grpc.CallOption) (*UpdateHabitGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateHabitGroupResponse)
+	err := c.cc.Invoke(ctx, HabitService_UpdateHabitGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) DeleteHabitGroup(ctx context.Context, in *DeleteHabitGroupRequest, opts ...grpc.CallOption) (*DeleteHabitGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHabitGroupResponse)
+	err := c.cc.Invoke(ctx, HabitService_DeleteHabitGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ReorderHabitGroups(ctx context.Context, in *ReorderHabitGroupsRequest, opts ...grpc.CallOption) (*ReorderHabitGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderHabitGroupsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ReorderHabitGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListHabitTemplates(ctx context.Context, in *ListHabitTemplatesRequest, opts ...grpc.CallOption) (*ListHabitTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHabitTemplatesResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListHabitTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) CreateHabitFromTemplate(ctx context.Context, in *CreateHabitFromTemplateRequest, opts ...grpc.CallOption) (*CreateHabitFromTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHabitFromTemplateResponse)
+	err := c.cc.Invoke(ctx, HabitService_CreateHabitFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) SaveHabitAsTemplate(ctx context.Context, in *SaveHabitAsTemplateRequest, opts ...grpc.CallOption) (*SaveHabitAsTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveHabitAsTemplateResponse)
+	err := c.cc.Invoke(ctx, HabitService_SaveHabitAsTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) DeleteHabitTemplate(ctx context.Context, in *DeleteHabitTemplateRequest, opts ...grpc.CallOption) (*DeleteHabitTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHabitTemplateResponse)
+	err := c.cc.Invoke(ctx, HabitService_DeleteHabitTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) InviteHabitPartner(ctx context.Context, in *InviteHabitPartnerRequest, opts ...grpc.CallOption) (*InviteHabitPartnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteHabitPartnerResponse)
+	err := c.cc.Invoke(ctx, HabitService_InviteHabitPartner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListHabitPartners(ctx context.Context, in *ListHabitPartnersRequest, opts ...grpc.CallOption) (*ListHabitPartnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHabitPartnersResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListHabitPartners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListPartnerInvitations(ctx context.Context, in *ListPartnerInvitationsRequest, opts ...grpc.CallOption) (*ListPartnerInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPartnerInvitationsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListPartnerInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) RespondToPartnerInvitation(ctx context.Context, in *RespondToPartnerInvitationRequest, opts ...grpc.CallOption) (*RespondToPartnerInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondToPartnerInvitationResponse)
+	err := c.cc.Invoke(ctx, HabitService_RespondToPartnerInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) RemoveHabitPartner(ctx context.Context, in *RemoveHabitPartnerRequest, opts ...grpc.CallOption) (*RemoveHabitPartnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveHabitPartnerResponse)
+	err := c.cc.Invoke(ctx, HabitService_RemoveHabitPartner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListSharedHabits(ctx context.Context, in *ListSharedHabitsRequest, opts ...grpc.CallOption) (*ListSharedHabitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharedHabitsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListSharedHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) NudgeHabit(ctx context.Context, in *NudgeHabitRequest, opts ...grpc.CallOption) (*NudgeHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NudgeHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_NudgeHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) CreateChallenge(ctx context.Context, in *CreateChallengeRequest, opts ...grpc.CallOption) (*CreateChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChallengeResponse)
+	err := c.cc.Invoke(ctx, HabitService_CreateChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetChallenge(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*GetChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChallengeResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListChallenges(ctx context.Context, in *ListChallengesRequest, opts ...grpc.CallOption) (*ListChallengesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChallengesResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListChallenges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) UpdateChallenge(ctx context.Context, in *UpdateChallengeRequest, opts ...grpc.CallOption) (*UpdateChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChallengeResponse)
+	err := c.cc.Invoke(ctx, HabitService_UpdateChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) DeleteChallenge(ctx context.Context, in *DeleteChallengeRequest, opts ...grpc.CallOption) (*DeleteChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChallengeResponse)
+	err := c.cc.Invoke(ctx, HabitService_DeleteChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) JoinChallenge(ctx context.Context, in *JoinChallengeRequest, opts ...grpc.CallOption) (*JoinChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinChallengeResponse)
+	err := c.cc.Invoke(ctx, HabitService_JoinChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) LeaveChallenge(ctx context.Context, in *LeaveChallengeRequest, opts ...grpc.CallOption) (*LeaveChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveChallengeResponse)
+	err := c.cc.Invoke(ctx, HabitService_LeaveChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetChallengeLeaderboard(ctx context.Context, in *GetChallengeLeaderboardRequest, opts ...grpc.CallOption) (*GetChallengeLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChallengeLeaderboardResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetChallengeLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAchievementsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListAchievements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowUserResponse)
+	err := c.cc.Invoke(ctx, HabitService_FollowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowersResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...grpc.CallOption) (*ListFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowingResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListFollowRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) RespondToFollowRequest(ctx context.Context, in *RespondToFollowRequestRequest, opts ...grpc.CallOption) (*RespondToFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondToFollowRequestResponse)
+	err := c.cc.Invoke(ctx, HabitService_RespondToFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowUserResponse)
+	err := c.cc.Invoke(ctx, HabitService_UnfollowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) RemoveFollower(ctx context.Context, in *RemoveFollowerRequest, opts ...grpc.CallOption) (*RemoveFollowerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFollowerResponse)
+	err := c.cc.Invoke(ctx, HabitService_RemoveFollower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetActivityFeed(ctx context.Context, in *GetActivityFeedRequest, opts ...grpc.CallOption) (*GetActivityFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivityFeedResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetActivityFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetPublicProfile(ctx context.Context, in *GetPublicProfileRequest, opts ...grpc.CallOption) (*GetPublicProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicProfileResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetPublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetPublicHabit(ctx context.Context, in *GetPublicHabitRequest, opts ...grpc.CallOption) (*GetPublicHabitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicHabitResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetPublicHabit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) RotateCalendarFeedToken(ctx context.Context, in *RotateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*RotateCalendarFeedTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateCalendarFeedTokenResponse)
+	err := c.cc.Invoke(ctx, HabitService_RotateCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) RevokeCalendarFeedToken(ctx context.Context, in *RevokeCalendarFeedTokenRequest, opts ...grpc.CallOption) (*RevokeCalendarFeedTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeCalendarFeedTokenResponse)
+	err := c.cc.Invoke(ctx, HabitService_RevokeCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ImportHabits(ctx context.Context, in *ImportHabitsRequest, opts ...grpc.CallOption) (*ImportHabitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportHabitsResponse)
+	err := c.cc.Invoke(ctx, HabitService_ImportHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetHabitImport(ctx context.Context, in *GetHabitImportRequest, opts ...grpc.CallOption) (*GetHabitImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHabitImportResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetHabitImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ExportHabitHistory(ctx context.Context, in *ExportHabitHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HabitHistoryChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HabitService_ServiceDesc.Streams[0], HabitService_ExportHabitHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportHabitHistoryRequest, HabitHistoryChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HabitService_ExportHabitHistoryClient = grpc.ServerStreamingClient[HabitHistoryChunk]
+
+func (c *habitServiceClient) SaveJournalEntry(ctx context.Context, in *SaveJournalEntryRequest, opts ...grpc.CallOption) (*SaveJournalEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveJournalEntryResponse)
+	err := c.cc.Invoke(ctx, HabitService_SaveJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*GetJournalEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJournalEntryResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, HabitService_ListJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) DeleteJournalEntry(ctx context.Context, in *DeleteJournalEntryRequest, opts ...grpc.CallOption) (*DeleteJournalEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteJournalEntryResponse)
+	err := c.cc.Invoke(ctx, HabitService_DeleteJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) SearchNotes(ctx context.Context, in *SearchNotesRequest, opts ...grpc.CallOption) (*SearchNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchNotesResponse)
+	err := c.cc.Invoke(ctx, HabitService_SearchNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*UploadAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadAttachmentResponse)
+	err := c.cc.Invoke(ctx, HabitService_UploadAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttachmentChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HabitService_ServiceDesc.Streams[1], HabitService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, AttachmentChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HabitService_DownloadAttachmentClient = grpc.ServerStreamingClient[AttachmentChunk]
+
+func (c *habitServiceClient) GetAttachmentUsage(ctx context.Context, in *GetAttachmentUsageRequest, opts ...grpc.CallOption) (*GetAttachmentUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttachmentUsageResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetAttachmentUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HabitServiceServer is the server API for HabitService service.
+// All implementations must embed UnimplementedHabitServiceServer
+// for forward compatibility.
+//
+// HabitService provides habit management functionality
+type HabitServiceServer interface {
+	// CreateHabit creates a new habit
+	CreateHabit(context.Context, *CreateHabitRequest) (*CreateHabitResponse, error)
+	// GetHabit retrieves a habit by ID
+	GetHabit(context.Context, *GetHabitRequest) (*GetHabitResponse, error)
+	// ListHabits retrieves all habits for a user
+	ListHabits(context.Context, *ListHabitsRequest) (*ListHabitsResponse, error)
+	// GetTodayAgenda groups the user's active habits into due, at risk, done and upcoming,
+	// each ordered by deadline
+	GetTodayAgenda(context.Context, *GetTodayAgendaRequest) (*GetTodayAgendaResponse, error)
+	// UpdateHabit updates a habit
+	UpdateHabit(context.Context, *UpdateHabitRequest) (*UpdateHabitResponse, error)
+	// DeleteHabit archives a habit, kept for compatibility with ArchiveHabit
+	DeleteHabit(context.Context, *DeleteHabitRequest) (*DeleteHabitResponse, error)
+	// ArchiveHabit hides a habit and pauses its deadlines, streak and history are kept
+	ArchiveHabit(context.Context, *ArchiveHabitRequest) (*ArchiveHabitResponse, error)
+	// UnarchiveHabit restores an archived habit with its streak, deadlines restart from now
+	UnarchiveHabit(context.Context, *UnarchiveHabitRequest) (*UnarchiveHabitResponse, error)
+	// PurgeHabit permanently deletes an archived habit together with its confirmations
+	PurgeHabit(context.Context, *PurgeHabitRequest) (*PurgeHabitResponse, error)
+	// PauseHabits starts a vacation for one habit or all active habits of a user.
+	// Deadlines are frozen and streaks preserved until the pause ends
+	PauseHabits(context.Context, *PauseHabitsRequest) (*PauseHabitsResponse, error)
+	// ResumeHabits ends ongoing pauses early and cancels upcoming ones
+	ResumeHabits(context.Context, *ResumeHabitsRequest) (*ResumeHabitsResponse, error)
+	// ListHabitPauses retrieves pauses of a user that have not ended yet
+	ListHabitPauses(context.Context, *ListHabitPausesRequest) (*ListHabitPausesResponse, error)
+	// ConfirmHabit confirms habit completion for current period
+	ConfirmHabit(context.Context, *ConfirmHabitRequest) (*ConfirmHabitResponse, error)
+	// GetHabitHistory retrieves confirmation history for a habit
+	GetHabitHistory(context.Context, *GetHabitHistoryRequest) (*GetHabitHistoryResponse, error)
+	// GetHabitCalendar returns the state of every local date in a range, computed by the same
+	// schedule logic as GetHabitStats
+	GetHabitCalendar(context.Context, *GetHabitCalendarRequest) (*GetHabitCalendarResponse, error)
+	// GetHabitStats retrieves statistics for a habit
+	GetHabitStats(context.Context, *GetHabitStatsRequest) (*GetHabitStatsResponse, error)
+	// ExportUserHabits retrieves all data habits-service stores about a user for data export
+	ExportUserHabits(context.Context, *ExportUserHabitsRequest) (*ExportUserHabitsResponse, error)
+	// ReorderHabits moves the listed habits to the top of the manual order in the given order.
+	// Habits not listed keep their relative order after them
+	ReorderHabits(context.Context, *ReorderHabitsRequest) (*ReorderHabitsResponse, error)
+	// ListHabitTags retrieves the tags of a user with the number of habits carrying each
+	ListHabitTags(context.Context, *ListHabitTagsRequest) (*ListHabitTagsResponse, error)
+	// CreateHabitGroup creates a group of habits, e.g. "Morning routine"
+	CreateHabitGroup(context.Context, *CreateHabitGroupRequest) (*CreateHabitGroupResponse, error)
+	// ListHabitGroups retrieves all groups of a user in manual order
+	ListHabitGroups(context.Context, *ListHabitGroupsRequest) (*ListHabitGroupsResponse, error)
+	// UpdateHabitGroup renames or restyles a group
+	UpdateHabitGroup(context.Context, *UpdateHabitGroupRequest) (*UpdateHabitGroupResponse, error)
+	// DeleteHabitGroup deletes a group, its habits become ungrouped
+	DeleteHabitGroup(context.Context, *DeleteHabitGroupRequest) (*DeleteHabitGroupResponse, error)
+	// ReorderHabitGroups sets the manual order of groups the same way as ReorderHabits
+	ReorderHabitGroups(context.Context, *ReorderHabitGroupsRequest) (*ReorderHabitGroupsResponse, error)
+	// ListHabitTemplates retrieves the template catalog followed by the user's private templates
+	ListHabitTemplates(context.Context, *ListHabitTemplatesRequest) (*ListHabitTemplatesResponse, error)
+	// CreateHabitFromTemplate creates a habit with the name, schedule and style of a template
+	CreateHabitFromTemplate(context.Context, *CreateHabitFromTemplateRequest) (*CreateHabitFromTemplateResponse, error)
+	// SaveHabitAsTemplate saves one of the user's habits as a private template
+	SaveHabitAsTemplate(context.Context, *SaveHabitAsTemplateRequest) (*SaveHabitAsTemplateResponse, error)
+	// DeleteHabitTemplate deletes a private template, catalog templates can't be deleted
+	DeleteHabitTemplate(context.Context, *DeleteHabitTemplateRequest) (*DeleteHabitTemplateResponse, error)
+	// InviteHabitPartner invites another user by username as an accountability partner on a habit.
+	// Accepted partners can read the habit, its stats and calendar, and nudge the owner
+	InviteHabitPartner(context.Context, *InviteHabitPartnerRequest) (*InviteHabitPartnerResponse, error)
+	// ListHabitPartners retrieves the partners and pending invitations of a habit for its owner
+	ListHabitPartners(context.Context, *ListHabitPartnersRequest) (*ListHabitPartnersResponse, error)
+	// ListPartnerInvitations retrieves the pending invitations sent to a user
+	ListPartnerInvitations(context.Context, *ListPartnerInvitationsRequest) (*ListPartnerInvitationsResponse, error)
+	// RespondToPartnerInvitation accepts or declines an invitation
+	RespondToPartnerInvitation(context.Context, *RespondToPartnerInvitationRequest) (*RespondToPartnerInvitationResponse, error)
+	// RemoveHabitPartner ends a partnership or withdraws an invitation.
+	// Allowed for both the owner of the habit and the partner
+	RemoveHabitPartner(context.Context, *RemoveHabitPartnerRequest) (*RemoveHabitPartnerResponse, error)
+	// ListSharedHabits retrieves the active habits a user is an accepted partner on
+	ListSharedHabits(context.Context, *ListSharedHabitsRequest) (*ListSharedHabitsResponse, error)
+	// NudgeHabit sends the owner of a habit a reminder from one of its partners
+	NudgeHabit(context.Context, *NudgeHabitRequest) (*NudgeHabitResponse, error)
+	// CreateChallenge creates a time-boxed challenge with an invite code to share
+	CreateChallenge(context.Context, *CreateChallengeRequest) (*CreateChallengeResponse, error)
+	// GetChallenge retrieves a challenge for its creator or a participant
+	GetChallenge(context.Context, *GetChallengeRequest) (*GetChallengeResponse, error)
+	// ListChallenges retrieves the challenges a user created or joined, latest start first
+	ListChallenges(context.Context, *ListChallengesRequest) (*ListChallengesResponse, error)
+	// UpdateChallenge updates a challenge of its creator. Changing the window recalculates the leaderboard
+	UpdateChallenge(context.Context, *UpdateChallengeRequest) (*UpdateChallengeResponse, error)
+	// DeleteChallenge deletes a challenge of its creator with its leaderboard
+	DeleteChallenge(context.Context, *DeleteChallengeRequest) (*DeleteChallengeResponse, error)
+	// JoinChallenge joins a challenge by invite code with one of the user's active habits.
+	// Confirmations of the habit for dates inside the window count toward the leaderboard
+	JoinChallenge(context.Context, *JoinChallengeRequest) (*JoinChallengeResponse, error)
+	// LeaveChallenge removes the user from a challenge and its leaderboard
+	LeaveChallenge(context.Context, *LeaveChallengeRequest) (*LeaveChallengeResponse, error)
+	// GetChallengeLeaderboard ranks participants by completions in the window, then by current streak,
+	// and includes the position of the requesting user
+	GetChallengeLeaderboard(context.Context, *GetChallengeLeaderboardRequest) (*GetChallengeLeaderboardResponse, error)
+	// ListAchievements retrieves the XP and level of a user with every achievement, unlocked or locked.
+	// XP comes from confirmations and unlocked achievements
+	ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error)
+	// FollowUser sends a follow request to another user by username.
+	// Accepted followers see the user's friends-only habits and get them in their activity feed
+	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
+	// ListFollowers retrieves the accepted followers of a user
+	ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersResponse, error)
+	// ListFollowing retrieves the users a user follows, including requests awaiting an answer
+	ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingResponse, error)
+	// ListFollowRequests retrieves the follow requests awaiting the user's answer
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error)
+	// RespondToFollowRequest accepts or declines a follow request. Declined requests are deleted
+	RespondToFollowRequest(context.Context, *RespondToFollowRequestRequest) (*RespondToFollowRequestResponse, error)
+	// UnfollowUser stops following a user or withdraws a follow request
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error)
+	// RemoveFollower removes one of the user's followers
+	RemoveFollower(context.Context, *RemoveFollowerRequest) (*RemoveFollowerResponse, error)
+	// GetActivityFeed retrieves confirmations and milestones of followed users, newest first.
+	// Items of habits that are private now or of users no longer followed are left out
+	GetActivityFeed(context.Context, *GetActivityFeedRequest) (*GetActivityFeedResponse, error)
+	// GetPublicProfile retrieves the public profile of a user with their active public habits.
+	// Users who have not opted in to a public profile are not found
+	GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileResponse, error)
+	// GetPublicHabit retrieves a single active public habit from a public profile, e.g. for a streak card
+	GetPublicHabit(context.Context, *GetPublicHabitRequest) (*GetPublicHabitResponse, error)
+	// RotateCalendarFeedToken creates a new secret token for the ICS subscription of a user.
+	// The previous token stops working, the token can't be retrieved again later
+	RotateCalendarFeedToken(context.Context, *RotateCalendarFeedTokenRequest) (*RotateCalendarFeedTokenResponse, error)
+	// RevokeCalendarFeedToken disables the ICS subscription of a user
+	RevokeCalendarFeedToken(context.Context, *RevokeCalendarFeedTokenRequest) (*RevokeCalendarFeedTokenResponse, error)
+	// GetCalendarFeed renders the active habits of a token's user as an iCalendar document.
+	// Every habit is a recurring event on its due dates, completed occurrences are marked confirmed
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error)
+	// ImportHabits maps an export of Loop Habit Tracker, Habitica or Streaks to habits with their history.
+	// A dry run only reports the mapping and skipped rows. Otherwise the habits are imported by a background
+	// job, at most one per user at a time
+	ImportHabits(context.Context, *ImportHabitsRequest) (*ImportHabitsResponse, error)
+	// GetHabitImport retrieves the progress and issues of an import job
+	GetHabitImport(context.Context, *GetHabitImportRequest) (*GetHabitImportResponse, error)
+	// ExportHabitHistory streams the habits of a user and their confirmations over a date range
+	// as a CSV or JSON file. Validation errors arrive before the first chunk
+	ExportHabitHistory(*ExportHabitHistoryRequest, grpc.ServerStreamingServer[HabitHistoryChunk]) error
+	// SaveJournalEntry creates or replaces the journal entry of a day, optionally linked to the day's confirmations
+	SaveJournalEntry(context.Context, *SaveJournalEntryRequest) (*SaveJournalEntryResponse, error)
+	// GetJournalEntry retrieves the journal entry of a day
+	GetJournalEntry(context.Context, *GetJournalEntryRequest) (*GetJournalEntryResponse, error)
+	// ListJournalEntries retrieves the journal entries within a date range, optionally with a tag
+	ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error)
+	// DeleteJournalEntry deletes the journal entry of a day
+	DeleteJournalEntry(context.Context, *DeleteJournalEntryRequest) (*DeleteJournalEntryResponse, error)
+	// SearchNotes runs a full-text search over journal entries and confirmation notes
+	SearchNotes(context.Context, *SearchNotesRequest) (*SearchNotesResponse, error)
+	// UploadAttachment stores a JPEG or PNG photo with a thumbnail, counted against the user's quota.
+	// Uploads not attached to a confirmation within a day are deleted
+	UploadAttachment(context.Context, *UploadAttachmentRequest) (*UploadAttachmentResponse, error)
+	// DownloadAttachment streams a photo or its thumbnail to its owner, or to users who can read the habit
+	// of its confirmation. Errors arrive before the first chunk
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[AttachmentChunk]) error
+	// GetAttachmentUsage retrieves the storage used by the photos of a user and the quota
+	GetAttachmentUsage(context.Context, *GetAttachmentUsageRequest) (*GetAttachmentUsageResponse, error)
+	mustEmbedUnimplementedHabitServiceServer()
+}
+
+// UnimplementedHabitServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHabitServiceServer struct{}
+
+func (UnimplementedHabitServiceServer) CreateHabit(context.Context, *CreateHabitRequest) (*CreateHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) GetHabit(context.Context, *GetHabitRequest) (*GetHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) ListHabits(context.Context, *ListHabitsRequest) (*ListHabitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHabits not implemented")
+}
+func (UnimplementedHabitServiceServer) GetTodayAgenda(context.Context, *GetTodayAgendaRequest) (*GetTodayAgendaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTodayAgenda not implemented")
+}
+func (UnimplementedHabitServiceServer) UpdateHabit(context.Context, *UpdateHabitRequest) (*UpdateHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) DeleteHabit(context.Context, *DeleteHabitRequest) (*DeleteHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) ArchiveHabit(context.Context, *ArchiveHabitRequest) (*ArchiveHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) UnarchiveHabit(context.Context, *UnarchiveHabitRequest) (*UnarchiveHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) PurgeHabit(context.Context, *PurgeHabitRequest) (*PurgeHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) PauseHabits(context.Context, *PauseHabitsRequest) (*PauseHabitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseHabits not implemented")
+}
+func (UnimplementedHabitServiceServer) ResumeHabits(context.Context, *ResumeHabitsRequest) (*ResumeHabitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeHabits not implemented")
+}
+func (UnimplementedHabitServiceServer) ListHabitPauses(context.Context, *ListHabitPausesRequest) (*ListHabitPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHabitPauses not implemented")
+}
+func (UnimplementedHabitServiceServer) ConfirmHabit(context.Context, *ConfirmHabitRequest) (*ConfirmHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) GetHabitHistory(context.Context, *GetHabitHistoryRequest) (*GetHabitHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHabitHistory not implemented")
+}
+func (UnimplementedHabitServiceServer) GetHabitCalendar(context.Context, *GetHabitCalendarRequest) (*GetHabitCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHabitCalendar not implemented")
+}
+func (UnimplementedHabitServiceServer) GetHabitStats(context.Context, *GetHabitStatsRequest) (*GetHabitStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHabitStats not implemented")
+}
+func (UnimplementedHabitServiceServer) ExportUserHabits(context.Context, *ExportUserHabitsRequest) (*ExportUserHabitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserHabits not implemented")
+}
+func (UnimplementedHabitServiceServer) ReorderHabits(context.Context, *ReorderHabitsRequest) (*ReorderHabitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderHabits not implemented")
+}
+func (UnimplementedHabitServiceServer) ListHabitTags(context.Context, *ListHabitTagsRequest) (*ListHabitTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHabitTags not implemented")
+}
+func (UnimplementedHabitServiceServer) CreateHabitGroup(context.Context, *CreateHabitGroupRequest) (*CreateHabitGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHabitGroup not implemented")
+}
+func (UnimplementedHabitServiceServer) ListHabitGroups(context.Context, *ListHabitGroupsRequest) (*ListHabitGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHabitGroups not implemented")
+}
+func (UnimplementedHabitServiceServer) UpdateHabitGroup(context.Context, *UpdateHabitGroupRequest) (*UpdateHabitGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHabitGroup not implemented")
+}
+func (UnimplementedHabitServiceServer) DeleteHabitGroup(context.Context, *DeleteHabitGroupRequest) (*DeleteHabitGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHabitGroup not implemented")
+}
+func (UnimplementedHabitServiceServer) ReorderHabitGroups(context.Context, *ReorderHabitGroupsRequest) (*ReorderHabitGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderHabitGroups not implemented")
+}
+func (UnimplementedHabitServiceServer) ListHabitTemplates(context.Context, *ListHabitTemplatesRequest) (*ListHabitTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHabitTemplates not implemented")
+}
+func (UnimplementedHabitServiceServer) CreateHabitFromTemplate(context.Context, *CreateHabitFromTemplateRequest) (*CreateHabitFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHabitFromTemplate not implemented")
+}
+func (UnimplementedHabitServiceServer) SaveHabitAsTemplate(context.Context, *SaveHabitAsTemplateRequest) (*SaveHabitAsTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveHabitAsTemplate not implemented")
+}
+func (UnimplementedHabitServiceServer) DeleteHabitTemplate(context.Context, *DeleteHabitTemplateRequest) (*DeleteHabitTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHabitTemplate not implemented")
+}
+func (UnimplementedHabitServiceServer) InviteHabitPartner(context.Context, *InviteHabitPartnerRequest) (*InviteHabitPartnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteHabitPartner not implemented")
+}
+func (UnimplementedHabitServiceServer) ListHabitPartners(context.Context, *ListHabitPartnersRequest) (*ListHabitPartnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHabitPartners not implemented")
+}
+func (UnimplementedHabitServiceServer) ListPartnerInvitations(context.Context, *ListPartnerInvitationsRequest) (*ListPartnerInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPartnerInvitations not implemented")
+}
+func (UnimplementedHabitServiceServer) RespondToPartnerInvitation(context.Context, *RespondToPartnerInvitationRequest) (*RespondToPartnerInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToPartnerInvitation not implemented")
+}
+func (UnimplementedHabitServiceServer) RemoveHabitPartner(context.Context, *RemoveHabitPartnerRequest) (*RemoveHabitPartnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHabitPartner not implemented")
+}
+func (UnimplementedHabitServiceServer) ListSharedHabits(context.Context, *ListSharedHabitsRequest) (*ListSharedHabitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedHabits not implemented")
+}
+func (UnimplementedHabitServiceServer) NudgeHabit(context.Context, *NudgeHabitRequest) (*NudgeHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NudgeHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) CreateChallenge(context.Context, *CreateChallengeRequest) (*CreateChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChallenge not implemented")
+}
+func (UnimplementedHabitServiceServer) GetChallenge(context.Context, *GetChallengeRequest) (*GetChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallenge not implemented")
+}
+func (UnimplementedHabitServiceServer) ListChallenges(context.Context, *ListChallengesRequest) (*ListChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChallenges not implemented")
+}
+func (UnimplementedHabitServiceServer) UpdateChallenge(context.Context, *UpdateChallengeRequest) (*UpdateChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChallenge not implemented")
+}
+func (UnimplementedHabitServiceServer) DeleteChallenge(context.Context, *DeleteChallengeRequest) (*DeleteChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChallenge not implemented")
+}
+func (UnimplementedHabitServiceServer) JoinChallenge(context.Context, *JoinChallengeRequest) (*JoinChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChallenge not implemented")
+}
+func (UnimplementedHabitServiceServer) LeaveChallenge(context.Context, *LeaveChallengeRequest) (*LeaveChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveChallenge not implemented")
+}
+func (UnimplementedHabitServiceServer) GetChallengeLeaderboard(context.Context, *GetChallengeLeaderboardRequest) (*GetChallengeLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallengeLeaderboard not implemented")
+}
+func (UnimplementedHabitServiceServer) ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAchievements not implemented")
+}
+func (UnimplementedHabitServiceServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedHabitServiceServer) ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedHabitServiceServer) ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedHabitServiceServer) ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedHabitServiceServer) RespondToFollowRequest(context.Context, *RespondToFollowRequestRequest) (*RespondToFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToFollowRequest not implemented")
+}
+func (UnimplementedHabitServiceServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedHabitServiceServer) RemoveFollower(context.Context, *RemoveFollowerRequest) (*RemoveFollowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFollower not implemented")
+}
+func (UnimplementedHabitServiceServer) GetActivityFeed(context.Context, *GetActivityFeedRequest) (*GetActivityFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityFeed not implemented")
+}
+func (UnimplementedHabitServiceServer) GetPublicProfile(context.Context, *GetPublicProfileRequest) (*GetPublicProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicProfile not implemented")
+}
+func (UnimplementedHabitServiceServer) GetPublicHabit(context.Context, *GetPublicHabitRequest) (*GetPublicHabitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicHabit not implemented")
+}
+func (UnimplementedHabitServiceServer) RotateCalendarFeedToken(context.Context, *RotateCalendarFeedTokenRequest) (*RotateCalendarFeedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCalendarFeedToken not implemented")
+}
+func (UnimplementedHabitServiceServer) RevokeCalendarFeedToken(context.Context, *RevokeCalendarFeedTokenRequest) (*RevokeCalendarFeedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCalendarFeedToken not implemented")
+}
+func (UnimplementedHabitServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedHabitServiceServer) ImportHabits(context.Context, *ImportHabitsRequest) (*ImportHabitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportHabits not implemented")
+}
+func (UnimplementedHabitServiceServer) GetHabitImport(context.Context, *GetHabitImportRequest) (*GetHabitImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHabitImport not implemented")
+}
+func (UnimplementedHabitServiceServer) ExportHabitHistory(*ExportHabitHistoryRequest, grpc.ServerStreamingServer[HabitHistoryChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportHabitHistory not implemented")
+}
+func (UnimplementedHabitServiceServer) SaveJournalEntry(context.Context, *SaveJournalEntryRequest) (*SaveJournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveJournalEntry not implemented")
+}
+func (UnimplementedHabitServiceServer) GetJournalEntry(context.Context, *GetJournalEntryRequest) (*GetJournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalEntry not implemented")
+}
+func (UnimplementedHabitServiceServer) ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalEntries not implemented")
+}
+func (UnimplementedHabitServiceServer) DeleteJournalEntry(context.Context, *DeleteJournalEntryRequest) (*DeleteJournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJournalEntry not implemented")
+}
+func (UnimplementedHabitServiceServer) SearchNotes(context.Context, *SearchNotesRequest) (*SearchNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNotes not implemented")
+}
+func (UnimplementedHabitServiceServer) UploadAttachment(context.Context, *UploadAttachmentRequest) (*UploadAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedHabitServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[AttachmentChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedHabitServiceServer) GetAttachmentUsage(context.Context, *GetAttachmentUsageRequest) (*GetAttachmentUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentUsage not implemented")
+}
+func (UnimplementedHabitServiceServer) mustEmbedUnimplementedHabitServiceServer() {}
+func (UnimplementedHabitServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeHabitServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HabitServiceServer will
+// result in compilation errors.
+type UnsafeHabitServiceServer interface {
+	mustEmbedUnimplementedHabitServiceServer()
+}
+
+func RegisterHabitServiceServer(s grpc.ServiceRegistrar, srv HabitServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHabitServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HabitService_ServiceDesc, srv)
+}
+
+func _HabitService_CreateHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).CreateHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_CreateHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).CreateHabit(ctx, req.(*CreateHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetHabit(ctx, req.(*GetHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListHabits(ctx, req.(*ListHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetTodayAgenda_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodayAgendaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetTodayAgenda(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetTodayAgenda_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetTodayAgenda(ctx, req.(*GetTodayAgendaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_UpdateHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).UpdateHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_UpdateHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).UpdateHabit(ctx, req.(*UpdateHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_DeleteHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).DeleteHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_DeleteHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).DeleteHabit(ctx, req.(*DeleteHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ArchiveHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ArchiveHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ArchiveHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ArchiveHabit(ctx, req.(*ArchiveHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_UnarchiveHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).UnarchiveHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_UnarchiveHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).UnarchiveHabit(ctx, req.(*UnarchiveHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_PurgeHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).PurgeHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_PurgeHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).PurgeHabit(ctx, req.(*PurgeHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_PauseHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).PauseHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_PauseHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).PauseHabits(ctx, req.(*PauseHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ResumeHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ResumeHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ResumeHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ResumeHabits(ctx, req.(*ResumeHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListHabitPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHabitPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListHabitPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListHabitPauses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListHabitPauses(ctx, req.(*ListHabitPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ConfirmHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ConfirmHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ConfirmHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ConfirmHabit(ctx, req.(*ConfirmHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetHabitHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHabitHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetHabitHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetHabitHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetHabitHistory(ctx, req.(*GetHabitHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetHabitCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHabitCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetHabitCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetHabitCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetHabitCalendar(ctx, req.(*GetHabitCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetHabitStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHabitStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetHabitStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetHabitStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetHabitStats(ctx, req.(*GetHabitStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ExportUserHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ExportUserHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ExportUserHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ExportUserHabits(ctx, req.(*ExportUserHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ReorderHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ReorderHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ReorderHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ReorderHabits(ctx, req.(*ReorderHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListHabitTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHabitTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListHabitTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListHabitTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListHabitTags(ctx, req.(*ListHabitTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_CreateHabitGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHabitGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).CreateHabitGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_CreateHabitGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).CreateHabitGroup(ctx, req.(*CreateHabitGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListHabitGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHabitGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListHabitGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListHabitGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListHabitGroups(ctx, req.(*ListHabitGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_UpdateHabitGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHabitGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).UpdateHabitGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_UpdateHabitGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).UpdateHabitGroup(ctx, req.(*UpdateHabitGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_DeleteHabitGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHabitGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).DeleteHabitGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_DeleteHabitGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).DeleteHabitGroup(ctx, req.(*DeleteHabitGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ReorderHabitGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderHabitGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ReorderHabitGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ReorderHabitGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ReorderHabitGroups(ctx, req.(*ReorderHabitGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListHabitTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHabitTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListHabitTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListHabitTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListHabitTemplates(ctx, req.(*ListHabitTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_CreateHabitFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHabitFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).CreateHabitFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_CreateHabitFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).CreateHabitFromTemplate(ctx, req.(*CreateHabitFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_SaveHabitAsTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveHabitAsTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).SaveHabitAsTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_SaveHabitAsTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).SaveHabitAsTemplate(ctx, req.(*SaveHabitAsTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_DeleteHabitTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHabitTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).DeleteHabitTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_DeleteHabitTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).DeleteHabitTemplate(ctx, req.(*DeleteHabitTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_InviteHabitPartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteHabitPartnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).InviteHabitPartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_InviteHabitPartner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).InviteHabitPartner(ctx, req.(*InviteHabitPartnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListHabitPartners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHabitPartnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListHabitPartners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListHabitPartners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListHabitPartners(ctx, req.(*ListHabitPartnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListPartnerInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartnerInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListPartnerInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListPartnerInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListPartnerInvitations(ctx, req.(*ListPartnerInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_RespondToPartnerInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToPartnerInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).RespondToPartnerInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_RespondToPartnerInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).RespondToPartnerInvitation(ctx, req.(*RespondToPartnerInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_RemoveHabitPartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveHabitPartnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).RemoveHabitPartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_RemoveHabitPartner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).RemoveHabitPartner(ctx, req.(*RemoveHabitPartnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListSharedHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListSharedHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListSharedHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListSharedHabits(ctx, req.(*ListSharedHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_NudgeHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NudgeHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).NudgeHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_NudgeHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).NudgeHabit(ctx, req.(*NudgeHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_CreateChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).CreateChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_CreateChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).CreateChallenge(ctx, req.(*CreateChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetChallenge(ctx, req.(*GetChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListChallenges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListChallenges(ctx, req.(*ListChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_UpdateChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).UpdateChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_UpdateChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).UpdateChallenge(ctx, req.(*UpdateChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_DeleteChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).DeleteChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_DeleteChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).DeleteChallenge(ctx, req.(*DeleteChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_JoinChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).JoinChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_JoinChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).JoinChallenge(ctx, req.(*JoinChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_LeaveChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).LeaveChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_LeaveChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).LeaveChallenge(ctx, req.(*LeaveChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetChallengeLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetChallengeLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetChallengeLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetChallengeLeaderboard(ctx, req.(*GetChallengeLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListAchievements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListAchievements(ctx, req.(*ListAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListFollowers(ctx, req.(*ListFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListFollowing(ctx, req.(*ListFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_RespondToFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).RespondToFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_RespondToFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).RespondToFollowRequest(ctx, req.(*RespondToFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_RemoveFollower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFollowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).RemoveFollower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_RemoveFollower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).RemoveFollower(ctx, req.(*RemoveFollowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetActivityFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetActivityFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetActivityFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetActivityFeed(ctx, req.(*GetActivityFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetPublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetPublicProfile(ctx, req.(*GetPublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetPublicHabit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicHabitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetPublicHabit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetPublicHabit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetPublicHabit(ctx, req.(*GetPublicHabitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_RotateCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).RotateCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_RotateCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).RotateCalendarFeedToken(ctx, req.(*RotateCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_RevokeCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).RevokeCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_RevokeCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).RevokeCalendarFeedToken(ctx, req.(*RevokeCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ImportHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ImportHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ImportHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ImportHabits(ctx, req.(*ImportHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetHabitImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHabitImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetHabitImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetHabitImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetHabitImport(ctx, req.(*GetHabitImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ExportHabitHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportHabitHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HabitServiceServer).ExportHabitHistory(m, &grpc.GenericServerStream[ExportHabitHistoryRequest, HabitHistoryChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HabitService_ExportHabitHistoryServer = grpc.ServerStreamingServer[HabitHistoryChunk]
+
+func _HabitService_SaveJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).SaveJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_SaveJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).SaveJournalEntry(ctx, req.(*SaveJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetJournalEntry(ctx, req.(*GetJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_ListJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).ListJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_ListJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).ListJournalEntries(ctx, req.(*ListJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_DeleteJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).DeleteJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_DeleteJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).DeleteJournalEntry(ctx, req.(*DeleteJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_SearchNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).SearchNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_SearchNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).SearchNotes(ctx, req.(*SearchNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_UploadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).UploadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_UploadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).UploadAttachment(ctx, req.(*UploadAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HabitServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, AttachmentChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HabitService_DownloadAttachmentServer = grpc.ServerStreamingServer[AttachmentChunk]
+
+func _HabitService_GetAttachmentUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetAttachmentUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetAttachmentUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetAttachmentUsage(ctx, req.(*GetAttachmentUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HabitService_ServiceDesc is the grpc.ServiceDesc for HabitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HabitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "habits.v1.HabitService",
+	HandlerType: (*HabitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateHabit",
+			Handler:    _HabitService_CreateHabit_Handler,
+		},
+		{
+			MethodName: "GetHabit",
+			Handler:    _HabitService_GetHabit_Handler,
+		},
+		{
+			MethodName: "ListHabits",
+			Handler:    _HabitService_ListHabits_Handler,
+		},
+		{
+			MethodName: "GetTodayAgenda",
+			Handler:    _HabitService_GetTodayAgenda_Handler,
+		},
+		{
+			MethodName: "UpdateHabit",
+			Handler:    _HabitService_UpdateHabit_Handler,
+		},
+		{
+			MethodName: "DeleteHabit",
+			Handler:    _HabitService_DeleteHabit_Handler,
+		},
+		{
+			MethodName: "ArchiveHabit",
+			Handler:    _HabitService_ArchiveHabit_Handler,
+		},
+		{
+			MethodName: "UnarchiveHabit",
+			Handler:    _HabitService_UnarchiveHabit_Handler,
+		},
+		{
+			MethodName: "PurgeHabit",
+			Handler:    _HabitService_PurgeHabit_Handler,
+		},
+		{
+			MethodName: "PauseHabits",
+			Handler:    _HabitService_PauseHabits_Handler,
+		},
+		{
+			MethodName: "ResumeHabits",
+			Handler:    _HabitService_ResumeHabits_Handler,
+		},
+		{
+			MethodName: "ListHabitPauses",
+			Handler:    _HabitService_ListHabitPauses_Handler,
+		},
+		{
+			MethodName: "ConfirmHabit",
+			Handler:    _HabitService_ConfirmHabit_Handler,
+		},
+		{
+			MethodName: "GetHabitHistory",
+			Handler:    _HabitService_GetHabitHistory_Handler,
+		},
+		{
+			MethodName: "GetHabitCalendar",
+			Handler:    _HabitService_GetHabitCalendar_Handler,
+		},
+		{
+			MethodName: "GetHabitStats",
+			Handler:    _HabitService_GetHabitStats_Handler,
+		},
+		{
+			MethodName: "ExportUserHabits",
+			Handler:    _HabitService_ExportUserHabits_Handler,
+		},
+		{
+			MethodName: "ReorderHabits",
+			Handler:    _HabitService_ReorderHabits_Handler,
+		},
+		{
+			MethodName: "ListHabitTags",
+			Handler:    _HabitService_ListHabitTags_Handler,
+		},
+		{
+			MethodName: "CreateHabitGroup",
+			Handler:    _HabitService_CreateHabitGroup_Handler,
+		},
+		{
+			MethodName: "ListHabitGroups",
+			Handler:    _HabitService_ListHabitGroups_Handler,
+		},
+		{
+			MethodName: "UpdateHabitGroup",
+			Handler:    _HabitService_UpdateHabitGroup_Handler,
+		},
+		{
+			MethodName: "DeleteHabitGroup",
+			Handler:    _HabitService_DeleteHabitGroup_Handler,
+		},
+		{
+			MethodName: "ReorderHabitGroups",
+			Handler:    _HabitService_ReorderHabitGroups_Handler,
+		},
+		{
+			MethodName: "ListHabitTemplates",
+			Handler:    _HabitService_ListHabitTemplates_Handler,
+		},
+		{
+			MethodName: "CreateHabitFromTemplate",
+			Handler:    _HabitService_CreateHabitFromTemplate_Handler,
+		},
+		{
+			MethodName: "SaveHabitAsTemplate",
+			Handler:    _HabitService_SaveHabitAsTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteHabitTemplate",
+			Handler:    _HabitService_DeleteHabitTemplate_Handler,
+		},
+		{
+			MethodName: "InviteHabitPartner",
+			Handler:    _HabitService_InviteHabitPartner_Handler,
+		},
+		{
+			MethodName: "ListHabitPartners",
+			Handler:    _HabitService_ListHabitPartners_Handler,
+		},
+		{
+			MethodName: "ListPartnerInvitations",
+			Handler:    _HabitService_ListPartnerInvitations_Handler,
+		},
+		{
+			MethodName: "RespondToPartnerInvitation",
+			Handler:    _HabitService_RespondToPartnerInvitation_Handler,
+		},
+		{
+			MethodName: "RemoveHabitPartner",
+			Handler:    _HabitService_RemoveHabitPartner_Handler,
+		},
+		{
+			MethodName: "ListSharedHabits",
+			Handler:    _HabitService_ListSharedHabits_Handler,
+		},
+		{
+			MethodName: "NudgeHabit",
+			Handler:    _HabitService_NudgeHabit_Handler,
+		},
+		{
+			MethodName: "CreateChallenge",
+			Handler:    _HabitService_CreateChallenge_Handler,
+		},
+		{
+			MethodName: "GetChallenge",
+			Handler:    _HabitService_GetChallenge_Handler,
+		},
+		{
+			MethodName: "ListChallenges",
+			Handler:    _HabitService_ListChallenges_Handler,
+		},
+		{
+			MethodName: "UpdateChallenge",
+			Handler:    _HabitService_UpdateChallenge_Handler,
+		},
+		{
+			MethodName: "DeleteChallenge",
+			Handler:    _HabitService_DeleteChallenge_Handler,
+		},
+		{
+			MethodName: "JoinChallenge",
+			Handler:    _HabitService_JoinChallenge_Handler,
+		},
+		{
+			MethodName: "LeaveChallenge",
+			Handler:    _HabitService_LeaveChallenge_Handler,
+		},
+		{
+			MethodName: "GetChallengeLeaderboard",
+			Handler:    _HabitService_GetChallengeLeaderboard_Handler,
+		},
+		{
+			MethodName: "ListAchievements",
+			Handler:    _HabitService_ListAchievements_Handler,
+		},
+		{
+			MethodName: "FollowUser",
+			Handler:    _HabitService_FollowUser_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _HabitService_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _HabitService_ListFollowing_Handler,
+		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _HabitService_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "RespondToFollowRequest",
+			Handler:    _HabitService_RespondToFollowRequest_Handler,
+		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _HabitService_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "RemoveFollower",
+			Handler:    _HabitService_RemoveFollower_Handler,
+		},
+		{
+			MethodName: "GetActivityFeed",
+			Handler:    _HabitService_GetActivityFeed_Handler,
+		},
+		{
+			MethodName: "GetPublicProfile",
+			Handler:    _HabitService_GetPublicProfile_Handler,
+		},
+		{
+			MethodName: "GetPublicHabit",
+			Handler:    _HabitService_GetPublicHabit_Handler,
+		},
+		{
+			MethodName: "RotateCalendarFeedToken",
+			Handler:    _HabitService_RotateCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "RevokeCalendarFeedToken",
+			Handler:    _HabitService_RevokeCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _HabitService_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ImportHabits",
+			Handler:    _HabitService_ImportHabits_Handler,
+		},
+		{
+			MethodName: "GetHabitImport",
+			Handler:    _HabitService_GetHabitImport_Handler,
+		},
+		{
+			MethodName: "SaveJournalEntry",
+			Handler:    _HabitService_SaveJournalEntry_Handler,
+		},
+		{
+			MethodName: "GetJournalEntry",
+			Handler:    _HabitService_GetJournalEntry_Handler,
+		},
+		{
+			MethodName: "ListJournalEntries",
+			Handler:    _HabitService_ListJournalEntries_Handler,
+		},
+		{
+			MethodName: "DeleteJournalEntry",
+			Handler:    _HabitService_DeleteJournalEntry_Handler,
+		},
+		{
+			MethodName: "SearchNotes",
+			Handler:    _HabitService_SearchNotes_Handler,
+		},
+		{
+			MethodName: "UploadAttachment",
+			Handler:    _HabitService_UploadAttachment_Handler,
+		},
+		{
+			MethodName: "GetAttachmentUsage",
+			Handler:    _HabitService_GetAttachmentUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportHabitHistory",
+			Handler:       _HabitService_ExportHabitHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _HabitService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "habits.proto",
+}
+
+const (
+	HabitAnalyticsService_GetCompletionTrend_FullMethodName   = "/habits.v1.HabitAnalyticsService/GetCompletionTrend"
+	HabitAnalyticsService_GetWeekdayBreakdown_FullMethodName  = "/habits.v1.HabitAnalyticsService/GetWeekdayBreakdown"
+	HabitAnalyticsService_GetHourDistribution_FullMethodName  = "/habits.v1.HabitAnalyticsService/GetHourDistribution"
+	HabitAnalyticsService_GetHabitCorrelations_FullMethodName = "/habits.v1.HabitAnalyticsService/GetHabitCorrelations"
+	HabitAnalyticsService_GetMoodCorrelations_FullMethodName  = "/habits.v1.HabitAnalyticsService/GetMoodCorrelations"
+)
+
+// HabitAnalyticsServiceClient is the client API for HabitAnalyticsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// HabitAnalyticsService provides analytics across all habits of a user.
+// Served from rollups updated on every confirmation and missed deadline
+type HabitAnalyticsServiceClient interface {
+	// GetCompletionTrend retrieves the completion rate over time and its direction
+	GetCompletionTrend(ctx context.Context, in *GetCompletionTrendRequest, opts ...grpc.CallOption) (*GetCompletionTrendResponse, error)
+	// GetWeekdayBreakdown retrieves the completion rate per day of the week
+	GetWeekdayBreakdown(ctx context.Context, in *GetWeekdayBreakdownRequest, opts ...grpc.CallOption) (*GetWeekdayBreakdownResponse, error)
+	// GetHourDistribution retrieves the number of confirmations per local hour of the day
+	GetHourDistribution(ctx context.Context, in *GetHourDistributionRequest, opts ...grpc.CallOption) (*GetHourDistributionResponse, error)
+	// GetHabitCorrelations retrieves habit pairs most often completed on the same day
+	GetHabitCorrelations(ctx context.Context, in *GetHabitCorrelationsRequest, opts ...grpc.CallOption) (*GetHabitCorrelationsResponse, error)
+	// GetMoodCorrelations compares the journal mood on days each habit was done and not done
+	GetMoodCorrelations(ctx context.Context, in *GetMoodCorrelationsRequest, opts ...grpc.CallOption) (*GetMoodCorrelationsResponse, error)
+}
+
+type habitAnalyticsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHabitAnalyticsServiceClient(cc grpc.ClientConnInterface) HabitAnalyticsServiceClient {
+	return &habitAnalyticsServiceClient{cc}
+}
+
+func (c *habitAnalyticsServiceClient) GetCompletionTrend(ctx context.Context, in *GetCompletionTrendRequest, opts ...grpc.CallOption) (*GetCompletionTrendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompletionTrendResponse)
+	err := c.cc.Invoke(ctx, HabitAnalyticsService_GetCompletionTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitAnalyticsServiceClient) GetWeekdayBreakdown(ctx context.Context, in *GetWeekdayBreakdownRequest, opts ...grpc.CallOption) (*GetWeekdayBreakdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWeekdayBreakdownResponse)
+	err := c.cc.Invoke(ctx, HabitAnalyticsService_GetWeekdayBreakdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitAnalyticsServiceClient) GetHourDistribution(ctx context.Context, in *GetHourDistributionRequest, opts ...grpc.CallOption) (*GetHourDistributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHourDistributionResponse)
+	err := c.cc.Invoke(ctx, HabitAnalyticsService_GetHourDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitAnalyticsServiceClient) GetHabitCorrelations(ctx context.Context, in *GetHabitCorrelationsRequest, opts ...grpc.CallOption) (*GetHabitCorrelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHabitCorrelationsResponse)
+	err := c.cc.Invoke(ctx, HabitAnalyticsService_GetHabitCorrelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitAnalyticsServiceClient) GetMoodCorrelations(ctx context.Context, in *GetMoodCorrelationsRequest, opts ...grpc.CallOption) (*GetMoodCorrelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMoodCorrelationsResponse)
+	err := c.cc.Invoke(ctx, HabitAnalyticsService_GetMoodCorrelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HabitAnalyticsServiceServer is the server API for HabitAnalyticsService service.
+// All implementations must embed UnimplementedHabitAnalyticsServiceServer
+// for forward compatibility.
+//
+// HabitAnalyticsService provides analytics across all habits of a user.
+// Served from rollups updated on every confirmation and missed deadline
+type HabitAnalyticsServiceServer interface {
+	// GetCompletionTrend retrieves the completion rate over time and its direction
+	GetCompletionTrend(context.Context, *GetCompletionTrendRequest) (*GetCompletionTrendResponse, error)
+	// GetWeekdayBreakdown retrieves the completion rate per day of the week
+	GetWeekdayBreakdown(context.Context, *GetWeekdayBreakdownRequest) (*GetWeekdayBreakdownResponse, error)
+	// GetHourDistribution retrieves the number of confirmations per local hour of the day
+	GetHourDistribution(context.Context, *GetHourDistributionRequest) (*GetHourDistributionResponse, error)
+	// GetHabitCorrelations retrieves habit pairs most often completed on the same day
+	GetHabitCorrelations(context.Context, *GetHabitCorrelationsRequest) (*GetHabitCorrelationsResponse, error)
+	// GetMoodCorrelations compares the journal mood on days each habit was done and not done
+	GetMoodCorrelations(context.Context, *GetMoodCorrelationsRequest) (*GetMoodCorrelationsResponse, error)
+	mustEmbedUnimplementedHabitAnalyticsServiceServer()
+}
+
+// UnimplementedHabitAnalyticsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHabitAnalyticsServiceServer struct{}
+
+func (UnimplementedHabitAnalyticsServiceServer) GetCompletionTrend(context.Context, *GetCompletionTrendRequest) (*GetCompletionTrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompletionTrend not implemented")
+}
+func (UnimplementedHabitAnalyticsServiceServer) GetWeekdayBreakdown(context.Context, *GetWeekdayBreakdownRequest) (*GetWeekdayBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeekdayBreakdown not implemented")
+}
+func (UnimplementedHabitAnalyticsServiceServer) GetHourDistribution(context.Context, *GetHourDistributionRequest) (*GetHourDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHourDistribution not implemented")
+}
+func (UnimplementedHabitAnalyticsServiceServer) GetHabitCorrelations(context.Context, *GetHabitCorrelationsRequest) (*GetHabitCorrelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHabitCorrelations not implemented")
+}
+func (UnimplementedHabitAnalyticsServiceServer) GetMoodCorrelations(context.Context, *GetMoodCorrelationsRequest) (*GetMoodCorrelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMoodCorrelations not implemented")
+}
+func (UnimplementedHabitAnalyticsServiceServer) mustEmbedUnimplementedHabitAnalyticsServiceServer() {}
+func (UnimplementedHabitAnalyticsServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeHabitAnalyticsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HabitAnalyticsServiceServer will
+// result in compilation errors.
+type UnsafeHabitAnalyticsServiceServer interface {
+	mustEmbedUnimplementedHabitAnalyticsServiceServer()
+}
+
+func RegisterHabitAnalyticsServiceServer(s grpc.ServiceRegistrar, srv HabitAnalyticsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHabitAnalyticsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HabitAnalyticsService_ServiceDesc, srv)
+}
+
+func _HabitAnalyticsService_GetCompletionTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompletionTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitAnalyticsServiceServer).GetCompletionTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitAnalyticsService_GetCompletionTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitAnalyticsServiceServer).GetCompletionTrend(ctx, req.(*GetCompletionTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitAnalyticsService_GetWeekdayBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWeekdayBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitAnalyticsServiceServer).GetWeekdayBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitAnalyticsService_GetWeekdayBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitAnalyticsServiceServer).GetWeekdayBreakdown(ctx, req.(*GetWeekdayBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitAnalyticsService_GetHourDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHourDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitAnalyticsServiceServer).GetHourDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitAnalyticsService_GetHourDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitAnalyticsServiceServer).GetHourDistribution(ctx, req.(*GetHourDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitAnalyticsService_GetHabitCorrelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHabitCorrelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitAnalyticsServiceServer).GetHabitCorrelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitAnalyticsService_GetHabitCorrelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitAnalyticsServiceServer).GetHabitCorrelations(ctx, req.(*GetHabitCorrelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitAnalyticsService_GetMoodCorrelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMoodCorrelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitAnalyticsServiceServer).GetMoodCorrelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitAnalyticsService_GetMoodCorrelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitAnalyticsServiceServer).GetMoodCorrelations(ctx, req.(*GetMoodCorrelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HabitAnalyticsService_ServiceDesc is the grpc.ServiceDesc for HabitAnalyticsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HabitAnalyticsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "habits.v1.HabitAnalyticsService",
+	HandlerType: (*HabitAnalyticsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCompletionTrend",
+			Handler:    _HabitAnalyticsService_GetCompletionTrend_Handler,
+		},
+		{
+			MethodName: "GetWeekdayBreakdown",
+			Handler:    _HabitAnalyticsService_GetWeekdayBreakdown_Handler,
+		},
+		{
+			MethodName: "GetHourDistribution",
+			Handler:    _HabitAnalyticsService_GetHourDistribution_Handler,
+		},
+		{
+			MethodName: "GetHabitCorrelations",
+			Handler:    _HabitAnalyticsService_GetHabitCorrelations_Handler,
+		},
+		{
+			MethodName: "GetMoodCorrelations",
+			Handler:    _HabitAnalyticsService_GetMoodCorrelations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "habits.proto",
+}